@@ -65,11 +65,17 @@ func addDbUpdateFlags(c *cobra.Command) {
 	c.Flags().Uint32("indexing-sync-threshold", tbtree.DefaultSyncThld, "number of new index entries between disk flushes with file sync")
 	c.Flags().Uint32("indexing-cache-size", tbtree.DefaultCacheSize, "size of the Btree node cache (number of nodes)")
 	c.Flags().Uint32("indexing-max-active-snapshots", tbtree.DefaultMaxActiveSnapshots, "maximum number of active btree snapshots")
+	c.Flags().Bool("indexing-incremental-compaction", false, "compact the index incrementally instead of dumping it in full")
+	c.Flags().Uint32("indexing-incremental-compaction-step-size", tbtree.DefaultIncrementalCompactionStepSize, "amount of node data (in bytes) released by each step of an incremental compaction")
+	c.Flags().Duration("indexing-incremental-compaction-delay", tbtree.DefaultIncrementalCompactionDelay, "delay between the steps of an incremental compaction")
 
 	c.Flags().Uint32("write-tx-header-version", 1, "set write tx header version (use 0 for compatibility with immudb 1.1, 1 for immudb 1.2+)")
 	c.Flags().Uint32("max-commit-concurrency", store.DefaultMaxConcurrency, "set the maximum commit concurrency")
 	c.Flags().Duration("sync-frequency", store.DefaultSyncFrequency, "set the fsync frequency during commit process")
 	c.Flags().Uint32("write-buffer-size", store.DefaultWriteBufferSize, "set the size of in-memory buffers for file abstractions")
+	c.Flags().String("compression-format", "none", "set the compression format of new value-log files (none, flate, gzip, lzw, zlib, zstd, lz4)")
+	c.Flags().Uint32("compression-level", uint32(store.DefaultCompressionLevel), "set the compression level of new value-log files")
+	c.Flags().String("compression-dictionary", "", "path of the zstd dictionary used to compress new value-log files")
 	c.Flags().Uint32("read-tx-pool-size", database.DefaultReadTxPoolSize, "set transaction read pool size (used for reading transaction objects)")
 	c.Flags().Bool("autoload", true, "enable database autoloading")
 	c.Flags().Duration("retention-period", 0, "duration of time to retain data in storage")
@@ -346,6 +352,25 @@ func (cl *commandline) database(cmd *cobra.Command) {
 		Args: cobra.ExactArgs(0),
 	}
 
+	compactValuesCmd := &cobra.Command{
+		Use:               "compact-values",
+		Short:             "Reclaim disk space used by values of overwritten, deleted or expired keys",
+		Example:           "compact-values",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := cl.immuClient.CompactValueLog(cl.context)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "database values successfully compacted: %d files rewritten, %d values discarded, %d bytes reclaimed\n",
+				res.CompactedFiles, res.DiscardedValues, res.ReclaimedBytes)
+			return nil
+		},
+		Args: cobra.ExactArgs(0),
+	}
+
 	truncateCmd := &cobra.Command{
 		Use:               "truncate",
 		Short:             "Truncate database (unrecoverable operation)",
@@ -391,6 +416,7 @@ func (cl *commandline) database(cmd *cobra.Command) {
 	dbCmd.AddCommand(updateCmd)
 	dbCmd.AddCommand(flushCmd)
 	dbCmd.AddCommand(compactCmd)
+	dbCmd.AddCommand(compactValuesCmd)
 	dbCmd.AddCommand(truncateCmd)
 	dbCmd.AddCommand(cl.createExportCmd())
 	dbCmd.AddCommand(cl.createImportCmd())
@@ -794,6 +820,21 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
+	ret.IndexSettings.IncrementalCompaction, err = condBool("indexing-incremental-compaction")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.IndexSettings.IncrementalCompactionStepSize, err = condUInt32("indexing-incremental-compaction-step-size")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.IndexSettings.IncrementalCompactionDelay, err = condDuration("indexing-incremental-compaction-delay")
+	if err != nil {
+		return nil, err
+	}
+
 	ret.WriteTxHeaderVersion, err = condUInt32("write-tx-header-version")
 	if err != nil {
 		return nil, err
@@ -814,6 +855,21 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
+	ret.CompressionFormat, err = condString("compression-format")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.CompressionLevel, err = condUInt32("compression-level")
+	if err != nil {
+		return nil, err
+	}
+
+	ret.CompressionDictionary, err = condString("compression-dictionary")
+	if err != nil {
+		return nil, err
+	}
+
 	ret.ReadTxPoolSize, err = condUInt32("read-tx-pool-size")
 	if err != nil {
 		return nil, err
@@ -880,6 +936,18 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 		propertiesStr = append(propertiesStr, fmt.Sprintf("write-buffer-size: %d", settings.GetWriteBufferSize().GetValue()))
 	}
 
+	if settings.CompressionFormat != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("compression-format: %s", settings.GetCompressionFormat().GetValue()))
+	}
+
+	if settings.CompressionLevel != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("compression-level: %d", settings.GetCompressionLevel().GetValue()))
+	}
+
+	if settings.CompressionDictionary != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("compression-dictionary: %s", settings.GetCompressionDictionary().GetValue()))
+	}
+
 	if settings.ReadTxPoolSize != nil {
 		propertiesStr = append(propertiesStr, fmt.Sprintf("read-tx-pool-size: %d", settings.GetMaxConcurrency().GetValue()))
 	}
@@ -219,6 +219,28 @@ func TestImmudbMtls(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestImmudbPgsqlClientCAs(t *testing.T) {
+	defer viper.Reset()
+
+	var config string
+	cmd := &cobra.Command{}
+	cmd.Flags().StringVar(&config, "config", "", "test")
+	setupDefaults(server.DefaultOptions())
+
+	viper.Set("pgsql-server-client-cas", "../../../test/mtls_certs/ca-chain.cert.pem")
+	viper.Set("pgsql-server-cert-user-map", []string{`/^(.*)\.example\.org$ \1`})
+
+	options, err := parseOptions()
+	require.NoError(t, err)
+	require.NotNil(t, options.PgsqlServerClientCAs)
+	require.Equal(t, []string{`/^(.*)\.example\.org$ \1`}, options.PgsqlServerCertUserMap)
+
+	viper.Set("pgsql-server-client-cas", "banana")
+
+	_, err = parseOptions()
+	require.Error(t, err)
+}
+
 func TestImmudbLogFile(t *testing.T) {
 	defer viper.Reset()
 
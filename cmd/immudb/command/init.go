@@ -45,6 +45,7 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Bool("replication-skip-integrity-check", options.ReplicationOptions.SkipIntegrityCheck, "disable integrity check when reading data during replication")
 	cmd.Flags().Bool("replication-wait-for-indexing", options.ReplicationOptions.WaitForIndexing, "wait for indexing to be up to date during replication")
 	cmd.Flags().Int("max-active-databases", options.MaxActiveDatabases, "the maximum number of databases that can be active simultaneously")
+	cmd.Flags().Int("key-index-commit-threshold", 1000, "number of transactions after which the key index gets committed in the background (0 = disabled)")
 
 	cmd.PersistentFlags().StringVar(&cl.config.CfgFn, "config", "", "config file (default path are configs or $HOME. Default filename is immudb.toml)")
 	cmd.Flags().String("pidfile", options.Pidfile, "pid path with filename e.g. /var/run/immudb.pid")
@@ -76,6 +77,10 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Int("web-server-port", options.WebServerPort, "web/console server port")
 	cmd.Flags().Bool("pgsql-server", true, "enable or disable pgsql server")
 	cmd.Flags().Int("pgsql-server-port", 5432, "pgsql server port")
+	cmd.Flags().StringSlice("pgsql-server-auth-methods", options.PgsqlServerAuthMethods, "authentication methods accepted by the pgsql server (scram-sha-256, md5, password, cert). Client certificates are preferred, otherwise the strongest method available for the user is used. Users created by previous versions can use scram-sha-256 and md5 once they log in with their password")
+	cmd.Flags().String("pgsql-server-client-cas", "", "certificate authorities used to verify the client certificates presented to the pgsql server, required by the cert authentication method")
+	cmd.Flags().StringSlice("pgsql-server-cert-user-map", nil, "mappings of client certificate identities (subject common name, DNS, email or URI alternative names) to users in the form '<identity> <user>', identities starting with a slash are regular expressions whose first group can be referenced as \\1 in the user name. Without mappings, an identity must match the user name")
+	cmd.Flags().Int("pgsql-server-copy-rows-per-tx", options.PgsqlServerCopyRowsPerTx, "maximum number of rows inserted by each transaction of a COPY FROM STDIN through the pgsql server")
 	cmd.Flags().Bool("pprof", false, "add pprof profiling endpoint on the metrics server")
 	cmd.Flags().Bool("s3-storage", false, "enable or disable s3 storage")
 	cmd.Flags().Bool("s3-role-enabled", false, "enable role-based authentication for s3 storage")
@@ -88,6 +93,9 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().String("s3-path-prefix", "", "s3 path prefix (multiple immudb instances can share the same bucket if they have different prefixes)")
 	cmd.Flags().Bool("s3-external-identifier", false, "use the remote identifier if there is no local identifier")
 	cmd.Flags().String("s3-instance-metadata-url", "http://169.254.169.254", "s3 instance metadata url")
+	cmd.Flags().String("encryption-algorithm", "", "algorithm used to encrypt newly created data files (aes-gcm, xchacha20-poly1305), data is not encrypted if empty. Existing files are read as they were written")
+	cmd.Flags().String("encryption-master-key-file", "", "file holding the hex-encoded 256-bit master key used to wrap the data keys of each database")
+	cmd.Flags().StringSlice("encryption-previous-master-key-files", nil, "files holding previous master keys, data keys wrapped with them are re-wrapped with the current master key")
 	cmd.Flags().Int("max-sessions", 100, "maximum number of simultaneously opened sessions")
 	cmd.Flags().Duration("max-session-inactivity-time", 3*time.Minute, "max session inactivity time is a duration after which an active session is declared inactive by the server. A session is kept active if server is still receiving requests from client (keep-alive or other methods)")
 	cmd.Flags().Duration("max-session-age-time", 0, "the current default value is infinity. max session age time is a duration after which session will be forcibly closed")
@@ -147,6 +155,10 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("web-server-port", options.WebServerPort)
 	viper.SetDefault("pgsql-server", true)
 	viper.SetDefault("pgsql-server-port", 5432)
+	viper.SetDefault("pgsql-server-auth-methods", options.PgsqlServerAuthMethods)
+	viper.SetDefault("pgsql-server-copy-rows-per-tx", options.PgsqlServerCopyRowsPerTx)
+	viper.SetDefault("pgsql-server-client-cas", "")
+	viper.SetDefault("pgsql-server-cert-user-map", []string{})
 	viper.SetDefault("pprof", false)
 	viper.SetDefault("s3-storage", false)
 	viper.SetDefault("s3-endpoint", "")
@@ -159,10 +171,14 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("s3-path-prefix", "")
 	viper.SetDefault("s3-external-identifier", false)
 	viper.SetDefault("s3-instance-metadata-url", "http://169.254.169.254")
+	viper.SetDefault("encryption-algorithm", "")
+	viper.SetDefault("encryption-master-key-file", "")
+	viper.SetDefault("encryption-previous-master-key-files", []string{})
 	viper.SetDefault("max-sessions", 100)
 	viper.SetDefault("max-session-inactivity-time", 3*time.Minute)
 	viper.SetDefault("max-session-age-time", 0)
 	viper.SetDefault("max-active-databases", options.MaxActiveDatabases)
+	viper.SetDefault("key-index-commit-threshold", 1000)
 	viper.SetDefault("session-timeout", 2*time.Minute)
 	viper.SetDefault("sessions-guard-check-interval", 1*time.Minute)
 	viper.SetDefault("logformat", logger.LogFormatText)
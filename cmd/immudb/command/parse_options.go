@@ -17,6 +17,8 @@ limitations under the License.
 package immudb
 
 import (
+	"crypto/x509"
+
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/spf13/viper"
@@ -85,6 +87,10 @@ func parseOptions() (options *server.Options, err error) {
 
 	pgsqlServer := viper.GetBool("pgsql-server")
 	pgsqlServerPort := viper.GetInt("pgsql-server-port")
+	pgsqlServerAuthMethods := viper.GetStringSlice("pgsql-server-auth-methods")
+	pgsqlServerCopyRowsPerTx := viper.GetInt("pgsql-server-copy-rows-per-tx")
+	pgsqlServerClientCAs := viper.GetString("pgsql-server-client-cas")
+	pgsqlServerCertUserMap := viper.GetStringSlice("pgsql-server-cert-user-map")
 
 	pprof := viper.GetBool("pprof")
 
@@ -93,6 +99,7 @@ func parseOptions() (options *server.Options, err error) {
 	logRequestMetadata := viper.GetBool("log-request-metadata")
 
 	maxActiveDatabases := viper.GetInt("max-active-databases")
+	keyIndexCommitThreshold := viper.GetInt("key-index-commit-threshold")
 
 	s3Storage := viper.GetBool("s3-storage")
 	s3RoleEnabled := viper.GetBool("s3-role-enabled")
@@ -119,6 +126,11 @@ func parseOptions() (options *server.Options, err error) {
 		WithS3ExternalIdentifier(s3ExternalIdentifier).
 		WithS3InstanceMetadataURL(s3MetadataURL)
 
+	encryptionOptions := server.DefaultEncryptionOptions().
+		WithAlgorithm(viper.GetString("encryption-algorithm")).
+		WithMasterKeyFile(viper.GetString("encryption-master-key-file")).
+		WithPreviousMasterKeyFiles(viper.GetStringSlice("encryption-previous-master-key-files"))
+
 	sessionOptions := sessions.DefaultOptions().
 		WithMaxSessions(viper.GetInt("max-sessions")).
 		WithSessionGuardCheckInterval(viper.GetDuration("sessions-guard-check-interval")).
@@ -131,6 +143,14 @@ func parseOptions() (options *server.Options, err error) {
 		return options, err
 	}
 
+	var pgsqlClientCAs *x509.CertPool
+	if pgsqlServerClientCAs != "" {
+		pgsqlClientCAs, err = loadCertPool(pgsqlServerClientCAs)
+		if err != nil {
+			return options, err
+		}
+	}
+
 	options = server.
 		DefaultOptions().
 		WithDir(dir).
@@ -155,6 +175,7 @@ func parseOptions() (options *server.Options, err error) {
 		WithSigningKey(signingKey).
 		WithSynced(synced).
 		WithRemoteStorageOptions(remoteStorageOptions).
+		WithEncryptionOptions(encryptionOptions).
 		WithTokenExpiryTime(tokenExpTime).
 		WithMetricsServer(metricsServer).
 		WithMetricsServerPort(metricsServerPort).
@@ -162,13 +183,18 @@ func parseOptions() (options *server.Options, err error) {
 		WithWebServerPort(webServerPort).
 		WithPgsqlServer(pgsqlServer).
 		WithPgsqlServerPort(pgsqlServerPort).
+		WithPgsqlServerAuthMethods(pgsqlServerAuthMethods).
+		WithPgsqlServerCopyRowsPerTx(pgsqlServerCopyRowsPerTx).
+		WithPgsqlServerClientCAs(pgsqlClientCAs).
+		WithPgsqlServerCertUserMap(pgsqlServerCertUserMap).
 		WithSessionOptions(sessionOptions).
 		WithPProf(pprof).
 		WithLogFormat(logFormat).
 		WithSwaggerUIEnabled(swaggerUIEnabled).
 		WithGRPCReflectionServerEnabled(grpcReflectionServerEnabled).
 		WithLogRequestMetadata(logRequestMetadata).
-		WithMaxActiveDatabases(maxActiveDatabases)
+		WithMaxActiveDatabases(maxActiveDatabases).
+		WithKeyIndexCommitThreshold(keyIndexCommitThreshold)
 
 	return options, nil
 }
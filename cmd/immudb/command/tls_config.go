@@ -79,22 +79,31 @@ func setUpTLS(pkeyPath, certPath, ca string, mtls bool, autoCert bool) (*tls.Con
 
 	// if CA is not provided there is an automatic load of local CA in os
 	if mtls && ca != "" {
-		certPool := x509.NewCertPool()
 		// Trusted store, contain the list of trusted certificates. client has to use one of this certificate to be trusted by this server
-		bs, err := os.ReadFile(ca)
+		certPool, err := loadCertPool(ca)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read client ca cert: %v", err)
-		}
-
-		ok := certPool.AppendCertsFromPEM(bs)
-		if !ok {
-			return nil, fmt.Errorf("failed to append client certs: %v", err)
+			return nil, err
 		}
 		c.ClientCAs = certPool
 	}
 	return c, nil
 }
 
+func loadCertPool(caPath string) (*x509.CertPool, error) {
+	bs, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client ca cert: %v", err)
+	}
+
+	certPool := x509.NewCertPool()
+
+	ok := certPool.AppendCertsFromPEM(bs)
+	if !ok {
+		return nil, fmt.Errorf("failed to append client certs from %s", caPath)
+	}
+	return certPool, nil
+}
+
 func loadCert(certPath, keyPath string) (*tls.Certificate, error) {
 	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
 	if err != nil {
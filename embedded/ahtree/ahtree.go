@@ -114,6 +114,7 @@ func Open(path string, opts *Options) (*AHtree, error) {
 		WithAutoSync(opts.autoSync).
 		WithFileSize(opts.fileSize).
 		WithFileMode(opts.fileMode).
+		WithEncryption(opts.encryptionAlgorithm, opts.keyRing).
 		WithMetadata(metadata.Bytes())
 
 	appFactory := opts.appFactory
@@ -52,6 +52,9 @@ type Options struct {
 
 	appFactory AppFactoryFunc
 
+	encryptionAlgorithm int
+	keyRing             appendable.KeyRing
+
 	dataCacheSlots    int
 	digestsCacheSlots int
 
@@ -174,6 +177,12 @@ func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opts
 }
 
+func (opts *Options) WithEncryption(encryptionAlgorithm int, keyRing appendable.KeyRing) *Options {
+	opts.encryptionAlgorithm = encryptionAlgorithm
+	opts.keyRing = keyRing
+	return opts
+}
+
 func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	opts.appFactory = appFactory
 	return opts
@@ -19,9 +19,13 @@ package appendable
 import (
 	"compress/flate"
 	"crypto/sha256"
+	"errors"
 	"io"
 )
 
+var ErrOverwriteNotSupported = errors.New("appendable: overwrite not supported")
+var ErrCompactionNotSupported = errors.New("appendable: compaction not supported")
+
 const DefaultCompressionFormat = NoCompression
 const DefaultCompressionLevel = BestSpeed
 
@@ -31,6 +35,16 @@ const (
 	GZipCompression
 	LZWCompression
 	ZLibCompression
+	ZStdCompression
+	LZ4Compression
+)
+
+const DefaultEncryptionAlgorithm = NoEncryption
+
+const (
+	NoEncryption = iota
+	AESGCMEncryption
+	XChaCha20Poly1305Encryption
 )
 
 const (
@@ -57,6 +71,35 @@ type Appendable interface {
 	CompressionLevel() int
 }
 
+// Overwriter is implemented by appendables able to replace already appended data in-place.
+// Overwriting never changes the size of the appendable.
+type Overwriter interface {
+	OverwriteAt(bs []byte, off int64) (int, error)
+}
+
+// KeyRing provides the data keys used to encrypt appendables.
+// Encrypted appendables record the identifier of the key employed to encrypt them,
+// thus they remain readable after a different key becomes the current one.
+type KeyRing interface {
+	// CurrentKey returns the key to be used to encrypt new appendables
+	CurrentKey() (id string, key []byte, err error)
+
+	// Key returns the key with the given identifier
+	Key(id string) (key []byte, err error)
+}
+
+// Segment is a range of appended data
+type Segment struct {
+	Off  int64
+	Size int64
+}
+
+// Compactor is implemented by appendables able to reclaim the space used by discarded data.
+// Retained data keeps its offsets while reading discarded data results in io.EOF.
+type Compactor interface {
+	Compact(off, size int64, discard []Segment) (reclaimed int64, err error)
+}
+
 func Checksum(rAt io.ReaderAt, off, n int64) (checksum [sha256.Size]byte, err error) {
 	h := sha256.New()
 	r := io.NewSectionReader(rAt, off, n)
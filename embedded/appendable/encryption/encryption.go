@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+var ErrIllegalArguments = errors.New("encryption: illegal arguments")
+var ErrUnsupportedAlgorithm = fmt.Errorf("%w: unsupported encryption algorithm", ErrIllegalArguments)
+var ErrInvalidKey = fmt.Errorf("%w: invalid key", ErrIllegalArguments)
+var ErrKeyNotFound = errors.New("encryption: key not found")
+var ErrCorruptedKeyRing = errors.New("encryption: corrupted key ring")
+
+// KeySize is the size of both master and data keys
+const KeySize = 32
+
+const derivedKeyInfo = "immudb appendable"
+
+// NewAEAD returns the authenticated cipher of the given algorithm
+func NewAEAD(algorithm int, key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	switch algorithm {
+	case appendable.AESGCMEncryption:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+
+		return cipher.NewGCM(block)
+	case appendable.XChaCha20Poly1305Encryption:
+		return chacha20poly1305.NewX(key)
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrUnsupportedAlgorithm, algorithm)
+}
+
+// IsSupportedAlgorithm returns true if the algorithm can be used to encrypt data
+func IsSupportedAlgorithm(algorithm int) bool {
+	return algorithm == appendable.AESGCMEncryption || algorithm == appendable.XChaCha20Poly1305Encryption
+}
+
+// NewKey returns a new random key
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+
+	_, err := io.ReadFull(rand.Reader, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// DeriveKey returns a key bound to the given salt, so the same data key
+// can safely be used to encrypt multiple appendables
+func DeriveKey(key, salt []byte) ([]byte, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	derivedKey := make([]byte, KeySize)
+
+	_, err := io.ReadFull(hkdf.New(sha256.New, key, salt, []byte(derivedKeyInfo)), derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return derivedKey, nil
+}
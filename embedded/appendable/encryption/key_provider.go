@@ -0,0 +1,119 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// KeyProvider supplies the master keys used to wrap data keys.
+// Master keys used to wrap existing data keys must remain available
+// until the key ring is rotated to the current master key.
+type KeyProvider interface {
+	// CurrentKeyID returns the identifier of the master key used to wrap data keys
+	CurrentKeyID() (string, error)
+
+	// Key returns the master key with the given identifier
+	Key(id string) ([]byte, error)
+}
+
+// KeyID returns the identifier of a master key, a fingerprint which does not disclose the key
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// StaticKeyProvider provides master keys held in memory
+type StaticKeyProvider struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+var _ KeyProvider = (*StaticKeyProvider)(nil)
+
+// NewStaticKeyProvider returns a provider of the given master keys,
+// the first one being the current key and the remaining ones previous keys
+func NewStaticKeyProvider(currentKey []byte, previousKeys ...[]byte) (*StaticKeyProvider, error) {
+	p := &StaticKeyProvider{
+		keys: make(map[string][]byte, 1+len(previousKeys)),
+	}
+
+	for i, key := range append([][]byte{currentKey}, previousKeys...) {
+		if len(key) != KeySize {
+			return nil, ErrInvalidKey
+		}
+
+		id := KeyID(key)
+
+		if i == 0 {
+			p.currentKeyID = id
+		}
+
+		p.keys[id] = key
+	}
+
+	return p, nil
+}
+
+func (p *StaticKeyProvider) CurrentKeyID() (string, error) {
+	return p.currentKeyID, nil
+}
+
+func (p *StaticKeyProvider) Key(id string) ([]byte, error) {
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: master key '%s'", ErrKeyNotFound, id)
+	}
+
+	return key, nil
+}
+
+// NewFileKeyProvider returns a provider of the master keys stored in the given files.
+// Each file holds a hex-encoded 256-bit key, the first file contains the current key
+// while the remaining ones contain previous keys.
+func NewFileKeyProvider(currentKeyFile string, previousKeyFiles ...string) (*StaticKeyProvider, error) {
+	keys := make([][]byte, 1+len(previousKeyFiles))
+
+	for i, keyFile := range append([]string{currentKeyFile}, previousKeyFiles...) {
+		key, err := readKeyFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = key
+	}
+
+	return NewStaticKeyProvider(keys[0], keys[1:]...)
+}
+
+func readKeyFile(keyFile string) ([]byte, error) {
+	content, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hex.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil || len(key) != KeySize {
+		return nil, fmt.Errorf("%w: key file '%s' must contain a hex-encoded %d-byte key", ErrInvalidKey, keyFile, KeySize)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/fileutils"
+)
+
+// KeyRing holds the data keys of a database, persisted wrapped with a master key.
+// Data keys are never discarded, thus data encrypted with any of them remains readable
+// after rotating either the data key or the master key, and no data needs to be rewritten.
+type KeyRing struct {
+	path     string
+	fileMode os.FileMode
+	provider KeyProvider
+
+	currentKeyID string
+	keys         map[string][]byte
+	entries      []keyRingEntry
+
+	mutex sync.RWMutex
+}
+
+var _ appendable.KeyRing = (*KeyRing)(nil)
+
+type keyRingFile struct {
+	CurrentKey string         `json:"currentKey"`
+	Keys       []keyRingEntry `json:"keys"`
+}
+
+type keyRingEntry struct {
+	ID          string    `json:"id"`
+	MasterKeyID string    `json:"masterKeyId"`
+	WrappedKey  []byte    `json:"wrappedKey"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// OpenKeyRing opens the key ring stored at the given path, creating it with a new data key
+// if it does not exist. Data keys wrapped with a master key other than the current one
+// are re-wrapped with the current master key.
+func OpenKeyRing(path string, provider KeyProvider, fileMode os.FileMode) (*KeyRing, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("%w: no key provider", ErrIllegalArguments)
+	}
+
+	kr := &KeyRing{
+		path:     path,
+		fileMode: fileMode,
+		provider: provider,
+		keys:     make(map[string][]byte),
+	}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		_, err = kr.RotateDataKey()
+		if err != nil {
+			return nil, err
+		}
+
+		return kr, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f keyRingFile
+
+	err = json.Unmarshal(content, &f)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedKeyRing, err)
+	}
+
+	for _, e := range f.Keys {
+		masterKey, err := provider.Key(e.MasterKeyID)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := unwrapKey(masterKey, e.ID, e.WrappedKey)
+		if err != nil {
+			return nil, err
+		}
+
+		kr.keys[e.ID] = key
+	}
+
+	if _, ok := kr.keys[f.CurrentKey]; !ok {
+		return nil, fmt.Errorf("%w: current key '%s' not found", ErrCorruptedKeyRing, f.CurrentKey)
+	}
+
+	kr.currentKeyID = f.CurrentKey
+	kr.entries = f.Keys
+
+	currentMasterKeyID, err := provider.CurrentKeyID()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range kr.entries {
+		if e.MasterKeyID != currentMasterKeyID {
+			return kr, kr.RotateMasterKey()
+		}
+	}
+
+	return kr, nil
+}
+
+// CurrentKey returns the data key used to encrypt new data
+func (kr *KeyRing) CurrentKey() (string, []byte, error) {
+	kr.mutex.RLock()
+	defer kr.mutex.RUnlock()
+
+	return kr.currentKeyID, kr.keys[kr.currentKeyID], nil
+}
+
+// Key returns the data key with the given identifier
+func (kr *KeyRing) Key(id string) ([]byte, error) {
+	kr.mutex.RLock()
+	defer kr.mutex.RUnlock()
+
+	key, ok := kr.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: data key '%s'", ErrKeyNotFound, id)
+	}
+
+	return key, nil
+}
+
+// RotateDataKey generates a new data key used from now on to encrypt new data,
+// data encrypted with previous keys remains readable
+func (kr *KeyRing) RotateDataKey() (string, error) {
+	kr.mutex.Lock()
+	defer kr.mutex.Unlock()
+
+	masterKeyID, masterKey, err := kr.currentMasterKey()
+	if err != nil {
+		return "", err
+	}
+
+	key, err := NewKey()
+	if err != nil {
+		return "", err
+	}
+
+	id := strconv.Itoa(len(kr.entries) + 1)
+
+	wrappedKey, err := wrapKey(masterKey, id, key)
+	if err != nil {
+		return "", err
+	}
+
+	entries := append(kr.entries, keyRingEntry{
+		ID:          id,
+		MasterKeyID: masterKeyID,
+		WrappedKey:  wrappedKey,
+		CreatedAt:   time.Now(),
+	})
+
+	err = kr.save(id, entries)
+	if err != nil {
+		return "", err
+	}
+
+	kr.keys[id] = key
+	kr.currentKeyID = id
+	kr.entries = entries
+
+	return id, nil
+}
+
+// RotateMasterKey wraps all data keys with the current master key of the provider,
+// once done previous master keys are no longer needed
+func (kr *KeyRing) RotateMasterKey() error {
+	kr.mutex.Lock()
+	defer kr.mutex.Unlock()
+
+	masterKeyID, masterKey, err := kr.currentMasterKey()
+	if err != nil {
+		return err
+	}
+
+	entries := make([]keyRingEntry, len(kr.entries))
+
+	for i, e := range kr.entries {
+		wrappedKey, err := wrapKey(masterKey, e.ID, kr.keys[e.ID])
+		if err != nil {
+			return err
+		}
+
+		entries[i] = keyRingEntry{
+			ID:          e.ID,
+			MasterKeyID: masterKeyID,
+			WrappedKey:  wrappedKey,
+			CreatedAt:   e.CreatedAt,
+		}
+	}
+
+	err = kr.save(kr.currentKeyID, entries)
+	if err != nil {
+		return err
+	}
+
+	kr.entries = entries
+
+	return nil
+}
+
+func (kr *KeyRing) currentMasterKey() (string, []byte, error) {
+	id, err := kr.provider.CurrentKeyID()
+	if err != nil {
+		return "", nil, err
+	}
+
+	key, err := kr.provider.Key(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return id, key, nil
+}
+
+// save atomically replaces the persisted key ring
+func (kr *KeyRing) save(currentKeyID string, entries []keyRingEntry) error {
+	content, err := json.Marshal(keyRingFile{
+		CurrentKey: currentKeyID,
+		Keys:       entries,
+	})
+	if err != nil {
+		return err
+	}
+
+	tmpPath := kr.path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, kr.fileMode)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(content)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	err = os.Rename(tmpPath, kr.path)
+	if err != nil {
+		return err
+	}
+
+	return fileutils.SyncDir(filepath.Dir(kr.path))
+}
+
+func wrapKey(masterKey []byte, id string, key []byte) ([]byte, error) {
+	aead, err := NewAEAD(appendable.AESGCMEncryption, masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, key, []byte(id)), nil
+}
+
+func unwrapKey(masterKey []byte, id string, wrappedKey []byte) ([]byte, error) {
+	aead, err := NewAEAD(appendable.AESGCMEncryption, masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid wrapped key '%s'", ErrCorruptedKeyRing, id)
+	}
+
+	key, err := aead.Open(nil, wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: data key '%s' can not be unwrapped", ErrCorruptedKeyRing, id)
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: invalid data key '%s'", ErrCorruptedKeyRing, id)
+	}
+
+	return key, nil
+}
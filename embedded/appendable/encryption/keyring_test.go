@@ -0,0 +1,191 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMasterKey(t *testing.T) []byte {
+	key, err := NewKey()
+	require.NoError(t, err)
+	return key
+}
+
+func TestKeyRing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keyring")
+
+	masterKey := newTestMasterKey(t)
+
+	provider, err := NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	kr, err := OpenKeyRing(path, provider, 0600)
+	require.NoError(t, err)
+
+	keyID1, key1, err := kr.CurrentKey()
+	require.NoError(t, err)
+	require.Len(t, key1, KeySize)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(content, key1))
+
+	keyID2, err := kr.RotateDataKey()
+	require.NoError(t, err)
+	require.NotEqual(t, keyID1, keyID2)
+
+	currentKeyID, key2, err := kr.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, keyID2, currentKeyID)
+	require.NotEqual(t, key1, key2)
+
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	// data keys are preserved when the master key is rotated
+	newMasterKey := newTestMasterKey(t)
+
+	provider, err = NewStaticKeyProvider(newMasterKey, masterKey)
+	require.NoError(t, err)
+
+	kr, err = OpenKeyRing(path, provider, 0600)
+	require.NoError(t, err)
+
+	key, err := kr.Key(keyID1)
+	require.NoError(t, err)
+	require.Equal(t, key1, key)
+
+	currentKeyID, key, err = kr.CurrentKey()
+	require.NoError(t, err)
+	require.Equal(t, keyID2, currentKeyID)
+	require.Equal(t, key2, key)
+
+	// the previous master key is no longer needed
+	provider, err = NewStaticKeyProvider(newMasterKey)
+	require.NoError(t, err)
+
+	kr, err = OpenKeyRing(path, provider, 0600)
+	require.NoError(t, err)
+
+	key, err = kr.Key(keyID1)
+	require.NoError(t, err)
+	require.Equal(t, key1, key)
+
+	provider, err = NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	_, err = OpenKeyRing(path, provider, 0600)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestKeyRingEdgeCases(t *testing.T) {
+	_, err := OpenKeyRing(filepath.Join(t.TempDir(), "keyring"), nil, 0600)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	path := filepath.Join(t.TempDir(), "keyring")
+
+	err = os.WriteFile(path, []byte("invalid"), 0600)
+	require.NoError(t, err)
+
+	provider, err := NewStaticKeyProvider(newTestMasterKey(t))
+	require.NoError(t, err)
+
+	_, err = OpenKeyRing(path, provider, 0600)
+	require.ErrorIs(t, err, ErrCorruptedKeyRing)
+
+	_, err = NewStaticKeyProvider([]byte("short key"))
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+
+	currentKey := newTestMasterKey(t)
+	previousKey := newTestMasterKey(t)
+
+	err := os.WriteFile(filepath.Join(dir, "current.key"), []byte(hex.EncodeToString(currentKey)+"\n"), 0600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "previous.key"), []byte(hex.EncodeToString(previousKey)), 0600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "invalid.key"), []byte("invalid"), 0600)
+	require.NoError(t, err)
+
+	provider, err := NewFileKeyProvider(filepath.Join(dir, "current.key"), filepath.Join(dir, "previous.key"))
+	require.NoError(t, err)
+
+	id, err := provider.CurrentKeyID()
+	require.NoError(t, err)
+	require.Equal(t, KeyID(currentKey), id)
+
+	key, err := provider.Key(KeyID(previousKey))
+	require.NoError(t, err)
+	require.Equal(t, previousKey, key)
+
+	_, err = provider.Key("unknown")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, err = NewFileKeyProvider(filepath.Join(dir, "invalid.key"))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewFileKeyProvider(filepath.Join(dir, "missing.key"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestNewAEAD(t *testing.T) {
+	key := newTestMasterKey(t)
+
+	for _, alg := range []int{appendable.AESGCMEncryption, appendable.XChaCha20Poly1305Encryption} {
+		require.True(t, IsSupportedAlgorithm(alg))
+
+		aead, err := NewAEAD(alg, key)
+		require.NoError(t, err)
+
+		nonce := make([]byte, aead.NonceSize())
+
+		sealed := aead.Seal(nil, nonce, []byte("data"), nil)
+
+		data, err := aead.Open(nil, nonce, sealed, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("data"), data)
+	}
+
+	require.False(t, IsSupportedAlgorithm(appendable.NoEncryption))
+
+	_, err := NewAEAD(appendable.NoEncryption, key)
+	require.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	_, err = NewAEAD(appendable.AESGCMEncryption, key[1:])
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	derivedKey1, err := DeriveKey(key, []byte("salt1"))
+	require.NoError(t, err)
+
+	derivedKey2, err := DeriveKey(key, []byte("salt2"))
+	require.NoError(t, err)
+	require.NotEqual(t, derivedKey1, derivedKey2)
+}
@@ -47,6 +47,8 @@ const (
 //---------------------------------------------------------
 
 var _ appendable.Appendable = (*MultiFileAppendable)(nil)
+var _ appendable.Overwriter = (*MultiFileAppendable)(nil)
+var _ appendable.Compactor = (*MultiFileAppendable)(nil)
 
 type MultiFileAppendableHooks interface {
 	// Hook to open underlying appendable.
@@ -109,6 +111,13 @@ type MultiFileAppendable struct {
 	readBufferSize int
 	prealloc       bool
 
+	compressionFormat int
+	compressionLevel  int
+	compressionDicts  [][]byte
+
+	encryptionAlgorithm int
+	keyRing             appendable.KeyRing
+
 	writeBuffer []byte // shared write-buffer only used by active appendable
 
 	closed bool
@@ -167,8 +176,10 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		WithFileMode(opts.fileMode).
 		WithCompressionFormat(opts.compressionFormat).
 		WithCompresionLevel(opts.compressionLevel).
+		WithCompressionDictionaries(opts.compressionDicts...).
 		WithReadBufferSize(opts.readBufferSize).
 		WithWriteBuffer(writeBuffer).
+		WithEncryption(opts.encryptionAlgorithm, opts.keyRing).
 		WithMetadata(m.Bytes())
 
 	if opts.prealloc {
@@ -203,6 +214,13 @@ func OpenWithHooks(path string, hooks MultiFileAppendableHooks, opts *Options) (
 		writeBuffer:    writeBuffer,
 		closed:         false,
 		hooks:          hooks,
+
+		compressionFormat: opts.compressionFormat,
+		compressionLevel:  opts.compressionLevel,
+		compressionDicts:  opts.compressionDicts,
+
+		encryptionAlgorithm: opts.encryptionAlgorithm,
+		keyRing:             opts.keyRing,
 	}, nil
 }
 
@@ -389,8 +407,10 @@ func (mf *MultiFileAppendable) openAppendable(appname string, createIfNotExists,
 		WithFileMode(mf.fileMode).
 		WithCreateIfNotExists(createIfNotExists).
 		WithReadBufferSize(mf.readBufferSize).
-		WithCompressionFormat(mf.currApp.CompressionFormat()).
-		WithCompresionLevel(mf.currApp.CompressionLevel()).
+		WithCompressionFormat(mf.compressionFormat).
+		WithCompresionLevel(mf.compressionLevel).
+		WithCompressionDictionaries(mf.compressionDicts...).
+		WithEncryption(mf.encryptionAlgorithm, mf.keyRing).
 		WithMetadata(mf.currApp.Metadata())
 
 	if mf.prealloc {
@@ -625,6 +645,184 @@ func (mf *MultiFileAppendable) ReadAt(bs []byte, off int64) (int, error) {
 	return r, nil
 }
 
+// OverwriteAt replaces already appended data starting at the given offset,
+// data may span multiple underlying appendables
+func (mf *MultiFileAppendable) OverwriteAt(bs []byte, off int64) (int, error) {
+	mf.mutex.Lock()
+	defer mf.mutex.Unlock()
+
+	if mf.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if mf.readOnly {
+		return 0, ErrReadOnly
+	}
+
+	if len(bs) == 0 {
+		return 0, ErrIllegalArguments
+	}
+
+	w := 0
+
+	for w < len(bs) {
+		offw := off + int64(w)
+		appID := appendableID(offw, mf.fileSize)
+
+		chunkOff := offw % int64(mf.fileSize)
+		chunkLen := minInt(len(bs)-w, mf.fileSize-int(chunkOff))
+
+		n, err := mf.overwriteChunkAt(appID, bs[w:w+chunkLen], chunkOff)
+		w += n
+		if err != nil {
+			return w, err
+		}
+	}
+
+	return w, nil
+}
+
+func (mf *MultiFileAppendable) overwriteChunkAt(appID int64, bs []byte, off int64) (int, error) {
+	app := mf.currApp
+
+	if appID != mf.currAppID {
+		// cached appendables are switched to read-only mode once they are full,
+		// thus a writable instance is opened just for the overwrite
+		var err error
+
+		app, err = mf.openAppendable(appendableName(appID, mf.fileExt), false, false)
+		if os.IsNotExist(err) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		defer app.Close()
+	}
+
+	ow, ok := app.(appendable.Overwriter)
+	if !ok {
+		return 0, appendable.ErrOverwriteNotSupported
+	}
+
+	n, err := ow.OverwriteAt(bs, off)
+	if err != nil || appID == mf.currAppID {
+		return n, err
+	}
+
+	// the active appendable is synced as usual but the temporary instance must be synced now
+	return n, app.Sync()
+}
+
+// Compact rewrites the underlying appendable holding the data in the range [off, off+size)
+// without the discarded segments. The range must match a whole underlying appendable
+// which is no longer active. Offsets are preserved, reading discarded data results in io.EOF.
+func (mf *MultiFileAppendable) Compact(off, size int64, discard []appendable.Segment) (int64, error) {
+	mf.mutex.Lock()
+
+	if mf.closed {
+		mf.mutex.Unlock()
+		return 0, ErrAlreadyClosed
+	}
+
+	if mf.readOnly {
+		mf.mutex.Unlock()
+		return 0, ErrReadOnly
+	}
+
+	appID := appendableID(off, mf.fileSize)
+
+	if off < 0 || off%int64(mf.fileSize) != 0 || size != int64(mf.fileSize) || appID >= mf.currAppID {
+		mf.mutex.Unlock()
+		return 0, fmt.Errorf("%w: only inactive appendables can be compacted", ErrIllegalArguments)
+	}
+
+	if _, ok := mf.hooks.(*DefaultMultiFileAppendableHooks); !ok {
+		mf.mutex.Unlock()
+		return 0, appendable.ErrCompactionNotSupported
+	}
+
+	mf.mutex.Unlock()
+
+	var chunkDiscard []appendable.Segment
+
+	for _, seg := range discard {
+		from := seg.Off
+		if from < off {
+			from = off
+		}
+
+		to := seg.Off + seg.Size
+		if to > off+size {
+			to = off + size
+		}
+
+		if from < to {
+			chunkDiscard = append(chunkDiscard, appendable.Segment{Off: from - off, Size: to - from})
+		}
+	}
+
+	if len(chunkDiscard) == 0 {
+		return 0, nil
+	}
+
+	appFile := filepath.Join(mf.path, appendableName(appID, mf.fileExt))
+	tmpFile := appFile + ".compacting"
+
+	// a leftover of an interrupted compaction is discarded
+	err := os.Remove(tmpFile)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	// the underlying appendable is immutable but for overwrites, which are not expected
+	// to happen concurrently with its compaction, thus it can be copied without locking
+	reclaimed, err := singleapp.CompactFile(appFile, tmpFile, chunkDiscard, mf.fileMode)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil || reclaimed == 0 {
+		return 0, err
+	}
+
+	mf.mutex.Lock()
+	defer mf.mutex.Unlock()
+
+	if mf.closed {
+		os.Remove(tmpFile)
+		return 0, ErrAlreadyClosed
+	}
+
+	// the appendable may have been discarded in the meantime
+	_, err = os.Stat(appFile)
+	if os.IsNotExist(err) {
+		return 0, os.Remove(tmpFile)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	app, err := mf.appendables.Pop(appID)
+	if err == nil {
+		err = app.Close()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = os.Rename(tmpFile, appFile)
+	if err != nil {
+		return 0, err
+	}
+
+	err = fileutils.SyncDir(mf.path)
+	if err != nil {
+		return 0, err
+	}
+
+	return reclaimed, nil
+}
+
 func (mf *MultiFileAppendable) SwitchToReadOnlyMode() error {
 	mf.mutex.Lock()
 	defer mf.mutex.Unlock()
@@ -17,6 +17,7 @@ limitations under the License.
 package multiapp
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
 	"os"
@@ -24,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 	"github.com/codenotary/immudb/embedded/appendable/singleapp"
 
 	"github.com/stretchr/testify/require"
@@ -303,6 +305,48 @@ func TestMultiAppCompression(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMultiAppMixedCompressionFormats(t *testing.T) {
+	path := t.TempDir()
+
+	data := bytes.Repeat([]byte("compressible"), 50)
+
+	var offs []int64
+
+	for _, format := range []int{
+		appendable.FlateCompression,
+		appendable.ZStdCompression,
+		appendable.LZ4Compression,
+		appendable.NoCompression,
+	} {
+		a, err := Open(path, DefaultOptions().WithFileSize(100).WithCompressionFormat(format))
+		require.NoError(t, err)
+
+		// the current file keeps its format, new files are written in the configured one
+		for i := 0; i < 3; i++ {
+			off, _, err := a.Append(data)
+			require.NoError(t, err)
+
+			offs = append(offs, off)
+		}
+
+		require.Equal(t, format, a.CompressionFormat())
+
+		err = a.Close()
+		require.NoError(t, err)
+	}
+
+	a, err := Open(path, DefaultOptions().WithFileSize(100).WithCompressionFormat(appendable.ZStdCompression))
+	require.NoError(t, err)
+	defer a.Close()
+
+	for _, off := range offs {
+		bs := make([]byte, len(data))
+		_, err = a.ReadAt(bs, off)
+		require.NoError(t, err)
+		require.Equal(t, data, bs)
+	}
+}
+
 func TestMultiAppAppendableForCurrentChunk(t *testing.T) {
 	path := t.TempDir()
 
@@ -384,3 +428,197 @@ func TestMultiAppDiscard(t *testing.T) {
 	err = a.Close()
 	require.NoError(t, err)
 }
+
+func TestMultiAppOverwrite(t *testing.T) {
+	dir := t.TempDir()
+
+	a, err := Open(dir, DefaultOptions().WithFileSize(4))
+	require.NoError(t, err)
+
+	_, err = a.OverwriteAt(nil, 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = a.Append([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	require.NoError(t, err)
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	// overwritten data spans three underlying appendables
+	n, err := a.OverwriteAt([]byte{0, 0, 0, 0, 0, 0}, 3)
+	require.NoError(t, err)
+	require.Equal(t, 6, n)
+
+	_, err = a.OverwriteAt([]byte{0, 0}, 9)
+	require.ErrorIs(t, err, io.EOF)
+
+	b := make([]byte, 10)
+	_, err = a.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 10}, b)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	a, err = Open(dir, DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	_, err = a.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 10}, b)
+
+	_, err = a.OverwriteAt([]byte{0}, 0)
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
+func TestMultiAppCompact(t *testing.T) {
+	dir := t.TempDir()
+
+	data := make([]byte, 2500)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	a, err := Open(dir, DefaultOptions().WithFileSize(1000))
+	require.NoError(t, err)
+
+	_, _, err = a.Append(data)
+	require.NoError(t, err)
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	_, err = a.Compact(500, 1000, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	// the active appendable can not be compacted
+	_, err = a.Compact(2000, 1000, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	// discarded data spans two underlying appendables, only the first one is compacted
+	reclaimed, err := a.Compact(0, 1000, []appendable.Segment{{Off: 500, Size: 400}, {Off: 1950, Size: 100}})
+	require.NoError(t, err)
+	require.Greater(t, reclaimed, int64(300))
+
+	// retained data spanning the end of the compacted appendable
+	b := make([]byte, 200)
+	_, err = a.ReadAt(b, 900)
+	require.NoError(t, err)
+	require.Equal(t, data[900:1100], b)
+
+	reclaimed, err = a.Compact(0, 1000, []appendable.Segment{{Off: 500, Size: 1000}})
+	require.NoError(t, err)
+	require.Greater(t, reclaimed, int64(50))
+
+	b = make([]byte, 500)
+	_, err = a.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, data[:500], b)
+
+	_, err = a.ReadAt(b, 500)
+	require.ErrorIs(t, err, io.EOF)
+
+	b = make([]byte, 1500)
+	_, err = a.ReadAt(b, 1000)
+	require.NoError(t, err)
+	require.Equal(t, data[1000:], b)
+
+	// nothing left to reclaim
+	reclaimed, err = a.Compact(0, 1000, []appendable.Segment{{Off: 500, Size: 500}})
+	require.NoError(t, err)
+	require.Zero(t, reclaimed)
+
+	_, _, err = a.Append([]byte{1})
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	a, err = Open(dir, DefaultOptions().WithFileSize(1000).WithReadOnly(true))
+	require.NoError(t, err)
+	defer a.Close()
+
+	sz, err := a.Size()
+	require.NoError(t, err)
+	require.EqualValues(t, 2501, sz)
+
+	b = make([]byte, 10)
+	_, err = a.ReadAt(b, 600)
+	require.ErrorIs(t, err, io.EOF)
+
+	_, err = a.Compact(0, 1000, nil)
+	require.ErrorIs(t, err, ErrReadOnly)
+}
+
+func TestMultiAppEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	masterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	provider, err := encryption.NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	keyRing, err := encryption.OpenKeyRing(filepath.Join(t.TempDir(), "keyring"), provider, 0600)
+	require.NoError(t, err)
+
+	data := make([]byte, 2500)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	// the first files are not encrypted
+	a, err := Open(dir, DefaultOptions().WithFileSize(1000))
+	require.NoError(t, err)
+
+	_, _, err = a.Append(data[:1500])
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	opts := DefaultOptions().
+		WithFileSize(1000).
+		WithEncryption(appendable.AESGCMEncryption, keyRing)
+
+	a, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	_, _, err = a.Append(data[1500:])
+	require.NoError(t, err)
+
+	_, err = keyRing.RotateDataKey()
+	require.NoError(t, err)
+
+	_, _, err = a.Append(data)
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	a, err = Open(dir, opts)
+	require.NoError(t, err)
+	defer a.Close()
+
+	b := make([]byte, 5000)
+	_, err = a.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, append(data, data...), b)
+
+	content, err := os.ReadFile(filepath.Join(dir, "00000000.aof"))
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(content, data[100:200]))
+
+	content, err = os.ReadFile(filepath.Join(dir, "00000002.aof"))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(content, data[2100:2200]))
+
+	_, err = a.Compact(2000, 1000, []appendable.Segment{{Off: 2000, Size: 500}})
+	require.ErrorIs(t, err, appendable.ErrCompactionNotSupported)
+
+	_, err = Open(dir, DefaultOptions().WithEncryption(appendable.AESGCMEncryption, nil))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
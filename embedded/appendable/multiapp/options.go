@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 )
 
 const DefaultFileSize = 1 << 26 // 64Mb
@@ -28,6 +29,7 @@ const DefaultMaxOpenedFiles = 10
 const DefaultFileMode = os.FileMode(0755)
 const DefaultCompressionFormat = appendable.DefaultCompressionFormat
 const DefaultCompressionLevel = appendable.DefaultCompressionLevel
+const DefaultEncryptionAlgorithm = appendable.DefaultEncryptionAlgorithm
 const DefaultReadBufferSize = 4096
 const DefaultWriteBufferSize = 4096
 
@@ -47,6 +49,10 @@ type Options struct {
 	maxOpenedFiles    int
 	compressionFormat int
 	compressionLevel  int
+	compressionDicts  [][]byte
+
+	encryptionAlgorithm int
+	keyRing             appendable.KeyRing
 }
 
 func DefaultOptions() *Options {
@@ -62,6 +68,8 @@ func DefaultOptions() *Options {
 		compressionLevel:  DefaultCompressionLevel,
 		readBufferSize:    DefaultReadBufferSize,
 		writeBufferSize:   DefaultWriteBufferSize,
+
+		encryptionAlgorithm: DefaultEncryptionAlgorithm,
 	}
 }
 
@@ -90,6 +98,20 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid writeBufferSize", ErrInvalidOptions)
 	}
 
+	if opts.encryptionAlgorithm != appendable.NoEncryption {
+		if !encryption.IsSupportedAlgorithm(opts.encryptionAlgorithm) {
+			return fmt.Errorf("%w: invalid encryptionAlgorithm", ErrInvalidOptions)
+		}
+
+		if opts.keyRing == nil {
+			return fmt.Errorf("%w: no key ring provided for encryption", ErrInvalidOptions)
+		}
+
+		if opts.prealloc {
+			return fmt.Errorf("%w: preallocation is not supported with encryption", ErrInvalidOptions)
+		}
+	}
+
 	return nil
 }
 
@@ -143,6 +165,22 @@ func (opt *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opt
 }
 
+// WithCompressionDictionaries sets the zstd dictionaries used to compress and decompress data.
+// New files are compressed using the first dictionary, unless it's nil, while existing ones
+// keep being compressed using the dictionary they were created with.
+func (opt *Options) WithCompressionDictionaries(dicts ...[]byte) *Options {
+	opt.compressionDicts = dicts
+	return opt
+}
+
+// WithEncryption sets the algorithm used to encrypt new files with the current key of the key ring.
+// The key ring is also used to decrypt existing files, which can be encrypted using any of its keys.
+func (opt *Options) WithEncryption(encryptionAlgorithm int, keyRing appendable.KeyRing) *Options {
+	opt.encryptionAlgorithm = encryptionAlgorithm
+	opt.keyRing = keyRing
+	return opt
+}
+
 func (opts *Options) WithReadBufferSize(size int) *Options {
 	opts.readBufferSize = size
 	return opts
@@ -166,6 +204,18 @@ func (opt *Options) GetFileMode() os.FileMode {
 	return opt.fileMode
 }
 
+func (opts *Options) GetCompressionDictionaries() [][]byte {
+	return opts.compressionDicts
+}
+
+func (opts *Options) GetEncryptionAlgorithm() int {
+	return opts.encryptionAlgorithm
+}
+
+func (opts *Options) GetKeyRing() appendable.KeyRing {
+	return opts.keyRing
+}
+
 func (opts *Options) GetReadBufferSize() int {
 	return opts.readBufferSize
 }
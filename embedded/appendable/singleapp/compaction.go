@@ -0,0 +1,305 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleapp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/fileutils"
+)
+
+// compactedSegmentSize is the size of each entry of the segment table of a compacted file
+const compactedSegmentSize = 16
+
+// compactedSegment is a range of retained data of a compacted file.
+// physicalOff is relative to the end of the metadata header and accounts for the segment table.
+type compactedSegment struct {
+	off         int64
+	size        int64
+	physicalOff int64
+}
+
+func metadataHeader(m *appendable.Metadata) []byte {
+	mBs := m.Bytes()
+
+	hdr := make([]byte, 4+len(mBs))
+	binary.BigEndian.PutUint32(hdr, uint32(len(mBs)))
+	copy(hdr[4:], mBs)
+
+	return hdr
+}
+
+func readCompactedSegments(f *os.File, fileBaseOffset int64, count int) ([]compactedSegment, error) {
+	if count < 0 {
+		return nil, ErrCorruptedMetadata
+	}
+
+	b := make([]byte, count*compactedSegmentSize)
+
+	_, err := f.ReadAt(b, fileBaseOffset)
+	if err != nil {
+		return nil, ErrCorruptedMetadata
+	}
+
+	segments := make([]compactedSegment, count)
+
+	physicalOff := int64(len(b))
+
+	for i := range segments {
+		segments[i].off = int64(binary.BigEndian.Uint64(b[i*compactedSegmentSize:]))
+		segments[i].size = int64(binary.BigEndian.Uint64(b[i*compactedSegmentSize+8:]))
+		segments[i].physicalOff = physicalOff
+
+		if segments[i].off < 0 || segments[i].size <= 0 ||
+			(i > 0 && segments[i].off < segments[i-1].off+segments[i-1].size) {
+			return nil, ErrCorruptedMetadata
+		}
+
+		physicalOff += segments[i].size
+	}
+
+	return segments, nil
+}
+
+// physicalOffset maps a range of logical offsets into the location of its data,
+// the range must be fully contained in a retained segment
+func (aof *AppendableFile) physicalOffset(off, size int64) (int64, bool) {
+	i := sort.Search(len(aof.segments), func(i int) bool {
+		return aof.segments[i].off+aof.segments[i].size > off
+	})
+
+	if i == len(aof.segments) {
+		return 0, false
+	}
+
+	seg := aof.segments[i]
+
+	if off < seg.off || off+size > seg.off+seg.size {
+		return 0, false
+	}
+
+	return seg.physicalOff + off - seg.off, true
+}
+
+func (aof *AppendableFile) compactedReadAt(bs []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	// reads past the end of the file are partially served as it's done with
+	// non-compacted files, as values may span multiple files
+	size := int64(len(bs))
+	if off+size > aof.fileOffset {
+		size = aof.fileOffset - off
+	}
+
+	if size <= 0 {
+		return 0, io.EOF
+	}
+
+	physicalOff, ok := aof.physicalOffset(off, size)
+	if !ok {
+		return 0, io.EOF
+	}
+
+	n, err = aof.f.ReadAt(bs[:size], aof.fileBaseOffset+physicalOff)
+	if err == nil && n < len(bs) {
+		err = io.EOF
+	}
+
+	return n, err
+}
+
+// retainedSegments returns the segments which are not covered by any of the discarded ones
+func retainedSegments(segments []appendable.Segment, discard []appendable.Segment) []appendable.Segment {
+	discard = append([]appendable.Segment(nil), discard...)
+
+	sort.Slice(discard, func(i, j int) bool {
+		return discard[i].Off < discard[j].Off
+	})
+
+	var retained []appendable.Segment
+
+	for _, seg := range segments {
+		off := seg.Off
+		end := seg.Off + seg.Size
+
+		for _, d := range discard {
+			if d.Size <= 0 || d.Off+d.Size <= off {
+				continue
+			}
+
+			if d.Off >= end {
+				break
+			}
+
+			if d.Off > off {
+				retained = append(retained, appendable.Segment{Off: off, Size: d.Off - off})
+			}
+
+			off = d.Off + d.Size
+		}
+
+		if off < end {
+			retained = append(retained, appendable.Segment{Off: off, Size: end - off})
+		}
+	}
+
+	return retained
+}
+
+// CompactFile writes into dstFileName a copy of srcFileName without the discarded segments.
+// Offsets are preserved: data which is kept can be read at the same offsets while
+// reading discarded data results in io.EOF. The resulting file is read-only.
+// The amount of reclaimed bytes is returned, zero meaning there was nothing to reclaim
+// and no file was left written.
+func CompactFile(srcFileName, dstFileName string, discard []appendable.Segment, fileMode os.FileMode) (reclaimed int64, err error) {
+	src, err := Open(srcFileName, DefaultOptions().WithReadOnly(true))
+	if errors.Is(err, ErrMissingKeyRing) {
+		return 0, fmt.Errorf("%w: encrypted data can not be compacted", appendable.ErrCompactionNotSupported)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	if src.compressionFormat != appendable.NoCompression {
+		return 0, fmt.Errorf("%w: compressed data can not be compacted", appendable.ErrCompactionNotSupported)
+	}
+
+	size := src.fileOffset
+
+	current := []appendable.Segment{{Off: 0, Size: size}}
+
+	if src.segments != nil {
+		current = make([]appendable.Segment, len(src.segments))
+
+		for i, seg := range src.segments {
+			current[i] = appendable.Segment{Off: seg.off, Size: seg.size}
+		}
+	}
+
+	retained := retainedSegments(current, discard)
+
+	var currentSize, retainedSize int64
+
+	for _, seg := range current {
+		currentSize += seg.Size
+	}
+
+	for _, seg := range retained {
+		retainedSize += seg.Size
+	}
+
+	if retainedSize == currentSize {
+		return 0, nil
+	}
+
+	dst, err := os.OpenFile(dstFileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, fileMode)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		dst.Close()
+
+		if err != nil {
+			os.Remove(dstFileName)
+		}
+	}()
+
+	m := appendable.NewMetadata(nil)
+	m.PutInt(metaPreallocSize, 0)
+	m.PutInt(metaCompressionFormat, src.compressionFormat)
+	m.PutInt(metaCompressionLevel, src.compressionLevel)
+	m.Put(metaWrappedMeta, src.metadata)
+	m.PutInt(metaCompactedSize, int(size))
+	m.PutInt(metaCompactedSegments, len(retained))
+
+	w := bufio.NewWriter(dst)
+
+	_, err = w.Write(metadataHeader(m))
+	if err != nil {
+		return 0, err
+	}
+
+	var b [compactedSegmentSize]byte
+
+	for _, seg := range retained {
+		binary.BigEndian.PutUint64(b[:], uint64(seg.Off))
+		binary.BigEndian.PutUint64(b[8:], uint64(seg.Size))
+
+		_, err = w.Write(b[:])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for _, seg := range retained {
+		physicalOff := seg.Off
+
+		if src.segments != nil {
+			physicalOff, _ = src.physicalOffset(seg.Off, seg.Size)
+		}
+
+		_, err = io.Copy(w, io.NewSectionReader(src.f, src.fileBaseOffset+physicalOff, seg.Size))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return 0, err
+	}
+
+	err = dst.Sync()
+	if err != nil {
+		return 0, err
+	}
+
+	err = fileutils.SyncDir(filepath.Dir(dstFileName))
+	if err != nil {
+		return 0, err
+	}
+
+	srcInfo, err := src.f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	dstInfo, err := dst.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	reclaimed = srcInfo.Size() - dstInfo.Size()
+
+	if reclaimed <= 0 {
+		// the segment table takes more space than the discarded data
+		return 0, os.Remove(dstFileName)
+	}
+
+	return reclaimed, nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleapp
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// lz4HeaderSize is the size of the uncompressed length stored before each lz4 block
+const lz4HeaderSize = 4
+
+// zstdDictionaryID returns the identifier of a zstd dictionary, zero if it's not a valid one
+func zstdDictionaryID(dict []byte) uint32 {
+	d, err := zstd.InspectDictionary(dict)
+	if err != nil {
+		return 0
+	}
+
+	return d.ID()
+}
+
+// zstdDictionary returns the dictionary with the given identifier
+func zstdDictionary(dicts [][]byte, id uint32) ([]byte, error) {
+	for _, dict := range dicts {
+		if zstdDictionaryID(dict) == id {
+			return dict, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: dictionary id %d", ErrMissingCompressionDictionary, id)
+}
+
+// zstdEncoderLevel maps flate compression levels into zstd ones
+func zstdEncoderLevel(level int) zstd.EncoderLevel {
+	switch {
+	case level == appendable.BestCompression:
+		return zstd.SpeedBestCompression
+	case level < appendable.BestSpeed:
+		// default compression and huffman-only levels
+		return zstd.SpeedDefault
+	}
+
+	return zstd.EncoderLevelFromZstd(level)
+}
+
+// zstdCompress compresses data using an encoder created on first use,
+// encoding is done in a single call, thus no stream is kept between appends
+func (aof *AppendableFile) zstdCompress(bs []byte) ([]byte, error) {
+	if aof.zstdEncoder == nil {
+		opts := []zstd.EOption{
+			zstd.WithEncoderLevel(zstdEncoderLevel(aof.compressionLevel)),
+			zstd.WithEncoderConcurrency(1),
+		}
+
+		if aof.compressionDict != nil {
+			opts = append(opts, zstd.WithEncoderDict(aof.compressionDict))
+		}
+
+		enc, err := zstd.NewWriter(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		aof.zstdEncoder = enc
+	}
+
+	return aof.zstdEncoder.EncodeAll(bs, nil), nil
+}
+
+func (aof *AppendableFile) zstdDecompress(cBs []byte) ([]byte, error) {
+	if aof.zstdDecoder == nil {
+		opts := []zstd.DOption{
+			zstd.WithDecoderConcurrency(1),
+		}
+
+		if aof.compressionDict != nil {
+			opts = append(opts, zstd.WithDecoderDicts(aof.compressionDict))
+		}
+
+		dec, err := zstd.NewReader(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		aof.zstdDecoder = dec
+	}
+
+	bs, err := aof.zstdDecoder.DecodeAll(cBs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedData, err)
+	}
+
+	return bs, nil
+}
+
+func (aof *AppendableFile) closeZstdCodecs() {
+	if aof.zstdEncoder != nil {
+		aof.zstdEncoder.Close()
+		aof.zstdEncoder = nil
+	}
+
+	if aof.zstdDecoder != nil {
+		aof.zstdDecoder.Close()
+		aof.zstdDecoder = nil
+	}
+}
+
+// lz4Compress compresses data into a single lz4 block preceded by the uncompressed length,
+// the fast compressor is used at best speed and the high compression one at higher levels
+func lz4Compress(bs []byte, level int) ([]byte, error) {
+	cBs := make([]byte, lz4HeaderSize+lz4.CompressBlockBound(len(bs)))
+	binary.BigEndian.PutUint32(cBs, uint32(len(bs)))
+
+	var n int
+	var err error
+
+	if level <= appendable.BestSpeed {
+		n, err = lz4.CompressBlock(bs, cBs[lz4HeaderSize:], nil)
+	} else {
+		n, err = lz4.CompressBlockHC(bs, cBs[lz4HeaderSize:], lz4CompressionLevel(level), nil, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cBs[:lz4HeaderSize+n], nil
+}
+
+func lz4Decompress(cBs []byte) ([]byte, error) {
+	if len(cBs) < lz4HeaderSize {
+		return nil, fmt.Errorf("%w: invalid lz4 block", ErrCorruptedData)
+	}
+
+	bs := make([]byte, binary.BigEndian.Uint32(cBs))
+
+	n, err := lz4.UncompressBlock(cBs[lz4HeaderSize:], bs)
+	if err != nil || n != len(bs) {
+		return nil, fmt.Errorf("%w: invalid lz4 block", ErrCorruptedData)
+	}
+
+	return bs, nil
+}
+
+// lz4CompressionLevel maps flate compression levels above best speed into lz4 ones
+func lz4CompressionLevel(level int) lz4.CompressionLevel {
+	if level > appendable.BestCompression {
+		level = appendable.BestCompression
+	}
+
+	return lz4.CompressionLevel(1 << (8 + level))
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleapp
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
+)
+
+// encryptedBlockSize is the amount of data held by each encrypted block
+const encryptedBlockSize = 4096
+
+// encryptedBlockLenSize is the size of the length of the data held by an encrypted block
+const encryptedBlockLenSize = 4
+
+// encryptionSaltSize is the size of the random salt used to derive the key of each file
+const encryptionSaltSize = 16
+
+// blockCipher encrypts file content in fixed-size blocks so data can be read at any offset.
+// Each block is stored as nonce || seal(len || data || padding) and is authenticated together
+// with its index, thus blocks can neither be modified nor reordered without being detected.
+// The last block of the file is re-encrypted in place when more data is appended to it.
+type blockCipher struct {
+	aead     cipher.AEAD
+	slotSize int64
+
+	// set when stale blocks may be found beyond the end of the data
+	truncateRequired bool
+
+	// data of the most recently accessed block
+	cachedBlock int64
+	cachedData  []byte
+}
+
+func newBlockCipher(encryptionAlgorithm int, keyRing appendable.KeyRing, keyID string, salt []byte) (*blockCipher, error) {
+	if keyRing == nil {
+		return nil, ErrMissingKeyRing
+	}
+
+	key, err := keyRing.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey, err := encryption.DeriveKey(key, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := encryption.NewAEAD(encryptionAlgorithm, fileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockCipher{
+		aead:        aead,
+		slotSize:    int64(aead.NonceSize() + encryptedBlockLenSize + encryptedBlockSize + aead.Overhead()),
+		cachedBlock: -1,
+	}, nil
+}
+
+func newEncryptionSalt() ([]byte, error) {
+	salt := make([]byte, encryptionSaltSize)
+
+	_, err := io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+func blockAdditionalData(i int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(i))
+	return b[:]
+}
+
+// encryptedSize returns the amount of data stored in a file of the given physical size.
+// Incomplete trailing blocks are the result of interrupted writes and are ignored.
+func (aof *AppendableFile) encryptedSize(physicalSize int64) (int64, error) {
+	c := aof.cipher
+
+	blocks := physicalSize / c.slotSize
+
+	c.truncateRequired = physicalSize != blocks*c.slotSize
+
+	if blocks == 0 {
+		return 0, nil
+	}
+
+	data, err := aof.readBlock(blocks - 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return (blocks-1)*encryptedBlockSize + int64(len(data)), nil
+}
+
+func (aof *AppendableFile) readBlock(i int64) ([]byte, error) {
+	c := aof.cipher
+
+	if c.cachedBlock == i {
+		return c.cachedData, nil
+	}
+
+	slot := make([]byte, c.slotSize)
+
+	_, err := aof.f.ReadAt(slot, aof.fileBaseOffset+i*c.slotSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := c.aead.NonceSize()
+
+	data, err := c.aead.Open(slot[nonceSize:nonceSize], slot[:nonceSize], slot[nonceSize:], blockAdditionalData(i))
+	if err != nil {
+		return nil, fmt.Errorf("%w: block %d can not be decrypted", ErrCorruptedData, i)
+	}
+
+	size := binary.BigEndian.Uint32(data)
+	if size > encryptedBlockSize {
+		return nil, fmt.Errorf("%w: invalid size of block %d", ErrCorruptedData, i)
+	}
+
+	c.cachedBlock = i
+	c.cachedData = data[encryptedBlockLenSize : encryptedBlockLenSize+size]
+
+	return c.cachedData, nil
+}
+
+func (aof *AppendableFile) writeBlock(i int64, data []byte) error {
+	c := aof.cipher
+
+	nonceSize := c.aead.NonceSize()
+
+	slot := make([]byte, nonceSize, c.slotSize)
+
+	_, err := io.ReadFull(rand.Reader, slot)
+	if err != nil {
+		return err
+	}
+
+	plain := make([]byte, encryptedBlockLenSize+encryptedBlockSize)
+	binary.BigEndian.PutUint32(plain, uint32(len(data)))
+	copy(plain[encryptedBlockLenSize:], data)
+
+	slot = c.aead.Seal(slot, slot[:nonceSize], plain, blockAdditionalData(i))
+
+	_, err = aof.f.WriteAt(slot, aof.fileBaseOffset+i*c.slotSize)
+	if err != nil {
+		c.cachedBlock = -1
+		return err
+	}
+
+	c.cachedBlock = i
+	c.cachedData = plain[encryptedBlockLenSize : encryptedBlockLenSize+len(data)]
+
+	return nil
+}
+
+// encryptedReadAt reads data already written into the file
+func (aof *AppendableFile) encryptedReadAt(bs []byte, off int64) (n int, err error) {
+	for n < len(bs) {
+		i := (off + int64(n)) / encryptedBlockSize
+		boff := int((off + int64(n)) % encryptedBlockSize)
+
+		data, err := aof.readBlock(i)
+		if err != nil {
+			return n, err
+		}
+
+		if boff >= len(data) {
+			return n, io.EOF
+		}
+
+		n += copy(bs[n:], data[boff:])
+	}
+
+	return n, nil
+}
+
+// encryptedWriteAt writes data into the file starting at an offset not beyond the end of the
+// data written so far. Blocks partially covered by the data are re-encrypted with their content.
+func (aof *AppendableFile) encryptedWriteAt(bs []byte, off int64) (n int, err error) {
+	c := aof.cipher
+
+	if c.truncateRequired {
+		// stale blocks are removed before any block is rewritten
+		blocks := (aof.fileOffset + encryptedBlockSize - 1) / encryptedBlockSize
+
+		err = aof.f.Truncate(aof.fileBaseOffset + blocks*c.slotSize)
+		if err != nil {
+			return 0, err
+		}
+
+		c.truncateRequired = false
+	}
+
+	for n < len(bs) {
+		i := (off + int64(n)) / encryptedBlockSize
+		boff := int((off + int64(n)) % encryptedBlockSize)
+
+		// amount of data of the block which must be preserved
+		size := int(aof.fileOffset - i*encryptedBlockSize)
+		if size < 0 {
+			size = 0
+		} else if size > encryptedBlockSize {
+			size = encryptedBlockSize
+		}
+
+		data := make([]byte, encryptedBlockSize)
+
+		if size > 0 {
+			existing, err := aof.readBlock(i)
+			if err != nil {
+				return n, err
+			}
+
+			if len(existing) < size {
+				return n, fmt.Errorf("%w: unexpected size of block %d", ErrCorruptedData, i)
+			}
+
+			copy(data, existing[:size])
+		}
+
+		chunkSize := minInt(len(bs)-n, encryptedBlockSize-boff)
+
+		copy(data[boff:], bs[n:n+chunkSize])
+
+		if boff+chunkSize > size {
+			size = boff + chunkSize
+		}
+
+		err = aof.writeBlock(i, data[:size])
+		if err != nil {
+			return n, err
+		}
+
+		n += chunkSize
+	}
+
+	return n, nil
+}
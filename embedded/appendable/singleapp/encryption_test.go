@@ -0,0 +1,254 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleapp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyRing(t *testing.T) *encryption.KeyRing {
+	masterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	provider, err := encryption.NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	keyRing, err := encryption.OpenKeyRing(filepath.Join(t.TempDir(), "keyring"), provider, 0644)
+	require.NoError(t, err)
+
+	return keyRing
+}
+
+func TestSingleAppEncryption(t *testing.T) {
+	for _, alg := range []int{appendable.AESGCMEncryption, appendable.XChaCha20Poly1305Encryption} {
+		t.Run(fmt.Sprintf("algorithm=%d", alg), func(t *testing.T) {
+			keyRing := newTestKeyRing(t)
+
+			fileName := filepath.Join(t.TempDir(), "testdata.aof")
+
+			opts := DefaultOptions().
+				WithWriteBuffer(make([]byte, 1000)).
+				WithEncryption(alg, keyRing)
+
+			a, err := Open(fileName, opts)
+			require.NoError(t, err)
+			require.Equal(t, alg, a.EncryptionAlgorithm())
+
+			var data []byte
+
+			for i := 0; i < 100; i++ {
+				bs := make([]byte, 1+rand.Intn(300))
+				rand.Read(bs)
+
+				off, _, err := a.Append(bs)
+				require.NoError(t, err)
+				require.EqualValues(t, len(data), off)
+
+				data = append(data, bs...)
+
+				if i%7 == 0 {
+					err = a.Flush()
+					require.NoError(t, err)
+				}
+			}
+
+			requireContent := func(a *AppendableFile) {
+				sz, err := a.Size()
+				require.NoError(t, err)
+				require.EqualValues(t, len(data), sz)
+
+				for i := 0; i < 100; i++ {
+					off := rand.Intn(len(data))
+					bs := make([]byte, 1+rand.Intn(len(data)-off))
+
+					_, err := a.ReadAt(bs, int64(off))
+					require.NoError(t, err)
+					require.Equal(t, data[off:off+len(bs)], bs)
+				}
+
+				_, err = a.ReadAt(make([]byte, 10), int64(len(data)-5))
+				require.ErrorIs(t, err, io.EOF)
+			}
+
+			requireContent(a)
+
+			err = a.Close()
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(fileName)
+			require.NoError(t, err)
+			require.False(t, bytes.Contains(content, data[100:132]))
+
+			_, err = Open(fileName, DefaultOptions())
+			require.ErrorIs(t, err, ErrMissingKeyRing)
+
+			// the key ring is used to decrypt existing files, even when new files are not encrypted
+			a, err = Open(fileName, DefaultOptions().WithEncryption(appendable.NoEncryption, keyRing))
+			require.NoError(t, err)
+
+			requireContent(a)
+
+			// data is discarded and new data is appended in the middle of a block
+			err = a.SetOffset(int64(len(data) - 5000))
+			require.NoError(t, err)
+
+			data = data[:len(data)-5000]
+
+			_, _, err = a.Append([]byte("new data"))
+			require.NoError(t, err)
+
+			data = append(data, []byte("new data")...)
+
+			err = a.Sync()
+			require.NoError(t, err)
+
+			_, err = a.OverwriteAt([]byte("overwritten"), 4090)
+			require.NoError(t, err)
+
+			copy(data[4090:], []byte("overwritten"))
+
+			requireContent(a)
+
+			err = a.Close()
+			require.NoError(t, err)
+
+			// incomplete trailing blocks are ignored
+			f, err := os.OpenFile(fileName, os.O_APPEND|os.O_WRONLY, 0644)
+			require.NoError(t, err)
+
+			_, err = f.Write([]byte("partially written block"))
+			require.NoError(t, err)
+
+			err = f.Close()
+			require.NoError(t, err)
+
+			a, err = Open(fileName, opts)
+			require.NoError(t, err)
+
+			requireContent(a)
+
+			err = a.Close()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSingleAppEncryptionWithCompression(t *testing.T) {
+	opts := DefaultOptions().
+		WithCompressionFormat(appendable.ZLibCompression).
+		WithEncryption(appendable.AESGCMEncryption, newTestKeyRing(t))
+
+	a, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), opts)
+	require.NoError(t, err)
+	defer a.Close()
+
+	data := bytes.Repeat([]byte("compressed and encrypted;"), 1000)
+
+	off, _, err := a.Append(data)
+	require.NoError(t, err)
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	bs := make([]byte, len(data))
+
+	_, err = a.ReadAt(bs, off)
+	require.NoError(t, err)
+	require.Equal(t, data, bs)
+}
+
+func TestSingleAppEncryptionTampering(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().WithEncryption(appendable.AESGCMEncryption, newTestKeyRing(t))
+
+	a, err := Open(fileName, opts)
+	require.NoError(t, err)
+
+	_, _, err = a.Append(make([]byte, 3*encryptedBlockSize))
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	f, err := os.OpenFile(fileName, os.O_RDWR, 0644)
+	require.NoError(t, err)
+
+	info, err := f.Stat()
+	require.NoError(t, err)
+
+	// a byte of the first block is modified
+	_, err = f.WriteAt([]byte{1}, info.Size()-2*a.cipher.slotSize-100)
+	require.NoError(t, err)
+
+	err = f.Close()
+	require.NoError(t, err)
+
+	a, err = Open(fileName, opts)
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, err = a.ReadAt(make([]byte, 10), 0)
+	require.ErrorIs(t, err, ErrCorruptedData)
+
+	_, err = a.ReadAt(make([]byte, 10), encryptedBlockSize)
+	require.NoError(t, err)
+}
+
+func TestSingleAppEncryptionInvalidOptions(t *testing.T) {
+	_, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), DefaultOptions().
+		WithEncryption(appendable.AESGCMEncryption, nil))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, err = Open(filepath.Join(t.TempDir(), "testdata.aof"), DefaultOptions().
+		WithEncryption(100, newTestKeyRing(t)))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, err = Open(filepath.Join(t.TempDir(), "testdata.aof"), DefaultOptions().
+		WithEncryption(appendable.AESGCMEncryption, newTestKeyRing(t)).
+		WithPreallocSize(1024))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestSingleAppCompactEncryptedFile(t *testing.T) {
+	dir := t.TempDir()
+
+	a, err := Open(filepath.Join(dir, "00000000.aof"), DefaultOptions().
+		WithEncryption(appendable.AESGCMEncryption, newTestKeyRing(t)))
+	require.NoError(t, err)
+
+	_, _, err = a.Append(make([]byte, 1000))
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	_, err = CompactFile(filepath.Join(dir, "00000000.aof"), filepath.Join(dir, "00000000.compacting"),
+		[]appendable.Segment{{Off: 0, Size: 500}}, DefaultFileMode)
+	require.ErrorIs(t, err, appendable.ErrCompactionNotSupported)
+}
@@ -21,11 +21,13 @@ import (
 	"os"
 
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 )
 
 const DefaultFileMode = os.FileMode(0644)
 const DefaultCompressionFormat = appendable.DefaultCompressionFormat
 const DefaultCompressionLevel = appendable.DefaultCompressionLevel
+const DefaultEncryptionAlgorithm = appendable.DefaultEncryptionAlgorithm
 const DefaultReadBufferSize = 4096
 const DefaultWriteBufferSize = 4096
 
@@ -41,6 +43,10 @@ type Options struct {
 
 	compressionFormat int
 	compressionLevel  int
+	compressionDicts  [][]byte
+
+	encryptionAlgorithm int
+	keyRing             appendable.KeyRing
 
 	preallocSize      int
 	createIfNotExists bool
@@ -59,6 +65,8 @@ func DefaultOptions() *Options {
 		compressionLevel:  DefaultCompressionLevel,
 		readBufferSize:    DefaultReadBufferSize,
 		writeBuffer:       make([]byte, DefaultWriteBufferSize),
+
+		encryptionAlgorithm: DefaultEncryptionAlgorithm,
 	}
 }
 
@@ -79,6 +87,26 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid preallocSize", ErrInvalidOptions)
 	}
 
+	for i, dict := range opts.compressionDicts {
+		if (i > 0 || dict != nil) && zstdDictionaryID(dict) == 0 {
+			return fmt.Errorf("%w: invalid compression dictionary", ErrInvalidOptions)
+		}
+	}
+
+	if opts.encryptionAlgorithm != appendable.NoEncryption {
+		if !encryption.IsSupportedAlgorithm(opts.encryptionAlgorithm) {
+			return fmt.Errorf("%w: invalid encryptionAlgorithm", ErrInvalidOptions)
+		}
+
+		if opts.keyRing == nil {
+			return fmt.Errorf("%w: %v", ErrInvalidOptions, ErrMissingKeyRing)
+		}
+
+		if opts.preallocSize > 0 {
+			return fmt.Errorf("%w: preallocation is not supported with encryption", ErrInvalidOptions)
+		}
+	}
+
 	return nil
 }
 
@@ -107,6 +135,22 @@ func (opts *Options) WithCompressionFormat(compressionFormat int) *Options {
 	return opts
 }
 
+// WithCompressionDictionaries sets the zstd dictionaries used to compress and decompress data.
+// New files are compressed using the first dictionary, unless it's nil, while existing ones
+// keep being compressed using the dictionary they were created with.
+func (opts *Options) WithCompressionDictionaries(dicts ...[]byte) *Options {
+	opts.compressionDicts = dicts
+	return opts
+}
+
+// WithEncryption sets the algorithm used to encrypt new files with the current key of the key ring.
+// The key ring is also used to decrypt existing files, which can be encrypted using any of its keys.
+func (opts *Options) WithEncryption(encryptionAlgorithm int, keyRing appendable.KeyRing) *Options {
+	opts.encryptionAlgorithm = encryptionAlgorithm
+	opts.keyRing = keyRing
+	return opts
+}
+
 func (opts *Options) WithPreallocSize(preallocSize int) *Options {
 	opts.preallocSize = preallocSize
 	return opts
@@ -125,6 +169,18 @@ func (opts *Options) GetCompressionLevel() int {
 	return opts.compressionLevel
 }
 
+func (opts *Options) GetCompressionDictionaries() [][]byte {
+	return opts.compressionDicts
+}
+
+func (opts *Options) GetEncryptionAlgorithm() int {
+	return opts.encryptionAlgorithm
+}
+
+func (opts *Options) GetKeyRing() appendable.KeyRing {
+	return opts.keyRing
+}
+
 func (opts *Options) GetReadBufferSize() int {
 	return opts.readBufferSize
 }
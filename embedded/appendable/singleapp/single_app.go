@@ -33,6 +33,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/appendable/fileutils"
+	"github.com/klauspost/compress/zstd"
 )
 
 var ErrorPathIsNotADirectory = errors.New("singleapp: path is not a directory")
@@ -43,15 +44,25 @@ var ErrReadOnly = errors.New("singleapp: read-only mode")
 var ErrCorruptedMetadata = errors.New("singleapp: corrupted metadata")
 var ErrBufferFull = errors.New("singleapp: buffer full")
 var ErrNegativeOffset = errors.New("singleapp: negative offset")
+var ErrCorruptedData = errors.New("singleapp: corrupted data")
+var ErrMissingKeyRing = errors.New("singleapp: a key ring is required to access encrypted data")
+var ErrMissingCompressionDictionary = errors.New("singleapp: compression dictionary not found")
 
 const (
 	metaPreallocSize      = "PREALLOC_SIZE"
 	metaCompressionFormat = "COMPRESSION_FORMAT"
 	metaCompressionLevel  = "COMPRESSION_LEVEL"
+	metaCompressionDictID = "COMPRESSION_DICTIONARY_ID"
 	metaWrappedMeta       = "WRAPPED_METADATA"
+	metaCompactedSize     = "COMPACTED_SIZE"
+	metaCompactedSegments = "COMPACTED_SEGMENTS"
+	metaEncryptionAlg     = "ENCRYPTION_ALGORITHM"
+	metaEncryptionKeyID   = "ENCRYPTION_KEY_ID"
+	metaEncryptionSalt    = "ENCRYPTION_SALT"
 )
 
 var _ appendable.Appendable = (*AppendableFile)(nil)
+var _ appendable.Overwriter = (*AppendableFile)(nil)
 
 type AppendableFile struct {
 	f              *os.File
@@ -70,11 +81,19 @@ type AppendableFile struct {
 
 	compressionFormat int
 	compressionLevel  int
+	compressionDict   []byte // zstd dictionary, nil when not employed
+	zstdEncoder       *zstd.Encoder
+	zstdDecoder       *zstd.Decoder
+
+	encryptionAlgorithm int
+	cipher              *blockCipher // nil when data is not encrypted
 
 	preallocSize int
 
 	metadata []byte
 
+	segments []compactedSegment // retained data of a compacted file
+
 	closed bool
 
 	mutex sync.Mutex
@@ -109,10 +128,17 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 	var metadata []byte
 	var compressionFormat int
 	var compressionLevel int
+	var compressionDict []byte
 	var fileBaseOffset int64
 
 	var preallocSize int
 
+	var segments []compactedSegment
+	var compactedSize int64
+
+	var encryptionAlgorithm int
+	var cipher *blockCipher
+
 	if notExist {
 		m := appendable.NewMetadata(nil)
 		m.PutInt(metaPreallocSize, opts.preallocSize)
@@ -120,18 +146,40 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		m.PutInt(metaCompressionLevel, opts.compressionLevel)
 		m.Put(metaWrappedMeta, opts.metadata)
 
-		mBs := m.Bytes()
-		mLenBs := make([]byte, 4)
-		binary.BigEndian.PutUint32(mLenBs, uint32(len(mBs)))
+		if opts.compressionFormat == appendable.ZStdCompression && len(opts.compressionDicts) > 0 && opts.compressionDicts[0] != nil {
+			// data is compressed using the first dictionary
+			compressionDict = opts.compressionDicts[0]
+			m.PutInt(metaCompressionDictID, int(zstdDictionaryID(compressionDict)))
+		}
 
-		w := bufio.NewWriter(f)
+		if opts.encryptionAlgorithm != appendable.NoEncryption {
+			keyID, _, err := opts.keyRing.CurrentKey()
+			if err != nil {
+				return nil, err
+			}
 
-		_, err := w.Write(mLenBs)
-		if err != nil {
-			return nil, err
+			salt, err := newEncryptionSalt()
+			if err != nil {
+				return nil, err
+			}
+
+			cipher, err = newBlockCipher(opts.encryptionAlgorithm, opts.keyRing, keyID, salt)
+			if err != nil {
+				return nil, err
+			}
+
+			m.PutInt(metaEncryptionAlg, opts.encryptionAlgorithm)
+			m.Put(metaEncryptionKeyID, []byte(keyID))
+			m.Put(metaEncryptionSalt, salt)
+
+			encryptionAlgorithm = opts.encryptionAlgorithm
 		}
 
-		_, err = w.Write(mBs)
+		hdr := metadataHeader(m)
+
+		w := bufio.NewWriter(f)
+
+		_, err := w.Write(hdr)
 		if err != nil {
 			return nil, err
 		}
@@ -168,7 +216,7 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		compressionLevel = opts.compressionLevel
 		metadata = opts.metadata
 
-		fileBaseOffset = int64(4 + len(mBs))
+		fileBaseOffset = int64(len(hdr))
 	} else {
 		r := bufio.NewReader(f)
 
@@ -203,12 +251,55 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		}
 		compressionLevel = cl
 
+		dictID, ok := m.GetInt(metaCompressionDictID)
+		if ok {
+			compressionDict, err = zstdDictionary(opts.compressionDicts, uint32(dictID))
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		metadata, ok = m.Get(metaWrappedMeta)
 		if !ok {
 			return nil, ErrCorruptedMetadata
 		}
 
 		fileBaseOffset = int64(4 + len(mBs))
+
+		segmentCount, ok := m.GetInt(metaCompactedSegments)
+		if ok {
+			size, ok := m.GetInt(metaCompactedSize)
+			if !ok {
+				return nil, ErrCorruptedMetadata
+			}
+
+			segments, err = readCompactedSegments(f, fileBaseOffset, segmentCount)
+			if err != nil {
+				return nil, err
+			}
+
+			compactedSize = int64(size)
+		}
+
+		alg, ok := m.GetInt(metaEncryptionAlg)
+		if ok && alg != appendable.NoEncryption {
+			keyID, ok := m.Get(metaEncryptionKeyID)
+			if !ok {
+				return nil, ErrCorruptedMetadata
+			}
+
+			salt, ok := m.Get(metaEncryptionSalt)
+			if !ok {
+				return nil, ErrCorruptedMetadata
+			}
+
+			cipher, err = newBlockCipher(alg, opts.keyRing, string(keyID), salt)
+			if err != nil {
+				return nil, err
+			}
+
+			encryptionAlgorithm = alg
+		}
 	}
 
 	fileOffset, err := f.Seek(0, io.SeekEnd)
@@ -216,21 +307,41 @@ func Open(fileName string, opts *Options) (*AppendableFile, error) {
 		return nil, err
 	}
 
-	return &AppendableFile{
-		f:                 f,
-		fileBaseOffset:    fileBaseOffset,
-		fileOffset:        fileOffset - fileBaseOffset,
-		writeBuffer:       opts.writeBuffer,
-		readBufferSize:    opts.readBufferSize,
-		compressionFormat: compressionFormat,
-		compressionLevel:  compressionLevel,
-		preallocSize:      preallocSize,
-		metadata:          metadata,
-		readOnly:          opts.readOnly,
-		retryableSync:     opts.retryableSync,
-		autoSync:          opts.autoSync,
-		closed:            false,
-	}, nil
+	if segments != nil {
+		// offsets of a compacted file are logical ones, retained data is located through its segments
+		fileOffset = fileBaseOffset + compactedSize
+	}
+
+	aof := &AppendableFile{
+		f:                   f,
+		fileBaseOffset:      fileBaseOffset,
+		fileOffset:          fileOffset - fileBaseOffset,
+		writeBuffer:         opts.writeBuffer,
+		readBufferSize:      opts.readBufferSize,
+		compressionFormat:   compressionFormat,
+		compressionLevel:    compressionLevel,
+		compressionDict:     compressionDict,
+		encryptionAlgorithm: encryptionAlgorithm,
+		cipher:              cipher,
+		preallocSize:        preallocSize,
+		metadata:            metadata,
+		segments:            segments,
+		readOnly:            opts.readOnly,
+		retryableSync:       opts.retryableSync,
+		autoSync:            opts.autoSync,
+		closed:              false,
+	}
+
+	if cipher != nil {
+		// offsets of an encrypted file refer to its decrypted content
+		aof.fileOffset, err = aof.encryptedSize(fileOffset - fileBaseOffset)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
+	return aof, nil
 }
 
 func (aof *AppendableFile) Copy(dstPath string) error {
@@ -275,6 +386,10 @@ func (aof *AppendableFile) CompressionLevel() int {
 	return aof.compressionLevel
 }
 
+func (aof *AppendableFile) EncryptionAlgorithm() int {
+	return aof.encryptionAlgorithm
+}
+
 func (aof *AppendableFile) Metadata() []byte {
 	return aof.metadata
 }
@@ -309,7 +424,7 @@ func (aof *AppendableFile) SetOffset(newOffset int64) error {
 		return ErrAlreadyClosed
 	}
 
-	if aof.readOnly {
+	if aof.readOnly || aof.segments != nil {
 		return ErrReadOnly
 	}
 
@@ -336,6 +451,10 @@ func (aof *AppendableFile) SetOffset(newOffset int64) error {
 	aof.fileOffset = newOffset
 	aof.seekRequired = true
 
+	if aof.cipher != nil {
+		aof.cipher.truncateRequired = true
+	}
+
 	// discard in-memory data
 	aof.wbufFlushedOffset = 0
 	aof.wbufUnwrittenOffset = 0
@@ -386,6 +505,54 @@ func (aof *AppendableFile) reader(r io.Reader) (reader io.ReadCloser, err error)
 	return
 }
 
+func (aof *AppendableFile) compress(bs []byte) ([]byte, error) {
+	switch aof.compressionFormat {
+	case appendable.ZStdCompression:
+		return aof.zstdCompress(bs)
+	case appendable.LZ4Compression:
+		return lz4Compress(bs, aof.compressionLevel)
+	}
+
+	var b bytes.Buffer
+
+	w, err := aof.writer(&b)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.Write(bs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.(io.Closer).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func (aof *AppendableFile) decompress(cBs []byte) ([]byte, error) {
+	switch aof.compressionFormat {
+	case appendable.ZStdCompression:
+		return aof.zstdDecompress(cBs)
+	case appendable.LZ4Compression:
+		return lz4Decompress(cBs)
+	}
+
+	r, err := aof.reader(bytes.NewReader(cBs))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	return buf.Bytes(), nil
+}
+
 func (aof *AppendableFile) Append(bs []byte) (off int64, n int, err error) {
 	aof.mutex.Lock()
 	defer aof.mutex.Unlock()
@@ -394,7 +561,7 @@ func (aof *AppendableFile) Append(bs []byte) (off int64, n int, err error) {
 		return 0, 0, ErrAlreadyClosed
 	}
 
-	if aof.readOnly {
+	if aof.readOnly || aof.segments != nil {
 		return 0, 0, ErrReadOnly
 	}
 
@@ -409,22 +576,11 @@ func (aof *AppendableFile) Append(bs []byte) (off int64, n int, err error) {
 		return off, n, err
 	}
 
-	var b bytes.Buffer
-
-	w, err := aof.writer(&b)
-	if err != nil {
-		return 0, 0, err
-	}
-
-	_, err = w.Write(bs)
+	bb, err := aof.compress(bs)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	w.(io.Closer).Close()
-
-	bb := b.Bytes()
-
 	bbLenBs := make([]byte, 4)
 	binary.BigEndian.PutUint32(bbLenBs, uint32(len(bb)))
 
@@ -487,7 +643,12 @@ func (aof *AppendableFile) readAt(bs []byte, off int64) (n int, err error) {
 	// boff is the offset to employ when reading from the buffer
 	var boff int
 
-	if off < aof.fileOffset {
+	if off < aof.fileOffset && aof.cipher != nil {
+		n, err = aof.encryptedReadAt(bs[:minInt(len(bs), int(aof.fileOffset-off))], off)
+		if err != nil {
+			return n, err
+		}
+	} else if off < aof.fileOffset {
 		n, err = aof.f.ReadAt(bs, aof.fileBaseOffset+off)
 	} else {
 		boff = int(off - aof.fileOffset)
@@ -526,6 +687,10 @@ func (aof *AppendableFile) ReadAt(bs []byte, off int64) (n int, err error) {
 		return 0, ErrIllegalArguments
 	}
 
+	if aof.segments != nil {
+		return aof.compactedReadAt(bs, off)
+	}
+
 	if aof.compressionFormat == appendable.NoCompression {
 		return aof.readAt(bs, off)
 	}
@@ -542,15 +707,10 @@ func (aof *AppendableFile) ReadAt(bs []byte, off int64) (n int, err error) {
 		return 0, err
 	}
 
-	r, err := aof.reader(bytes.NewReader(cBs))
+	rbs, err := aof.decompress(cBs)
 	if err != nil {
 		return 0, err
 	}
-	defer r.Close()
-
-	var buf bytes.Buffer
-	buf.ReadFrom(r)
-	rbs := buf.Bytes()
 
 	n = minInt(len(rbs), len(bs))
 
@@ -563,6 +723,75 @@ func (aof *AppendableFile) ReadAt(bs []byte, off int64) (n int, err error) {
 	return
 }
 
+// OverwriteAt replaces already appended data starting at the given offset.
+// Compressed appendables can not be overwritten because offsets do not
+// correspond to the uncompressed content.
+func (aof *AppendableFile) OverwriteAt(bs []byte, off int64) (n int, err error) {
+	aof.mutex.Lock()
+	defer aof.mutex.Unlock()
+
+	if aof.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if aof.readOnly {
+		return 0, ErrReadOnly
+	}
+
+	if aof.compressionFormat != appendable.NoCompression {
+		return 0, fmt.Errorf("%w: compressed data can not be overwritten", appendable.ErrOverwriteNotSupported)
+	}
+
+	if len(bs) == 0 {
+		return 0, ErrIllegalArguments
+	}
+
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	end := off + int64(len(bs))
+
+	if end > aof.offset() {
+		return 0, io.EOF
+	}
+
+	if aof.segments != nil {
+		physicalOff, ok := aof.physicalOffset(off, int64(len(bs)))
+		if !ok {
+			return 0, io.EOF
+		}
+
+		return aof.f.WriteAt(bs, aof.fileBaseOffset+physicalOff)
+	}
+
+	if off < aof.fileOffset && aof.cipher != nil {
+		_, err = aof.encryptedWriteAt(bs[:minInt(len(bs), int(aof.fileOffset-off))], off)
+		if err != nil {
+			return 0, err
+		}
+	} else if off < aof.fileOffset {
+		_, err = aof.f.WriteAt(bs[:minInt(len(bs), int(aof.fileOffset-off))], aof.fileBaseOffset+off)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// buffered data may include data already written into the file but not yet synced
+	bufStart := aof.fileOffset - int64(aof.wbufFlushedOffset)
+
+	if end > bufStart {
+		from := off
+		if from < bufStart {
+			from = bufStart
+		}
+
+		copy(aof.writeBuffer[from-bufStart:], bs[from-off:])
+	}
+
+	return len(bs), nil
+}
+
 func (aof *AppendableFile) SwitchToReadOnlyMode() error {
 	aof.mutex.Lock()
 	defer aof.mutex.Unlock()
@@ -634,13 +863,20 @@ func (aof *AppendableFile) flush() error {
 		return nil
 	}
 
-	// ensure that the file is written at the expected location
-	err := aof.seekIfRequired()
-	if err != nil {
-		return err
-	}
+	var n int
+	var err error
+
+	if aof.cipher != nil {
+		n, err = aof.encryptedWriteAt(aof.writeBuffer[aof.wbufFlushedOffset:aof.wbufUnwrittenOffset], aof.fileOffset)
+	} else {
+		// ensure that the file is written at the expected location
+		err = aof.seekIfRequired()
+		if err != nil {
+			return err
+		}
 
-	n, err := aof.f.Write(aof.writeBuffer[aof.wbufFlushedOffset:aof.wbufUnwrittenOffset])
+		n, err = aof.f.Write(aof.writeBuffer[aof.wbufFlushedOffset:aof.wbufUnwrittenOffset])
+	}
 
 	aof.fileOffset += int64(n)
 	aof.wbufFlushedOffset += n
@@ -727,6 +963,8 @@ func (aof *AppendableFile) Close() error {
 
 	aof.closed = true
 
+	aof.closeZstdCodecs()
+
 	return aof.f.Close()
 }
 
@@ -18,6 +18,7 @@ package singleapp
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/binary"
 	"io"
 	"io/ioutil"
@@ -479,6 +480,113 @@ func TestSingleAppLZWCompression(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSingleAppZStdCompression(t *testing.T) {
+	opts := DefaultOptions().WithCompressionFormat(appendable.ZStdCompression)
+	a, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), opts)
+	require.NoError(t, err)
+
+	off, _, err := a.Append([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), off)
+
+	err = a.Flush()
+	require.NoError(t, err)
+
+	bs := make([]byte, 3)
+	_, err = a.ReadAt(bs, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, bs)
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
+func TestSingleAppLZ4Compression(t *testing.T) {
+	for _, level := range []int{appendable.BestSpeed, appendable.DefaultCompression, appendable.BestCompression} {
+		opts := DefaultOptions().
+			WithCompressionFormat(appendable.LZ4Compression).
+			WithCompresionLevel(level)
+
+		a, err := Open(filepath.Join(t.TempDir(), "testdata.aof"), opts)
+		require.NoError(t, err)
+
+		off, _, err := a.Append([]byte{1, 2, 3})
+		require.NoError(t, err)
+		require.Equal(t, int64(0), off)
+
+		data := bytes.Repeat([]byte("compressible"), 100)
+
+		off, n, err := a.Append(data)
+		require.NoError(t, err)
+		require.Less(t, n, len(data))
+
+		err = a.Flush()
+		require.NoError(t, err)
+
+		bs := make([]byte, 3)
+		_, err = a.ReadAt(bs, 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2, 3}, bs)
+
+		bs = make([]byte, len(data))
+		_, err = a.ReadAt(bs, off)
+		require.NoError(t, err)
+		require.Equal(t, data, bs)
+
+		err = a.Close()
+		require.NoError(t, err)
+	}
+}
+
+func TestSingleAppZStdCompressionWithDictionary(t *testing.T) {
+	dict, err := os.ReadFile("testdata/zstd.dict")
+	require.NoError(t, err)
+
+	// same dictionary content registered with a different id
+	otherDict := append([]byte{}, dict...)
+	binary.LittleEndian.PutUint32(otherDict[4:], zstdDictionaryID(dict)+1)
+
+	_, err = Open(filepath.Join(t.TempDir(), "testdata.aof"), DefaultOptions().WithCompressionDictionaries([]byte{1, 2, 3}))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	path := filepath.Join(t.TempDir(), "testdata.aof")
+
+	opts := DefaultOptions().
+		WithCompressionFormat(appendable.ZStdCompression).
+		WithCompressionDictionaries(dict)
+
+	a, err := Open(path, opts)
+	require.NoError(t, err)
+
+	data := []byte("hello hello hello world")
+
+	off, _, err := a.Append(data)
+	require.NoError(t, err)
+
+	err = a.Close()
+	require.NoError(t, err)
+
+	_, err = Open(path, DefaultOptions())
+	require.ErrorIs(t, err, ErrMissingCompressionDictionary)
+
+	// the file keeps being compressed with the dictionary it was created with
+	a, err = Open(path, DefaultOptions().WithCompressionDictionaries(otherDict, dict))
+	require.NoError(t, err)
+
+	off1, _, err := a.Append(data)
+	require.NoError(t, err)
+
+	for _, o := range []int64{off, off1} {
+		bs := make([]byte, len(data))
+		_, err = a.ReadAt(bs, o)
+		require.NoError(t, err)
+		require.Equal(t, data, bs)
+	}
+
+	err = a.Close()
+	require.NoError(t, err)
+}
+
 func TestSingleAppCantCreateFile(t *testing.T) {
 	dir := t.TempDir()
 	os.Mkdir(filepath.Join(dir, "exists"), 0644)
@@ -512,6 +620,82 @@ func TestSingleAppDiscard(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSingleAppOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata_overwrite.aof")
+
+	app, err := Open(path, DefaultOptions())
+	require.NoError(t, err)
+
+	_, err = app.OverwriteAt(nil, 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = app.OverwriteAt([]byte{0}, -1)
+	require.ErrorIs(t, err, ErrNegativeOffset)
+
+	_, err = app.OverwriteAt([]byte{0}, 0)
+	require.ErrorIs(t, err, io.EOF)
+
+	_, _, err = app.Append([]byte{1, 2, 3, 4})
+	require.NoError(t, err)
+
+	err = app.Flush()
+	require.NoError(t, err)
+
+	// data written into the file and still buffered
+	_, _, err = app.Append([]byte{5, 6, 7, 8})
+	require.NoError(t, err)
+
+	n, err := app.OverwriteAt([]byte{0, 0, 0, 0}, 2)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	_, err = app.OverwriteAt([]byte{0, 0}, 7)
+	require.ErrorIs(t, err, io.EOF)
+
+	b := make([]byte, 8)
+	_, err = app.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 0, 0, 0, 0, 7, 8}, b)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	_, err = app.OverwriteAt([]byte{0}, 0)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	app, err = Open(path, DefaultOptions())
+	require.NoError(t, err)
+
+	_, err = app.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 0, 0, 0, 0, 7, 8}, b)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	app, err = Open(path, DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+
+	_, err = app.OverwriteAt([]byte{0}, 0)
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	app, err = Open(filepath.Join(t.TempDir(), "testdata_overwrite_compressed.aof"),
+		DefaultOptions().WithCompressionFormat(appendable.FlateCompression))
+	require.NoError(t, err)
+
+	_, _, err = app.Append([]byte{1, 2, 3, 4})
+	require.NoError(t, err)
+
+	_, err = app.OverwriteAt([]byte{0}, 0)
+	require.ErrorIs(t, err, appendable.ErrOverwriteNotSupported)
+
+	err = app.Close()
+	require.NoError(t, err)
+}
+
 func BenchmarkAppendFlush(b *testing.B) {
 	opts := DefaultOptions().
 		WithRetryableSync(false).
@@ -560,3 +744,115 @@ func BenchmarkAppendFlushless(b *testing.B) {
 	err = app.Close()
 	require.NoError(b, err)
 }
+
+func TestSingleAppCompactFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "testdata_compact.aof")
+
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	app, err := Open(path, DefaultOptions())
+	require.NoError(t, err)
+
+	_, _, err = app.Append(data)
+	require.NoError(t, err)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	// nothing to reclaim
+	reclaimed, err := CompactFile(path, filepath.Join(dir, "noop.aof"), []appendable.Segment{{Off: 1000, Size: 10}}, 0644)
+	require.NoError(t, err)
+	require.Zero(t, reclaimed)
+	require.NoFileExists(t, filepath.Join(dir, "noop.aof"))
+
+	// the segment table would take more space than the discarded data
+	reclaimed, err = CompactFile(path, filepath.Join(dir, "noop.aof"), []appendable.Segment{{Off: 10, Size: 1}}, 0644)
+	require.NoError(t, err)
+	require.Zero(t, reclaimed)
+	require.NoFileExists(t, filepath.Join(dir, "noop.aof"))
+
+	compactedPath := filepath.Join(dir, "compacted.aof")
+
+	reclaimed, err = CompactFile(path, compactedPath, []appendable.Segment{{Off: 100, Size: 200}, {Off: 900, Size: 100}}, 0644)
+	require.NoError(t, err)
+	require.Greater(t, reclaimed, int64(200))
+
+	app, err = Open(compactedPath, DefaultOptions())
+	require.NoError(t, err)
+
+	sz, err := app.Size()
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, sz)
+
+	b := make([]byte, 100)
+	_, err = app.ReadAt(b, 0)
+	require.NoError(t, err)
+	require.Equal(t, data[:100], b)
+
+	_, err = app.ReadAt(b, 300)
+	require.NoError(t, err)
+	require.Equal(t, data[300:400], b)
+
+	_, err = app.ReadAt(b, 50)
+	require.ErrorIs(t, err, io.EOF)
+
+	_, err = app.ReadAt(b, 850)
+	require.ErrorIs(t, err, io.EOF)
+
+	_, _, err = app.Append([]byte{1})
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	err = app.SetOffset(0)
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	_, err = app.OverwriteAt([]byte{0}, 300)
+	require.NoError(t, err)
+
+	_, err = app.OverwriteAt([]byte{0}, 200)
+	require.ErrorIs(t, err, io.EOF)
+
+	err = app.Close()
+	require.NoError(t, err)
+
+	// compacting an already compacted file
+	recompactedPath := filepath.Join(dir, "recompacted.aof")
+
+	reclaimed, err = CompactFile(compactedPath, recompactedPath, []appendable.Segment{{Off: 0, Size: 100}}, 0644)
+	require.NoError(t, err)
+	require.Positive(t, reclaimed)
+
+	app, err = Open(recompactedPath, DefaultOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer app.Close()
+
+	_, err = app.ReadAt(b, 0)
+	require.ErrorIs(t, err, io.EOF)
+
+	_, err = app.ReadAt(b, 300)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{0}, data[301:400]...), b)
+
+	_, err = app.ReadAt(b, 800)
+	require.NoError(t, err)
+	require.Equal(t, data[800:900], b)
+
+	t.Run("compressed files can not be compacted", func(t *testing.T) {
+		path := filepath.Join(dir, "compressed.aof")
+
+		app, err := Open(path, DefaultOptions().WithCompressionFormat(appendable.FlateCompression))
+		require.NoError(t, err)
+
+		_, _, err = app.Append(data)
+		require.NoError(t, err)
+
+		err = app.Close()
+		require.NoError(t, err)
+
+		_, err = CompactFile(path, filepath.Join(dir, "compressed_compacted.aof"), []appendable.Segment{{Off: 0, Size: 100}}, 0644)
+		require.ErrorIs(t, err, appendable.ErrCompactionNotSupported)
+	})
+}
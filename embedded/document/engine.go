@@ -69,7 +69,8 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 
 	sqlOpts := sql.DefaultOptions().
 		WithPrefix(opts.prefix).
-		WithLazyIndexConstraintValidation(true)
+		WithLazyIndexConstraintValidation(true).
+		WithLoggedUserFunc(opts.loggedUser)
 
 	engine, err := sql.NewEngine(store, sqlOpts)
 	if err != nil {
@@ -287,6 +288,22 @@ func (e *Engine) GetCollections(ctx context.Context) ([]*protomodel.Collection,
 	return collections, nil
 }
 
+// CollectionKeyPrefix returns the prefix of the keys of the entries written when
+// the documents of the collection are created, updated or deleted
+func (e *Engine) CollectionKeyPrefix(ctx context.Context, collectionName string) ([]byte, error) {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := e.sqlEngine.TableRowsPrefix(ctx, collectionName)
+	if errors.Is(err, sql.ErrTableDoesNotExist) {
+		return nil, fmt.Errorf("%w (%s)", mayTranslateError(err), collectionName)
+	}
+
+	return prefix, mayTranslateError(err)
+}
+
 func docIDFieldName(table *sql.Table) string {
 	return table.PrimaryIndex().Cols()[0].Name()
 }
@@ -655,6 +672,80 @@ func (e *Engine) DeleteIndex(ctx context.Context, username, collectionName strin
 	return mayTranslateError(err)
 }
 
+// CreateCollectionPolicy defines a row-level security policy on the collection, documents can only be
+// accessed through the specified command (SELECT, UPDATE, DELETE or empty for all of them) when they
+// satisfy the condition e.g. "owner = CURRENT_USER".
+func (e *Engine) CreateCollectionPolicy(ctx context.Context, username, collectionName, policyName string, cmd sql.SQLPrivilege, condition string) error {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return err
+	}
+
+	exp, err := sql.ParseExpFromString(condition)
+	if err != nil {
+		return fmt.Errorf("%w: invalid policy condition: %s", ErrIllegalArguments, err)
+	}
+
+	opts := sql.DefaultTxOptions().
+		WithUnsafeMVCC(true).
+		WithExtra([]byte(username)).
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
+		WithSnapshotRenewalPeriod(0).
+		WithExplicitClose(true)
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	_, _, err = e.sqlEngine.ExecPreparedStmts(
+		ctx,
+		sqlTx,
+		[]sql.SQLStmt{sql.NewCreatePolicyStmt(policyName, collectionName, cmd, exp)},
+		nil,
+	)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	err = sqlTx.Commit(ctx)
+	return mayTranslateError(err)
+}
+
+func (e *Engine) DeleteCollectionPolicy(ctx context.Context, username, collectionName, policyName string) error {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return err
+	}
+
+	opts := sql.DefaultTxOptions().
+		WithUnsafeMVCC(true).
+		WithExtra([]byte(username)).
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
+		WithSnapshotRenewalPeriod(0).
+		WithExplicitClose(true)
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	_, _, err = e.sqlEngine.ExecPreparedStmts(
+		ctx,
+		sqlTx,
+		[]sql.SQLStmt{sql.NewDropPolicyStmt(policyName, collectionName)},
+		nil,
+	)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	err = sqlTx.Commit(ctx)
+	return mayTranslateError(err)
+}
+
 func (e *Engine) InsertDocument(ctx context.Context, username, collectionName string, doc *structpb.Struct) (txID uint64, docID DocumentID, err error) {
 	txID, docIDs, err := e.InsertDocuments(ctx, username, collectionName, []*structpb.Struct{doc})
 	if err != nil {
@@ -1256,6 +1347,32 @@ func (e *Engine) getKeyForDocument(ctx context.Context, sqlTx *sql.SQLTx, collec
 	return searchKey, nil
 }
 
+// EncodedKeyForDocument returns the key of the entry written when the document is created or updated
+func EncodedKeyForDocument(prefix []byte, collectionID uint32, documentID DocumentID) ([]byte, error) {
+	valbuf := bytes.Buffer{}
+
+	rval := sql.NewBlob(documentID[:])
+	encVal, _, err := sql.EncodeRawValueAsKey(rval.RawValue(), sql.BLOBType, MaxDocumentIDLength)
+	if err != nil {
+		return nil, err
+	}
+	_, err = valbuf.Write(encVal)
+	if err != nil {
+		return nil, err
+	}
+
+	pkEncVals := valbuf.Bytes()
+
+	return sql.MapKey(
+		prefix,
+		sql.RowPrefix,
+		sql.EncodeID(1), // fixed database identifier
+		sql.EncodeID(collectionID),
+		sql.EncodeID(sql.PKIndexID),
+		pkEncVals,
+	), nil
+}
+
 func (e *Engine) getDocument(key []byte, valRef store.ValueRef, includePayload bool) (docAtRevision *protomodel.DocumentAtRevision, err error) {
 	var encodedDocVal []byte
 
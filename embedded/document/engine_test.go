@@ -2166,3 +2166,94 @@ func BenchmarkInsertion(b *testing.B) {
 		wg.Wait()
 	}
 }
+
+type testUser string
+
+func (u testUser) Username() string {
+	return string(u)
+}
+
+func (u testUser) Permission() sql.Permission {
+	return sql.PermissionReadWrite
+}
+
+func (u testUser) SQLPrivileges() []sql.SQLPrivilege {
+	return sql.DefaultSQLPrivilegesForPermission(sql.PermissionReadWrite)
+}
+
+func (u testUser) TablePrivileges() []sql.TablePrivilege {
+	return nil
+}
+
+type testUserKey struct{}
+
+func TestCollectionPolicies(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer st.Close()
+
+	loggedUser := func(ctx context.Context) (sql.User, error) {
+		return ctx.Value(testUserKey{}).(testUser), nil
+	}
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(docPrefix).WithLoggedUserFunc(loggedUser))
+	require.NoError(t, err)
+
+	aliceCtx := context.WithValue(context.Background(), testUserKey{}, testUser("alice"))
+	bobCtx := context.WithValue(context.Background(), testUserKey{}, testUser("bob"))
+
+	collectionName := "notes"
+
+	err = engine.CreateCollection(aliceCtx, "alice", collectionName, "", []*protomodel.Field{
+		{Name: "owner", Type: protomodel.FieldType_STRING},
+		{Name: "text", Type: protomodel.FieldType_STRING},
+	}, nil)
+	require.NoError(t, err)
+
+	for _, owner := range []string{"alice", "alice", "bob"} {
+		_, _, err = engine.InsertDocument(aliceCtx, owner, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"owner": structpb.NewStringValue(owner),
+				"text":  structpb.NewStringValue("note"),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	err = engine.CreateCollectionPolicy(aliceCtx, "alice", collectionName, "owner_only", "", "owner = CURRENT_USER")
+	require.NoError(t, err)
+
+	err = engine.CreateCollectionPolicy(aliceCtx, "alice", collectionName, "invalid", "", "owner = ")
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.CreateCollectionPolicy(aliceCtx, "alice", "unknown", "owner_only", "", "owner = CURRENT_USER")
+	require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+	query := &protomodel.Query{CollectionName: collectionName}
+
+	n, err := engine.CountDocuments(aliceCtx, query, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+
+	n, err = engine.CountDocuments(bobCtx, query, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+
+	err = engine.DeleteDocuments(bobCtx, "bob", query)
+	require.NoError(t, err)
+
+	n, err = engine.CountDocuments(aliceCtx, query, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+
+	n, err = engine.CountDocuments(bobCtx, query, 0)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	err = engine.DeleteCollectionPolicy(aliceCtx, "alice", collectionName, "owner_only")
+	require.NoError(t, err)
+
+	n, err = engine.CountDocuments(bobCtx, query, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+}
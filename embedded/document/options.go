@@ -16,8 +16,10 @@ limitations under the License.
 package document
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -26,6 +28,7 @@ const DefaultDocumentMaxNestedFields = 3
 type Options struct {
 	prefix          []byte
 	maxNestedFields int
+	loggedUser      func(ctx context.Context) (sql.User, error)
 }
 
 func DefaultOptions() *Options {
@@ -51,3 +54,10 @@ func (opts *Options) WithMaxNestedFields(maxNestedFields int) *Options {
 	opts.maxNestedFields = maxNestedFields
 	return opts
 }
+
+// WithLoggedUserFunc specifies how the user accessing documents is identified
+// when evaluating the row-level security policies defined on collections.
+func (opts *Options) WithLoggedUserFunc(loggedUser func(ctx context.Context) (sql.User, error)) *Options {
+	opts.loggedUser = loggedUser
+	return opts
+}
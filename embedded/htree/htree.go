@@ -193,3 +193,131 @@ func VerifyInclusion(proof *InclusionProof, digest, root [sha256.Size]byte) bool
 
 	return i == r && root == calcRoot
 }
+
+// MultiInclusionProof proves the inclusion of a set of leaves at once.
+// Nodes shared by the inclusion proofs of the leaves are included only once,
+// and nodes which can be computed from the leaves themselves are not included at all.
+type MultiInclusionProof struct {
+	Leaves []int
+	Width  int
+	Terms  [][sha256.Size]byte
+}
+
+// MultiInclusionProof returns the list of additional nodes required to compute the root from a set of leaves.
+// Leaves must be provided in strictly increasing order.
+// Terms are sorted by level, from the leaves up to the root, and by position within each level.
+func (t *HTree) MultiInclusionProof(leaves []int) (proof *MultiInclusionProof, err error) {
+	if len(leaves) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	for i, leaf := range leaves {
+		if leaf < 0 || leaf >= t.width || (i > 0 && leaf <= leaves[i-1]) {
+			return nil, ErrIllegalArguments
+		}
+	}
+
+	proof = &MultiInclusionProof{
+		Leaves: make([]int, len(leaves)),
+		Width:  t.width,
+	}
+	copy(proof.Leaves, leaves)
+
+	nodes := make([]int, len(leaves))
+	copy(nodes, leaves)
+
+	for l, w := 0, t.width; w > 1; l, w = l+1, (w+1)/2 {
+		parents := nodes[:0]
+
+		for i := 0; i < len(nodes); i++ {
+			n := nodes[i]
+			sibling := n ^ 1
+
+			if sibling < w {
+				if i+1 < len(nodes) && nodes[i+1] == sibling {
+					// the sibling is computed from the leaves
+					i++
+				} else {
+					proof.Terms = append(proof.Terms, t.levels[l][sibling])
+				}
+			}
+			// otherwise the node has no sibling and gets promoted to the upper level
+
+			parents = append(parents, n/2)
+		}
+
+		nodes = parents
+	}
+
+	return proof, nil
+}
+
+func VerifyMultiInclusion(proof *MultiInclusionProof, digests [][sha256.Size]byte, root [sha256.Size]byte) bool {
+	if proof == nil || len(proof.Leaves) == 0 || len(proof.Leaves) != len(digests) {
+		return false
+	}
+
+	nodes := make([]int, len(proof.Leaves))
+	hashes := make([][sha256.Size]byte, len(digests))
+
+	for i, leaf := range proof.Leaves {
+		if leaf < 0 || leaf >= proof.Width || (i > 0 && leaf <= proof.Leaves[i-1]) {
+			return false
+		}
+
+		b := [1 + sha256.Size]byte{LeafPrefix}
+		copy(b[1:], digests[i][:])
+
+		nodes[i] = leaf
+		hashes[i] = sha256.Sum256(b[:])
+	}
+
+	terms := proof.Terms
+
+	for w := proof.Width; w > 1; w = (w + 1) / 2 {
+		parents := nodes[:0]
+		parentHashes := hashes[:0]
+
+		for i := 0; i < len(nodes); i++ {
+			n := nodes[i]
+			h := hashes[i]
+			sibling := n ^ 1
+
+			if sibling < w {
+				var siblingHash [sha256.Size]byte
+
+				if i+1 < len(nodes) && nodes[i+1] == sibling {
+					siblingHash = hashes[i+1]
+					i++
+				} else {
+					if len(terms) == 0 {
+						return false
+					}
+
+					siblingHash = terms[0]
+					terms = terms[1:]
+				}
+
+				b := [1 + 2*sha256.Size]byte{NodePrefix}
+
+				if n%2 == 0 {
+					copy(b[1:], h[:])
+					copy(b[1+sha256.Size:], siblingHash[:])
+				} else {
+					copy(b[1:], siblingHash[:])
+					copy(b[1+sha256.Size:], h[:])
+				}
+
+				h = sha256.Sum256(b[:])
+			}
+
+			parents = append(parents, n/2)
+			parentHashes = append(parentHashes, h)
+		}
+
+		nodes = parents
+		hashes = parentHashes
+	}
+
+	return len(terms) == 0 && root == hashes[0]
+}
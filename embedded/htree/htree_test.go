@@ -85,3 +85,107 @@ func TestHTree(t *testing.T) {
 	_, err = tree.InclusionProof(maxWidth)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 }
+
+func TestHTreeMultiInclusionProof(t *testing.T) {
+	const maxWidth = 70
+
+	tree, err := New(maxWidth)
+	require.NoError(t, err)
+
+	digests := make([][sha256.Size]byte, maxWidth)
+
+	for i := 0; i < len(digests); i++ {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uint64(i))
+		digests[i] = sha256.Sum256(b[:])
+	}
+
+	for width := 1; width <= maxWidth; width++ {
+		err = tree.BuildWith(digests[:width])
+		require.NoError(t, err)
+
+		root := tree.Root()
+
+		// leaf sets: single leaves, consecutive leaves, every other leaf and all the leaves
+		leafSets := [][]int{{0}, {width - 1}}
+
+		for step := 1; step <= 3; step++ {
+			var leaves []int
+			for i := 0; i < width; i += step {
+				leaves = append(leaves, i)
+			}
+			leafSets = append(leafSets, leaves)
+		}
+
+		if width > 4 {
+			leafSets = append(leafSets, []int{1, 2, 3}, []int{0, width / 2, width - 1})
+		}
+
+		for _, leaves := range leafSets {
+			proof, err := tree.MultiInclusionProof(leaves)
+			require.NoError(t, err)
+
+			leafDigests := make([][sha256.Size]byte, len(leaves))
+			termsCount := 0
+
+			for i, leaf := range leaves {
+				leafDigests[i] = digests[leaf]
+
+				singleProof, err := tree.InclusionProof(leaf)
+				require.NoError(t, err)
+
+				termsCount += len(singleProof.Terms)
+			}
+
+			require.LessOrEqual(t, len(proof.Terms), termsCount)
+			require.True(t, VerifyMultiInclusion(proof, leafDigests, root))
+
+			require.False(t, VerifyMultiInclusion(proof, leafDigests, sha256.Sum256(root[:])))
+
+			tamperedDigests := make([][sha256.Size]byte, len(leafDigests))
+			copy(tamperedDigests, leafDigests)
+			tamperedDigests[0] = sha256.Sum256(tamperedDigests[0][:])
+			require.False(t, VerifyMultiInclusion(proof, tamperedDigests, root))
+
+			require.False(t, VerifyMultiInclusion(proof, leafDigests[1:], root))
+
+			if len(proof.Terms) > 0 {
+				terms := proof.Terms
+
+				proof.Terms = terms[1:]
+				require.False(t, VerifyMultiInclusion(proof, leafDigests, root))
+
+				proof.Terms = append(append([][sha256.Size]byte{}, terms...), root)
+				require.False(t, VerifyMultiInclusion(proof, leafDigests, root))
+
+				proof.Terms = terms
+			}
+
+			if leaves[0] > 0 {
+				proof.Leaves[0]--
+				require.False(t, VerifyMultiInclusion(proof, leafDigests, root))
+				proof.Leaves[0]++
+			}
+		}
+	}
+
+	proof, err := tree.MultiInclusionProof([]int{2, 7, 19})
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 7, 19}, proof.Leaves)
+	require.Equal(t, maxWidth, proof.Width)
+
+	_, err = tree.MultiInclusionProof(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = tree.MultiInclusionProof([]int{1, 1})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = tree.MultiInclusionProof([]int{2, 1})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = tree.MultiInclusionProof([]int{maxWidth})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	require.False(t, VerifyMultiInclusion(nil, nil, tree.Root()))
+	require.False(t, VerifyMultiInclusion(&MultiInclusionProof{Leaves: []int{1, 0}, Width: 2}, digests[:2], tree.Root()))
+}
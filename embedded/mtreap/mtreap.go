@@ -0,0 +1,1096 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtreap
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/cache"
+	"github.com/codenotary/immudb/embedded/multierr"
+)
+
+var ErrIllegalArguments = errors.New("mtreap: illegal arguments")
+var ErrInvalidOptions = fmt.Errorf("%w: invalid options", ErrIllegalArguments)
+var ErrorPathIsNotADirectory = errors.New("mtreap: path is not a directory")
+var ErrorCorruptedData = errors.New("mtreap: data log is corrupted")
+var ErrAlreadyClosed = errors.New("mtreap: already closed")
+var ErrVersionNotFound = errors.New("mtreap: version not found")
+var ErrIndexOutOfRange = fmt.Errorf("%w: index out of range", ErrIllegalArguments)
+
+const LeafPrefix = byte(0)
+const NodePrefix = byte(1)
+
+const Version = 1
+
+const (
+	MetaVersion = "VERSION"
+)
+
+const vLogEntrySize = 8 + offsetSize + offsetSize
+const offsetSize = 8
+const szSize = 4
+
+const noNode = int64(-1)
+
+// EmptyRoot is the root of a tree without keys
+var EmptyRoot = sha256.Sum256(nil)
+
+// MTreap stands for Merkle Treap, a binary search tree over sorted keys whose shape is fully determined
+// by the set of keys, as the priority of each key is given by its hash. Every node holds the digest
+// of its subtree together with its size, thus the root commits to the position of each key.
+//
+// Nodes are immutable, changes are applied by copying the path from the root to the changed nodes,
+// which takes O(log N) for each changed key. Changes are committed as versions, every committed
+// version remains readable as its nodes are never overwritten.
+type MTreap struct {
+	nLog appendable.Appendable
+	vLog appendable.Appendable
+
+	nLogSize int64
+	vLogSize int64
+
+	// last committed version
+	version uint64
+	rootOff int64
+
+	// root of the tree including uncommitted changes
+	root *node
+
+	pendingNodes    int
+	maxPendingNodes int
+
+	cache      *cache.Cache
+	cacheSlots int
+
+	closed bool
+	mutex  sync.RWMutex
+}
+
+type node struct {
+	key   []byte
+	value []byte
+
+	priority [sha256.Size]byte
+
+	size uint64
+	hash [sha256.Size]byte
+
+	// offset of the node within the nodes log, noNode until the node gets written
+	off int64
+
+	// children of written nodes
+	leftOff  int64
+	rightOff int64
+
+	// children of nodes not yet written
+	left  *node
+	right *node
+}
+
+// Snapshot provides read access to a committed version of the tree
+type Snapshot struct {
+	t       *MTreap
+	version uint64
+	root    *node
+}
+
+func Open(path string, opts *Options) (*MTreap, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	finfo, err := os.Stat(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		err := os.Mkdir(path, opts.fileMode)
+		if err != nil {
+			return nil, err
+		}
+	} else if !finfo.IsDir() {
+		return nil, fmt.Errorf("%w: '%s'", ErrorPathIsNotADirectory, path)
+	}
+
+	metadata := appendable.NewMetadata(nil)
+	metadata.PutInt(MetaVersion, Version)
+
+	appendableOpts := multiapp.DefaultOptions().
+		WithReadBufferSize(opts.readBufferSize).
+		WithWriteBufferSize(opts.writeBufferSize).
+		WithFileSize(opts.fileSize).
+		WithFileMode(opts.fileMode).
+		WithEncryption(opts.encryptionAlgorithm, opts.keyRing).
+		WithMetadata(metadata.Bytes())
+
+	appFactory := opts.appFactory
+	if appFactory == nil {
+		appFactory = func(rootPath, subPath string, opts *multiapp.Options) (appendable.Appendable, error) {
+			path := filepath.Join(rootPath, subPath)
+			return multiapp.Open(path, opts)
+		}
+	}
+
+	appendableOpts.WithFileExt("n")
+	nLog, err := appFactory(path, "nodes", appendableOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	appendableOpts.WithFileExt("v")
+	vLog, err := appFactory(path, "versions", appendableOpts)
+	if err != nil {
+		nLog.Close()
+		return nil, err
+	}
+
+	return OpenWith(nLog, vLog, opts)
+}
+
+func OpenWith(nLog, vLog appendable.Appendable, opts *Options) (*MTreap, error) {
+	if nLog == nil || vLog == nil {
+		return nil, fmt.Errorf("%w: nil appendable", ErrIllegalArguments)
+	}
+
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	nCache, err := cache.NewCache(opts.cacheSlots)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &MTreap{
+		nLog:            nLog,
+		vLog:            vLog,
+		rootOff:         noNode,
+		maxPendingNodes: opts.maxPendingNodes,
+		cache:           nCache,
+		cacheSlots:      opts.cacheSlots,
+	}
+
+	vLogSize, err := vLog.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	// partially written versions are discarded
+	err = t.resetTo(vLogSize / vLogEntrySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// resetTo discards every version after the first n ones, together with the nodes written since then
+func (t *MTreap) resetTo(n int64) error {
+	t.vLogSize = n * vLogEntrySize
+	t.version = 0
+	t.rootOff = noNode
+	t.nLogSize = 0
+
+	if n > 0 {
+		version, rootOff, nLogSize, err := t.readVersion(n - 1)
+		if err != nil {
+			return err
+		}
+
+		t.version = version
+		t.rootOff = rootOff
+		t.nLogSize = nLogSize
+	}
+
+	nLogSize, err := t.nLog.Size()
+	if err != nil {
+		return err
+	}
+
+	if nLogSize < t.nLogSize {
+		return ErrorCorruptedData
+	}
+
+	err = t.vLog.SetOffset(t.vLogSize)
+	if err != nil {
+		return err
+	}
+
+	// nodes written after the version are overwritten
+	err = t.nLog.SetOffset(t.nLogSize)
+	if err != nil {
+		return err
+	}
+
+	// cached nodes may have been discarded
+	t.cache, err = cache.NewCache(t.cacheSlots)
+	if err != nil {
+		return err
+	}
+
+	t.root, err = t.nodeAt(t.rootOff)
+	if err != nil {
+		return err
+	}
+
+	t.pendingNodes = 0
+
+	return nil
+}
+
+func (t *MTreap) readVersion(i int64) (version uint64, rootOff int64, nLogSize int64, err error) {
+	var b [vLogEntrySize]byte
+
+	_, err = t.vLog.ReadAt(b[:], i*vLogEntrySize)
+	if err != nil {
+		return
+	}
+
+	version = binary.BigEndian.Uint64(b[:])
+	rootOff = int64(binary.BigEndian.Uint64(b[8:]))
+	nLogSize = int64(binary.BigEndian.Uint64(b[8+offsetSize:]))
+
+	return
+}
+
+// Version returns the last committed version, zero denotes the empty tree preceding any version
+func (t *MTreap) Version() (uint64, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if t.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	return t.version, nil
+}
+
+// Set sets the value of a key, the change becomes readable once committed
+func (t *MTreap) Set(key, value []byte) error {
+	if len(key) == 0 || value == nil {
+		return ErrIllegalArguments
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	// keys and values are kept by the nodes of the tree
+	k := make([]byte, len(key))
+	copy(k, key)
+
+	v := make([]byte, len(value))
+	copy(v, value)
+
+	root, err := t.insert(t.root, k, v, priorityOf(k))
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+
+	return t.flushIfNeeded()
+}
+
+// Delete removes a key, if present. The change becomes readable once committed
+func (t *MTreap) Delete(key []byte) error {
+	if len(key) == 0 {
+		return ErrIllegalArguments
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	root, _, err := t.delete(t.root, key)
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+
+	return t.flushIfNeeded()
+}
+
+// Commit makes the changes applied since the last version readable as the specified version,
+// which must be greater than the last one
+func (t *MTreap) Commit(version uint64) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	if version <= t.version {
+		return fmt.Errorf("%w: version %d is not greater than the last one %d", ErrIllegalArguments, version, t.version)
+	}
+
+	err := t.write(t.root)
+	if err != nil {
+		return err
+	}
+
+	rootOff := noNode
+	if t.root != nil {
+		rootOff = t.root.off
+	}
+
+	err = t.nLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = t.nLog.Sync()
+	if err != nil {
+		return err
+	}
+
+	nLogSize, err := t.nLog.Size()
+	if err != nil {
+		return err
+	}
+
+	var b [vLogEntrySize]byte
+	binary.BigEndian.PutUint64(b[:], version)
+	binary.BigEndian.PutUint64(b[8:], uint64(rootOff))
+	binary.BigEndian.PutUint64(b[8+offsetSize:], uint64(nLogSize))
+
+	_, _, err = t.vLog.Append(b[:])
+	if err != nil {
+		return err
+	}
+
+	err = t.vLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = t.vLog.Sync()
+	if err != nil {
+		return err
+	}
+
+	t.version = version
+	t.rootOff = rootOff
+	t.nLogSize = nLogSize
+	t.vLogSize += vLogEntrySize
+	t.pendingNodes = 0
+
+	return nil
+}
+
+// Rollback discards the versions greater than the specified one together with uncommitted changes
+func (t *MTreap) Rollback(version uint64) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	n, _, err := t.searchVersion(version)
+	if err != nil {
+		return err
+	}
+
+	return t.resetTo(n)
+}
+
+// searchVersion returns the number of versions not greater than the specified one,
+// and whether the last of them is the specified version
+func (t *MTreap) searchVersion(version uint64) (int64, bool, error) {
+	i, j := int64(0), t.vLogSize/vLogEntrySize
+
+	for i < j {
+		h := int64(uint64(i+j) >> 1)
+
+		v, _, _, err := t.readVersion(h)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if v == version {
+			return h + 1, true, nil
+		}
+
+		if v < version {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+
+	return i, false, nil
+}
+
+// SnapshotAt returns a snapshot of the specified version, zero denotes the empty tree preceding any version
+func (t *MTreap) SnapshotAt(version uint64) (*Snapshot, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if t.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	rootOff := noNode
+
+	if version == t.version {
+		rootOff = t.rootOff
+	} else if version > 0 {
+		n, found, err := t.searchVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: %d", ErrVersionNotFound, version)
+		}
+
+		_, rootOff, _, err = t.readVersion(n - 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	root, err := t.nodeAt(rootOff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{t: t, version: version, root: root}, nil
+}
+
+func (t *MTreap) Sync() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	err := t.nLog.Sync()
+	if err != nil {
+		return err
+	}
+
+	return t.vLog.Sync()
+}
+
+func (t *MTreap) Close() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	t.closed = true
+
+	merrors := multierr.NewMultiErr()
+
+	err := t.nLog.Close()
+	merrors.Append(err)
+
+	err = t.vLog.Close()
+	merrors.Append(err)
+
+	return merrors.Reduce()
+}
+
+func priorityOf(key []byte) [sha256.Size]byte {
+	return sha256.Sum256(key)
+}
+
+func (t *MTreap) newNode(key, value []byte, priority [sha256.Size]byte, left, right *node) *node {
+	n := &node{
+		key:      key,
+		value:    value,
+		priority: priority,
+		off:      noNode,
+		leftOff:  noNode,
+		rightOff: noNode,
+		left:     left,
+		right:    right,
+	}
+
+	n.size = sizeOf(left) + 1 + sizeOf(right)
+	n.hash = nodeHash(sizeOf(left), hashOf(left), LeafDigest(key, value), sizeOf(right), hashOf(right))
+
+	t.pendingNodes++
+
+	return n
+}
+
+func sizeOf(n *node) uint64 {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func hashOf(n *node) [sha256.Size]byte {
+	if n == nil {
+		return EmptyRoot
+	}
+	return n.hash
+}
+
+func (t *MTreap) leftOf(n *node) (*node, error) {
+	if n.off == noNode {
+		return n.left, nil
+	}
+	return t.nodeAt(n.leftOff)
+}
+
+func (t *MTreap) rightOf(n *node) (*node, error) {
+	if n.off == noNode {
+		return n.right, nil
+	}
+	return t.nodeAt(n.rightOff)
+}
+
+func (t *MTreap) children(n *node) (left, right *node, err error) {
+	left, err = t.leftOf(n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	right, err = t.rightOf(n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return left, right, nil
+}
+
+func (t *MTreap) insert(n *node, key, value []byte, priority [sha256.Size]byte) (*node, error) {
+	if n == nil {
+		return t.newNode(key, value, priority, nil, nil), nil
+	}
+
+	c := bytes.Compare(key, n.key)
+
+	if c == 0 {
+		if bytes.Equal(value, n.value) {
+			return n, nil
+		}
+
+		left, right, err := t.children(n)
+		if err != nil {
+			return nil, err
+		}
+
+		return t.newNode(key, value, priority, left, right), nil
+	}
+
+	// the key is not held by the subtree, as its priority would not be lower than the one of the node
+	if bytes.Compare(priority[:], n.priority[:]) > 0 {
+		left, right, err := t.split(n, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return t.newNode(key, value, priority, left, right), nil
+	}
+
+	left, right, err := t.children(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if c < 0 {
+		nleft, err := t.insert(left, key, value, priority)
+		if err != nil {
+			return nil, err
+		}
+
+		if nleft == left {
+			return n, nil
+		}
+
+		return t.newNode(n.key, n.value, n.priority, nleft, right), nil
+	}
+
+	nright, err := t.insert(right, key, value, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	if nright == right {
+		return n, nil
+	}
+
+	return t.newNode(n.key, n.value, n.priority, left, nright), nil
+}
+
+// split splits the subtree into the keys lower and greater than the specified one, which must not be present
+func (t *MTreap) split(n *node, key []byte) (*node, *node, error) {
+	if n == nil {
+		return nil, nil, nil
+	}
+
+	left, right, err := t.children(n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bytes.Compare(n.key, key) < 0 {
+		l, r, err := t.split(right, key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return t.newNode(n.key, n.value, n.priority, left, l), r, nil
+	}
+
+	l, r, err := t.split(left, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return l, t.newNode(n.key, n.value, n.priority, r, right), nil
+}
+
+func (t *MTreap) delete(n *node, key []byte) (*node, bool, error) {
+	if n == nil {
+		return nil, false, nil
+	}
+
+	left, right, err := t.children(n)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c := bytes.Compare(key, n.key)
+
+	if c == 0 {
+		merged, err := t.merge(left, right)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return merged, true, nil
+	}
+
+	if c < 0 {
+		nleft, deleted, err := t.delete(left, key)
+		if err != nil || !deleted {
+			return n, false, err
+		}
+
+		return t.newNode(n.key, n.value, n.priority, nleft, right), true, nil
+	}
+
+	nright, deleted, err := t.delete(right, key)
+	if err != nil || !deleted {
+		return n, false, err
+	}
+
+	return t.newNode(n.key, n.value, n.priority, left, nright), true, nil
+}
+
+// merge merges two subtrees, keys of the first one being lower than the ones of the second one
+func (t *MTreap) merge(a, b *node) (*node, error) {
+	if a == nil {
+		return b, nil
+	}
+
+	if b == nil {
+		return a, nil
+	}
+
+	if bytes.Compare(a.priority[:], b.priority[:]) > 0 {
+		left, right, err := t.children(a)
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := t.merge(right, b)
+		if err != nil {
+			return nil, err
+		}
+
+		return t.newNode(a.key, a.value, a.priority, left, m), nil
+	}
+
+	left, right, err := t.children(b)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := t.merge(a, left)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.newNode(b.key, b.value, b.priority, m, right), nil
+}
+
+// flushIfNeeded writes the nodes of uncommitted changes once they exceed the allowed amount,
+// so that memory usage is bounded regardless of the number of changes
+func (t *MTreap) flushIfNeeded() error {
+	if t.pendingNodes < t.maxPendingNodes {
+		return nil
+	}
+
+	err := t.write(t.root)
+	if err != nil {
+		return err
+	}
+
+	t.pendingNodes = 0
+
+	return nil
+}
+
+// write writes the nodes of the subtree not yet written, children being written before their parents
+func (t *MTreap) write(n *node) error {
+	if n == nil || n.off != noNode {
+		return nil
+	}
+
+	err := t.write(n.left)
+	if err != nil {
+		return err
+	}
+
+	err = t.write(n.right)
+	if err != nil {
+		return err
+	}
+
+	leftOff := noNode
+	if n.left != nil {
+		leftOff = n.left.off
+	}
+
+	rightOff := noNode
+	if n.right != nil {
+		rightOff = n.right.off
+	}
+
+	b := make([]byte, szSize+szSize+len(n.key)+szSize+len(n.value)+2*offsetSize+8+sha256.Size)
+	i := szSize
+
+	binary.BigEndian.PutUint32(b[i:], uint32(len(n.key)))
+	i += szSize
+
+	copy(b[i:], n.key)
+	i += len(n.key)
+
+	binary.BigEndian.PutUint32(b[i:], uint32(len(n.value)))
+	i += szSize
+
+	copy(b[i:], n.value)
+	i += len(n.value)
+
+	binary.BigEndian.PutUint64(b[i:], uint64(leftOff))
+	i += offsetSize
+
+	binary.BigEndian.PutUint64(b[i:], uint64(rightOff))
+	i += offsetSize
+
+	binary.BigEndian.PutUint64(b[i:], n.size)
+	i += 8
+
+	copy(b[i:], n.hash[:])
+
+	binary.BigEndian.PutUint32(b, uint32(len(b)-szSize))
+
+	off, _, err := t.nLog.Append(b)
+	if err != nil {
+		return err
+	}
+
+	n.off = off
+	n.leftOff = leftOff
+	n.rightOff = rightOff
+
+	// written children are read back when needed
+	n.left = nil
+	n.right = nil
+
+	_, _, err = t.cache.Put(off, n)
+	return err
+}
+
+func (t *MTreap) nodeAt(off int64) (*node, error) {
+	if off == noNode {
+		return nil, nil
+	}
+
+	cached, err := t.cache.Get(off)
+	if err == nil {
+		return cached.(*node), nil
+	}
+
+	var sb [szSize]byte
+	_, err = t.nLog.ReadAt(sb[:], off)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, binary.BigEndian.Uint32(sb[:]))
+	_, err = t.nLog.ReadAt(b, off+szSize)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := decodeNode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	n.off = off
+
+	_, _, err = t.cache.Put(off, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func decodeNode(b []byte) (*node, error) {
+	n := &node{}
+	i := 0
+
+	if len(b) < szSize {
+		return nil, ErrorCorruptedData
+	}
+
+	klen := int(binary.BigEndian.Uint32(b[i:]))
+	i += szSize
+
+	if len(b) < i+klen+szSize {
+		return nil, ErrorCorruptedData
+	}
+
+	n.key = b[i : i+klen]
+	i += klen
+
+	vlen := int(binary.BigEndian.Uint32(b[i:]))
+	i += szSize
+
+	if len(b) != i+vlen+2*offsetSize+8+sha256.Size {
+		return nil, ErrorCorruptedData
+	}
+
+	n.value = b[i : i+vlen]
+	i += vlen
+
+	n.leftOff = int64(binary.BigEndian.Uint64(b[i:]))
+	i += offsetSize
+
+	n.rightOff = int64(binary.BigEndian.Uint64(b[i:]))
+	i += offsetSize
+
+	n.size = binary.BigEndian.Uint64(b[i:])
+	i += 8
+
+	copy(n.hash[:], b[i:])
+
+	n.priority = priorityOf(n.key)
+
+	return n, nil
+}
+
+func (s *Snapshot) Version() uint64 {
+	return s.version
+}
+
+// Size returns the number of keys
+func (s *Snapshot) Size() uint64 {
+	return sizeOf(s.root)
+}
+
+func (s *Snapshot) Root() [sha256.Size]byte {
+	return hashOf(s.root)
+}
+
+// Rank returns the number of keys lower than the specified one and whether the key is present
+func (s *Snapshot) Rank(key []byte) (rank uint64, found bool, err error) {
+	s.t.mutex.RLock()
+	defer s.t.mutex.RUnlock()
+
+	if s.t.closed {
+		return 0, false, ErrAlreadyClosed
+	}
+
+	n := s.root
+
+	for n != nil {
+		left, err := s.t.leftOf(n)
+		if err != nil {
+			return 0, false, err
+		}
+
+		c := bytes.Compare(key, n.key)
+
+		if c == 0 {
+			return rank + sizeOf(left), true, nil
+		}
+
+		if c < 0 {
+			n = left
+			continue
+		}
+
+		rank += sizeOf(left) + 1
+
+		n, err = s.t.rightOf(n)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	return rank, false, nil
+}
+
+// At returns the i-th lowest key together with its value
+func (s *Snapshot) At(i uint64) (key, value []byte, err error) {
+	path, _, err := s.pathTo(i)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := path[len(path)-1]
+
+	return n.key, n.value, nil
+}
+
+// InclusionProof returns the proof for inclusion of the i-th lowest key
+func (s *Snapshot) InclusionProof(i uint64) (*InclusionProof, error) {
+	path, rights, err := s.pathTo(i)
+	if err != nil {
+		return nil, err
+	}
+
+	s.t.mutex.RLock()
+	defer s.t.mutex.RUnlock()
+
+	if s.t.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	n := path[len(path)-1]
+
+	left, right, err := s.t.children(n)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &InclusionProof{
+		Leaf:      i,
+		Width:     s.Size(),
+		LeftSize:  sizeOf(left),
+		LeftHash:  hashOf(left),
+		RightSize: sizeOf(right),
+		RightHash: hashOf(right),
+		Path:      make([]*ProofStep, 0, len(path)-1),
+	}
+
+	for j := len(path) - 2; j >= 0; j-- {
+		a := path[j]
+
+		var sibling *node
+
+		if rights[j] {
+			sibling, err = s.t.leftOf(a)
+		} else {
+			sibling, err = s.t.rightOf(a)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		proof.Path = append(proof.Path, &ProofStep{
+			Right:       rights[j],
+			Digest:      LeafDigest(a.key, a.value),
+			SiblingSize: sizeOf(sibling),
+			SiblingHash: hashOf(sibling),
+		})
+	}
+
+	return proof, nil
+}
+
+// pathTo returns the nodes from the root to the one holding the i-th lowest key,
+// together with whether the path goes through the right child of each of them
+func (s *Snapshot) pathTo(i uint64) ([]*node, []bool, error) {
+	s.t.mutex.RLock()
+	defer s.t.mutex.RUnlock()
+
+	if s.t.closed {
+		return nil, nil, ErrAlreadyClosed
+	}
+
+	if i >= s.Size() {
+		return nil, nil, ErrIndexOutOfRange
+	}
+
+	var path []*node
+	var rights []bool
+
+	n := s.root
+
+	for {
+		left, err := s.t.leftOf(n)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		path = append(path, n)
+
+		if i == sizeOf(left) {
+			rights = append(rights, false)
+			return path, rights, nil
+		}
+
+		if i < sizeOf(left) {
+			rights = append(rights, false)
+			n = left
+			continue
+		}
+
+		i -= sizeOf(left) + 1
+		rights = append(rights, true)
+
+		n, err = s.t.rightOf(n)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
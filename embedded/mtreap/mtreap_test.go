@@ -0,0 +1,353 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtreap
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireSnapshot checks the snapshot holds exactly the specified keys and proves each of them
+func requireSnapshot(t *testing.T, snap *Snapshot, kvs map[string]string) {
+	keys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	require.Equal(t, uint64(len(keys)), snap.Size())
+
+	if len(keys) == 0 {
+		require.Equal(t, EmptyRoot, snap.Root())
+	}
+
+	for i, k := range keys {
+		key, value, err := snap.At(uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, k, string(key))
+		require.Equal(t, kvs[k], string(value))
+
+		rank, found, err := snap.Rank([]byte(k))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, uint64(i), rank)
+
+		proof, err := snap.InclusionProof(uint64(i))
+		require.NoError(t, err)
+		require.True(t, VerifyInclusion(proof, key, value, snap.Root()))
+
+		// the proof is bound to the key, its value and its position
+		require.False(t, VerifyInclusion(proof, key, []byte("other"), snap.Root()))
+
+		proof.Leaf++
+		require.False(t, VerifyInclusion(proof, key, value, snap.Root()))
+	}
+
+	_, _, err := snap.At(uint64(len(keys)))
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestMTreap(t *testing.T) {
+	tree, err := Open(t.TempDir(), DefaultOptions().WithMaxPendingNodes(10))
+	require.NoError(t, err)
+	defer tree.Close()
+
+	rnd := rand.New(rand.NewSource(0))
+
+	kvs := make(map[string]string)
+	versions := make(map[uint64]map[string]string)
+
+	for v := uint64(1); v <= 20; v++ {
+		for i := 0; i < 50; i++ {
+			k := fmt.Sprintf("key%03d", rnd.Intn(200))
+
+			if rnd.Intn(4) == 0 {
+				err := tree.Delete([]byte(k))
+				require.NoError(t, err)
+
+				delete(kvs, k)
+				continue
+			}
+
+			val := fmt.Sprintf("value%d-%d", v, i)
+
+			err := tree.Set([]byte(k), []byte(val))
+			require.NoError(t, err)
+
+			kvs[k] = val
+		}
+
+		err := tree.Commit(v * 10)
+		require.NoError(t, err)
+
+		versions[v*10] = make(map[string]string, len(kvs))
+		for k, val := range kvs {
+			versions[v*10][k] = val
+		}
+	}
+
+	lastVersion, err := tree.Version()
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), lastVersion)
+
+	err = tree.Commit(lastVersion)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	for v, kvs := range versions {
+		snap, err := tree.SnapshotAt(v)
+		require.NoError(t, err)
+		require.Equal(t, v, snap.Version())
+
+		requireSnapshot(t, snap, kvs)
+	}
+
+	snap, err := tree.SnapshotAt(0)
+	require.NoError(t, err)
+	requireSnapshot(t, snap, nil)
+
+	_, err = tree.SnapshotAt(15)
+	require.ErrorIs(t, err, ErrVersionNotFound)
+
+	t.Run("the root only depends on the keys", func(t *testing.T) {
+		other, err := Open(t.TempDir(), DefaultOptions())
+		require.NoError(t, err)
+		defer other.Close()
+
+		keys := make([]string, 0, len(kvs))
+		for k := range kvs {
+			keys = append(keys, k)
+		}
+		rnd.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+
+		for _, k := range keys {
+			err := other.Set([]byte(k), []byte(kvs[k]))
+			require.NoError(t, err)
+		}
+
+		err = other.Commit(1)
+		require.NoError(t, err)
+
+		otherSnap, err := other.SnapshotAt(1)
+		require.NoError(t, err)
+
+		snap, err := tree.SnapshotAt(lastVersion)
+		require.NoError(t, err)
+
+		require.Equal(t, snap.Root(), otherSnap.Root())
+	})
+
+	t.Run("missing keys are ranked among the existing ones", func(t *testing.T) {
+		snap, err := tree.SnapshotAt(lastVersion)
+		require.NoError(t, err)
+
+		rank, found, err := snap.Rank([]byte("key"))
+		require.NoError(t, err)
+		require.False(t, found)
+		require.Zero(t, rank)
+
+		rank, found, err = snap.Rank([]byte("zzz"))
+		require.NoError(t, err)
+		require.False(t, found)
+		require.Equal(t, snap.Size(), rank)
+	})
+}
+
+func TestMTreapUncommittedChanges(t *testing.T) {
+	tree, err := Open(t.TempDir(), DefaultOptions().WithMaxPendingNodes(1))
+	require.NoError(t, err)
+	defer tree.Close()
+
+	err = tree.Set([]byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	err = tree.Commit(1)
+	require.NoError(t, err)
+
+	err = tree.Set([]byte("key2"), []byte("value2"))
+	require.NoError(t, err)
+
+	snap, err := tree.SnapshotAt(1)
+	require.NoError(t, err)
+	requireSnapshot(t, snap, map[string]string{"key1": "value1"})
+
+	err = tree.Rollback(1)
+	require.NoError(t, err)
+
+	err = tree.Commit(2)
+	require.NoError(t, err)
+
+	snap, err = tree.SnapshotAt(2)
+	require.NoError(t, err)
+	requireSnapshot(t, snap, map[string]string{"key1": "value1"})
+
+	err = tree.Set(nil, []byte("value"))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = tree.Set([]byte("key"), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = tree.Delete(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
+
+func TestMTreapReopening(t *testing.T) {
+	dir := t.TempDir()
+
+	tree, err := Open(dir, DefaultOptions())
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 3; v++ {
+		err := tree.Set([]byte(fmt.Sprintf("key%d", v)), []byte(fmt.Sprintf("value%d", v)))
+		require.NoError(t, err)
+
+		err = tree.Commit(v)
+		require.NoError(t, err)
+	}
+
+	// uncommitted changes are lost
+	err = tree.Set([]byte("key4"), []byte("value4"))
+	require.NoError(t, err)
+
+	err = tree.Close()
+	require.NoError(t, err)
+
+	err = tree.Close()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	_, err = tree.SnapshotAt(1)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	// partially written versions are discarded
+	f, err := os.OpenFile(filepath.Join(dir, "versions", "00000000.v"), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+
+	_, err = f.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+
+	err = f.Close()
+	require.NoError(t, err)
+
+	tree, err = Open(dir, DefaultOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	version, err := tree.Version()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), version)
+
+	snap, err := tree.SnapshotAt(3)
+	require.NoError(t, err)
+	requireSnapshot(t, snap, map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"})
+
+	snap, err = tree.SnapshotAt(1)
+	require.NoError(t, err)
+	requireSnapshot(t, snap, map[string]string{"key1": "value1"})
+
+	err = tree.Rollback(1)
+	require.NoError(t, err)
+
+	version, err = tree.Version()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+
+	_, err = tree.SnapshotAt(2)
+	require.ErrorIs(t, err, ErrVersionNotFound)
+
+	err = tree.Delete([]byte("key1"))
+	require.NoError(t, err)
+
+	err = tree.Commit(2)
+	require.NoError(t, err)
+
+	snap, err = tree.SnapshotAt(2)
+	require.NoError(t, err)
+	requireSnapshot(t, snap, nil)
+}
+
+func TestMTreapOpening(t *testing.T) {
+	_, err := Open(t.TempDir(), nil)
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, err = OpenWith(nil, nil, DefaultOptions())
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	path := filepath.Join(t.TempDir(), "file")
+
+	err = os.WriteFile(path, nil, 0644)
+	require.NoError(t, err)
+
+	_, err = Open(path, DefaultOptions())
+	require.ErrorIs(t, err, ErrorPathIsNotADirectory)
+}
+
+func TestVerifyInclusion(t *testing.T) {
+	tree, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer tree.Close()
+
+	for i := 0; i < 10; i++ {
+		err := tree.Set([]byte{byte(i)}, []byte{byte(i)})
+		require.NoError(t, err)
+	}
+
+	err = tree.Commit(1)
+	require.NoError(t, err)
+
+	snap, err := tree.SnapshotAt(1)
+	require.NoError(t, err)
+
+	// the root is the only node without ancestors
+	proof, err := snap.InclusionProof(0)
+	require.NoError(t, err)
+
+	i := byte(0)
+	for ; len(proof.Path) == 0; i++ {
+		proof, err = snap.InclusionProof(uint64(i + 1))
+		require.NoError(t, err)
+	}
+
+	key := []byte{i}
+	require.True(t, VerifyInclusion(proof, key, key, snap.Root()))
+
+	require.False(t, VerifyInclusion(nil, key, key, snap.Root()))
+
+	proof.Width++
+	require.False(t, VerifyInclusion(proof, key, key, snap.Root()))
+	proof.Width--
+
+	proof.Path[0].SiblingSize++
+	require.False(t, VerifyInclusion(proof, key, key, snap.Root()))
+	proof.Path[0].SiblingSize--
+
+	proof.Path[0].Right = !proof.Path[0].Right
+	require.False(t, VerifyInclusion(proof, key, key, snap.Root()))
+	proof.Path[0].Right = !proof.Path[0].Right
+
+	proof.Path = append(proof.Path, nil)
+	require.False(t, VerifyInclusion(proof, key, key, snap.Root()))
+	proof.Path = proof.Path[:len(proof.Path)-1]
+
+	// sizes can not overflow
+	proof.LeftSize = ^uint64(0)
+	require.False(t, VerifyInclusion(proof, key, key, snap.Root()))
+}
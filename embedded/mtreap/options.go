@@ -0,0 +1,143 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtreap
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+)
+
+const DefaultFileSize = multiapp.DefaultFileSize
+const DefaultFileMode = os.FileMode(0755)
+const DefaultCacheSlots = 100_000
+const DefaultMaxPendingNodes = 100_000
+
+type AppFactoryFunc func(
+	rootPath string,
+	subPath string,
+	opts *multiapp.Options,
+) (appendable.Appendable, error)
+
+type Options struct {
+	readBufferSize  int
+	writeBufferSize int
+
+	fileMode os.FileMode
+
+	appFactory AppFactoryFunc
+
+	encryptionAlgorithm int
+	keyRing             appendable.KeyRing
+
+	cacheSlots int
+
+	// nodes created by uncommitted changes are written once they exceed the specified amount
+	maxPendingNodes int
+
+	// Options below are only set during initialization and stored as metadata
+	fileSize int
+}
+
+func DefaultOptions() *Options {
+	return &Options{
+		readBufferSize:  multiapp.DefaultReadBufferSize,
+		writeBufferSize: multiapp.DefaultWriteBufferSize,
+
+		fileMode:        DefaultFileMode,
+		cacheSlots:      DefaultCacheSlots,
+		maxPendingNodes: DefaultMaxPendingNodes,
+
+		// Options below are only set during initialization and stored as metadata
+		fileSize: DefaultFileSize,
+	}
+}
+
+func (opts *Options) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil options", ErrInvalidOptions)
+	}
+
+	if opts.fileSize <= 0 {
+		return fmt.Errorf("%w: invalid fileSize", ErrInvalidOptions)
+	}
+
+	if opts.cacheSlots <= 0 {
+		return fmt.Errorf("%w: invalid cacheSlots", ErrInvalidOptions)
+	}
+
+	if opts.maxPendingNodes <= 0 {
+		return fmt.Errorf("%w: invalid maxPendingNodes", ErrInvalidOptions)
+	}
+
+	if opts.readBufferSize <= 0 {
+		return fmt.Errorf("%w: invalid readBufferSize", ErrInvalidOptions)
+	}
+
+	if opts.writeBufferSize <= 0 {
+		return fmt.Errorf("%w: invalid writeBufferSize", ErrInvalidOptions)
+	}
+
+	if opts.encryptionAlgorithm != appendable.NoEncryption && opts.keyRing == nil {
+		return fmt.Errorf("%w: invalid keyRing", ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+func (opts *Options) WithReadBufferSize(size int) *Options {
+	opts.readBufferSize = size
+	return opts
+}
+
+func (opts *Options) WithWriteBufferSize(size int) *Options {
+	opts.writeBufferSize = size
+	return opts
+}
+
+func (opts *Options) WithFileMode(fileMode os.FileMode) *Options {
+	opts.fileMode = fileMode
+	return opts
+}
+
+func (opts *Options) WithCacheSlots(cacheSlots int) *Options {
+	opts.cacheSlots = cacheSlots
+	return opts
+}
+
+func (opts *Options) WithMaxPendingNodes(maxPendingNodes int) *Options {
+	opts.maxPendingNodes = maxPendingNodes
+	return opts
+}
+
+func (opts *Options) WithFileSize(fileSize int) *Options {
+	opts.fileSize = fileSize
+	return opts
+}
+
+func (opts *Options) WithEncryption(encryptionAlgorithm int, keyRing appendable.KeyRing) *Options {
+	opts.encryptionAlgorithm = encryptionAlgorithm
+	opts.keyRing = keyRing
+	return opts
+}
+
+func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
+	opts.appFactory = appFactory
+	return opts
+}
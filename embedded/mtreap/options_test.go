@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtreap
+
+import (
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidOptions(t *testing.T) {
+	for _, d := range []struct {
+		n    string
+		opts *Options
+	}{
+		{"nil", nil},
+		{"empty", &Options{}},
+		{"FileSize", DefaultOptions().WithFileSize(0)},
+		{"CacheSlots", DefaultOptions().WithCacheSlots(0)},
+		{"MaxPendingNodes", DefaultOptions().WithMaxPendingNodes(0)},
+		{"ReadBufferSize", DefaultOptions().WithReadBufferSize(0)},
+		{"WriteBufferSize", DefaultOptions().WithWriteBufferSize(0)},
+		{"KeyRing", DefaultOptions().WithEncryption(appendable.AESGCMEncryption, nil)},
+	} {
+		t.Run(d.n, func(t *testing.T) {
+			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
+		})
+	}
+}
+
+func TestDefaultOptions(t *testing.T) {
+	require.NoError(t, DefaultOptions().Validate())
+}
+
+func TestValidOptions(t *testing.T) {
+	opts := &Options{}
+
+	dummyAppFactory := func(rootPath, subPath string, opts *multiapp.Options) (appendable.Appendable, error) {
+		return nil, nil
+	}
+
+	require.Equal(t, DefaultFileSize, opts.WithFileSize(DefaultFileSize).fileSize)
+	require.Equal(t, DefaultFileMode, opts.WithFileMode(DefaultFileMode).fileMode)
+	require.Equal(t, DefaultCacheSlots, opts.WithCacheSlots(DefaultCacheSlots).cacheSlots)
+	require.Equal(t, DefaultMaxPendingNodes, opts.WithMaxPendingNodes(DefaultMaxPendingNodes).maxPendingNodes)
+	require.Equal(t, 1<<20, opts.WithReadBufferSize(1<<20).readBufferSize)
+	require.Equal(t, 1<<22, opts.WithWriteBufferSize(1<<22).writeBufferSize)
+	require.NotNil(t, opts.WithAppFactory(dummyAppFactory).appFactory)
+	require.Equal(t, appendable.NoEncryption, opts.WithEncryption(appendable.NoEncryption, nil).encryptionAlgorithm)
+
+	require.NoError(t, opts.Validate())
+}
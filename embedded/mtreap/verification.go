@@ -0,0 +1,139 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mtreap
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// InclusionProof proves the inclusion of a key at a given position among the sorted keys of a tree.
+// Sizes of the subtrees are part of the digests, thus the position of the key and the number of keys
+// are computed while the root is evaluated.
+type InclusionProof struct {
+	// Leaf is the position of the key
+	Leaf uint64
+	// Width is the number of keys in the tree
+	Width uint64
+
+	// children of the node holding the key
+	LeftSize  uint64
+	LeftHash  [sha256.Size]byte
+	RightSize uint64
+	RightHash [sha256.Size]byte
+
+	// ancestors of the node holding the key, from its parent up to the root
+	Path []*ProofStep
+}
+
+type ProofStep struct {
+	// Right is true if the subtree holding the key is the right child of the node
+	Right bool
+	// Digest is the leaf digest of the key of the node
+	Digest [sha256.Size]byte
+
+	// the other child of the node
+	SiblingSize uint64
+	SiblingHash [sha256.Size]byte
+}
+
+// LeafDigest returns the digest binding a key to its value
+func LeafDigest(key, value []byte) [sha256.Size]byte {
+	b := make([]byte, 1+4+len(key)+len(value))
+
+	b[0] = LeafPrefix
+	binary.BigEndian.PutUint32(b[1:], uint32(len(key)))
+	copy(b[5:], key)
+	copy(b[5+len(key):], value)
+
+	return sha256.Sum256(b)
+}
+
+func nodeHash(leftSize uint64, leftHash, digest [sha256.Size]byte, rightSize uint64, rightHash [sha256.Size]byte) [sha256.Size]byte {
+	var b [1 + 8 + sha256.Size + sha256.Size + 8 + sha256.Size]byte
+
+	b[0] = NodePrefix
+	i := 1
+
+	binary.BigEndian.PutUint64(b[i:], leftSize)
+	i += 8
+
+	copy(b[i:], leftHash[:])
+	i += sha256.Size
+
+	copy(b[i:], digest[:])
+	i += sha256.Size
+
+	binary.BigEndian.PutUint64(b[i:], rightSize)
+	i += 8
+
+	copy(b[i:], rightHash[:])
+
+	return sha256.Sum256(b[:])
+}
+
+func VerifyInclusion(proof *InclusionProof, key, value []byte, root [sha256.Size]byte) bool {
+	if proof == nil {
+		return false
+	}
+
+	h, size, leaf, ok := EvalInclusion(proof, LeafDigest(key, value))
+
+	return ok && h == root && size == proof.Width && leaf == proof.Leaf
+}
+
+// EvalInclusion returns the root, the number of keys and the position of the leaf resulting from the proof
+func EvalInclusion(proof *InclusionProof, digest [sha256.Size]byte) (root [sha256.Size]byte, size, leaf uint64, ok bool) {
+	size, ok = addSizes(proof.LeftSize, proof.RightSize)
+	if !ok {
+		return
+	}
+
+	leaf = proof.LeftSize
+	root = nodeHash(proof.LeftSize, proof.LeftHash, digest, proof.RightSize, proof.RightHash)
+
+	for _, step := range proof.Path {
+		if step == nil {
+			return root, size, leaf, false
+		}
+
+		if step.Right {
+			root = nodeHash(step.SiblingSize, step.SiblingHash, step.Digest, size, root)
+
+			leaf, ok = addSizes(leaf, step.SiblingSize)
+			if !ok {
+				return
+			}
+		} else {
+			root = nodeHash(size, root, step.Digest, step.SiblingSize, step.SiblingHash)
+		}
+
+		size, ok = addSizes(size, step.SiblingSize)
+		if !ok {
+			return
+		}
+	}
+
+	return root, size, leaf, true
+}
+
+// addSizes returns the size of a node whose children have the specified sizes
+func addSizes(a, b uint64) (uint64, bool) {
+	s, carry := bits.Add64(a, b, 1)
+	return s, carry == 0
+}
@@ -21,6 +21,7 @@ import "strconv"
 type AggregatedValue interface {
 	TypedValue
 	updateWith(val TypedValue) error
+	mergeWith(partial AggregatedValue) error
 	Selector() string
 	ColBounded() bool
 }
@@ -77,6 +78,16 @@ func (v *CountValue) updateWith(val TypedValue) error {
 	return nil
 }
 
+func (v *CountValue) mergeWith(partial AggregatedValue) error {
+	pv, ok := partial.(*CountValue)
+	if !ok {
+		return ErrUnexpected
+	}
+
+	v.c += pv.c
+	return nil
+}
+
 // ValueExp
 
 func (v *CountValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -177,6 +188,14 @@ func (v *SumValue) updateWith(val TypedValue) error {
 	return nil
 }
 
+func (v *SumValue) mergeWith(partial AggregatedValue) error {
+	pv, ok := partial.(*SumValue)
+	if !ok {
+		return ErrUnexpected
+	}
+	return v.updateWith(pv.val)
+}
+
 // ValueExp
 
 func (v *SumValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -275,6 +294,14 @@ func (v *MinValue) updateWith(val TypedValue) error {
 	return nil
 }
 
+func (v *MinValue) mergeWith(partial AggregatedValue) error {
+	pv, ok := partial.(*MinValue)
+	if !ok {
+		return ErrUnexpected
+	}
+	return v.updateWith(pv.val)
+}
+
 // ValueExp
 
 func (v *MinValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -382,6 +409,14 @@ func (v *MaxValue) updateWith(val TypedValue) error {
 	return nil
 }
 
+func (v *MaxValue) mergeWith(partial AggregatedValue) error {
+	pv, ok := partial.(*MaxValue)
+	if !ok {
+		return ErrUnexpected
+	}
+	return v.updateWith(pv.val)
+}
+
 // ValueExp
 
 func (v *MaxValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -506,6 +541,32 @@ func (v *AVGValue) updateWith(val TypedValue) error {
 	return nil
 }
 
+func (v *AVGValue) mergeWith(partial AggregatedValue) error {
+	pv, ok := partial.(*AVGValue)
+	if !ok {
+		return ErrUnexpected
+	}
+
+	if pv.s.IsNull() {
+		return nil
+	}
+
+	if v.s.IsNull() {
+		v.s = pv.s
+		v.c = pv.c
+		return nil
+	}
+
+	newVal, err := applyNumOperator(ADDOP, v.s, pv.s)
+	if err != nil {
+		return err
+	}
+
+	v.s = newVal
+	v.c += pv.c
+	return nil
+}
+
 // ValueExp
 
 func (v *AVGValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -40,6 +41,10 @@ type Catalog struct {
 	tablesByName map[string]*Table
 
 	maxTableID uint32 // The maxTableID variable is used to assign unique ids to new tables as they are created.
+
+	sequences       []*Sequence
+	sequencesByName map[string]*Sequence
+	maxSequenceID   uint32
 }
 
 type Constraint interface{}
@@ -52,6 +57,28 @@ type CheckConstraint struct {
 	exp  ValueExp
 }
 
+// Policy is a row-level security policy restricting the rows of a table
+// which can be accessed by the logged user through a given command.
+type Policy struct {
+	id   uint32
+	name string
+	cmd  SQLPrivilege // SELECT, UPDATE, DELETE or empty when the policy applies to all of them
+	exp  ValueExp
+}
+
+// Sequence generates integer values which can be shared across tables.
+// Its last value is stored apart from the definition and updated transactionally.
+type Sequence struct {
+	catalog   *Catalog
+	id        uint32
+	name      string
+	increment int64
+	minValue  int64
+	maxValue  int64
+	start     int64
+	cycle     bool
+}
+
 type Table struct {
 	catalog          *Catalog
 	id               uint32
@@ -63,12 +90,14 @@ type Table struct {
 	indexesByName    map[string]*Index
 	indexesByColID   map[uint32][]*Index
 	checkConstraints map[string]CheckConstraint
+	policies         map[string]*Policy
 	primaryIndex     *Index
 	autoIncrementPK  bool
 	maxPK            int64
 
-	maxColID   uint32
-	maxIndexID uint32
+	maxColID    uint32
+	maxIndexID  uint32
+	maxPolicyID uint32
 }
 
 type Index struct {
@@ -87,13 +116,15 @@ type Column struct {
 	maxLen        int
 	autoIncrement bool
 	notNull       bool
+	defaultValue  ValueExp
 }
 
 func newCatalog(enginePrefix []byte) *Catalog {
 	ctlg := &Catalog{
-		enginePrefix: enginePrefix,
-		tablesByID:   make(map[uint32]*Table),
-		tablesByName: make(map[string]*Table),
+		enginePrefix:    enginePrefix,
+		tablesByID:      make(map[uint32]*Table),
+		tablesByName:    make(map[string]*Table),
+		sequencesByName: make(map[string]*Sequence),
 	}
 
 	pgTypeTable := &Table{
@@ -155,6 +186,198 @@ func (catlg *Catalog) GetTables() []*Table {
 	return ts
 }
 
+// GetSequences returns the sequences of the catalog in order of creation
+func (catlg *Catalog) GetSequences() []*Sequence {
+	return append([]*Sequence(nil), catlg.sequences...)
+}
+
+func (catlg *Catalog) GetSequenceByName(name string) (*Sequence, error) {
+	seq, exists := catlg.sequencesByName[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrSequenceDoesNotExist, name)
+	}
+	return seq, nil
+}
+
+func (catlg *Catalog) ExistSequence(name string) bool {
+	_, exists := catlg.sequencesByName[name]
+	return exists
+}
+
+func (catlg *Catalog) newSequence(name string, opts *sequenceOptions) (*Sequence, error) {
+	if len(name) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	if catlg.ExistSequence(name) {
+		return nil, fmt.Errorf("%w (%s)", ErrSequenceAlreadyExists, name)
+	}
+
+	seq := &Sequence{
+		catalog:   catlg,
+		id:        catlg.maxSequenceID + 1,
+		name:      name,
+		increment: 1,
+		cycle:     opts.cycle != nil && *opts.cycle,
+	}
+
+	if opts.increment != nil {
+		seq.increment = *opts.increment
+	}
+
+	if seq.increment == 0 {
+		return nil, fmt.Errorf("%w: INCREMENT must not be zero", ErrInvalidSequence)
+	}
+
+	// ascending sequences start from the min value while descending ones do from the max value
+	seq.minValue, seq.maxValue = 1, math.MaxInt64
+	if seq.increment < 0 {
+		seq.minValue, seq.maxValue = math.MinInt64, -1
+	}
+
+	if opts.minValue != nil {
+		seq.minValue = *opts.minValue
+	}
+
+	if opts.maxValue != nil {
+		seq.maxValue = *opts.maxValue
+	}
+
+	if seq.minValue >= seq.maxValue {
+		return nil, fmt.Errorf("%w: MINVALUE (%d) must be less than MAXVALUE (%d)", ErrInvalidSequence, seq.minValue, seq.maxValue)
+	}
+
+	seq.start = seq.minValue
+	if seq.increment < 0 {
+		seq.start = seq.maxValue
+	}
+
+	if opts.start != nil {
+		seq.start = *opts.start
+	}
+
+	if seq.start < seq.minValue || seq.start > seq.maxValue {
+		return nil, fmt.Errorf("%w: START value (%d) must be between MINVALUE (%d) and MAXVALUE (%d)", ErrInvalidSequence, seq.start, seq.minValue, seq.maxValue)
+	}
+
+	catlg.sequences = append(catlg.sequences, seq)
+	catlg.sequencesByName[name] = seq
+	catlg.maxSequenceID++
+
+	return seq, nil
+}
+
+func (catlg *Catalog) deleteSequence(seq *Sequence) {
+	sequences := make([]*Sequence, 0, len(catlg.sequences)-1)
+	for _, s := range catlg.sequences {
+		if s.id != seq.id {
+			sequences = append(sequences, s)
+		}
+	}
+
+	catlg.sequences = sequences
+	delete(catlg.sequencesByName, seq.name)
+}
+
+func (seq *Sequence) ID() uint32 {
+	return seq.id
+}
+
+func (seq *Sequence) Name() string {
+	return seq.name
+}
+
+func (seq *Sequence) Increment() int64 {
+	return seq.increment
+}
+
+func (seq *Sequence) MinValue() int64 {
+	return seq.minValue
+}
+
+func (seq *Sequence) MaxValue() int64 {
+	return seq.maxValue
+}
+
+func (seq *Sequence) Start() int64 {
+	return seq.start
+}
+
+func (seq *Sequence) Cycle() bool {
+	return seq.cycle
+}
+
+func (seq *Sequence) valueKey(tx *SQLTx) []byte {
+	return MapKey(tx.sqlPrefix(), catalogSequenceValuePrefix, EncodeID(DatabaseID), EncodeID(seq.id))
+}
+
+// lastValue returns the last value generated by the sequence as seen by the transaction,
+// called is false when no value has been generated yet.
+func (seq *Sequence) lastValue(ctx context.Context, tx *SQLTx) (value int64, called bool, err error) {
+	valRef, err := tx.get(ctx, seq.valueKey(tx))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return seq.start, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	v, err := valRef.Resolve()
+	if err != nil {
+		return 0, false, err
+	}
+
+	// v={called}{lastValue}
+	if len(v) != 9 {
+		return 0, false, ErrCorruptedData
+	}
+	return int64(binary.BigEndian.Uint64(v[1:])), v[0] == 1, nil
+}
+
+func (seq *Sequence) setValue(tx *SQLTx, value int64, called bool) error {
+	if value < seq.minValue || value > seq.maxValue {
+		return fmt.Errorf("%w: value %d is out of bounds for sequence '%s' (%d..%d)", ErrIllegalArguments, value, seq.name, seq.minValue, seq.maxValue)
+	}
+
+	v := make([]byte, 9)
+	if called {
+		v[0] = 1
+	}
+	binary.BigEndian.PutUint64(v[1:], uint64(value))
+
+	return tx.set(seq.valueKey(tx), nil, v)
+}
+
+// nextValue advances the sequence within the transaction, concurrent transactions
+// advancing the same sequence conflict so values are never skipped nor repeated.
+func (seq *Sequence) nextValue(ctx context.Context, tx *SQLTx) (int64, error) {
+	last, called, err := seq.lastValue(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	next := last
+
+	if called {
+		exhausted := (seq.increment > 0 && last > seq.maxValue-seq.increment) ||
+			(seq.increment < 0 && last < seq.minValue-seq.increment)
+
+		if exhausted && !seq.cycle {
+			return 0, fmt.Errorf("%w (%s)", ErrSequenceLimitReached, seq.name)
+		}
+
+		if exhausted && seq.increment > 0 {
+			next = seq.minValue
+		} else if exhausted {
+			next = seq.maxValue
+		} else {
+			next = last + seq.increment
+		}
+	}
+
+	return next, seq.setValue(tx, next, true)
+}
+
 func (catlg *Catalog) GetTableByName(name string) (*Table, error) {
 	table, exists := catlg.tablesByName[name]
 	if !exists {
@@ -249,6 +472,37 @@ func (t *Table) GetMaxColID() uint32 {
 	return t.maxColID
 }
 
+// GetPolicies returns the row-level security policies of the table in creation order
+func (t *Table) GetPolicies() []*Policy {
+	policies := make([]*Policy, 0, len(t.policies))
+	for _, p := range t.policies {
+		policies = append(policies, p)
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].id < policies[j].id
+	})
+
+	return policies
+}
+
+func (p *Policy) Name() string {
+	return p.name
+}
+
+// Command returns the command the policy applies to, an empty value means all commands
+func (p *Policy) Command() SQLPrivilege {
+	return p.cmd
+}
+
+func (p *Policy) Condition() string {
+	return p.exp.String()
+}
+
+func (p *Policy) appliesTo(cmd SQLPrivilege) bool {
+	return p.cmd == "" || p.cmd == cmd
+}
+
 func (i *Index) IsPrimary() bool {
 	return i.id == PKIndexID
 }
@@ -354,6 +608,10 @@ func (i *Index) ID() uint32 {
 	return i.id
 }
 
+func (i *Index) Table() *Table {
+	return i.table
+}
+
 func (t *Table) GetIndexByName(name string) (*Index, error) {
 	idx, exists := t.indexesByName[name]
 	if !exists {
@@ -419,6 +677,7 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 		indexesByName:    make(map[string]*Index),
 		indexesByColID:   make(map[uint32][]*Index),
 		checkConstraints: checkConstraints,
+		policies:         make(map[string]*Policy),
 		maxColID:         maxColID,
 	}
 
@@ -446,6 +705,10 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 			return nil, ErrLimitedMaxLen
 		}
 
+		if err := cs.validateDefaultValue(); err != nil {
+			return nil, err
+		}
+
 		col := &Column{
 			id:            uint32(id),
 			table:         table,
@@ -454,6 +717,7 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 			maxLen:        cs.maxLen,
 			autoIncrement: cs.autoIncrement,
 			notNull:       cs.notNull,
+			defaultValue:  cs.defaultValue,
 		}
 
 		table.cols = append(table.cols, col)
@@ -569,6 +833,10 @@ func (t *Table) newColumn(spec *ColSpec) (*Column, error) {
 		return nil, fmt.Errorf("%w (%s)", ErrLimitedMaxLen, spec.colName)
 	}
 
+	if err := spec.validateDefaultValue(); err != nil {
+		return nil, err
+	}
+
 	_, exists := t.colsByName[spec.colName]
 	if exists {
 		return nil, fmt.Errorf("%w (%s)", ErrColumnAlreadyExists, spec.colName)
@@ -584,6 +852,7 @@ func (t *Table) newColumn(spec *ColSpec) (*Column, error) {
 		maxLen:        spec.maxLen,
 		autoIncrement: spec.autoIncrement,
 		notNull:       spec.notNull,
+		defaultValue:  spec.defaultValue,
 	}
 
 	t.cols = append(t.cols, col)
@@ -678,6 +947,69 @@ func (t *Table) deleteCheck(name string) (uint32, error) {
 	return c.id, nil
 }
 
+func (t *Table) newPolicy(name string, cmd SQLPrivilege, exp ValueExp) (*Policy, error) {
+	if _, exists := t.policies[name]; exists {
+		return nil, fmt.Errorf("%w (%s.%s)", ErrPolicyAlreadyExists, t.name, name)
+	}
+
+	t.maxPolicyID++
+
+	p := &Policy{
+		id:   t.maxPolicyID,
+		name: name,
+		cmd:  cmd,
+		exp:  exp,
+	}
+
+	t.policies[name] = p
+
+	return p, nil
+}
+
+func (t *Table) deletePolicy(name string) (*Policy, error) {
+	p, exists := t.policies[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s.%s)", ErrPolicyDoesNotExist, t.name, name)
+	}
+
+	delete(t.policies, name)
+	return p, nil
+}
+
+// policyCondition returns the condition rows must satisfy to be accessed through
+// the given command by the logged user. The conditions of all the applicable policies
+// are combined with OR, a nil condition is returned when access is not restricted
+// i.e. no policy applies to the command or the user has administrative permissions.
+func (t *Table) policyCondition(ctx context.Context, tx *SQLTx, cmd SQLPrivilege) (ValueExp, error) {
+	var cond ValueExp
+
+	for _, p := range t.GetPolicies() {
+		if !p.appliesTo(cmd) {
+			continue
+		}
+
+		if cond == nil {
+			cond = p.exp
+		} else {
+			cond = &BinBoolExp{op: Or, left: cond, right: p.exp}
+		}
+	}
+
+	if cond == nil {
+		return nil, nil
+	}
+
+	user, err := tx.LoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user != nil && (user.Permission() == PermissionAdmin || user.Permission() == PermissionSysAdmin) {
+		return nil, nil
+	}
+	return cond, nil
+}
+
 func (t *Table) deleteIndex(index *Index) error {
 	if index.IsPrimary() {
 		return fmt.Errorf("%w: primary key index can NOT be deleted", ErrIllegalArguments)
@@ -735,6 +1067,38 @@ func (c *Column) IsAutoIncremental() bool {
 	return c.autoIncrement
 }
 
+// DefaultValue returns the expression assigned to the column when no value is specified,
+// an empty string is returned if the column has no default value.
+func (c *Column) DefaultValue() string {
+	if c.defaultValue == nil {
+		return ""
+	}
+	return c.defaultValue.String()
+}
+
+// validateDefaultValue checks the default value is a constant expression of the column type
+func (spec *ColSpec) validateDefaultValue() error {
+	if spec.defaultValue == nil {
+		return nil
+	}
+
+	if spec.autoIncrement {
+		return fmt.Errorf("%w: auto incremental column '%s' can not have a default value", ErrInvalidDefaultValue, spec.colName)
+	}
+
+	params := make(map[string]SQLValueType)
+
+	err := spec.defaultValue.requiresType(spec.colType, map[string]ColDescriptor{}, params, "")
+	if err != nil {
+		return fmt.Errorf("%w: %s (%s)", ErrInvalidDefaultValue, err.Error(), spec.colName)
+	}
+
+	if len(params) > 0 {
+		return fmt.Errorf("%w: parameters are not allowed (%s)", ErrInvalidDefaultValue, spec.colName)
+	}
+	return nil
+}
+
 func validMaxLenForType(maxLen int, sqlType SQLValueType) bool {
 	switch sqlType {
 	case BooleanType:
@@ -759,7 +1123,7 @@ func (catlg *Catalog) load(ctx context.Context, tx *store.OngoingTx) error {
 func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
 	prefix := MapKey(catlg.enginePrefix, catalogTablePrefix, EncodeID(1))
 
-	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
 		dbID, tableID, err := unmapTableID(catlg.enginePrefix, key)
 		if err != nil {
 			return err
@@ -798,7 +1162,86 @@ func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copy
 				return err
 			}
 		}
-		return table.loadIndexes(ctx, catlg.enginePrefix, tx, copyToTx)
+		err = table.loadIndexes(ctx, catlg.enginePrefix, tx, copyToTx)
+		if err != nil {
+			return err
+		}
+		return table.loadPolicies(ctx, catlg.enginePrefix, tx, copyToTx)
+	})
+	if err != nil {
+		return err
+	}
+
+	return catlg.loadSequences(ctx, tx, copyToTx)
+}
+
+func (catlg *Catalog) loadSequences(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(catlg.enginePrefix, catalogSequencePrefix, EncodeID(DatabaseID))
+
+	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			catlg.maxSequenceID++
+			return nil
+		}
+
+		encID, err := trimPrefix(catlg.enginePrefix, key, []byte(catalogSequencePrefix))
+		if err != nil {
+			return err
+		}
+
+		if len(encID) != EncIDLen*2 || binary.BigEndian.Uint32(encID) != DatabaseID {
+			return ErrCorruptedData
+		}
+
+		// v={increment}{minValue}{maxValue}{start}{cycle}{name}
+		if len(value) < 33 {
+			return ErrCorruptedData
+		}
+
+		increment := int64(binary.BigEndian.Uint64(value))
+		minValue := int64(binary.BigEndian.Uint64(value[8:]))
+		maxValue := int64(binary.BigEndian.Uint64(value[16:]))
+		start := int64(binary.BigEndian.Uint64(value[24:]))
+		cycle := value[32] == 1
+
+		seq, err := catlg.newSequence(string(value[33:]), &sequenceOptions{
+			increment: &increment,
+			minValue:  &minValue,
+			maxValue:  &maxValue,
+			start:     &start,
+			cycle:     &cycle,
+		})
+		if err != nil {
+			return err
+		}
+
+		if seq.id != binary.BigEndian.Uint32(encID[EncIDLen:]) {
+			return ErrCorruptedData
+		}
+
+		if !copyToTx {
+			return nil
+		}
+
+		if err := tx.Set(key, nil, value); err != nil {
+			return err
+		}
+
+		valKey := MapKey(catlg.enginePrefix, catalogSequenceValuePrefix, EncodeID(DatabaseID), EncodeID(seq.id))
+
+		valRef, err := tx.Get(ctx, valKey)
+		if errors.Is(err, store.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		val, err := valRef.Resolve()
+		if err != nil {
+			return err
+		}
+		return tx.Set(valKey, nil, val)
 	})
 }
 
@@ -865,13 +1308,33 @@ func loadColSpec(sqlPrefix, key, value []byte, tableID uint32) (*ColSpec, uint32
 		return nil, 0, ErrCorruptedData
 	}
 
-	return &ColSpec{
+	spec := &ColSpec{
 		colName:       string(value[5:]),
 		colType:       colType,
 		maxLen:        int(binary.BigEndian.Uint32(value[1:])),
 		autoIncrement: value[0]&autoIncrementFlag != 0,
 		notNull:       value[0]&nullableFlag != 0,
-	}, colID, nil
+	}
+
+	if value[0]&defaultValueFlag == 0 {
+		return spec, colID, nil
+	}
+
+	// v={flags}{maxLen}{nameLen}{colNAME}{defaultExp}
+	if len(value) < 9 || len(value) < 9+int(binary.BigEndian.Uint32(value[5:])) {
+		return nil, 0, ErrCorruptedData
+	}
+
+	nameLen := int(binary.BigEndian.Uint32(value[5:]))
+	spec.colName = string(value[9 : 9+nameLen])
+
+	defaultValue, err := ParseExpFromString(string(value[9+nameLen:]))
+	if err != nil {
+		return nil, 0, err
+	}
+	spec.defaultValue = defaultValue
+
+	return spec, colID, nil
 }
 
 func loadCheckConstraints(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]CheckConstraint, error) {
@@ -950,6 +1413,72 @@ func (table *Table) loadIndexes(ctx context.Context, sqlPrefix []byte, tx *store
 	})
 }
 
+func (table *Table) loadPolicies(ctx context.Context, sqlPrefix []byte, tx *store.OngoingTx, copyToTx bool) error {
+	prefix := MapKey(sqlPrefix, catalogPolicyPrefix, EncodeID(DatabaseID), EncodeID(table.id))
+
+	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			table.maxPolicyID++
+			return nil
+		}
+
+		policyID, err := unmapPolicyID(sqlPrefix, key, table.id)
+		if err != nil {
+			return err
+		}
+
+		// v={cmdLen}{cmd}{nameLen-1}{name}{expText}
+		if len(value) < 1 || len(value) < 2+int(value[0]) {
+			return ErrCorruptedData
+		}
+
+		cmdLen := int(value[0])
+		cmd := SQLPrivilege(value[1 : 1+cmdLen])
+
+		nameLen := int(value[1+cmdLen]) + 1
+		if len(value) < 2+cmdLen+nameLen {
+			return ErrCorruptedData
+		}
+
+		name := string(value[2+cmdLen : 2+cmdLen+nameLen])
+
+		exp, err := ParseExpFromString(string(value[2+cmdLen+nameLen:]))
+		if err != nil {
+			return err
+		}
+
+		p, err := table.newPolicy(name, cmd, exp)
+		if err != nil {
+			return err
+		}
+
+		if p.id != policyID {
+			return ErrCorruptedData
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
+
+func unmapPolicyID(sqlPrefix, mkey []byte, tableID uint32) (uint32, error) {
+	encID, err := trimPrefix(sqlPrefix, mkey, []byte(catalogPolicyPrefix))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(encID) != EncIDLen*3 {
+		return 0, ErrCorruptedData
+	}
+
+	if binary.BigEndian.Uint32(encID) != DatabaseID || binary.BigEndian.Uint32(encID[EncIDLen:]) != tableID {
+		return 0, ErrCorruptedData
+	}
+	return binary.BigEndian.Uint32(encID[EncIDLen*2:]), nil
+}
+
 func trimPrefix(prefix, mkey []byte, mappingPrefix []byte) ([]byte, error) {
 	if len(prefix)+len(mappingPrefix) > len(mkey) ||
 		!bytes.Equal(prefix, mkey[:len(prefix)]) ||
@@ -1063,6 +1592,76 @@ func unmapIndex(sqlPrefix, mkey []byte) (dbID, tableID, indexID uint32, err erro
 	return
 }
 
+// DecodeRowEntry decodes a row entry as written by a transaction, returning the table
+// it belongs to and the values of its non-null columns indexed by column id.
+// Deleted rows are written with the values they had before being deleted.
+func (catlg *Catalog) DecodeRowEntry(key, value []byte) (*Table, map[uint32]TypedValue, error) {
+	enc, err := trimPrefix(catlg.enginePrefix, key, []byte(RowPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(enc) <= EncIDLen*3 {
+		return nil, nil, ErrCorruptedData
+	}
+
+	tableID := binary.BigEndian.Uint32(enc[EncIDLen:])
+
+	table, err := catlg.GetTableByID(tableID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(value) < EncLenLen {
+		return nil, nil, ErrCorruptedData
+	}
+
+	voff := 0
+
+	cols := int(binary.BigEndian.Uint32(value[voff:]))
+	voff += EncLenLen
+
+	valuesByColID := make(map[uint32]TypedValue, cols)
+
+	for i := 0; i < cols; i++ {
+		if len(value)-voff < EncIDLen {
+			return nil, nil, ErrCorruptedData
+		}
+
+		colID := binary.BigEndian.Uint32(value[voff:])
+		voff += EncIDLen
+
+		col, err := table.GetColumnByID(colID)
+		if errors.Is(err, ErrColumnDoesNotExist) && colID <= table.maxColID {
+			// Dropped column, skip it
+			vlen, n, err := DecodeValueLength(value[voff:])
+			if err != nil {
+				return nil, nil, err
+			}
+			voff += n + vlen
+
+			continue
+		}
+		if err != nil {
+			return nil, nil, ErrCorruptedData
+		}
+
+		val, n, err := DecodeValue(value[voff:], col.colType)
+		if err != nil {
+			return nil, nil, err
+		}
+		voff += n
+
+		valuesByColID[colID] = val
+	}
+
+	if len(value)-voff > 0 {
+		return nil, nil, ErrCorruptedData
+	}
+
+	return table, valuesByColID, nil
+}
+
 func unmapIndexEntry(index *Index, sqlPrefix, mkey []byte) (encPKVals []byte, err error) {
 	if index == nil {
 		return nil, ErrIllegalArguments
@@ -99,6 +99,19 @@ var (
 	ErrCannotIndexJson                        = errors.New("cannot index column of type JSON")
 	ErrInvalidTxMetadata                      = errors.New("invalid transaction metadata")
 	ErrAccessDenied                           = errors.New("access denied")
+	ErrPolicyAlreadyExists                    = errors.New("policy already exists")
+	ErrPolicyDoesNotExist                     = errors.New("policy does not exist")
+	ErrInvalidPolicy                          = errors.New("invalid policy")
+	ErrPolicyViolation                        = fmt.Errorf("%w: row-level security policy violation", ErrAccessDenied)
+	ErrInvalidPrivilege                       = errors.New("invalid privilege")
+	ErrSequenceAlreadyExists                  = errors.New("sequence already exists")
+	ErrSequenceDoesNotExist                   = errors.New("sequence does not exist")
+	ErrInvalidSequence                        = errors.New("invalid sequence")
+	ErrSequenceLimitReached                   = errors.New("sequence reached its limit")
+	ErrSequenceNotYetCalled                   = fmt.Errorf("%w: no value has been generated by the sequence yet", store.ErrIllegalState)
+	ErrInvalidDefaultValue                    = errors.New("invalid default value")
+	ErrCursorAlreadyExists                    = errors.New("cursor already exists")
+	ErrCursorDoesNotExist                     = errors.New("cursor does not exist")
 )
 
 var MaxKeyLen = 512
@@ -118,8 +131,10 @@ type Engine struct {
 	sortBufferSize                int
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
+	parallelism                   int
 	parseTxMetadata               func([]byte) (map[string]interface{}, error)
 	multidbHandler                MultiDBHandler
+	loggedUser                    func(ctx context.Context) (User, error)
 	tableResolvers                map[string]TableResolver
 }
 
@@ -133,6 +148,8 @@ type MultiDBHandler interface {
 	AlterUser(ctx context.Context, username, password string, permission Permission) error
 	GrantSQLPrivileges(ctx context.Context, database, username string, privileges []SQLPrivilege) error
 	RevokeSQLPrivileges(ctx context.Context, database, username string, privileges []SQLPrivilege) error
+	GrantTablePrivileges(ctx context.Context, username string, privileges []TablePrivilege) error
+	RevokeTablePrivileges(ctx context.Context, username string, privileges []TablePrivilege) error
 	DropUser(ctx context.Context, username string) error
 	ExecPreparedStmts(ctx context.Context, opts *TxOptions, stmts []SQLStmt, params map[string]interface{}) (ntx *SQLTx, committedTxs []*SQLTx, err error)
 }
@@ -146,6 +163,7 @@ type User interface {
 	Username() string
 	Permission() Permission
 	SQLPrivileges() []SQLPrivilege
+	TablePrivileges() []TablePrivilege
 }
 
 func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
@@ -169,8 +187,18 @@ func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
 		sortBufferSize:                opts.sortBufferSize,
 		autocommit:                    opts.autocommit,
 		lazyIndexConstraintValidation: opts.lazyIndexConstraintValidation,
+		parallelism:                   opts.parallelism,
 		parseTxMetadata:               opts.parseTxMetadata,
 		multidbHandler:                opts.multidbHandler,
+		loggedUser:                    opts.loggedUser,
+	}
+
+	if e.loggedUser == nil && e.multidbHandler != nil {
+		e.loggedUser = e.multidbHandler.GetLoggedUser
+	}
+
+	if e.parallelism > st.MaxConcurrency() {
+		e.parallelism = st.MaxConcurrency()
 	}
 
 	copy(e.prefix, opts.prefix)
@@ -551,13 +579,25 @@ func (e *Engine) checkUserPermissions(ctx context.Context, stmt SQLStmt) error {
 		return fmt.Errorf("%w: statement requires %s permission", ErrAccessDenied, PermissionReadWrite)
 	}
 
+	// privileges granted on specific tables are checked while resolving the statement
 	requiredPrivileges := stmt.requiredPrivileges()
-	if !hasAllPrivileges(user.SQLPrivileges(), requiredPrivileges) {
-		return fmt.Errorf("%w: statement requires %v privileges", ErrAccessDenied, requiredPrivileges)
+	for _, p := range requiredPrivileges {
+		if !hasAllPrivileges(user.SQLPrivileges(), []SQLPrivilege{p}) && !hasTablePrivilege(user, p) {
+			return fmt.Errorf("%w: statement requires %v privileges", ErrAccessDenied, requiredPrivileges)
+		}
 	}
 	return nil
 }
 
+func hasTablePrivilege(user User, privilege SQLPrivilege) bool {
+	for _, p := range user.TablePrivileges() {
+		if p.Privilege == privilege {
+			return true
+		}
+	}
+	return false
+}
+
 func hasAllPrivileges(userPrivileges, privileges []SQLPrivilege) bool {
 	for _, p := range privileges {
 		has := false
@@ -741,6 +781,21 @@ func (e *Engine) GetPrefix() []byte {
 	return e.prefix
 }
 
+// TableRowsPrefix returns the prefix of the keys holding the rows of the specified table
+func (e *Engine) TableRowsPrefix(ctx context.Context, tableName string) ([]byte, error) {
+	catalog, err := e.Catalog(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := catalog.GetTableByName(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapKey(e.prefix, RowPrefix, EncodeID(DatabaseID), EncodeID(table.id)), nil
+}
+
 func (e *Engine) tableResolveFor(tableName string) TableResolver {
 	if e.tableResolvers == nil {
 		return nil
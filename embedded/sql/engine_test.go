@@ -6831,6 +6831,47 @@ func TestUnmapIndex(t *testing.T) {
 	require.EqualValues(t, 0x21222324, colID)
 }
 
+func TestDecodeRowEntry(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE payments (id INTEGER, amount INTEGER, note VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	_, ctxs, err := engine.Exec(context.Background(), nil, "INSERT INTO payments (id, amount) VALUES (1, 100), (2, 200)", nil)
+	require.NoError(t, err)
+	require.Len(t, ctxs, 1)
+
+	catalog, err := engine.Catalog(context.Background(), nil)
+	require.NoError(t, err)
+
+	tx := store.NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+	err = st.ReadTx(ctxs[0].TxHeader().ID, false, tx)
+	require.NoError(t, err)
+	require.Len(t, tx.Entries(), 2)
+
+	for i, e := range tx.Entries() {
+		v, err := st.ReadValue(e)
+		require.NoError(t, err)
+
+		table, values, err := catalog.DecodeRowEntry(e.Key(), v)
+		require.NoError(t, err)
+		require.Equal(t, "payments", table.Name())
+		require.Len(t, values, 2)
+		require.Equal(t, int64(i+1), values[1].RawValue())
+		require.Equal(t, int64((i+1)*100), values[2].RawValue())
+
+		_, _, err = catalog.DecodeRowEntry(e.Key(), v[:len(v)-1])
+		require.ErrorIs(t, err, ErrCorruptedData)
+	}
+
+	_, _, err = catalog.DecodeRowEntry([]byte{sqlPrefix[0], 'M', '.'}, nil)
+	require.ErrorIs(t, err, ErrIllegalMappedKey)
+
+	_, _, err = catalog.DecodeRowEntry(MapKey(sqlPrefix, RowPrefix, EncodeID(DatabaseID), EncodeID(99), EncodeID(PKIndexID), []byte{0x80}), nil)
+	require.ErrorIs(t, err, ErrTableDoesNotExist)
+}
+
 func TestUnmapIndexEntry(t *testing.T) {
 	e := Engine{prefix: []byte("e-prefix.")}
 
@@ -7793,9 +7834,10 @@ func TestMultiDBCatalogQueries(t *testing.T) {
 }
 
 type mockUser struct {
-	username      string
-	permission    Permission
-	sqlPrivileges []SQLPrivilege
+	username        string
+	permission      Permission
+	sqlPrivileges   []SQLPrivilege
+	tablePrivileges []TablePrivilege
 }
 
 func (u *mockUser) Username() string {
@@ -7810,10 +7852,17 @@ func (u *mockUser) SQLPrivileges() []SQLPrivilege {
 	return u.sqlPrivileges
 }
 
+func (u *mockUser) TablePrivileges() []TablePrivilege {
+	return u.tablePrivileges
+}
+
 type multidbHandlerMock struct {
 	dbs    []string
 	user   *mockUser
 	engine *Engine
+
+	// sessionKey, when set, must be part of the context the user is retrieved with
+	sessionKey interface{}
 }
 
 func (h *multidbHandlerMock) ListDatabases(ctx context.Context) ([]string, error) {
@@ -7832,12 +7881,38 @@ func (h *multidbHandlerMock) RevokeSQLPrivileges(ctx context.Context, database,
 	return ErrNoSupported
 }
 
+func (h *multidbHandlerMock) GrantTablePrivileges(ctx context.Context, username string, privileges []TablePrivilege) error {
+	h.user.tablePrivileges = append(h.user.tablePrivileges, privileges...)
+	return nil
+}
+
+func (h *multidbHandlerMock) RevokeTablePrivileges(ctx context.Context, username string, privileges []TablePrivilege) error {
+	granted := h.user.tablePrivileges[:0]
+
+	for _, gp := range h.user.tablePrivileges {
+		revoked := false
+		for _, p := range privileges {
+			if gp.Privilege == p.Privilege && gp.Table == p.Table {
+				revoked = true
+				break
+			}
+		}
+
+		if !revoked {
+			granted = append(granted, gp)
+		}
+	}
+
+	h.user.tablePrivileges = granted
+	return nil
+}
+
 func (h *multidbHandlerMock) UseDatabase(ctx context.Context, db string) error {
 	return nil
 }
 
 func (h *multidbHandlerMock) GetLoggedUser(ctx context.Context) (User, error) {
-	if h.user == nil {
+	if h.user == nil || (h.sessionKey != nil && ctx.Value(h.sessionKey) == nil) {
 		return nil, fmt.Errorf("no logged user")
 	}
 	return h.user, nil
@@ -9408,6 +9483,156 @@ func TestGrantSQLPrivileges(t *testing.T) {
 	checkGrants("SHOW GRANTS FOR myuser")
 }
 
+func TestColumnPrivileges(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	handler := &multidbHandlerMock{
+		user: &mockUser{
+			username:      "analyst",
+			permission:    PermissionReadWrite,
+			sqlPrivileges: allPrivileges,
+		},
+	}
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler).WithAutocommit(true))
+	require.NoError(t, err)
+
+	handler.engine = engine
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE payments(id INTEGER AUTO_INCREMENT, amount INTEGER, ssn VARCHAR[16], PRIMARY KEY id);
+		CREATE INDEX ON payments(ssn);
+		INSERT INTO payments(amount, ssn) VALUES (10, '111-11-1111'), (20, '222-22-2222');
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT DELETE (amount) ON payments TO analyst", nil)
+	require.ErrorIs(t, err, ErrInvalidPrivilege)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT CREATE ON TABLE payments TO USER analyst", nil)
+	require.ErrorIs(t, err, ErrInvalidPrivilege)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT (id, phone) ON payments TO analyst", nil)
+	require.ErrorIs(t, err, ErrColumnDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT SELECT (id, amount) ON payments TO analyst", nil)
+	require.NoError(t, err)
+
+	handler.user.sqlPrivileges = nil
+
+	rows, err := engine.queryAll(context.Background(), nil, "SELECT * FROM payments", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Len(t, rows[0].ValuesByPosition, 2)
+	require.Equal(t, int64(10), rows[0].ValuesByPosition[1].RawValue())
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id, ssn FROM payments", nil)
+	require.ErrorIs(t, err, ErrColumnDoesNotExist)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM payments WHERE ssn = '111-11-1111'", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM payments USE INDEX ON (ssn)", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments(amount) VALUES (30)", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT INSERT (amount) ON payments TO analyst", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments(amount) VALUES (30)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO payments(amount, ssn) VALUES (40, '444-44-4444')", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE payments SET amount = 15 WHERE id = 1", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT UPDATE (amount) ON payments TO analyst", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE payments SET amount = 15 WHERE id = 1", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE payments SET ssn = NULL WHERE id = 1", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM payments WHERE id = 1", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, _, err = engine.Exec(context.Background(), nil, "GRANT DELETE ON payments TO analyst", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM payments WHERE id = 1", nil)
+	require.NoError(t, err)
+
+	rows, err = engine.queryAll(context.Background(), nil, "SHOW GRANTS FOR analyst", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	require.Equal(t, "SELECT", rows[0].ValuesByPosition[1].RawValue())
+	require.Equal(t, "payments", rows[0].ValuesByPosition[2].RawValue())
+	require.Equal(t, "id", rows[0].ValuesByPosition[3].RawValue())
+	require.Equal(t, "amount", rows[1].ValuesByPosition[3].RawValue())
+
+	require.Equal(t, "DELETE", rows[4].ValuesByPosition[1].RawValue())
+	require.True(t, rows[4].ValuesByPosition[3].IsNull())
+
+	_, _, err = engine.Exec(context.Background(), nil, "REVOKE SELECT ON payments FROM analyst", nil)
+	require.NoError(t, err)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT id FROM payments", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+}
+
+func TestColumnPrivilegesOnGroupedJoins(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	type sessionKey struct{}
+
+	handler := &multidbHandlerMock{
+		user: &mockUser{
+			username:      "analyst",
+			permission:    PermissionReadWrite,
+			sqlPrivileges: allPrivileges,
+		},
+		sessionKey: sessionKey{},
+	}
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler).WithAutocommit(true))
+	require.NoError(t, err)
+
+	handler.engine = engine
+
+	ctx := context.WithValue(context.Background(), sessionKey{}, "session")
+
+	_, _, err = engine.Exec(ctx, nil, `
+		CREATE TABLE accounts(id INTEGER AUTO_INCREMENT, owner VARCHAR, PRIMARY KEY id);
+		CREATE TABLE payments(id INTEGER AUTO_INCREMENT, account INTEGER, amount INTEGER, PRIMARY KEY id);
+		INSERT INTO accounts(owner) VALUES ('alice'), ('bob');
+		INSERT INTO payments(account, amount) VALUES (1, 10), (1, 20), (2, 30);
+	`, nil)
+	require.NoError(t, err)
+
+	// the user is retrieved with the request context while describing the joined tables
+	rows, err := engine.queryAll(ctx, nil, `
+		SELECT accounts.owner, COUNT(*) AS n
+		FROM payments
+		INNER JOIN accounts ON payments.account = accounts.id
+		GROUP BY accounts.owner
+		ORDER BY accounts.owner`, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "alice", rows[0].ValuesByPosition[0].RawValue())
+	require.Equal(t, int64(2), rows[0].ValuesByPosition[1].RawValue())
+}
+
 func TestFunctions(t *testing.T) {
 	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
 	require.NoError(t, err)
@@ -9660,3 +9885,437 @@ func (r *mockTableResolver) Resolve(ctx context.Context, tx *SQLTx, alias string
 		r.values,
 	)
 }
+
+func TestRowLevelSecurity(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	alice := &mockUser{username: "alice", permission: PermissionReadWrite, sqlPrivileges: allPrivileges}
+	bob := &mockUser{username: "bob", permission: PermissionReadWrite, sqlPrivileges: allPrivileges}
+	admin := &mockUser{username: "admin", permission: PermissionAdmin, sqlPrivileges: allPrivileges}
+
+	handler := &multidbHandlerMock{user: alice}
+
+	opts := DefaultOptions().
+		WithPrefix(sqlPrefix).
+		WithMultiDBHandler(handler)
+
+	engine, err := NewEngine(st, opts)
+	require.NoError(t, err)
+
+	handler.engine = engine
+
+	exec := func(t *testing.T, user *mockUser, stmt string) error {
+		handler.user = user
+		_, _, err := engine.Exec(context.Background(), nil, stmt, nil)
+		return err
+	}
+
+	queryAmounts := func(t *testing.T, user *mockUser, query string) map[string]int64 {
+		handler.user = user
+
+		rows, err := engine.queryAll(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+
+		amounts := make(map[string]int64)
+		for _, row := range rows {
+			amounts[row.ValuesByPosition[0].RawValue().(string)] += row.ValuesByPosition[1].RawValue().(int64)
+		}
+		return amounts
+	}
+
+	err = exec(t, alice, `
+		CREATE TABLE payments(id INTEGER AUTO_INCREMENT, owner VARCHAR[64], amount INTEGER, PRIMARY KEY id);
+		INSERT INTO payments(owner, amount) VALUES ('alice', 10), ('alice', 20), ('bob', 100), ('bob', 200);
+	`)
+	require.NoError(t, err)
+
+	t.Run("invalid policies should be rejected", func(t *testing.T) {
+		err := exec(t, alice, "CREATE POLICY p ON payments USING (amount)")
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		err = exec(t, alice, "CREATE POLICY p ON payments USING (unknown = CURRENT_USER)")
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		err = exec(t, alice, "CREATE POLICY p ON payments USING (amount > @min)")
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		err = exec(t, alice, "CREATE POLICY p ON unknown USING (owner = CURRENT_USER)")
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		err = exec(t, alice, "DROP POLICY p ON payments")
+		require.ErrorIs(t, err, ErrPolicyDoesNotExist)
+	})
+
+	err = exec(t, alice, "CREATE POLICY owner_select ON payments FOR SELECT USING (owner = CURRENT_USER)")
+	require.NoError(t, err)
+
+	err = exec(t, alice, "CREATE POLICY owner_select ON payments FOR SELECT USING (owner = CURRENT_USER)")
+	require.ErrorIs(t, err, ErrPolicyAlreadyExists)
+
+	t.Run("rows are filtered based on the logged user", func(t *testing.T) {
+		require.Equal(t, map[string]int64{"alice": 30}, queryAmounts(t, alice, "SELECT owner, amount FROM payments"))
+		require.Equal(t, map[string]int64{"bob": 300}, queryAmounts(t, bob, "SELECT owner, amount FROM payments"))
+		require.Equal(t, map[string]int64{"bob": 200}, queryAmounts(t, bob, "SELECT p.owner, p.amount FROM payments AS p WHERE p.amount > 100"))
+		require.Equal(t, map[string]int64{"alice": 30, "bob": 300}, queryAmounts(t, admin, "SELECT owner, amount FROM payments"))
+	})
+
+	t.Run("updates and deletes only reach visible rows", func(t *testing.T) {
+		err := exec(t, bob, "UPDATE payments SET amount = amount + 1")
+		require.NoError(t, err)
+
+		err = exec(t, bob, "DELETE FROM payments WHERE amount < 50")
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]int64{"alice": 30}, queryAmounts(t, alice, "SELECT owner, amount FROM payments"))
+		require.Equal(t, map[string]int64{"bob": 302}, queryAmounts(t, bob, "SELECT owner, amount FROM payments"))
+	})
+
+	err = exec(t, alice, `
+		CREATE POLICY owner_update ON payments FOR UPDATE USING (owner = CURRENT_USER);
+		CREATE POLICY owner_delete ON payments FOR DELETE USING (owner = CURRENT_USER);
+	`)
+	require.NoError(t, err)
+
+	t.Run("updated rows must satisfy update policies", func(t *testing.T) {
+		err := exec(t, bob, "UPDATE payments SET owner = 'alice' WHERE amount > 200")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		err = exec(t, bob, "UPSERT INTO payments(id, owner, amount) VALUES (1, 'bob', 1000)")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		err = exec(t, bob, "UPSERT INTO payments(id, owner, amount) VALUES (3, 'alice', 1000)")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		err = exec(t, bob, "UPSERT INTO payments(id, owner, amount) VALUES (3, 'bob', 1000)")
+		require.NoError(t, err)
+
+		err = exec(t, admin, "UPSERT INTO payments(id, owner, amount) VALUES (1, 'alice', 15)")
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]int64{"alice": 35}, queryAmounts(t, alice, "SELECT owner, amount FROM payments"))
+		require.Equal(t, map[string]int64{"bob": 1201}, queryAmounts(t, bob, "SELECT owner, amount FROM payments"))
+	})
+
+	t.Run("columns required by policies can not be dropped", func(t *testing.T) {
+		err := exec(t, alice, "ALTER TABLE payments DROP COLUMN owner")
+		require.ErrorIs(t, err, ErrCannotDropColumn)
+	})
+
+	t.Run("policies should be persisted", func(t *testing.T) {
+		engine, err = NewEngine(st, opts)
+		require.NoError(t, err)
+
+		handler.engine = engine
+
+		require.Equal(t, map[string]int64{"alice": 35}, queryAmounts(t, alice, "SELECT owner, amount FROM payments"))
+
+		handler.user = alice
+
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("payments")
+		require.NoError(t, err)
+
+		policies := table.GetPolicies()
+		require.Len(t, policies, 3)
+		require.Equal(t, "owner_select", policies[0].Name())
+		require.Equal(t, SQLPrivilegeSelect, policies[0].Command())
+		require.Equal(t, "(owner = CURRENT_USER())", policies[0].Condition())
+		require.Equal(t, "owner_delete", policies[2].Name())
+		require.Equal(t, SQLPrivilegeDelete, policies[2].Command())
+	})
+
+	t.Run("dropped policies no longer restrict access", func(t *testing.T) {
+		err := exec(t, alice, "DROP POLICY owner_select ON payments")
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]int64{"alice": 35, "bob": 1201}, queryAmounts(t, bob, "SELECT owner, amount FROM payments"))
+
+		err = exec(t, alice, "CREATE POLICY owner_select ON payments USING (owner = CURRENT_USER)")
+		require.NoError(t, err)
+
+		engine, err = NewEngine(st, opts)
+		require.NoError(t, err)
+
+		handler.engine = engine
+
+		require.Equal(t, map[string]int64{"bob": 1201}, queryAmounts(t, bob, "SELECT owner, amount FROM payments"))
+	})
+
+	t.Run("without users, CURRENT_USER is NULL", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT owner, amount FROM payments", nil)
+		require.NoError(t, err)
+		require.Empty(t, rows)
+	})
+
+	t.Run("dropping the table removes its policies", func(t *testing.T) {
+		err := exec(t, alice, `
+			DROP TABLE payments;
+			CREATE TABLE payments(id INTEGER AUTO_INCREMENT, owner VARCHAR[64], amount INTEGER, PRIMARY KEY id);
+			INSERT INTO payments(owner, amount) VALUES ('alice', 10), ('bob', 100);
+		`)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]int64{"alice": 10, "bob": 100}, queryAmounts(t, bob, "SELECT owner, amount FROM payments"))
+	})
+}
+
+func TestSequences(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	nextNumber := func(t *testing.T, query string) int64 {
+		rows, err := engine.queryAll(context.Background(), nil, query, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		return rows[0].ValuesByPosition[0].RawValue().(int64)
+	}
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SEQUENCE invalid_seq INCREMENT BY 0", nil)
+	require.ErrorIs(t, err, ErrInvalidSequence)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SEQUENCE invalid_seq START WITH 10 MAXVALUE 5", nil)
+	require.ErrorIs(t, err, ErrInvalidSequence)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE SEQUENCE invoice_seq INCREMENT BY 10 START WITH 1000 MAXVALUE 1030;
+
+		CREATE TABLE invoices (
+			id INTEGER AUTO_INCREMENT,
+			number INTEGER DEFAULT NEXTVAL('invoice_seq') NOT NULL,
+			PRIMARY KEY id
+		);
+
+		CREATE TABLE credit_notes (
+			id INTEGER AUTO_INCREMENT,
+			number INTEGER DEFAULT NEXTVAL('invoice_seq') NOT NULL,
+			note VARCHAR DEFAULT 'none',
+			PRIMARY KEY id
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SEQUENCE invoice_seq", nil)
+	require.ErrorIs(t, err, ErrSequenceAlreadyExists)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE SEQUENCE IF NOT EXISTS invoice_seq", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE invalid (id INTEGER DEFAULT id, PRIMARY KEY id)", nil)
+	require.ErrorIs(t, err, ErrInvalidDefaultValue)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE invalid (id INTEGER, title VARCHAR DEFAULT 1, PRIMARY KEY id)", nil)
+	require.ErrorIs(t, err, ErrInvalidDefaultValue)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT CURRVAL('invoice_seq') FROM invoices", nil)
+	require.NoError(t, err)
+
+	t.Run("values are shared across tables", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, `
+			INSERT INTO invoices(id) VALUES (1), (2);
+			INSERT INTO credit_notes(id) VALUES (1);
+		`, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, int64(1010), nextNumber(t, "SELECT number FROM invoices WHERE id = 2"))
+		require.Equal(t, int64(1020), nextNumber(t, "SELECT number FROM credit_notes WHERE id = 1"))
+		require.Equal(t, int64(1020), nextNumber(t, "SELECT CURRVAL('invoice_seq') FROM credit_notes"))
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT note FROM credit_notes", nil)
+		require.NoError(t, err)
+		require.Equal(t, "none", rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("values consumed by rolled back transactions are reused", func(t *testing.T) {
+		tx, _, err := engine.Exec(context.Background(), nil, "BEGIN TRANSACTION; INSERT INTO invoices(id) VALUES (3);", nil)
+		require.NoError(t, err)
+
+		err = tx.Cancel()
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id) VALUES (3)", nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(1030), nextNumber(t, "SELECT number FROM invoices WHERE id = 3"))
+	})
+
+	t.Run("exhausted sequences fail unless cycling", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id) VALUES (4)", nil)
+		require.ErrorIs(t, err, ErrSequenceLimitReached)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id, number) VALUES (4, SETVAL('invoice_seq', 1000, false))", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id) VALUES (5)", nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(1000), nextNumber(t, "SELECT number FROM invoices WHERE id = 5"))
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id, number) VALUES (6, SETVAL('invoice_seq', 2000))", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			CREATE SEQUENCE countdown INCREMENT BY -1 MINVALUE 1 MAXVALUE 2 CYCLE;
+			CREATE TABLE launches (id INTEGER, counter INTEGER DEFAULT NEXTVAL('countdown'), PRIMARY KEY id);
+			INSERT INTO launches(id) VALUES (1), (2), (3);
+		`, nil)
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT counter FROM launches", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, int64(2), rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(1), rows[1].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(2), rows[2].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("concurrent allocations conflict", func(t *testing.T) {
+		tx1, _, err := engine.Exec(context.Background(), nil, "BEGIN TRANSACTION;", nil)
+		require.NoError(t, err)
+
+		tx2, _, err := engine.Exec(context.Background(), nil, "BEGIN TRANSACTION;", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), tx1, "INSERT INTO launches(id) VALUES (10);", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), tx2, "INSERT INTO launches(id) VALUES (11);", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), tx1, "COMMIT;", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), tx2, "COMMIT;", nil)
+		require.ErrorIs(t, err, store.ErrTxReadConflict)
+	})
+
+	t.Run("sequences are persisted in the catalog", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		seq, err := tx.Catalog().GetSequenceByName("invoice_seq")
+		require.NoError(t, err)
+		require.Equal(t, int64(10), seq.Increment())
+		require.Equal(t, int64(1000), seq.Start())
+		require.Equal(t, int64(1030), seq.MaxValue())
+		require.False(t, seq.Cycle())
+
+		table, err := tx.Catalog().GetTableByName("credit_notes")
+		require.NoError(t, err)
+
+		col, err := table.GetColumnByName("number")
+		require.NoError(t, err)
+		require.Equal(t, "nextval('invoice_seq')", col.DefaultValue())
+	})
+
+	t.Run("dropped sequences can no longer be used", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "DROP SEQUENCE invoice_seq", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DROP SEQUENCE invoice_seq", nil)
+		require.ErrorIs(t, err, ErrSequenceDoesNotExist)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id) VALUES (20)", nil)
+		require.ErrorIs(t, err, ErrSequenceDoesNotExist)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE SEQUENCE invoice_seq", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO invoices(id) VALUES (20)", nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), nextNumber(t, "SELECT number FROM invoices WHERE id = 20"))
+	})
+}
+
+func TestCursors(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE items (id INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO items (id) VALUES (@id)", map[string]interface{}{"id": i})
+		require.NoError(t, err)
+	}
+
+	fetch := func(t *testing.T, tx *SQLTx, query string) []int64 {
+		rows, err := engine.queryAll(context.Background(), tx, query, nil)
+		require.NoError(t, err)
+
+		ids := make([]int64, len(rows))
+		for i, row := range rows {
+			ids[i] = row.ValuesByPosition[0].RawValue().(int64)
+		}
+		return ids
+	}
+
+	_, _, err = engine.Exec(context.Background(), nil, "DECLARE c CURSOR FOR SELECT id FROM items", nil)
+	require.ErrorIs(t, err, ErrNoOngoingTx)
+
+	tx, _, err := engine.Exec(context.Background(), nil, "BEGIN; DECLARE c CURSOR FOR SELECT id FROM items ORDER BY id", nil)
+	require.NoError(t, err)
+
+	// rows inserted after the cursor was declared are not returned
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO items (id) VALUES (11)", nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []int64{1, 2, 3}, fetch(t, tx, "FETCH 3 FROM c"))
+	require.Equal(t, []int64{4}, fetch(t, tx, "FETCH c"))
+
+	_, _, err = engine.Exec(context.Background(), tx, "MOVE 2 IN c", nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []int64{7, 8, 9, 10}, fetch(t, tx, "FETCH ALL c"))
+	require.Empty(t, fetch(t, tx, "FETCH c"))
+
+	_, _, err = engine.Exec(context.Background(), tx, "CLOSE c", nil)
+	require.NoError(t, err)
+
+	_, err = engine.queryAll(context.Background(), tx, "FETCH c", nil)
+	require.ErrorIs(t, err, ErrCursorDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), tx, "DECLARE c1 CURSOR FOR SELECT id FROM items; DECLARE c2 CURSOR FOR SELECT id FROM items", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), tx, "MOVE ALL c1; CLOSE ALL", nil)
+	require.NoError(t, err)
+
+	_, err = engine.queryAll(context.Background(), tx, "FETCH c2", nil)
+	require.ErrorIs(t, err, ErrCursorDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), tx, "DECLARE c CURSOR FOR SELECT id FROM items; COMMIT", nil)
+	require.NoError(t, err)
+
+	tx, _, err = engine.Exec(context.Background(), nil, "BEGIN", nil)
+	require.NoError(t, err)
+
+	// cursors are closed at the end of the transaction
+	_, err = engine.queryAll(context.Background(), tx, "FETCH c", nil)
+	require.ErrorIs(t, err, ErrCursorDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), tx, "DECLARE c CURSOR FOR SELECT id FROM items; DECLARE c CURSOR FOR SELECT id FROM items", nil)
+	require.ErrorIs(t, err, ErrCursorAlreadyExists)
+
+	// the transaction is rolled back on errors, closing its cursors
+	_, _, err = engine.Exec(context.Background(), tx, "CLOSE c", nil)
+	require.ErrorIs(t, err, ErrCursorDoesNotExist)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import "context"
+
+// fetchRowReader reads rows from the row reader of a cursor, which is kept open when closing the fetch
+type fetchRowReader struct {
+	rowReader RowReader
+
+	count uint64
+	all   bool
+	read  uint64
+
+	onCloseCallback func()
+}
+
+func newFetchRowReader(rowReader RowReader, count uint64, all bool) *fetchRowReader {
+	return &fetchRowReader{
+		rowReader: rowReader,
+		count:     count,
+		all:       all,
+	}
+}
+
+func (fr *fetchRowReader) onClose(callback func()) {
+	fr.onCloseCallback = callback
+}
+
+func (fr *fetchRowReader) Tx() *SQLTx {
+	return fr.rowReader.Tx()
+}
+
+func (fr *fetchRowReader) TableAlias() string {
+	return fr.rowReader.TableAlias()
+}
+
+func (fr *fetchRowReader) Parameters() map[string]interface{} {
+	return fr.rowReader.Parameters()
+}
+
+func (fr *fetchRowReader) OrderBy() []ColDescriptor {
+	return fr.rowReader.OrderBy()
+}
+
+func (fr *fetchRowReader) ScanSpecs() *ScanSpecs {
+	return fr.rowReader.ScanSpecs()
+}
+
+func (fr *fetchRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	return fr.rowReader.Columns(ctx)
+}
+
+func (fr *fetchRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	return fr.rowReader.colsBySelector(ctx)
+}
+
+func (fr *fetchRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (fr *fetchRowReader) Read(ctx context.Context) (*Row, error) {
+	if !fr.all && fr.read >= fr.count {
+		return nil, ErrNoMoreRows
+	}
+
+	row, err := fr.rowReader.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fr.read++
+
+	return row, nil
+}
+
+func (fr *fetchRowReader) Close() error {
+	if fr.onCloseCallback != nil {
+		fr.onCloseCallback()
+	}
+	return nil
+}
@@ -44,6 +44,10 @@ const (
 	PGGetUserByIDFnCall      string = "PG_GET_USERBYID"
 	PgTableIsVisibleFnCall   string = "PG_TABLE_IS_VISIBLE"
 	PgShobjDescriptionFnCall string = "SHOBJ_DESCRIPTION"
+	CurrentUserFnCall        string = "CURRENT_USER"
+	NextValFnCall            string = "NEXTVAL"
+	CurrValFnCall            string = "CURRVAL"
+	SetValFnCall             string = "SETVAL"
 )
 
 var builtinFunctions = map[string]Function{
@@ -59,6 +63,10 @@ var builtinFunctions = map[string]Function{
 	PGGetUserByIDFnCall:      &pgGetUserByIDFunc{},
 	PgTableIsVisibleFnCall:   &pgTableIsVisible{},
 	PgShobjDescriptionFnCall: &pgShobjDescription{},
+	CurrentUserFnCall:        &CurrentUserFn{},
+	NextValFnCall:            &NextValFn{},
+	CurrValFnCall:            &CurrValFn{},
+	SetValFnCall:             &SetValFn{},
 }
 
 type Function interface {
@@ -285,6 +293,161 @@ func (f *NowFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 	return &Timestamp{val: tx.Timestamp().Truncate(time.Microsecond).UTC()}, nil
 }
 
+// -------------------------------------
+// Session Functions
+// -------------------------------------
+
+type CurrentUserFn struct{}
+
+func (f *CurrentUserFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return VarcharType, nil
+}
+
+func (f *CurrentUserFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
+}
+
+func (f *CurrentUserFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) > 0 {
+		return nil, fmt.Errorf("%w: '%s' function does not expect any argument but %d were provided", ErrIllegalArguments, CurrentUserFnCall, len(params))
+	}
+
+	user, err := tx.LoggedUser(tx.tx.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// NULL is returned when users are not managed by the engine
+	if user == nil {
+		return &NullValue{t: VarcharType}, nil
+	}
+	return NewVarchar(user.Username()), nil
+}
+
+// -------------------------------------
+// Sequence Functions
+// -------------------------------------
+
+type NextValFn struct{}
+
+func (f *NextValFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return IntegerType, nil
+}
+
+func (f *NextValFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntegerType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
+	}
+	return nil
+}
+
+func (f *NextValFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, NextValFnCall, 1, len(params))
+	}
+
+	seq, err := sequenceByNameParam(tx, NextValFnCall, params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := seq.nextValue(tx.tx.Context(), tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Integer{val: v}, nil
+}
+
+type CurrValFn struct{}
+
+func (f *CurrValFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return IntegerType, nil
+}
+
+func (f *CurrValFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntegerType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
+	}
+	return nil
+}
+
+func (f *CurrValFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, CurrValFnCall, 1, len(params))
+	}
+
+	seq, err := sequenceByNameParam(tx, CurrValFnCall, params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	v, called, err := seq.lastValue(tx.tx.Context(), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !called {
+		return nil, fmt.Errorf("%w (%s)", ErrSequenceNotYetCalled, seq.name)
+	}
+	return &Integer{val: v}, nil
+}
+
+type SetValFn struct{}
+
+func (f *SetValFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return IntegerType, nil
+}
+
+func (f *SetValFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntegerType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
+	}
+	return nil
+}
+
+// Apply sets the last value of the sequence, the next call to NEXTVAL returns
+// the given value instead of advancing it when the optional is_called argument is false.
+func (f *SetValFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) != 2 && len(params) != 3 {
+		return nil, fmt.Errorf("%w: '%s' function expects %d or %d arguments but %d were provided", ErrIllegalArguments, SetValFnCall, 2, 3, len(params))
+	}
+
+	seq, err := sequenceByNameParam(tx, SetValFnCall, params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if params[1].Type() != IntegerType {
+		return nil, fmt.Errorf("%w: '%s' function expects an argument of type %s", ErrIllegalArguments, SetValFnCall, IntegerType)
+	}
+
+	called := true
+	if len(params) == 3 {
+		if params[2].Type() != BooleanType {
+			return nil, fmt.Errorf("%w: '%s' function expects an argument of type %s", ErrIllegalArguments, SetValFnCall, BooleanType)
+		}
+		called = params[2].RawValue().(bool)
+	}
+
+	v := params[1].RawValue().(int64)
+
+	err = seq.setValue(tx, v, called)
+	if err != nil {
+		return nil, err
+	}
+	return &Integer{val: v}, nil
+}
+
+func sequenceByNameParam(tx *SQLTx, fn string, param TypedValue) (*Sequence, error) {
+	if param.Type() != VarcharType || param.IsNull() {
+		return nil, fmt.Errorf("%w: '%s' function expects an argument of type %s", ErrIllegalArguments, fn, VarcharType)
+	}
+	return tx.catalog.GetSequenceByName(param.RawValue().(string))
+}
+
 // -------------------------------------
 // JSON Functions
 // -------------------------------------
@@ -32,11 +32,15 @@ type groupedRowReader struct {
 	cols            []ColDescriptor
 	allAggregations bool
 
+	// partials is set when rows contain partial aggregations computed over
+	// separate partitions, which get merged instead of being updated
+	partials bool
+
 	currRow *Row
 	empty   bool
 }
 
-func newGroupedRowReader(rowReader RowReader, allAggregations bool, selectors []*AggColSelector, groupBy []*ColSelector) (*groupedRowReader, error) {
+func newGroupedRowReader(ctx context.Context, rowReader RowReader, allAggregations bool, selectors []*AggColSelector, groupBy []*ColSelector) (*groupedRowReader, error) {
 	if rowReader == nil {
 		return nil, ErrIllegalArguments
 	}
@@ -49,7 +53,7 @@ func newGroupedRowReader(rowReader RowReader, allAggregations bool, selectors []
 		allAggregations: allAggregations,
 	}
 
-	cols, err := gr.columns()
+	cols, err := gr.columns(ctx)
 	if err == nil {
 		gr.cols = cols
 	}
@@ -80,8 +84,8 @@ func (gr *groupedRowReader) Columns(ctx context.Context) ([]ColDescriptor, error
 	return gr.cols, nil
 }
 
-func (gr *groupedRowReader) columns() ([]ColDescriptor, error) {
-	colsBySel, err := gr.colsBySelector(context.Background())
+func (gr *groupedRowReader) columns(ctx context.Context) ([]ColDescriptor, error) {
+	colsBySel, err := gr.colsBySelector(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +200,7 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 
 		if gr.currRow == nil {
 			gr.currRow = row
-			err = gr.initAggregations(gr.currRow)
+			err = gr.initRow(gr.currRow)
 			if err != nil {
 				return nil, err
 			}
@@ -212,7 +216,7 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 			r := gr.currRow
 			gr.currRow = row
 
-			err = gr.initAggregations(gr.currRow)
+			err = gr.initRow(gr.currRow)
 			if err != nil {
 				return nil, err
 			}
@@ -220,13 +224,25 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 		}
 
 		// Compatible rows get merged
-		err = updateRow(gr.currRow, row)
+		if gr.partials {
+			err = mergeRow(gr.currRow, row)
+		} else {
+			err = updateRow(gr.currRow, row)
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
 }
 
+func (gr *groupedRowReader) initRow(row *Row) error {
+	if gr.partials {
+		// partial rows already hold their aggregations
+		return nil
+	}
+	return gr.initAggregations(row)
+}
+
 func updateRow(currRow, newRow *Row) error {
 	for _, v := range currRow.ValuesBySelector {
 		aggV, isAggregatedValue := v.(AggregatedValue)
@@ -255,6 +271,27 @@ func updateRow(currRow, newRow *Row) error {
 	return nil
 }
 
+// mergeRow combines the partial aggregations of the same group computed over separate partitions
+func mergeRow(currRow, partialRow *Row) error {
+	for sel, v := range currRow.ValuesBySelector {
+		aggV, isAggregatedValue := v.(AggregatedValue)
+		if !isAggregatedValue {
+			continue
+		}
+
+		partial, isAggregatedValue := partialRow.ValuesBySelector[sel].(AggregatedValue)
+		if !isAggregatedValue {
+			return ErrUnexpected
+		}
+
+		err := aggV.mergeWith(partial)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (gr *groupedRowReader) emitCurrentRow(ctx context.Context) (*Row, error) {
 	if gr.empty && gr.allAggregations && len(gr.groupByCols) == 0 {
 		zr, err := gr.zeroRow(ctx)
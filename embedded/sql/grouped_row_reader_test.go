@@ -31,7 +31,7 @@ func TestGroupedRowReader(t *testing.T) {
 	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
 	require.NoError(t, err)
 
-	_, err = newGroupedRowReader(nil, false, nil, nil)
+	_, err = newGroupedRowReader(context.Background(), nil, false, nil, nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
 	tx, err := engine.NewTx(context.Background(), DefaultTxOptions())
@@ -50,7 +50,7 @@ func TestGroupedRowReader(t *testing.T) {
 	r, err := newRawRowReader(tx, nil, table, period{}, "", &ScanSpecs{Index: table.primaryIndex})
 	require.NoError(t, err)
 
-	gr, err := newGroupedRowReader(r, false, []*AggColSelector{{aggFn: "COUNT", col: "id"}}, []*ColSelector{{col: "id"}})
+	gr, err := newGroupedRowReader(context.Background(), r, false, []*AggColSelector{{aggFn: "COUNT", col: "id"}}, []*ColSelector{{col: "id"}})
 	require.NoError(t, err)
 
 	orderBy := gr.OrderBy()
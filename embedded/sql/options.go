@@ -17,6 +17,7 @@ limitations under the License.
 package sql
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/codenotary/immudb/embedded/store"
@@ -25,6 +26,7 @@ import (
 const (
 	defaultDistinctLimit  = 1 << 20 // ~ 1mi rows
 	defaultSortBufferSize = 1024
+	defaultParallelism    = 1
 )
 
 type Options struct {
@@ -33,9 +35,11 @@ type Options struct {
 	distinctLimit                 int
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
+	parallelism                   int
 	parseTxMetadata               func([]byte) (map[string]interface{}, error)
 
 	multidbHandler MultiDBHandler
+	loggedUser     func(ctx context.Context) (User, error)
 	tableResolvers []TableResolver
 }
 
@@ -43,6 +47,7 @@ func DefaultOptions() *Options {
 	return &Options{
 		sortBufferSize: defaultSortBufferSize,
 		distinctLimit:  defaultDistinctLimit,
+		parallelism:    defaultParallelism,
 	}
 }
 
@@ -59,6 +64,10 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid SortBufferSize value", store.ErrInvalidOptions)
 	}
 
+	if opts.parallelism < 0 {
+		return fmt.Errorf("%w: invalid Parallelism value", store.ErrInvalidOptions)
+	}
+
 	return nil
 }
 
@@ -87,6 +96,14 @@ func (opts *Options) WithMultiDBHandler(multidbHandler MultiDBHandler) *Options
 	return opts
 }
 
+// WithLoggedUserFunc specifies how the user executing statements is identified when
+// evaluating row-level security policies. It defaults to the GetLoggedUser method of the
+// MultiDBHandler, if one is specified.
+func (opts *Options) WithLoggedUserFunc(loggedUser func(ctx context.Context) (User, error)) *Options {
+	opts.loggedUser = loggedUser
+	return opts
+}
+
 // WithSortBufferSize specifies the size of the buffer used to sort rows in-memory
 // when executing queries containing an ORDER BY clause. The default value is 1024.
 // Increasing this value improves sorting speed at the expense of higher memory usage.
@@ -95,6 +112,16 @@ func (opts *Options) WithSortBufferSize(size int) *Options {
 	return opts
 }
 
+// WithParallelism specifies the maximum number of partitions a table scan is split into
+// when executing queries in read-only transactions. Partitions are read concurrently,
+// with filters and partial aggregations evaluated on each of them. The value is bounded
+// by the MaxConcurrency of the underlying store. The default value is 1, values lower than
+// 2 disable parallel scans.
+func (opts *Options) WithParallelism(parallelism int) *Options {
+	opts.parallelism = parallelism
+	return opts
+}
+
 func (opts *Options) WithParseTxMetadataFunc(parseFunc func([]byte) (map[string]interface{}, error)) *Options {
 	opts.parseTxMetadata = parseFunc
 	return opts
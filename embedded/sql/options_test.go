@@ -48,5 +48,11 @@ func TestOptions(t *testing.T) {
 	opts.WithSortBufferSize(defaultSortBufferSize)
 	require.Equal(t, opts.sortBufferSize, defaultSortBufferSize)
 
+	opts.WithParallelism(-1)
+	require.Error(t, opts.Validate())
+
+	opts.WithParallelism(4)
+	require.Equal(t, 4, opts.parallelism)
+
 	require.NoError(t, opts.Validate())
 }
@@ -0,0 +1,363 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+const parallelScanBatchSize = 100
+
+// keyPartition is a sub-range of an index scan, including loKey and excluding hiKey.
+// A nil bound leaves the corresponding side of the scan unchanged.
+type keyPartition struct {
+	loKey []byte
+	hiKey []byte
+}
+
+// partitionScan splits the key range covered by the scan into up to n partitions.
+// Boundaries are estimated by interpolating the first and last keys within the range,
+// so partitions are evenly sized when keys are uniformly distributed.
+// Partitions are returned following the order of the scan.
+func partitionScan(ctx context.Context, tx *SQLTx, table *Table, scanSpecs *ScanSpecs, n int) ([]*ScanSpecs, error) {
+	ascSpecs := *scanSpecs
+	ascSpecs.DescOrder = false
+	ascSpecs.IncludeHistory = false
+
+	rSpec, err := keyReaderSpecFrom(tx.engine.prefix, table, &ascSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	firstKey, err := firstKeyIn(ctx, tx, *rSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	rSpec.SeekKey, rSpec.EndKey = rSpec.EndKey, rSpec.SeekKey
+	rSpec.DescOrder = true
+
+	lastKey, err := firstKeyIn(ctx, tx, *rSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if firstKey == nil || lastKey == nil {
+		return []*ScanSpecs{scanSpecs}, nil
+	}
+
+	splitKeys := splitKeyRange(firstKey, lastKey, n, tx.engine.store.MaxKeyLen())
+
+	partitions := make([]*ScanSpecs, len(splitKeys)+1)
+
+	for i := range partitions {
+		partition := &keyPartition{}
+
+		if i > 0 {
+			partition.loKey = splitKeys[i-1]
+		}
+
+		if i < len(splitKeys) {
+			partition.hiKey = splitKeys[i]
+		}
+
+		partitionSpecs := *scanSpecs
+		partitionSpecs.partition = partition
+
+		if scanSpecs.DescOrder {
+			partitions[len(partitions)-1-i] = &partitionSpecs
+		} else {
+			partitions[i] = &partitionSpecs
+		}
+	}
+
+	return partitions, nil
+}
+
+func firstKeyIn(ctx context.Context, tx *SQLTx, rSpec store.KeyReaderSpec) ([]byte, error) {
+	r, err := tx.newKeyReader(rSpec)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	key, _, err := r.Read(ctx)
+	if errors.Is(err, store.ErrNoMoreEntries) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k := make([]byte, len(key))
+	copy(k, key)
+
+	return k, nil
+}
+
+// splitKeyRange returns up to n-1 ascending keys splitting the range between loKey and hiKey.
+// Keys are computed by interpolating the bytes following the common prefix of both bounds.
+func splitKeyRange(loKey, hiKey []byte, n int, maxKeyLen int) [][]byte {
+	prefixLen := 0
+	for prefixLen < len(loKey) && prefixLen < len(hiKey) && loKey[prefixLen] == hiKey[prefixLen] {
+		prefixLen++
+	}
+
+	width := maxKeyLen - prefixLen
+	if width > 8 {
+		width = 8
+	}
+
+	if width <= 0 || n < 2 {
+		return nil
+	}
+
+	lo := keyWindow(loKey, prefixLen, width)
+	hi := keyWindow(hiKey, prefixLen, width)
+
+	if hi <= lo {
+		return nil
+	}
+
+	if hi-lo < uint64(n) {
+		n = int(hi - lo)
+	}
+
+	step := (hi - lo) / uint64(n)
+
+	splitKeys := make([][]byte, n-1)
+
+	for i := range splitKeys {
+		v := lo + step*uint64(i+1)
+
+		k := make([]byte, prefixLen+width)
+		copy(k, loKey[:prefixLen])
+
+		for j := len(k) - 1; j >= prefixLen; j-- {
+			k[j] = byte(v)
+			v >>= 8
+		}
+
+		splitKeys[i] = k
+	}
+
+	return splitKeys
+}
+
+// keyWindow interprets width bytes of the key starting at off as a big-endian number,
+// missing bytes are considered to be zero.
+func keyWindow(key []byte, off, width int) uint64 {
+	var v uint64
+
+	for i := off; i < off+width; i++ {
+		v <<= 8
+
+		if i < len(key) {
+			v |= uint64(key[i])
+		}
+	}
+
+	return v
+}
+
+type partitionBatch struct {
+	rows []*Row
+	err  error
+}
+
+// parallelRowReader concurrently reads the partitions of a scan, each one through its own
+// row reader, and returns the rows of each partition following the order of the partitions.
+type parallelRowReader struct {
+	readers []RowReader
+	batches []chan partitionBatch
+
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	currPartition int
+	currBatch     []*Row
+
+	onCloseCallback func()
+}
+
+func newParallelRowReader(partitions []*ScanSpecs, resolvePartition func(scanSpecs *ScanSpecs) (RowReader, error)) (*parallelRowReader, error) {
+	if len(partitions) == 0 || resolvePartition == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	readers := make([]RowReader, 0, len(partitions))
+
+	for _, partition := range partitions {
+		r, err := resolvePartition(partition)
+		if err != nil {
+			for _, r := range readers {
+				r.Close()
+			}
+			return nil, err
+		}
+
+		readers = append(readers, r)
+	}
+
+	batches := make([]chan partitionBatch, len(readers))
+	for i := range batches {
+		batches[i] = make(chan partitionBatch, 1)
+	}
+
+	return &parallelRowReader{
+		readers: readers,
+		batches: batches,
+	}, nil
+}
+
+func (pr *parallelRowReader) onClose(callback func()) {
+	pr.onCloseCallback = callback
+}
+
+func (pr *parallelRowReader) Tx() *SQLTx {
+	return pr.readers[0].Tx()
+}
+
+func (pr *parallelRowReader) TableAlias() string {
+	return pr.readers[0].TableAlias()
+}
+
+func (pr *parallelRowReader) Parameters() map[string]interface{} {
+	return pr.readers[0].Parameters()
+}
+
+func (pr *parallelRowReader) OrderBy() []ColDescriptor {
+	return pr.readers[0].OrderBy()
+}
+
+func (pr *parallelRowReader) ScanSpecs() *ScanSpecs {
+	return pr.readers[0].ScanSpecs()
+}
+
+func (pr *parallelRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	return pr.readers[0].Columns(ctx)
+}
+
+func (pr *parallelRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	return pr.readers[0].colsBySelector(ctx)
+}
+
+func (pr *parallelRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	return pr.readers[0].InferParameters(ctx, params)
+}
+
+func (pr *parallelRowReader) start(ctx context.Context) {
+	ctx, pr.cancel = context.WithCancel(ctx)
+
+	for i := range pr.readers {
+		pr.wg.Add(1)
+		go pr.readPartition(ctx, i)
+	}
+
+	pr.started = true
+}
+
+func (pr *parallelRowReader) readPartition(ctx context.Context, i int) {
+	defer pr.wg.Done()
+	defer close(pr.batches[i])
+
+	err := ReadRowsBatch(ctx, pr.readers[i], parallelScanBatchSize, func(rows []*Row) error {
+		batch := make([]*Row, len(rows))
+		copy(batch, rows)
+
+		select {
+		case pr.batches[i] <- partitionBatch{rows: batch}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		select {
+		case pr.batches[i] <- partitionBatch{err: err}:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (pr *parallelRowReader) Read(ctx context.Context) (*Row, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !pr.started {
+		pr.start(ctx)
+	}
+
+	for len(pr.currBatch) == 0 {
+		if pr.currPartition == len(pr.batches) {
+			return nil, ErrNoMoreRows
+		}
+
+		var batch partitionBatch
+		var ok bool
+
+		select {
+		case batch, ok = <-pr.batches[pr.currPartition]:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if !ok {
+			pr.currPartition++
+			continue
+		}
+
+		if batch.err != nil {
+			return nil, batch.err
+		}
+
+		pr.currBatch = batch.rows
+	}
+
+	row := pr.currBatch[0]
+	pr.currBatch = pr.currBatch[1:]
+
+	return row, nil
+}
+
+func (pr *parallelRowReader) Close() error {
+	if pr.onCloseCallback != nil {
+		defer pr.onCloseCallback()
+	}
+
+	if pr.started {
+		pr.cancel()
+		pr.wg.Wait()
+	}
+
+	var err error
+
+	for _, r := range pr.readers {
+		rerr := r.Close()
+		if err == nil {
+			err = rerr
+		}
+	}
+
+	return err
+}
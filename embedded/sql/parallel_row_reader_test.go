@@ -0,0 +1,206 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitKeyRange(t *testing.T) {
+	t.Run("keys are evenly spaced within the range", func(t *testing.T) {
+		splitKeys := splitKeyRange([]byte{1, 0x00}, []byte{1, 0x80}, 4, 32)
+		require.Equal(t, [][]byte{
+			{1, 0x20, 0, 0, 0, 0, 0, 0, 0},
+			{1, 0x40, 0, 0, 0, 0, 0, 0, 0},
+			{1, 0x60, 0, 0, 0, 0, 0, 0, 0},
+		}, splitKeys)
+	})
+
+	t.Run("keys are within bounds", func(t *testing.T) {
+		loKey := []byte("prefix-a-long-key")
+		hiKey := []byte("prefix-z")
+
+		splitKeys := splitKeyRange(loKey, hiKey, 8, 32)
+		require.Len(t, splitKeys, 7)
+
+		prev := loKey
+		for _, k := range splitKeys {
+			require.Less(t, bytes.Compare(prev, k), 0)
+			prev = k
+		}
+		require.Less(t, bytes.Compare(prev, hiKey), 0)
+	})
+
+	t.Run("narrow ranges are split into fewer partitions", func(t *testing.T) {
+		require.Len(t, splitKeyRange([]byte{1}, []byte{1, 0, 0, 0, 0, 0, 0, 0, 3}, 8, 32), 2)
+		require.Empty(t, splitKeyRange([]byte{1}, []byte{1, 0}, 8, 32))
+		require.Empty(t, splitKeyRange([]byte{1}, []byte{1}, 8, 32))
+	})
+
+	t.Run("keys do not exceed the max key length", func(t *testing.T) {
+		splitKeys := splitKeyRange([]byte{1, 2, 0}, []byte{1, 2, 0xff}, 2, 3)
+		require.Equal(t, [][]byte{{1, 2, 0x7f}}, splitKeys)
+
+		require.Empty(t, splitKeyRange([]byte{1, 2, 3}, []byte{1, 2, 3, 4}, 2, 3))
+	})
+
+	t.Run("a single partition is not split", func(t *testing.T) {
+		require.Empty(t, splitKeyRange([]byte{0}, []byte{0xff}, 1, 32))
+	})
+}
+
+func TestParallelRowReader(t *testing.T) {
+	_, err := newParallelRowReader(nil, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	partitions := []*ScanSpecs{{}, {}}
+
+	_, err = newParallelRowReader(partitions, func(scanSpecs *ScanSpecs) (RowReader, error) {
+		return nil, errDummy
+	})
+	require.ErrorIs(t, err, errDummy)
+}
+
+func TestParallelScans(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	parallelEngine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithParallelism(4))
+	require.NoError(t, err)
+	require.Equal(t, 4, parallelEngine.parallelism)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE payments (
+			id INTEGER,
+			account VARCHAR[16],
+			amount INTEGER,
+			fee FLOAT,
+			PRIMARY KEY id
+		);
+
+		CREATE INDEX ON payments(account);
+
+		CREATE TABLE events (
+			name VARCHAR[32],
+			PRIMARY KEY name
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	tx, _, err := engine.Exec(context.Background(), nil, "BEGIN TRANSACTION;", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 300; i++ {
+		params := map[string]interface{}{
+			"id":      i * 17,
+			"account": fmt.Sprintf("account-%d", i%7),
+			"amount":  i,
+			"fee":     float64(i%10) / 4,
+			"name":    fmt.Sprintf("event-%04d", i),
+		}
+
+		_, _, err = engine.Exec(context.Background(), tx, `
+			INSERT INTO payments(id, account, amount, fee) VALUES (@id, @account, @amount, @fee);
+			INSERT INTO events(name) VALUES (@name);
+		`, params)
+		require.NoError(t, err)
+	}
+
+	_, _, err = engine.Exec(context.Background(), tx, "COMMIT;", nil)
+	require.NoError(t, err)
+
+	t.Run("scans are split into partitions", func(t *testing.T) {
+		tx, err := parallelEngine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		for _, tableName := range []string{"payments", "events"} {
+			table, err := tx.Catalog().GetTableByName(tableName)
+			require.NoError(t, err)
+
+			partitions, err := partitionScan(context.Background(), tx, table, &ScanSpecs{Index: table.primaryIndex}, 4)
+			require.NoError(t, err)
+			require.Len(t, partitions, 4)
+		}
+	})
+
+	queries := []string{
+		"SELECT * FROM payments",
+		"SELECT * FROM payments ORDER BY id DESC",
+		"SELECT id, amount FROM payments WHERE amount % 3 = 0",
+		"SELECT id FROM payments WHERE id > 1000 AND id < 9000",
+		"SELECT * FROM payments LIMIT 10 OFFSET 500",
+		"SELECT * FROM payments USE INDEX ON (account) ORDER BY account",
+		"SELECT name FROM events WHERE name > 'event-0100'",
+		"SELECT COUNT(*), SUM(amount), MIN(amount), MAX(amount), AVG(amount), SUM(fee) FROM payments",
+		"SELECT COUNT(*), SUM(amount) FROM payments WHERE amount > 100000",
+		"SELECT COUNT(*) FROM events",
+		"SELECT id, COUNT(*) FROM payments GROUP BY id HAVING COUNT(*) > 0",
+		"SELECT account, COUNT(*), SUM(amount), AVG(fee) FROM payments GROUP BY account",
+		"SELECT account, COUNT(*) FROM payments USE INDEX ON (account) GROUP BY account ORDER BY account DESC",
+		"SELECT DISTINCT account FROM payments",
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			expectedRows, err := engine.queryAll(context.Background(), nil, query, nil)
+			require.NoError(t, err)
+
+			rows, err := parallelEngine.queryAll(context.Background(), nil, query, nil)
+			require.NoError(t, err)
+			require.Equal(t, expectedRows, rows)
+		})
+	}
+
+	t.Run("read-write transactions are not partitioned", func(t *testing.T) {
+		tx, err := parallelEngine.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		rows, err := parallelEngine.queryAll(context.Background(), tx, "SELECT COUNT(*) FROM payments", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(300), rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("readers can be closed before being fully read", func(t *testing.T) {
+		r, err := parallelEngine.Query(context.Background(), nil, "SELECT * FROM payments", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.NoError(t, err)
+
+		err = r.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("parallelism is bounded by the store concurrency", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithParallelism(st.MaxConcurrency()+1))
+		require.NoError(t, err)
+		require.Equal(t, st.MaxConcurrency(), engine.parallelism)
+	})
+}
@@ -117,6 +117,22 @@ var reservedWords = map[string]int{
 	"THEN":           THEN,
 	"ELSE":           ELSE,
 	"END":            END,
+	"POLICY":         POLICY,
+	"USING":          USING,
+	"CURRENT_USER":   CURRENT_USER,
+	"SEQUENCE":       SEQUENCE,
+	"INCREMENT":      INCREMENT,
+	"MINVALUE":       MINVALUE,
+	"MAXVALUE":       MAXVALUE,
+	"START":          START,
+	"CYCLE":          CYCLE,
+	"NO":             NO,
+	"DEFAULT":        DEFAULT,
+	"DECLARE":        DECLARE,
+	"CURSOR":         CURSOR,
+	"FETCH":          FETCH,
+	"MOVE":           MOVE,
+	"CLOSE":          CLOSE,
 }
 
 var joinTypes = map[string]JoinType{
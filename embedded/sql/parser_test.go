@@ -953,6 +953,30 @@ func TestSelectStmt(t *testing.T) {
 				}},
 			expectedError: nil,
 		},
+		{
+			input: "SELECT table_name FROM information_schema.tables t",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					distinct: false,
+					targets: []TargetEntry{
+						{Exp: &ColSelector{col: "table_name"}},
+					},
+					ds: &tableRef{schema: "information_schema", table: "tables", as: "t"},
+				}},
+			expectedError: nil,
+		},
+		{
+			input: "SELECT id FROM public.table1",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					distinct: false,
+					targets: []TargetEntry{
+						{Exp: &ColSelector{col: "id"}},
+					},
+					ds: &tableRef{schema: "public", table: "table1"},
+				}},
+			expectedError: nil,
+		},
 		{
 			input: "SELECT t1.id, title FROM table1 t1",
 			expectedOutput: []SQLStmt{
@@ -2063,6 +2087,42 @@ func TestGrantRevokeStmt(t *testing.T) {
 				privileges: allPrivileges,
 			},
 		},
+		{
+			text: "GRANT SELECT (id, amount) ON payments TO analyst",
+			expectedStmt: &AlterPrivilegesStmt{
+				table:      "payments",
+				columns:    []string{"id", "amount"},
+				user:       "analyst",
+				privileges: []SQLPrivilege{SQLPrivilegeSelect},
+				isGrant:    true,
+			},
+		},
+		{
+			text: "GRANT SELECT, DELETE ON TABLE payments TO USER analyst",
+			expectedStmt: &AlterPrivilegesStmt{
+				table:      "payments",
+				user:       "analyst",
+				privileges: []SQLPrivilege{SQLPrivilegeDelete, SQLPrivilegeSelect},
+				isGrant:    true,
+			},
+		},
+		{
+			text: "REVOKE UPDATE (amount) ON payments FROM analyst",
+			expectedStmt: &AlterPrivilegesStmt{
+				table:      "payments",
+				columns:    []string{"amount"},
+				user:       "analyst",
+				privileges: []SQLPrivilege{SQLPrivilegeUpdate},
+			},
+		},
+		{
+			text: "REVOKE ALL PRIVILEGES ON TABLE payments FROM USER analyst",
+			expectedStmt: &AlterPrivilegesStmt{
+				table:      "payments",
+				user:       "analyst",
+				privileges: allPrivileges,
+			},
+		},
 	}
 
 	for i, tc := range cases {
@@ -2075,6 +2135,203 @@ func TestGrantRevokeStmt(t *testing.T) {
 	}
 }
 
+func TestPolicyStmt(t *testing.T) {
+	type test struct {
+		text         string
+		expectedStmt SQLStmt
+	}
+
+	cases := []test{
+		{
+			text: "CREATE POLICY owner_only ON payments FOR SELECT USING (owner = CURRENT_USER)",
+			expectedStmt: &CreatePolicyStmt{
+				name:  "owner_only",
+				table: "payments",
+				cmd:   SQLPrivilegeSelect,
+				exp:   &CmpBoolExp{op: EQ, left: &ColSelector{col: "owner"}, right: &FnCall{fn: CurrentUserFnCall}},
+			},
+		},
+		{
+			text: "CREATE POLICY owner_only ON payments FOR DELETE USING (owner = CURRENT_USER())",
+			expectedStmt: &CreatePolicyStmt{
+				name:  "owner_only",
+				table: "payments",
+				cmd:   SQLPrivilegeDelete,
+				exp:   &CmpBoolExp{op: EQ, left: &ColSelector{col: "owner"}, right: &FnCall{fn: CurrentUserFnCall}},
+			},
+		},
+		{
+			text: "CREATE POLICY small_amounts ON payments FOR UPDATE USING (amount < 100)",
+			expectedStmt: &CreatePolicyStmt{
+				name:  "small_amounts",
+				table: "payments",
+				cmd:   SQLPrivilegeUpdate,
+				exp:   &CmpBoolExp{op: LT, left: &ColSelector{col: "amount"}, right: &Integer{val: 100}},
+			},
+		},
+		{
+			text: "CREATE POLICY visible ON payments USING (visible)",
+			expectedStmt: &CreatePolicyStmt{
+				name:  "visible",
+				table: "payments",
+				exp:   &ColSelector{col: "visible"},
+			},
+		},
+		{
+			text: "CREATE POLICY visible ON payments FOR ALL USING (visible)",
+			expectedStmt: &CreatePolicyStmt{
+				name:  "visible",
+				table: "payments",
+				exp:   &ColSelector{col: "visible"},
+			},
+		},
+		{
+			text:         "DROP POLICY owner_only ON payments",
+			expectedStmt: &DropPolicyStmt{name: "owner_only", table: "payments"},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("policy_%d", i), func(t *testing.T) {
+			stmts, err := ParseSQLString(tc.text)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			require.Equal(t, tc.expectedStmt, stmts[0])
+		})
+	}
+
+	_, err := ParseSQLString("CREATE POLICY p ON payments FOR INSERT USING (true)")
+	require.Error(t, err)
+}
+
+func TestSequenceStmt(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+
+	type test struct {
+		text         string
+		expectedStmt SQLStmt
+	}
+
+	cases := []test{
+		{
+			text:         "CREATE SEQUENCE invoice_seq",
+			expectedStmt: &CreateSequenceStmt{name: "invoice_seq", opts: &sequenceOptions{}},
+		},
+		{
+			text: "CREATE SEQUENCE IF NOT EXISTS invoice_seq INCREMENT BY 10 START WITH 100 CYCLE",
+			expectedStmt: &CreateSequenceStmt{
+				name:        "invoice_seq",
+				ifNotExists: true,
+				opts:        &sequenceOptions{increment: int64Ptr(10), start: int64Ptr(100), cycle: boolPtr(true)},
+			},
+		},
+		{
+			text: "CREATE SEQUENCE countdown INCREMENT -1 MINVALUE -10 MAXVALUE 10 START 10 NO CYCLE",
+			expectedStmt: &CreateSequenceStmt{
+				name: "countdown",
+				opts: &sequenceOptions{
+					increment: int64Ptr(-1),
+					minValue:  int64Ptr(-10),
+					maxValue:  int64Ptr(10),
+					start:     int64Ptr(10),
+					cycle:     boolPtr(false),
+				},
+			},
+		},
+		{
+			text:         "DROP SEQUENCE invoice_seq",
+			expectedStmt: &DropSequenceStmt{name: "invoice_seq"},
+		},
+		{
+			text: "CREATE TABLE invoices (id INTEGER, number INTEGER DEFAULT NEXTVAL('invoice_seq') NOT NULL, PRIMARY KEY id)",
+			expectedStmt: &CreateTableStmt{
+				table: "invoices",
+				colsSpec: []*ColSpec{
+					{colName: "id", colType: IntegerType},
+					{
+						colName:      "number",
+						colType:      IntegerType,
+						defaultValue: &FnCall{fn: "nextval", params: []ValueExp{&Varchar{val: "invoice_seq"}}},
+						notNull:      true,
+					},
+				},
+				pkColNames: []string{"id"},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("sequence_%d", i), func(t *testing.T) {
+			stmts, err := ParseSQLString(tc.text)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			require.Equal(t, tc.expectedStmt, stmts[0])
+		})
+	}
+}
+
+func TestCursorStmt(t *testing.T) {
+	type test struct {
+		text         string
+		expectedStmt SQLStmt
+	}
+
+	cases := []test{
+		{
+			text: "DECLARE items_cursor CURSOR FOR SELECT id FROM items",
+			expectedStmt: &DeclareCursorStmt{
+				cursor: "items_cursor",
+				query: &SelectStmt{
+					targets: []TargetEntry{{Exp: &ColSelector{col: "id"}}},
+					ds:      &tableRef{table: "items"},
+				},
+			},
+		},
+		{
+			text:         "FETCH items_cursor",
+			expectedStmt: &FetchStmt{cursor: "items_cursor", count: 1},
+		},
+		{
+			text:         "FETCH 10 FROM items_cursor",
+			expectedStmt: &FetchStmt{cursor: "items_cursor", count: 10},
+		},
+		{
+			text:         "FETCH ALL IN items_cursor",
+			expectedStmt: &FetchStmt{cursor: "items_cursor", all: true},
+		},
+		{
+			text:         "MOVE IN items_cursor",
+			expectedStmt: &MoveStmt{cursor: "items_cursor", count: 1},
+		},
+		{
+			text:         "MOVE 5 items_cursor",
+			expectedStmt: &MoveStmt{cursor: "items_cursor", count: 5},
+		},
+		{
+			text:         "MOVE ALL FROM items_cursor",
+			expectedStmt: &MoveStmt{cursor: "items_cursor", all: true},
+		},
+		{
+			text:         "CLOSE items_cursor",
+			expectedStmt: &CloseCursorStmt{cursor: "items_cursor"},
+		},
+		{
+			text:         "CLOSE ALL",
+			expectedStmt: &CloseCursorStmt{all: true},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("cursor_%d", i), func(t *testing.T) {
+			stmts, err := ParseSQLString(tc.text)
+			require.NoError(t, err)
+			require.Len(t, stmts, 1)
+			require.Equal(t, tc.expectedStmt, stmts[0])
+		})
+	}
+}
+
 func TestExpString(t *testing.T) {
 	exps := []string{
 		"(1 + 1) / (2 * 5 - 10) % 2",
@@ -17,6 +17,7 @@ limitations under the License.
 package sql
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
@@ -49,6 +50,10 @@ type ScanSpecs struct {
 	DescOrder         bool
 	groupBySortExps   []*OrdExp
 	orderBySortExps   []*OrdExp
+
+	// partition narrows the scanned key range when the scan is split
+	// into partitions read concurrently
+	partition *keyPartition
 }
 
 func (s *ScanSpecs) extraCols() int {
@@ -311,18 +316,35 @@ func keyReaderSpecFrom(sqlPrefix []byte, table *Table, scanSpecs *ScanSpecs) (sp
 	// Ensure the hiKey is inclusive regarding all values with that prefix
 	hiKey = append(hiKey, KeyValPrefixUpperBound)
 
+	inclusiveHiKey := true
+
+	if p := scanSpecs.partition; p != nil {
+		if p.loKey != nil && bytes.Compare(p.loKey, loKey) > 0 {
+			loKey = p.loKey
+		}
+
+		if p.hiKey != nil && bytes.Compare(p.hiKey, hiKey) < 0 {
+			hiKey = p.hiKey
+			inclusiveHiKey = false
+		}
+	}
+
 	seekKey := loKey
+	inclusiveSeek := true
+
 	endKey := hiKey
+	inclusiveEnd := inclusiveHiKey
 
 	if scanSpecs.DescOrder {
 		seekKey, endKey = endKey, seekKey
+		inclusiveSeek, inclusiveEnd = inclusiveEnd, inclusiveSeek
 	}
 
 	return &store.KeyReaderSpec{
 		SeekKey:        seekKey,
-		InclusiveSeek:  true,
+		InclusiveSeek:  inclusiveSeek,
 		EndKey:         endKey,
-		InclusiveEnd:   true,
+		InclusiveEnd:   inclusiveEnd,
 		Prefix:         prefix,
 		DescOrder:      scanSpecs.DescOrder,
 		Filters:        []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
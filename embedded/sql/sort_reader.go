@@ -37,12 +37,12 @@ type sortRowReader struct {
 	resultReader resultReader
 }
 
-func newSortRowReader(rowReader RowReader, ordExps []*OrdExp) (*sortRowReader, error) {
+func newSortRowReader(ctx context.Context, rowReader RowReader, ordExps []*OrdExp) (*sortRowReader, error) {
 	if rowReader == nil || len(ordExps) == 0 {
 		return nil, ErrIllegalArguments
 	}
 
-	descriptors, err := rowReader.Columns(context.Background())
+	descriptors, err := rowReader.Columns(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,12 +59,12 @@ func newSortRowReader(rowReader RowReader, ordExps []*OrdExp) (*sortRowReader, e
 		return nil, err
 	}
 
-	colTypes, err := getColTypes(rowReader)
+	colTypes, err := getColTypes(ctx, rowReader)
 	if err != nil {
 		return nil, err
 	}
 
-	orderByDescriptors, err := getOrderByDescriptors(ordExps, rowReader)
+	orderByDescriptors, err := getOrderByDescriptors(ctx, ordExps, rowReader)
 	if err != nil {
 		return nil, err
 	}
@@ -135,8 +135,8 @@ func (s *sortRowReader) evalSortExps(inRow *Row, out Tuple) error {
 	return nil
 }
 
-func getOrderByDescriptors(ordExps []*OrdExp, rowReader RowReader) ([]ColDescriptor, error) {
-	colsBySel, err := rowReader.colsBySelector(context.Background())
+func getOrderByDescriptors(ctx context.Context, ordExps []*OrdExp, rowReader RowReader) ([]ColDescriptor, error) {
+	colsBySel, err := rowReader.colsBySelector(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +167,8 @@ func getOrderByDescriptors(ordExps []*OrdExp, rowReader RowReader) ([]ColDescrip
 	return orderByDescriptors, nil
 }
 
-func getColTypes(r RowReader) ([]string, error) {
-	descriptors, err := r.Columns(context.Background())
+func getColTypes(ctx context.Context, r RowReader) ([]string, error) {
+	descriptors, err := r.Columns(ctx)
 	if err != nil {
 		return nil, err
 	}
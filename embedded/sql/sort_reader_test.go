@@ -31,7 +31,7 @@ func TestSortRowReader(t *testing.T) {
 	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
 	require.NoError(t, err)
 
-	_, err = newSortRowReader(nil, nil)
+	_, err = newSortRowReader(context.Background(), nil, nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
 	tx, err := engine.NewTx(context.Background(), DefaultTxOptions())
@@ -50,7 +50,7 @@ func TestSortRowReader(t *testing.T) {
 	r, err := newRawRowReader(tx, nil, table, period{}, "", &ScanSpecs{Index: table.primaryIndex})
 	require.NoError(t, err)
 
-	sr, err := newSortRowReader(r, []*OrdExp{{exp: &ColSelector{col: "number"}}})
+	sr, err := newSortRowReader(context.Background(), r, []*OrdExp{{exp: &ColSelector{col: "number"}}})
 	require.NoError(t, err)
 
 	orderBy := sr.OrderBy()
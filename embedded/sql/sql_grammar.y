@@ -74,6 +74,7 @@ func setResult(l yyLexer, stmts []SQLStmt) {
     whenThenClauses []whenThenClause
     tableElem TableElem
     tableElems []TableElem
+    sequenceOpts *sequenceOptions
 }
 
 %token CREATE DROP USE DATABASE USER WITH PASSWORD READ READWRITE ADMIN SNAPSHOT HISTORY SINCE AFTER BEFORE UNTIL TX OF TIMESTAMP
@@ -84,6 +85,9 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %token NOT LIKE IF EXISTS IN IS
 %token AUTO_INCREMENT NULL CAST SCAST
 %token SHOW DATABASES TABLES USERS
+%token POLICY USING CURRENT_USER
+%token SEQUENCE INCREMENT MINVALUE MAXVALUE START CYCLE NO DEFAULT
+%token DECLARE CURSOR FETCH MOVE CLOSE
 %token <id> NPARAM
 %token <pparam> PPARAM
 %token <joinType> JOINTYPE
@@ -120,7 +124,7 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %left IS
 
 %type <stmts> sql sqlstmts
-%type <stmt> sqlstmt ddlstmt dmlstmt dqlstmt select_stmt
+%type <stmt> sqlstmt ddlstmt dmlstmt dqlstmt select_stmt cursorstmt
 %type <colSpec> colSpec
 %type <ids> ids one_or_more_ids opt_ids
 %type <cols> cols
@@ -159,9 +163,12 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %type <updates> updates
 %type <onConflict> opt_on_conflict
 %type <permission> permission
-%type <sqlPrivilege> sqlPrivilege
+%type <sqlPrivilege> sqlPrivilege opt_policy_cmd
 %type <sqlPrivileges> sqlPrivileges
 %type <whenThenClauses> when_then_clauses
+%type <sequenceOpts> opt_sequence_options sequence_options sequence_option
+%type <exp> opt_default
+%type <integer> signed_integer
 
 %start sql
 
@@ -186,7 +193,7 @@ sqlstmts:
 
 opt_separator: {} | STMT_SEPARATOR
 
-sqlstmt: ddlstmt | dmlstmt | dqlstmt
+sqlstmt: ddlstmt | dmlstmt | dqlstmt | cursorstmt
 
 ddlstmt:
     BEGIN TRANSACTION
@@ -263,6 +270,16 @@ ddlstmt:
     {
         $$ = &DropTableStmt{table: $3}
     }
+|
+    CREATE SEQUENCE opt_if_not_exists IDENTIFIER opt_sequence_options
+    {
+        $$ = &CreateSequenceStmt{ifNotExists: $3, name: $4, opts: $5}
+    }
+|
+    DROP SEQUENCE IDENTIFIER
+    {
+        $$ = &DropSequenceStmt{name: $3}
+    }
 |
     CREATE INDEX opt_if_not_exists ON IDENTIFIER '(' ids ')'
     {
@@ -308,6 +325,16 @@ ddlstmt:
     {
         $$ = &DropConstraintStmt{table: $3, constraintName: $6}
     }
+|
+    CREATE POLICY IDENTIFIER ON IDENTIFIER opt_policy_cmd USING '(' exp ')'
+    {
+        $$ = &CreatePolicyStmt{name: $3, table: $5, cmd: $6, exp: $9}
+    }
+|
+    DROP POLICY IDENTIFIER ON IDENTIFIER
+    {
+        $$ = &DropPolicyStmt{name: $3, table: $5}
+    }
 |
     CREATE USER IDENTIFIER WITH PASSWORD VARCHAR permission
     {
@@ -333,6 +360,30 @@ ddlstmt:
     {
         $$ = &AlterPrivilegesStmt{database: $5, user: $8, privileges: $2}
     }
+|
+    GRANT sqlPrivileges ON opt_table IDENTIFIER TO opt_user IDENTIFIER
+    {
+        $$ = &AlterPrivilegesStmt{table: $5, user: $8, privileges: $2, isGrant: true}
+    }
+|
+    GRANT sqlPrivileges '(' ids ')' ON opt_table IDENTIFIER TO opt_user IDENTIFIER
+    {
+        $$ = &AlterPrivilegesStmt{table: $8, columns: $4, user: $11, privileges: $2, isGrant: true}
+    }
+|
+    REVOKE sqlPrivileges ON opt_table IDENTIFIER FROM opt_user IDENTIFIER
+    {
+        $$ = &AlterPrivilegesStmt{table: $5, user: $8, privileges: $2}
+    }
+|
+    REVOKE sqlPrivileges '(' ids ')' ON opt_table IDENTIFIER FROM opt_user IDENTIFIER
+    {
+        $$ = &AlterPrivilegesStmt{table: $8, columns: $4, user: $11, privileges: $2}
+    }
+
+opt_table: {} | TABLE
+
+opt_user: {} | USER
 
 sqlPrivileges:
     ALL PRIVILEGES
@@ -386,6 +437,31 @@ sqlPrivilege:
         $$ = SQLPrivilegeAlter
     }
 
+opt_policy_cmd:
+    {
+        $$ = ""
+    }
+|
+    FOR ALL
+    {
+        $$ = ""
+    }
+|
+    FOR SELECT
+    {
+        $$ = SQLPrivilegeSelect
+    }
+|
+    FOR UPDATE
+    {
+        $$ = SQLPrivilegeUpdate
+    }
+|
+    FOR DELETE
+    {
+        $$ = SQLPrivilegeDelete
+    }
+
 permission:
     {
         $$ = PermissionReadWrite
@@ -448,6 +524,54 @@ dmlstmt:
         $$ = &UpdateStmt{tableRef: $2, updates: $4, where: $5, indexOn: $6, limit: $7, offset: $8}
     }
 
+cursorstmt:
+    DECLARE IDENTIFIER CURSOR FOR dqlstmt
+    {
+        $$ = &DeclareCursorStmt{cursor: $2, query: $5.(DataSource)}
+    }
+|
+    FETCH opt_from IDENTIFIER
+    {
+        $$ = &FetchStmt{cursor: $3, count: 1}
+    }
+|
+    FETCH INTEGER opt_from IDENTIFIER
+    {
+        $$ = &FetchStmt{cursor: $4, count: $2}
+    }
+|
+    FETCH ALL opt_from IDENTIFIER
+    {
+        $$ = &FetchStmt{cursor: $4, all: true}
+    }
+|
+    MOVE opt_from IDENTIFIER
+    {
+        $$ = &MoveStmt{cursor: $3, count: 1}
+    }
+|
+    MOVE INTEGER opt_from IDENTIFIER
+    {
+        $$ = &MoveStmt{cursor: $4, count: $2}
+    }
+|
+    MOVE ALL opt_from IDENTIFIER
+    {
+        $$ = &MoveStmt{cursor: $4, all: true}
+    }
+|
+    CLOSE IDENTIFIER
+    {
+        $$ = &CloseCursorStmt{cursor: $2}
+    }
+|
+    CLOSE ALL
+    {
+        $$ = &CloseCursorStmt{all: true}
+    }
+
+opt_from: {} | FROM | IN
+
 values_or_query:
 	VALUES rows
 	{
@@ -613,6 +737,16 @@ fnCall:
     {
         $$ = &FnCall{fn: $1, params: $3}
     }
+|
+    CURRENT_USER
+    {
+        $$ = &FnCall{fn: CurrentUserFnCall}
+    }
+|
+    CURRENT_USER '(' ')'
+    {
+        $$ = &FnCall{fn: CurrentUserFnCall}
+    }
 
 tableElems:
     tableElem
@@ -643,9 +777,102 @@ tableElem:
 ;
 
 colSpec:
-    IDENTIFIER TYPE opt_max_len opt_not_null opt_auto_increment opt_primary_key
+    IDENTIFIER TYPE opt_max_len opt_default opt_not_null opt_auto_increment opt_primary_key
+    {
+        $$ = &ColSpec{colName: $1, colType: $2, maxLen: int($3), defaultValue: $4, notNull: $5 || $7, autoIncrement: $6, primaryKey: $7}
+    }
+
+opt_default:
+    {
+        $$ = nil
+    }
+|
+    DEFAULT boundexp
+    {
+        $$ = $2
+    }
+
+opt_sequence_options:
+    {
+        $$ = &sequenceOptions{}
+    }
+|
+    sequence_options
+    {
+        $$ = $1
+    }
+
+sequence_options:
+    sequence_option
+    {
+        $$ = $1
+    }
+|
+    sequence_options sequence_option
     {
-        $$ = &ColSpec{colName: $1, colType: $2, maxLen: int($3), notNull: $4 || $6, autoIncrement: $5, primaryKey: $6}
+        $$ = $1.merge($2)
+    }
+
+sequence_option:
+    INCREMENT opt_by signed_integer
+    {
+        v := int64($3)
+        $$ = &sequenceOptions{increment: &v}
+    }
+|
+    MINVALUE signed_integer
+    {
+        v := int64($2)
+        $$ = &sequenceOptions{minValue: &v}
+    }
+|
+    NO MINVALUE
+    {
+        $$ = &sequenceOptions{}
+    }
+|
+    MAXVALUE signed_integer
+    {
+        v := int64($2)
+        $$ = &sequenceOptions{maxValue: &v}
+    }
+|
+    NO MAXVALUE
+    {
+        $$ = &sequenceOptions{}
+    }
+|
+    START opt_with signed_integer
+    {
+        v := int64($3)
+        $$ = &sequenceOptions{start: &v}
+    }
+|
+    CYCLE
+    {
+        v := true
+        $$ = &sequenceOptions{cycle: &v}
+    }
+|
+    NO CYCLE
+    {
+        v := false
+        $$ = &sequenceOptions{cycle: &v}
+    }
+
+opt_by: {} | BY
+
+opt_with: {} | WITH
+
+signed_integer:
+    INTEGER
+    {
+        $$ = $1
+    }
+|
+    '-' INTEGER
+    {
+        $$ = uint64(-int64($2))
     }
 
 opt_primary_key:
@@ -922,6 +1149,16 @@ tableRef:
     {
         $$ = &tableRef{table: $1}
     }
+|
+    IDENTIFIER DOT IDENTIFIER
+    {
+        $$ = &tableRef{schema: $1, table: $3}
+    }
+|
+    IDENTIFIER DOT TABLES
+    {
+        $$ = &tableRef{schema: $1, table: "tables"}
+    }
 
 opt_period:
     opt_period_start opt_period_end
@@ -60,6 +60,7 @@ type yySymType struct {
 	whenThenClauses []whenThenClause
 	tableElem       TableElem
 	tableElems      []TableElem
+	sequenceOpts    *sequenceOptions
 }
 
 const CREATE = 57346
@@ -149,25 +150,41 @@ const SHOW = 57429
 const DATABASES = 57430
 const TABLES = 57431
 const USERS = 57432
-const NPARAM = 57433
-const PPARAM = 57434
-const JOINTYPE = 57435
-const AND = 57436
-const OR = 57437
-const CMPOP = 57438
-const NOT_MATCHES_OP = 57439
-const IDENTIFIER = 57440
-const TYPE = 57441
-const INTEGER = 57442
-const FLOAT = 57443
-const VARCHAR = 57444
-const BOOLEAN = 57445
-const BLOB = 57446
-const AGGREGATE_FUNC = 57447
-const ERROR = 57448
-const DOT = 57449
-const ARROW = 57450
-const STMT_SEPARATOR = 57451
+const POLICY = 57433
+const USING = 57434
+const CURRENT_USER = 57435
+const SEQUENCE = 57436
+const INCREMENT = 57437
+const MINVALUE = 57438
+const MAXVALUE = 57439
+const START = 57440
+const CYCLE = 57441
+const NO = 57442
+const DEFAULT = 57443
+const DECLARE = 57444
+const CURSOR = 57445
+const FETCH = 57446
+const MOVE = 57447
+const CLOSE = 57448
+const NPARAM = 57449
+const PPARAM = 57450
+const JOINTYPE = 57451
+const AND = 57452
+const OR = 57453
+const CMPOP = 57454
+const NOT_MATCHES_OP = 57455
+const IDENTIFIER = 57456
+const TYPE = 57457
+const INTEGER = 57458
+const FLOAT = 57459
+const VARCHAR = 57460
+const BOOLEAN = 57461
+const BLOB = 57462
+const AGGREGATE_FUNC = 57463
+const ERROR = 57464
+const DOT = 57465
+const ARROW = 57466
+const STMT_SEPARATOR = 57467
 
 var yyToknames = [...]string{
 	"$end",
@@ -260,6 +277,22 @@ var yyToknames = [...]string{
 	"DATABASES",
 	"TABLES",
 	"USERS",
+	"POLICY",
+	"USING",
+	"CURRENT_USER",
+	"SEQUENCE",
+	"INCREMENT",
+	"MINVALUE",
+	"MAXVALUE",
+	"START",
+	"CYCLE",
+	"NO",
+	"DEFAULT",
+	"DECLARE",
+	"CURSOR",
+	"FETCH",
+	"MOVE",
+	"CLOSE",
 	"NPARAM",
 	"PPARAM",
 	"JOINTYPE",
@@ -302,300 +335,363 @@ var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 97,
-	78, 199,
-	81, 199,
-	-2, 180,
-	-1, 277,
-	59, 152,
-	-2, 147,
-	-1, 331,
-	59, 152,
-	-2, 149,
+	-1, 131,
+	78, 253,
+	81, 253,
+	-2, 234,
+	-1, 364,
+	59, 206,
+	-2, 201,
+	-1, 430,
+	59, 206,
+	-2, 203,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 593
+const yyLast = 744
 
 var yyAct = [...]int16{
-	131, 435, 324, 107, 271, 153, 203, 336, 200, 209,
-	244, 115, 353, 330, 335, 249, 245, 319, 6, 310,
-	147, 144, 71, 250, 22, 408, 358, 269, 357, 269,
-	301, 130, 374, 409, 269, 269, 404, 303, 395, 384,
-	106, 375, 129, 360, 314, 99, 302, 269, 101, 402,
-	401, 387, 118, 114, 214, 21, 270, 383, 381, 116,
-	117, 354, 96, 343, 341, 340, 119, 338, 109, 110,
-	111, 112, 113, 108, 300, 298, 297, 291, 268, 100,
-	355, 337, 180, 106, 309, 105, 290, 179, 99, 285,
-	284, 101, 179, 283, 162, 118, 114, 282, 256, 189,
-	169, 170, 116, 117, 162, 182, 172, 174, 149, 119,
-	162, 109, 110, 111, 112, 113, 108, 132, 161, 212,
-	213, 215, 100, 178, 157, 156, 158, 177, 105, 217,
-	171, 188, 154, 155, 157, 156, 158, 143, 154, 155,
-	157, 156, 158, 142, 434, 24, 205, 428, 211, 145,
-	374, 242, 202, 218, 301, 219, 220, 221, 222, 223,
-	224, 225, 226, 216, 162, 240, 269, 232, 206, 152,
-	186, 187, 83, 238, 436, 437, 159, 160, 161, 243,
-	246, 241, 176, 180, 134, 296, 265, 258, 239, 162,
-	234, 393, 154, 155, 157, 156, 158, 207, 259, 392,
-	235, 159, 160, 161, 350, 164, 76, 305, 233, 276,
-	242, 255, 252, 274, 254, 260, 277, 154, 155, 157,
-	156, 158, 286, 201, 287, 32, 168, 164, 275, 162,
-	280, 289, 33, 278, 163, 167, 378, 364, 295, 363,
-	162, 159, 160, 161, 362, 407, 166, 333, 342, 322,
-	307, 148, 159, 160, 161, 306, 163, 154, 155, 157,
-	156, 158, 264, 263, 262, 308, 261, 253, 154, 155,
-	157, 156, 158, 326, 257, 247, 229, 253, 77, 328,
-	198, 197, 190, 183, 334, 316, 150, 321, 323, 321,
-	133, 246, 162, 122, 347, 348, 120, 91, 54, 80,
-	79, 78, 351, 75, 159, 70, 161, 345, 22, 344,
-	69, 208, 288, 406, 39, 162, 31, 320, 361, 352,
-	154, 155, 157, 156, 158, 22, 368, 391, 181, 230,
-	49, 370, 231, 385, 390, 367, 65, 58, 246, 21,
-	369, 162, 121, 281, 377, 372, 379, 380, 376, 382,
-	386, 371, 60, 159, 160, 161, 21, 349, 90, 228,
-	394, 346, 237, 55, 388, 162, 227, 420, 325, 154,
-	155, 157, 156, 158, 272, 427, 279, 159, 160, 161,
-	413, 398, 400, 399, 22, 216, 403, 145, 293, 412,
-	294, 64, 373, 154, 155, 157, 156, 158, 140, 151,
-	52, 62, 56, 57, 59, 418, 414, 410, 415, 396,
-	88, 51, 50, 421, 25, 21, 82, 423, 106, 66,
-	67, 359, 92, 99, 426, 429, 101, 425, 432, 430,
-	118, 114, 433, 304, 417, 438, 191, 116, 117, 315,
-	439, 194, 195, 267, 119, 137, 109, 110, 111, 112,
-	113, 108, 192, 193, 106, 266, 424, 100, 94, 99,
-	124, 210, 101, 105, 299, 366, 118, 114, 135, 136,
-	327, 184, 123, 116, 117, 84, 81, 162, 43, 47,
-	119, 53, 109, 110, 111, 112, 113, 108, 273, 159,
-	160, 161, 68, 100, 10, 12, 11, 2, 339, 105,
-	38, 48, 138, 36, 318, 154, 155, 157, 156, 158,
-	73, 74, 85, 86, 87, 37, 196, 13, 34, 44,
-	35, 185, 63, 46, 45, 125, 14, 15, 128, 127,
-	42, 7, 317, 8, 9, 16, 17, 26, 30, 18,
-	19, 141, 311, 312, 313, 40, 22, 139, 204, 23,
-	236, 41, 365, 27, 29, 28, 146, 416, 165, 389,
-	405, 419, 431, 356, 95, 93, 102, 397, 98, 292,
-	97, 411, 173, 248, 251, 332, 331, 21, 329, 126,
-	72, 89, 61, 175, 103, 104, 422, 199, 20, 5,
-	4, 3, 1,
+	168, 550, 423, 141, 358, 204, 270, 413, 131, 435,
+	180, 149, 188, 277, 312, 452, 429, 434, 318, 313,
+	178, 405, 6, 328, 93, 419, 191, 319, 166, 237,
+	140, 515, 27, 516, 457, 133, 456, 263, 135, 508,
+	263, 507, 152, 148, 491, 388, 510, 487, 140, 498,
+	167, 154, 282, 133, 488, 478, 135, 485, 263, 263,
+	152, 148, 390, 22, 479, 150, 151, 459, 409, 154,
+	442, 389, 153, 440, 143, 144, 145, 146, 147, 142,
+	263, 453, 130, 150, 151, 134, 128, 439, 437, 357,
+	153, 139, 143, 144, 145, 146, 147, 142, 387, 263,
+	454, 436, 140, 134, 263, 385, 384, 133, 266, 139,
+	135, 378, 356, 262, 152, 148, 316, 280, 281, 283,
+	109, 185, 154, 154, 461, 169, 213, 399, 377, 105,
+	184, 372, 115, 213, 220, 221, 371, 150, 151, 195,
+	223, 225, 230, 285, 153, 370, 143, 144, 145, 146,
+	147, 142, 152, 148, 210, 211, 212, 134, 369, 231,
+	337, 154, 279, 139, 251, 234, 232, 229, 250, 230,
+	205, 206, 208, 207, 209, 150, 151, 29, 517, 208,
+	207, 209, 153, 228, 143, 144, 145, 146, 147, 142,
+	272, 222, 215, 248, 249, 213, 187, 268, 269, 186,
+	189, 139, 310, 273, 286, 213, 287, 288, 289, 290,
+	291, 292, 293, 294, 284, 329, 308, 549, 300, 306,
+	276, 542, 478, 210, 211, 212, 330, 110, 231, 388,
+	311, 314, 309, 210, 211, 212, 106, 214, 227, 205,
+	206, 208, 207, 209, 263, 302, 203, 303, 171, 205,
+	206, 208, 207, 209, 108, 115, 74, 275, 383, 348,
+	341, 307, 342, 496, 274, 334, 325, 324, 321, 78,
+	323, 495, 396, 140, 449, 74, 363, 392, 133, 75,
+	361, 135, 343, 301, 364, 152, 148, 215, 73, 489,
+	373, 194, 374, 80, 154, 551, 552, 213, 75, 376,
+	39, 362, 367, 365, 310, 530, 382, 40, 150, 151,
+	213, 99, 432, 529, 77, 153, 193, 143, 144, 145,
+	146, 147, 142, 181, 393, 210, 211, 212, 134, 120,
+	482, 494, 214, 72, 139, 468, 79, 467, 210, 211,
+	212, 205, 206, 208, 207, 209, 466, 213, 398, 322,
+	395, 465, 219, 464, 205, 206, 208, 207, 209, 397,
+	425, 218, 417, 463, 462, 448, 427, 441, 213, 394,
+	411, 433, 415, 213, 192, 210, 418, 212, 314, 421,
+	421, 446, 447, 422, 352, 347, 346, 213, 217, 450,
+	345, 205, 206, 208, 207, 209, 344, 444, 212, 100,
+	443, 210, 211, 212, 322, 218, 338, 38, 315, 451,
+	460, 386, 205, 206, 208, 207, 209, 205, 206, 208,
+	207, 209, 297, 265, 213, 472, 264, 261, 260, 253,
+	474, 205, 206, 208, 207, 209, 252, 314, 471, 246,
+	244, 475, 473, 481, 201, 483, 484, 476, 486, 490,
+	213, 480, 210, 211, 212, 200, 400, 199, 198, 497,
+	196, 170, 499, 158, 157, 492, 155, 124, 205, 206,
+	208, 207, 209, 121, 118, 63, 104, 103, 210, 211,
+	212, 102, 48, 101, 98, 97, 92, 91, 506, 284,
+	509, 505, 90, 70, 205, 206, 208, 207, 209, 58,
+	84, 331, 332, 514, 333, 528, 67, 368, 518, 519,
+	27, 375, 524, 45, 525, 238, 239, 241, 242, 243,
+	240, 69, 513, 534, 11, 13, 12, 536, 41, 512,
+	43, 527, 296, 86, 87, 88, 420, 540, 543, 295,
+	366, 22, 547, 545, 27, 213, 548, 14, 27, 298,
+	553, 74, 299, 233, 71, 554, 15, 16, 85, 156,
+	445, 8, 305, 9, 10, 17, 18, 31, 37, 19,
+	20, 65, 66, 68, 75, 22, 27, 117, 64, 22,
+	76, 533, 424, 32, 35, 34, 380, 278, 381, 359,
+	160, 182, 541, 52, 56, 523, 44, 404, 403, 42,
+	327, 504, 189, 522, 402, 477, 82, 22, 62, 501,
+	354, 202, 61, 531, 520, 502, 57, 114, 60, 401,
+	59, 30, 23, 107, 24, 25, 26, 122, 123, 340,
+	197, 119, 125, 126, 53, 458, 544, 391, 55, 54,
+	539, 257, 258, 255, 256, 51, 254, 111, 112, 113,
+	175, 36, 500, 410, 33, 353, 350, 349, 2, 537,
+	49, 470, 426, 355, 351, 245, 172, 161, 159, 360,
+	183, 89, 47, 173, 174, 177, 179, 438, 165, 164,
+	259, 95, 96, 406, 407, 408, 179, 46, 83, 247,
+	336, 179, 176, 162, 414, 416, 412, 271, 335, 326,
+	28, 493, 236, 235, 304, 339, 50, 469, 190, 538,
+	216, 511, 526, 532, 546, 455, 129, 127, 136, 503,
+	132, 379, 521, 224, 317, 320, 431, 430, 428, 163,
+	94, 116, 81, 226, 137, 138, 535, 267, 7, 21,
+	5, 4, 3, 1,
 }
 
 var yyPact = [...]int16{
-	490, -1000, -1000, 29, -1000, -1000, -1000, 372, -1000, -1000,
-	530, 218, 495, 492, 474, 474, 365, 364, 342, 200,
-	293, 314, 344, -1000, 490, -1000, 257, 257, 257, 467,
-	212, -1000, 207, 494, 205, 180, 203, 202, 201, 450,
-	376, 63, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 449,
-	200, 200, 200, 359, -1000, 287, -1000, -1000, 199, -1000,
-	383, 346, -1000, -1000, 198, 265, 195, 446, 257, 516,
-	-1000, -1000, 510, 11, 11, -1000, 192, 77, -1000, 440,
-	493, 540, -1000, 474, 534, 26, 20, 326, 153, 252,
-	-1000, -1000, 188, 341, -1000, 60, 158, 149, -1000, 382,
-	382, 13, -1000, -1000, -1000, 382, 382, 74, 10, -1000,
-	-1000, -1000, -1000, -1000, 6, -1000, -1000, -1000, -1000, -25,
-	-1000, 248, -12, 185, 445, 511, -1000, 11, 11, -1000,
-	382, 147, -1000, -18, 184, 405, 422, 410, 506, 183,
-	-1000, 182, 125, 125, 542, 382, 88, -1000, 215, -1000,
-	-1000, 31, 382, -1000, 382, 382, 382, 382, 382, 382,
-	382, 382, 282, -1000, 178, 251, 382, 109, -1000, 22,
-	12, 252, 82, 289, 147, 65, 86, 53, 382, 382,
-	177, -1000, 179, -19, 176, 85, -1000, -1000, 147, 125,
-	-1000, 169, 168, 166, 165, 164, 84, 425, 413, -40,
-	57, -1000, -62, 310, 463, 147, 542, 153, 382, 542,
-	494, 328, -20, -24, -27, -28, 136, -30, 158, 12,
-	12, 233, 233, 233, 22, 210, 28, -1000, 228, -1000,
-	382, -31, 22, -1000, -41, -1000, 315, 382, 83, -1000,
-	-42, -43, 76, 395, -44, 45, 147, -1000, -72, -1000,
-	-1000, -1000, 399, 108, 382, 152, 125, -33, 531, -74,
-	-1000, -1000, 409, -1000, -1000, 531, 524, 496, 269, 151,
-	269, 303, 382, 444, 310, -1000, 147, 154, 136, -36,
-	-51, 477, -53, -54, 150, -55, -1000, -1000, -1000, 22,
-	-32, -1000, 285, 382, 382, 283, -1000, -1000, -1000, 105,
-	-1000, 382, -1000, 179, -37, -91, 147, 386, -75, 125,
-	-1000, -1000, -1000, -1000, -1000, 146, -1000, 141, 139, 439,
-	-36, -1000, -1000, -1000, -1000, 382, 147, -37, 303, 326,
-	-1000, 154, 333, -1000, -1000, -77, -1000, 382, 136, 138,
-	136, 136, -60, 136, -61, -79, -1000, 259, 147, 382,
-	-67, 147, -1000, -1000, -1000, 125, 250, 99, 91, 382,
-	-1000, -80, -1000, -1000, -1000, -1000, 357, 41, 147, -1000,
-	-1000, 319, -1000, 31, -36, -1000, -68, -1000, -69, -1000,
-	-1000, -1000, -1000, -1000, -1000, 382, 147, -1000, -82, 230,
-	-1000, 161, -95, -85, 147, -1000, 354, 329, 317, 542,
-	-1000, -1000, 136, 147, -1000, 401, -1000, -1000, -1000, -1000,
-	351, 301, 382, 112, 430, -1000, -1000, 393, -1000, 310,
-	312, 147, 38, -1000, 382, -1000, 303, 382, 112, 147,
-	-1000, 35, 107, -1000, 382, -1000, -1000, -1000, 107, -1000,
+	520, -1000, -1000, 45, -1000, -1000, -1000, -1000, 579, -1000,
+	-1000, 560, 293, 505, 664, 589, 589, 573, 571, 554,
+	361, 508, 483, 379, 217, 198, 222, 549, -1000, 520,
+	-1000, 479, 479, 479, 479, 646, 378, 373, -1000, 372,
+	665, 371, 370, 285, 369, 367, 363, 362, 103, 583,
+	129, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 94, 361,
+	361, 361, 566, 132, 506, -1000, -1000, 360, -1000, 592,
+	226, 359, 493, 493, -1000, -1000, 353, 493, 493, -1000,
+	-1000, -42, -1000, -1000, 352, 482, 350, 349, 642, 479,
+	641, 684, -1000, -1000, 660, 30, 30, -1000, -1000, 347,
+	125, 640, -1000, 645, 683, 668, 209, -1000, 589, 663,
+	209, 66, 63, 541, 260, 202, 454, -1000, -1000, 346,
+	591, -1000, 344, 343, -1000, 341, 330, 553, -1000, 121,
+	123, 275, -1000, 201, 201, 58, -1000, -1000, -1000, 201,
+	201, 114, 50, -1000, -1000, -1000, -1000, -1000, 34, -1000,
+	-1000, -1000, -1000, 36, 33, -1000, 473, 32, 420, 326,
+	639, 325, 679, -1000, 30, 30, -1000, 201, 368, -1000,
+	31, 322, 315, 615, 613, 610, 670, 314, 313, -1000,
+	-21, -1000, -1000, 312, 309, -26, 209, 209, 691, 201,
+	139, -1000, 145, -1000, -1000, -1000, -1000, 454, -1000, -1000,
+	-1000, -1000, 29, 201, -1000, 201, 201, 201, 201, 201,
+	201, 201, 201, 455, -1000, 308, 471, 201, 168, -1000,
+	286, 51, 454, 113, 489, 368, 95, 143, 88, 201,
+	201, 294, -18, -1000, 235, -1000, 420, -1000, 537, 99,
+	405, 99, 681, -1000, 27, 292, 590, 142, -1000, -1000,
+	368, 209, -1000, -1000, 290, 282, 276, 272, 271, 141,
+	627, 626, 638, 270, 625, 552, 637, -22, 119, -45,
+	525, 644, 368, 691, 260, 201, -1000, 691, 665, 492,
+	25, 12, 3, -2, 218, 9, 123, 51, 51, 463,
+	463, 463, 286, 265, 305, -1000, 427, -1000, 201, -5,
+	286, -1000, -23, -1000, 513, 201, 140, -1000, -28, -29,
+	105, 342, -36, 104, 368, -1000, -1000, -63, -1000, -1000,
+	-1000, 603, 162, 201, 255, -1000, 99, -1000, -1000, -1000,
+	156, -1000, -1000, -1000, -1000, 99, -1000, 209, -6, 364,
+	548, 672, -66, -1000, -1000, 623, -1000, -1000, 672, 688,
+	686, 653, -1000, 687, 686, 653, 488, 488, 517, 201,
+	636, 525, -1000, 368, 203, 218, -32, -46, 656, -47,
+	-61, 253, -64, -1000, -1000, -1000, 286, -24, -1000, 484,
+	201, 201, 291, -1000, -1000, -1000, 159, -1000, 201, -1000,
+	235, -33, -99, 368, 600, -1000, -1000, -1000, -67, 209,
+	-9, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	250, -1000, 249, 239, -1000, 237, 232, 223, 221, 635,
+	-32, -1000, -1000, -1000, 201, 368, -33, 517, 541, -1000,
+	203, 546, -1000, -1000, -70, -1000, 201, 218, 216, 218,
+	218, -77, 218, -87, -80, -1000, 215, 368, 201, -90,
+	368, -1000, -1000, -1000, 209, 230, 155, 147, 201, -1000,
+	-85, 201, -1000, -1000, -1000, 622, -1000, -1000, 551, -1000,
+	563, 97, 368, -1000, -1000, 539, -1000, 29, -32, -1000,
+	-93, -1000, -95, -1000, -1000, -1000, -1000, -1000, -1000, 201,
+	368, -1000, -88, 445, 68, -105, -101, 368, -1000, 44,
+	686, 686, 561, 543, 532, 691, -1000, -1000, 218, 368,
+	-1000, 448, -1000, 421, 319, -1000, -1000, -1000, 199, 191,
+	559, 515, 201, 190, 633, -1000, 607, -1000, -1000, -1000,
+	-1000, -1000, 525, 529, 368, 96, -1000, 201, -1000, 602,
+	517, 201, 190, 368, -1000, -1000, 92, 228, -1000, 201,
+	-1000, -1000, -1000, 228, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 592, 497, 591, 590, 589, 18, 588, 23, 8,
-	12, 587, 586, 14, 7, 16, 10, 585, 11, 584,
-	583, 3, 582, 581, 9, 17, 461, 22, 580, 579,
-	42, 578, 13, 576, 575, 574, 15, 573, 0, 572,
-	21, 571, 570, 569, 568, 567, 4, 2, 566, 565,
-	564, 563, 5, 562, 561, 1, 6, 391, 560, 559,
-	558, 557, 20, 556, 552, 19, 551, 314, 550, 549,
+	0, 743, 658, 742, 741, 740, 22, 739, 738, 27,
+	10, 15, 737, 736, 17, 9, 19, 14, 735, 11,
+	734, 733, 3, 732, 731, 13, 25, 587, 24, 730,
+	729, 28, 728, 16, 727, 726, 725, 18, 724, 0,
+	723, 12, 722, 8, 721, 720, 719, 4, 2, 718,
+	717, 716, 715, 5, 714, 713, 1, 6, 500, 712,
+	711, 710, 709, 26, 708, 707, 21, 706, 705, 482,
+	704, 703, 702, 29, 701, 23, 700, 20, 7, 554,
+	699, 698,
 }
 
 var yyR1 = [...]int8{
-	0, 1, 2, 2, 69, 69, 3, 3, 3, 4,
+	0, 1, 2, 2, 76, 76, 3, 3, 3, 3,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 67, 67, 67, 66, 66, 66, 66,
-	66, 66, 66, 65, 65, 65, 65, 57, 57, 10,
-	10, 5, 5, 5, 5, 25, 25, 64, 64, 63,
-	63, 62, 11, 11, 13, 13, 14, 9, 9, 12,
-	12, 16, 16, 15, 15, 17, 17, 17, 17, 17,
-	17, 17, 17, 17, 17, 18, 37, 37, 36, 36,
-	36, 8, 61, 61, 51, 51, 51, 58, 58, 59,
-	59, 59, 6, 6, 6, 6, 6, 6, 6, 6,
-	7, 7, 23, 23, 22, 22, 49, 49, 50, 50,
-	19, 19, 19, 19, 20, 20, 21, 21, 24, 24,
-	24, 24, 24, 24, 24, 24, 24, 26, 27, 28,
-	28, 28, 29, 29, 29, 30, 30, 31, 31, 32,
-	32, 33, 34, 34, 40, 40, 45, 45, 41, 41,
-	46, 46, 47, 47, 54, 54, 56, 56, 53, 53,
-	55, 55, 55, 52, 52, 52, 35, 35, 39, 39,
-	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-	48, 68, 68, 43, 43, 42, 42, 42, 42, 60,
-	60, 44, 44, 44, 44, 44, 44, 44, 44, 44,
-	44,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 77, 77, 78, 78, 69, 69, 69, 67,
+	67, 67, 67, 67, 67, 67, 68, 68, 68, 68,
+	68, 66, 66, 66, 66, 58, 58, 11, 11, 5,
+	5, 5, 5, 8, 8, 8, 8, 8, 8, 8,
+	8, 8, 79, 79, 79, 26, 26, 65, 65, 64,
+	64, 63, 12, 12, 14, 14, 15, 10, 10, 13,
+	13, 17, 17, 16, 16, 18, 18, 18, 18, 18,
+	18, 18, 18, 18, 18, 19, 19, 19, 38, 38,
+	37, 37, 37, 9, 74, 74, 71, 71, 72, 72,
+	73, 73, 73, 73, 73, 73, 73, 73, 80, 80,
+	81, 81, 75, 75, 62, 62, 52, 52, 52, 59,
+	59, 60, 60, 60, 6, 6, 6, 6, 6, 6,
+	6, 6, 7, 7, 24, 24, 23, 23, 50, 50,
+	51, 51, 20, 20, 20, 20, 21, 21, 22, 22,
+	25, 25, 25, 25, 25, 25, 25, 25, 25, 27,
+	27, 27, 28, 29, 29, 29, 30, 30, 30, 31,
+	31, 32, 32, 33, 33, 34, 35, 35, 41, 41,
+	46, 46, 42, 42, 47, 47, 48, 48, 55, 55,
+	57, 57, 54, 54, 56, 56, 56, 53, 53, 53,
+	36, 36, 40, 40, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 49, 70, 70, 44, 44, 43,
+	43, 43, 43, 61, 61, 45, 45, 45, 45, 45,
+	45, 45, 45, 45, 45,
 }
 
 var yyR2 = [...]int8{
-	0, 1, 2, 3, 0, 1, 1, 1, 1, 2,
-	1, 1, 1, 4, 2, 3, 3, 7, 3, 8,
-	9, 7, 5, 6, 6, 8, 6, 6, 7, 7,
-	3, 8, 8, 2, 1, 3, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 0, 3, 1,
-	3, 8, 7, 7, 8, 2, 1, 0, 4, 1,
+	0, 1, 2, 3, 0, 1, 1, 1, 1, 1,
+	2, 1, 1, 1, 4, 2, 3, 3, 7, 3,
+	5, 3, 8, 9, 7, 5, 6, 6, 8, 6,
+	6, 10, 5, 7, 7, 3, 8, 8, 8, 11,
+	8, 11, 0, 1, 0, 1, 2, 1, 3, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 2, 2,
+	2, 0, 1, 1, 1, 0, 3, 1, 3, 8,
+	7, 7, 8, 5, 3, 4, 4, 3, 4, 4,
+	2, 2, 0, 1, 1, 2, 1, 0, 4, 1,
 	3, 3, 0, 1, 1, 3, 3, 1, 3, 1,
 	3, 0, 1, 1, 3, 1, 1, 1, 1, 1,
-	6, 1, 1, 1, 1, 4, 1, 3, 1, 1,
-	3, 6, 0, 2, 0, 3, 3, 0, 1, 0,
-	1, 2, 1, 4, 2, 2, 3, 2, 2, 4,
-	13, 3, 0, 1, 0, 1, 1, 1, 2, 4,
-	1, 2, 4, 4, 2, 3, 1, 3, 3, 4,
-	4, 4, 4, 4, 4, 2, 6, 1, 2, 0,
-	2, 2, 0, 2, 2, 2, 1, 0, 1, 1,
-	2, 6, 0, 1, 0, 2, 0, 3, 0, 2,
-	0, 2, 0, 2, 0, 3, 0, 4, 2, 4,
-	0, 1, 1, 0, 1, 2, 2, 4, 0, 1,
-	1, 1, 2, 2, 4, 3, 4, 6, 6, 1,
-	5, 4, 5, 0, 2, 1, 1, 3, 3, 0,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4,
+	6, 1, 1, 1, 1, 4, 1, 3, 1, 3,
+	1, 1, 3, 7, 0, 2, 0, 1, 1, 2,
+	3, 2, 2, 2, 2, 3, 1, 2, 0, 1,
+	0, 1, 1, 2, 0, 2, 0, 3, 3, 0,
+	1, 0, 1, 2, 1, 4, 2, 2, 3, 2,
+	2, 4, 13, 3, 0, 1, 0, 1, 1, 1,
+	2, 4, 1, 2, 4, 4, 2, 3, 1, 3,
+	3, 4, 4, 4, 4, 4, 4, 2, 6, 1,
+	3, 3, 2, 0, 2, 2, 0, 2, 2, 2,
+	1, 0, 1, 1, 2, 6, 0, 1, 0, 2,
+	0, 3, 0, 2, 0, 2, 0, 2, 0, 3,
+	0, 4, 2, 4, 0, 1, 1, 0, 1, 2,
+	2, 4, 0, 1, 1, 1, 2, 2, 4, 3,
+	4, 6, 6, 1, 5, 4, 5, 0, 2, 1,
+	1, 3, 3, 0, 1, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 4,
 }
 
 var yyChk = [...]int16{
-	-1000, -1, -2, -3, -4, -5, -6, 41, 43, 44,
-	4, 6, 5, 27, 36, 37, 45, 46, 49, 50,
-	-7, 87, 56, -69, 116, 42, 7, 23, 25, 24,
-	8, 98, 7, 14, 23, 25, 8, 23, 8, -67,
-	71, -66, 56, 4, 45, 50, 49, 5, 27, -67,
-	47, 47, 58, -26, 98, 70, 88, 89, 23, 90,
-	38, -22, 57, -2, -57, 79, -57, -57, 25, 98,
-	98, -27, -28, 16, 17, 98, 26, 98, 98, 98,
-	98, 26, 40, 109, 26, -26, -26, -26, 51, -23,
-	71, 98, 39, -49, 112, -50, -38, -42, -44, 77,
-	111, 80, -48, -19, -17, 117, 72, -21, 105, 100,
-	101, 102, 103, 104, 85, -18, 91, 92, 84, 98,
-	98, 77, 98, 26, -57, 9, -29, 19, 18, -30,
-	20, -38, -30, 98, 107, 28, 29, 5, 9, 7,
-	-67, 7, 117, 117, -40, 61, -63, -62, 98, -6,
-	98, 58, 109, -52, 110, 111, 113, 112, 114, 94,
-	95, 96, 82, 98, 69, -60, 97, 86, 77, -38,
-	-38, 117, -38, -39, -38, -20, 108, 117, 117, 117,
-	107, 80, 117, 98, 26, 10, -30, -30, -38, 117,
-	98, 31, 30, 31, 31, 32, 10, 98, 98, -11,
-	-9, 98, -9, -56, 6, -38, -40, 109, 96, -24,
-	-26, 117, 88, 89, 23, 90, -18, 98, -38, -38,
-	-38, -38, -38, -38, -38, -38, -38, 84, 77, 98,
-	78, 81, -38, 99, -6, 118, -68, 73, 108, 102,
-	112, -21, 98, -38, -16, -15, -38, 98, -37, -36,
-	-8, -35, 33, 98, 35, 32, 117, 98, 102, -9,
-	-8, 98, 98, 98, 98, 102, 30, 30, 118, 109,
-	118, -46, 64, 25, -56, -62, -38, -56, -27, 48,
-	-6, 15, 117, 117, 117, 117, -52, -52, 84, -38,
-	117, 118, -43, 73, 75, -38, 102, 118, 118, 69,
-	118, 109, 118, 109, 34, 99, -38, 98, -9, 117,
-	-65, 11, 12, 13, 118, 30, -65, 8, 8, -25,
-	48, -6, 98, -25, -47, 65, -38, 26, -46, -31,
-	-32, -33, -34, 93, -52, -13, -14, 117, 118, 21,
-	118, 118, 98, 118, -6, -15, 76, -38, -38, 74,
-	99, -38, -36, -10, 98, 117, -51, 119, 117, 35,
-	118, -9, 98, 98, 98, -64, 26, -13, -38, -10,
-	-47, -40, -32, 59, 109, 118, -16, -52, 98, -52,
-	-52, 118, -52, 118, 118, 74, -38, 118, -9, -59,
-	84, 77, 100, 100, -38, 118, 52, -45, 62, -24,
-	-14, 118, 118, -38, 118, -58, 83, 84, 120, 118,
-	53, -41, 60, 63, -56, -52, -61, 33, 54, -54,
-	66, -38, -12, -21, 26, 34, -46, 63, 109, -38,
-	-47, -53, -38, -21, 109, -55, 67, 68, -38, -55,
+	-1000, -1, -2, -3, -4, -5, -6, -8, 41, 43,
+	44, 4, 6, 5, 27, 36, 37, 45, 46, 49,
+	50, -7, 87, 102, 104, 105, 106, 56, -76, 132,
+	42, 7, 23, 94, 25, 24, 91, 8, 114, 7,
+	14, 23, 94, 25, 91, 8, 23, 8, -69, 71,
+	-67, 56, 4, 45, 50, 49, 5, 27, -69, 47,
+	47, 58, -27, 114, 70, 88, 89, 23, 90, 38,
+	114, -79, 116, 71, 58, 81, -79, 116, 71, 114,
+	71, -23, 57, -2, -58, 79, -58, -58, -58, 25,
+	114, 114, 114, -28, -29, 16, 17, 114, 114, 26,
+	114, 114, 114, 114, 114, 26, 133, 40, 125, 26,
+	133, -27, -27, -27, 51, 123, -24, 71, 114, 39,
+	103, 114, -79, -79, 114, -79, -79, -50, 128, -51,
+	-39, -43, -45, 77, 127, 80, -49, -20, -18, 133,
+	72, -22, 121, 116, 117, 118, 119, 120, 85, -19,
+	107, 108, 84, 114, 93, 114, 77, 114, 114, 26,
+	-58, 26, 9, -30, 19, 18, -31, 20, -39, -31,
+	114, 123, 26, 28, 29, 5, 9, 7, -77, 23,
+	-10, 114, -69, 7, -77, -10, 133, 133, -41, 61,
+	-64, -63, 114, 114, 89, -6, 114, 39, 114, 114,
+	114, 114, 58, 125, -53, 126, 127, 129, 128, 130,
+	110, 111, 112, 82, 114, 69, -61, 113, 86, 77,
+	-39, -39, 133, -39, -40, -39, -21, 124, 133, 133,
+	133, 123, 133, 80, 133, -71, -72, -73, 95, 96,
+	100, 97, 98, 99, 114, 26, 114, 10, -31, -31,
+	-39, 133, 114, 114, 31, 30, 31, 31, 32, 10,
+	114, 114, 134, 125, 114, 114, 134, -12, -10, -10,
+	-57, 6, -39, -41, 125, 112, -6, -25, -27, 133,
+	88, 89, 23, 90, -19, 114, -39, -39, -39, -39,
+	-39, -39, -39, -39, -39, 84, 77, 114, 78, 81,
+	-39, 115, -6, 134, -70, 73, 124, 118, 128, -22,
+	114, -39, -17, -16, -39, 114, 134, -38, -37, -9,
+	-36, 33, 114, 35, 32, -73, -80, 63, -75, 116,
+	127, 96, 97, 99, -75, -81, 9, 133, 114, -68,
+	39, 118, -10, -9, 114, 114, 114, 114, 118, 30,
+	30, 26, 114, 30, 58, 26, 134, 134, -47, 64,
+	25, -57, -63, -39, -57, -28, 48, -6, 15, 133,
+	133, 133, 133, -53, -53, 84, -39, 133, 134, -44,
+	73, 75, -39, 118, 134, 134, 69, 134, 125, 134,
+	125, 34, 115, -39, 114, -75, 116, -75, -10, 133,
+	92, 71, 56, 50, 49, -66, 11, 12, 13, 134,
+	30, -66, 8, -78, 8, -77, 8, -78, -77, -26,
+	48, -6, -26, -48, 65, -39, 26, -47, -32, -33,
+	-34, -35, 109, -53, -14, -15, 133, 134, 21, 134,
+	134, 114, 134, -6, -16, 76, -39, -39, 74, 115,
+	-39, -37, -11, 114, 133, -52, 135, 133, 35, 134,
+	-10, 133, 114, 114, 114, 114, 114, 114, 114, -65,
+	26, -14, -39, -11, -48, -41, -33, 59, 125, 134,
+	-17, -53, 114, -53, -53, 134, -53, 134, 134, 74,
+	-39, 134, -10, -74, 101, 116, 116, -39, 134, -39,
+	30, 58, 52, -46, 62, -25, -15, 134, 134, -39,
+	134, -60, 84, 77, -43, 136, 134, 134, -78, -78,
+	53, -42, 60, 63, -57, -53, -59, 83, 84, 114,
+	114, 54, -55, 66, -39, -13, -22, 26, -62, 33,
+	-47, 63, 125, -39, 34, -48, -54, -39, -22, 125,
+	-56, 67, 68, -39, -56,
 }
 
 var yyDef = [...]int16{
-	0, -2, 1, 4, 6, 7, 8, 10, 11, 12,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	102, 0, 114, 2, 5, 9, 47, 47, 47, 0,
-	0, 14, 0, 139, 0, 0, 0, 0, 0, 0,
-	0, 34, 36, 37, 38, 39, 40, 41, 42, 0,
-	0, 0, 0, 0, 137, 112, 104, 105, 0, 107,
-	108, 0, 115, 3, 0, 0, 0, 0, 47, 0,
-	15, 16, 142, 0, 0, 18, 0, 0, 30, 0,
-	0, 0, 33, 0, 0, 0, 0, 154, 0, 0,
-	113, 106, 0, 111, 116, 117, 173, -2, 181, 0,
-	0, 0, 189, 195, 196, 0, 178, 120, 0, 75,
-	76, 77, 78, 79, 0, 81, 82, 83, 84, 126,
-	13, 0, 0, 0, 0, 0, 138, 0, 0, 140,
-	0, 146, 141, 0, 0, 0, 0, 0, 0, 0,
-	35, 0, 62, 0, 166, 0, 154, 59, 0, 103,
-	109, 0, 0, 118, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 174, 0, 0, 0, 0, 200, 182,
-	183, 0, 0, 0, 179, 121, 0, 0, 0, 71,
-	0, 48, 0, 0, 0, 0, 143, 144, 145, 0,
-	22, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	63, 67, 0, 160, 0, 155, 166, 0, 0, 166,
-	139, 0, 0, 0, 0, 0, 173, 137, 173, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 0, 175,
-	0, 0, 185, 198, 0, 197, 193, 0, 0, 124,
-	0, 0, 126, 0, 0, 72, 73, 127, 0, 86,
-	88, 89, 0, 0, 0, 0, 0, 0, 43, 0,
-	23, 24, 0, 26, 27, 43, 0, 0, 0, 0,
-	0, 162, 0, 0, 160, 60, 61, -2, 173, 0,
-	0, 0, 0, 0, 0, 0, 135, 119, 210, 184,
-	0, 186, 0, 0, 0, 0, 125, 122, 123, 0,
-	85, 0, 17, 0, 0, 94, 176, 0, 0, 0,
-	28, 44, 45, 46, 21, 0, 29, 0, 0, 57,
-	0, 56, 68, 52, 53, 0, 161, 0, 162, 154,
-	148, -2, 0, 153, 128, 0, 64, 71, 173, 0,
-	173, 173, 0, 173, 0, 0, 190, 0, 194, 0,
-	0, 74, 87, 90, 49, 0, 99, 0, 0, 0,
-	19, 0, 25, 31, 32, 51, 0, 55, 163, 167,
-	54, 156, 150, 0, 0, 129, 0, 130, 0, 131,
-	132, 133, 134, 187, 188, 0, 191, 80, 0, 97,
-	100, 0, 0, 0, 177, 20, 0, 158, 0, 166,
-	65, 66, 173, 192, 50, 92, 98, 101, 95, 96,
-	0, 164, 0, 0, 0, 136, 91, 0, 58, 160,
-	0, 159, 157, 69, 0, 93, 162, 0, 0, 151,
-	110, 165, 170, 70, 0, 168, 171, 172, 170, 169,
+	0, -2, 1, 4, 6, 7, 8, 9, 11, 12,
+	13, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 154, 0, 0, 82, 82, 0, 166, 2, 5,
+	10, 65, 65, 65, 65, 0, 0, 0, 15, 0,
+	193, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	47, 49, 50, 51, 52, 53, 54, 55, 0, 0,
+	0, 0, 0, 189, 164, 156, 157, 0, 159, 160,
+	0, 0, 82, 82, 83, 84, 0, 82, 82, 80,
+	81, 0, 167, 3, 0, 0, 0, 0, 0, 65,
+	0, 0, 16, 17, 196, 0, 0, 19, 21, 0,
+	0, 0, 35, 0, 0, 42, 0, 46, 0, 42,
+	0, 0, 0, 208, 0, 0, 0, 165, 158, 0,
+	0, 74, 0, 0, 77, 0, 0, 163, 168, 169,
+	227, -2, 235, 0, 0, 0, 243, 249, 250, 0,
+	232, 172, 0, 105, 106, 107, 108, 109, 0, 111,
+	112, 113, 114, 178, 116, 14, 0, 0, 126, 0,
+	0, 0, 0, 192, 0, 0, 194, 0, 200, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 43,
+	0, 97, 48, 0, 0, 0, 92, 0, 220, 0,
+	208, 89, 0, 190, 191, 155, 161, 0, 75, 76,
+	78, 79, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 228, 0, 0, 0, 0, 254,
+	236, 237, 0, 0, 0, 233, 173, 0, 0, 0,
+	101, 0, 0, 66, 0, 20, 127, 128, 138, 0,
+	0, 0, 140, 136, 0, 0, 56, 0, 197, 198,
+	199, 0, 25, 32, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 93, 0,
+	214, 0, 209, 220, 0, 0, 73, 220, 193, 0,
+	0, 0, 0, 0, 227, 189, 227, 255, 256, 257,
+	258, 259, 260, 261, 262, 263, 0, 229, 0, 0,
+	239, 252, 0, 251, 247, 0, 0, 176, 0, 0,
+	178, 0, 0, 102, 103, 179, 117, 0, 118, 120,
+	121, 0, 0, 0, 0, 129, 0, 139, 131, 142,
+	0, 132, 134, 137, 133, 0, 141, 0, 0, 0,
+	0, 61, 0, 26, 27, 0, 29, 30, 61, 0,
+	44, 42, 98, 0, 44, 42, 0, 0, 216, 0,
+	0, 214, 90, 91, -2, 227, 0, 0, 0, 0,
+	0, 0, 0, 187, 171, 264, 238, 0, 240, 0,
+	0, 0, 0, 177, 174, 175, 0, 115, 0, 18,
+	0, 0, 146, 230, 0, 130, 143, 135, 0, 0,
+	0, 57, 58, 59, 60, 33, 62, 63, 64, 24,
+	0, 34, 0, 0, 45, 0, 0, 0, 0, 87,
+	0, 86, 70, 71, 0, 215, 0, 216, 208, 202,
+	-2, 0, 207, 180, 0, 94, 101, 227, 0, 227,
+	227, 0, 227, 0, 0, 244, 0, 248, 0, 0,
+	104, 119, 122, 67, 0, 124, 0, 0, 0, 22,
+	0, 0, 28, 36, 38, 0, 37, 40, 0, 69,
+	0, 85, 217, 221, 72, 210, 204, 0, 0, 181,
+	0, 182, 0, 183, 184, 185, 186, 241, 242, 0,
+	245, 110, 0, 151, 0, 0, 0, 231, 23, 0,
+	44, 44, 0, 212, 0, 220, 95, 96, 227, 246,
+	68, 149, 152, 0, 125, 147, 148, 31, 0, 0,
+	0, 218, 0, 0, 0, 188, 144, 150, 153, 39,
+	41, 88, 214, 0, 213, 211, 99, 0, 123, 0,
+	216, 0, 0, 205, 145, 162, 219, 224, 100, 0,
+	222, 225, 226, 224, 223,
 }
 
-var yyTok1 = [...]int8{
+var yyTok1 = [...]uint8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 114, 3, 3,
-	117, 118, 112, 110, 109, 111, 115, 113, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 130, 3, 3,
+	133, 134, 128, 126, 125, 127, 131, 129, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 119, 3, 120,
+	3, 135, 3, 136,
 }
 
-var yyTok2 = [...]int8{
+var yyTok2 = [...]uint8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -606,7 +702,9 @@ var yyTok2 = [...]int8{
 	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
 	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
 	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 116,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 132,
 }
 
 var yyTok3 = [...]int8{
@@ -968,47 +1066,47 @@ yydefault:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 		}
-	case 9:
+	case 10:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &BeginTransactionStmt{}
 		}
-	case 10:
+	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = &BeginTransactionStmt{}
 		}
-	case 11:
+	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = &CommitStmt{}
 		}
-	case 12:
+	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = &RollbackStmt{}
 		}
-	case 13:
+	case 14:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: yyDollar[3].boolean, DB: yyDollar[4].id}
 		}
-	case 14:
+	case 15:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[2].id}
 		}
-	case 15:
+	case 16:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[3].id}
 		}
-	case 16:
+	case 17:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &UseSnapshotStmt{period: yyDollar[3].period}
 		}
-	case 17:
+	case 18:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			colsSpecs := make([]*ColSpec, 0, 5)
@@ -1038,432 +1136,658 @@ yydefault:
 				checks:      checks,
 			}
 		}
-	case 18:
+	case 19:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].id}
 		}
-	case 19:
+	case 20:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &CreateSequenceStmt{ifNotExists: yyDollar[3].boolean, name: yyDollar[4].id, opts: yyDollar[5].sequenceOpts}
+		}
+	case 21:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &DropSequenceStmt{name: yyDollar[3].id}
+		}
+	case 22:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].id, cols: yyDollar[7].ids}
 		}
-	case 20:
+	case 23:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		{
 			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].id, cols: yyDollar[8].ids}
 		}
-	case 21:
+	case 24:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &DropIndexStmt{table: yyDollar[4].id, cols: yyDollar[6].ids}
 		}
-	case 22:
+	case 25:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.stmt = &DropIndexStmt{table: yyDollar[3].id, cols: []string{yyDollar[5].id}}
 		}
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].id, colSpec: yyDollar[6].colSpec}
 		}
-	case 24:
+	case 27:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &RenameTableStmt{oldName: yyDollar[3].id, newName: yyDollar[6].id}
 		}
-	case 25:
+	case 28:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].id, oldName: yyDollar[6].id, newName: yyDollar[8].id}
 		}
-	case 26:
+	case 29:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &DropColumnStmt{table: yyDollar[3].id, colName: yyDollar[6].id}
 		}
-	case 27:
+	case 30:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &DropConstraintStmt{table: yyDollar[3].id, constraintName: yyDollar[6].id}
 		}
-	case 28:
+	case 31:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		{
+			yyVAL.stmt = &CreatePolicyStmt{name: yyDollar[3].id, table: yyDollar[5].id, cmd: yyDollar[6].sqlPrivilege, exp: yyDollar[9].exp}
+		}
+	case 32:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &DropPolicyStmt{name: yyDollar[3].id, table: yyDollar[5].id}
+		}
+	case 33:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &CreateUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
 		}
-	case 29:
+	case 34:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &AlterUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
 		}
-	case 30:
+	case 35:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &DropUserStmt{username: yyDollar[3].id}
 		}
-	case 31:
+	case 36:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
 		}
-	case 32:
+	case 37:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
 		}
-	case 33:
+	case 38:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterPrivilegesStmt{table: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+		}
+	case 39:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.stmt = &AlterPrivilegesStmt{table: yyDollar[8].id, columns: yyDollar[4].ids, user: yyDollar[11].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+		}
+	case 40:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &AlterPrivilegesStmt{table: yyDollar[5].id, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
+		}
+	case 41:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.stmt = &AlterPrivilegesStmt{table: yyDollar[8].id, columns: yyDollar[4].ids, user: yyDollar[11].id, privileges: yyDollar[2].sqlPrivileges}
+		}
+	case 42:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 44:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.sqlPrivileges = allPrivileges
 		}
-	case 34:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivileges = []SQLPrivilege{yyDollar[1].sqlPrivilege}
 		}
-	case 35:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.sqlPrivileges = append(yyDollar[3].sqlPrivileges, yyDollar[1].sqlPrivilege)
 		}
-	case 36:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeSelect
 		}
-	case 37:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeCreate
 		}
-	case 38:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeInsert
 		}
-	case 39:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeUpdate
 		}
-	case 40:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeDelete
 		}
-	case 41:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeDrop
 		}
-	case 42:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeAlter
 		}
-	case 43:
+	case 56:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = ""
+		}
+	case 57:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = ""
+		}
+	case 58:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeSelect
+		}
+	case 59:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeUpdate
+		}
+	case 60:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeDelete
+		}
+	case 61:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.permission = PermissionReadWrite
 		}
-	case 44:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.permission = PermissionReadOnly
 		}
-	case 45:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.permission = PermissionReadWrite
 		}
-	case 46:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.permission = PermissionAdmin
 		}
-	case 47:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 48:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 49:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = []string{yyDollar[1].id}
 		}
-	case 50:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[2].ids
 		}
-	case 51:
+	case 69:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, ds: yyDollar[7].ds, onConflict: yyDollar[8].onConflict}
 		}
-	case 52:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, ds: yyDollar[7].ds}
 		}
-	case 53:
+	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].ids, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
 		}
-	case 54:
+	case 72:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].ids, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
 		}
-	case 55:
+	case 73:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &DeclareCursorStmt{cursor: yyDollar[2].id, query: yyDollar[5].stmt.(DataSource)}
+		}
+	case 74:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &FetchStmt{cursor: yyDollar[3].id, count: 1}
+		}
+	case 75:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmt = &FetchStmt{cursor: yyDollar[4].id, count: yyDollar[2].integer}
+		}
+	case 76:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmt = &FetchStmt{cursor: yyDollar[4].id, all: true}
+		}
+	case 77:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &MoveStmt{cursor: yyDollar[3].id, count: 1}
+		}
+	case 78:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmt = &MoveStmt{cursor: yyDollar[4].id, count: yyDollar[2].integer}
+		}
+	case 79:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmt = &MoveStmt{cursor: yyDollar[4].id, all: true}
+		}
+	case 80:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.stmt = &CloseCursorStmt{cursor: yyDollar[2].id}
+		}
+	case 81:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.stmt = &CloseCursorStmt{all: true}
+		}
+	case 82:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &valuesDataSource{rows: yyDollar[2].rows}
 		}
-	case 56:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ds = yyDollar[1].stmt.(DataSource)
 		}
-	case 57:
+	case 87:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.onConflict = nil
 		}
-	case 58:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.onConflict = &OnConflictDo{}
 		}
-	case 59:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.updates = []*colUpdate{yyDollar[1].update}
 		}
-	case 60:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.updates = append(yyDollar[1].updates, yyDollar[3].update)
 		}
-	case 61:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.update = &colUpdate{col: yyDollar[1].id, op: yyDollar[2].cmpOp, val: yyDollar[3].exp}
 		}
-	case 62:
+	case 92:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 63:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[1].ids
 		}
-	case 64:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.rows = []*RowSpec{yyDollar[1].row}
 		}
-	case 65:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.rows = append(yyDollar[1].rows, yyDollar[3].row)
 		}
-	case 66:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.row = &RowSpec{Values: yyDollar[2].values}
 		}
-	case 67:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = []string{yyDollar[1].id}
 		}
-	case 68:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ids = append(yyDollar[1].ids, yyDollar[3].id)
 		}
-	case 69:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.cols = []*ColSelector{yyDollar[1].col}
 		}
-	case 70:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = append(yyDollar[1].cols, yyDollar[3].col)
 		}
-	case 71:
+	case 101:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.values = nil
 		}
-	case 72:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = yyDollar[1].values
 		}
-	case 73:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = []ValueExp{yyDollar[1].exp}
 		}
-	case 74:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].exp)
 		}
-	case 75:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Integer{val: int64(yyDollar[1].integer)}
 		}
-	case 76:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Float64{val: float64(yyDollar[1].float)}
 		}
-	case 77:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Varchar{val: yyDollar[1].str}
 		}
-	case 78:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Bool{val: yyDollar[1].boolean}
 		}
-	case 79:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Blob{val: yyDollar[1].blob}
 		}
-	case 80:
+	case 110:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.value = &Cast{val: yyDollar[3].exp, t: yyDollar[5].sqlType}
 		}
-	case 81:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = yyDollar[1].value
 		}
-	case 82:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: yyDollar[1].id}
 		}
-	case 83:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
 		}
-	case 84:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &NullValue{t: AnyType}
 		}
-	case 85:
+	case 115:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
 		}
-	case 86:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.tableElems = []TableElem{yyDollar[1].tableElem}
+			yyVAL.value = &FnCall{fn: CurrentUserFnCall}
 		}
-	case 87:
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.value = &FnCall{fn: CurrentUserFnCall}
+		}
+	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.tableElems = []TableElem{yyDollar[1].tableElem}
+		}
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.tableElems = append(yyDollar[1].tableElems, yyDollar[3].tableElem)
 		}
-	case 88:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableElem = yyDollar[1].colSpec
 		}
-	case 89:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableElem = yyDollar[1].check
 		}
-	case 90:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.tableElem = PrimaryKeyConstraint(yyDollar[3].ids)
 		}
-	case 91:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 123:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			yyVAL.colSpec = &ColSpec{colName: yyDollar[1].id, colType: yyDollar[2].sqlType, maxLen: int(yyDollar[3].integer), notNull: yyDollar[4].boolean || yyDollar[6].boolean, autoIncrement: yyDollar[5].boolean, primaryKey: yyDollar[6].boolean}
+			yyVAL.colSpec = &ColSpec{colName: yyDollar[1].id, colType: yyDollar[2].sqlType, maxLen: int(yyDollar[3].integer), defaultValue: yyDollar[4].exp, notNull: yyDollar[5].boolean || yyDollar[7].boolean, autoIncrement: yyDollar[6].boolean, primaryKey: yyDollar[7].boolean}
 		}
-	case 92:
+	case 124:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.exp = nil
+		}
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.exp = yyDollar[2].exp
+		}
+	case 126:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.sequenceOpts = &sequenceOptions{}
+		}
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sequenceOpts = yyDollar[1].sequenceOpts
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sequenceOpts = yyDollar[1].sequenceOpts
+		}
+	case 129:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sequenceOpts = yyDollar[1].sequenceOpts.merge(yyDollar[2].sequenceOpts)
+		}
+	case 130:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			v := int64(yyDollar[3].integer)
+			yyVAL.sequenceOpts = &sequenceOptions{increment: &v}
+		}
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			v := int64(yyDollar[2].integer)
+			yyVAL.sequenceOpts = &sequenceOptions{minValue: &v}
+		}
+	case 132:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sequenceOpts = &sequenceOptions{}
+		}
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			v := int64(yyDollar[2].integer)
+			yyVAL.sequenceOpts = &sequenceOptions{maxValue: &v}
+		}
+	case 134:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sequenceOpts = &sequenceOptions{}
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			v := int64(yyDollar[3].integer)
+			yyVAL.sequenceOpts = &sequenceOptions{start: &v}
+		}
+	case 136:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			v := true
+			yyVAL.sequenceOpts = &sequenceOptions{cycle: &v}
+		}
+	case 137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			v := false
+			yyVAL.sequenceOpts = &sequenceOptions{cycle: &v}
+		}
+	case 138:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 140:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.integer = yyDollar[1].integer
+		}
+	case 143:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.integer = uint64(-int64(yyDollar[2].integer))
+		}
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 93:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 94:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.integer = 0
 		}
-	case 95:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.integer = yyDollar[2].integer
 		}
-	case 96:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.integer = yyDollar[2].integer
 		}
-	case 97:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 98:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 99:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 100:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 101:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 102:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 103:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &UnionStmt{
@@ -1472,49 +1796,49 @@ yydefault:
 				right:    yyDollar[4].stmt.(DataSource),
 			}
 		}
-	case 104:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "databases"}},
 			}
 		}
-	case 105:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "tables"}},
 			}
 		}
-	case 106:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: yyDollar[3].id}}}},
 			}
 		}
-	case 107:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "users"}},
 			}
 		}
-	case 108:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "grants"}},
 			}
 		}
-	case 109:
+	case 161:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "grants", params: []ValueExp{&Varchar{val: yyDollar[4].id}}}},
 			}
 		}
-	case 110:
+	case 162:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -1531,7 +1855,7 @@ yydefault:
 				offset:   yyDollar[13].exp,
 			}
 		}
-	case 111:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -1540,365 +1864,375 @@ yydefault:
 				ds:       &valuesDataSource{rows: []*RowSpec{{}}},
 			}
 		}
-	case 112:
+	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 113:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 114:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 115:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 116:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.targets = nil
 		}
-	case 117:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.targets = yyDollar[1].targets
 		}
-	case 118:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.targets = []TargetEntry{{Exp: yyDollar[1].exp, As: yyDollar[2].id}}
 		}
-	case 119:
+	case 171:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.targets = append(yyDollar[1].targets, TargetEntry{Exp: yyDollar[3].exp, As: yyDollar[4].id})
 		}
-	case 120:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sel = yyDollar[1].col
 		}
-	case 121:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: yyDollar[2].jsonFields}
 		}
-	case 122:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, col: "*"}
 		}
-	case 123:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col}
 		}
-	case 124:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.jsonFields = []string{yyDollar[2].str}
 		}
-	case 125:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.jsonFields = append(yyVAL.jsonFields, yyDollar[3].str)
 		}
-	case 126:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{col: yyDollar[1].id}
 		}
-	case 127:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{table: yyDollar[1].id, col: yyDollar[3].id}
 		}
-	case 128:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyDollar[1].tableRef.period = yyDollar[2].period
 			yyDollar[1].tableRef.as = yyDollar[3].id
 			yyVAL.ds = yyDollar[1].tableRef
 		}
-	case 129:
+	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &valuesDataSource{inferTypes: true, rows: yyDollar[3].rows}
 		}
-	case 130:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyDollar[2].stmt.(*SelectStmt).as = yyDollar[4].id
 			yyVAL.ds = yyDollar[2].stmt.(DataSource)
 		}
-	case 131:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "databases"}, as: yyDollar[4].id}
 		}
-	case 132:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "tables"}, as: yyDollar[4].id}
 		}
-	case 133:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: yyDollar[3].id}}}}
 		}
-	case 134:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "users"}, as: yyDollar[4].id}
 		}
-	case 135:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: yyDollar[1].value.(*FnCall), as: yyDollar[2].id}
 		}
-	case 136:
+	case 188:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.ds = &tableRef{table: yyDollar[4].id, history: true, as: yyDollar[6].id}
 		}
-	case 137:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableRef = &tableRef{table: yyDollar[1].id}
 		}
-	case 138:
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.tableRef = &tableRef{schema: yyDollar[1].id, table: yyDollar[3].id}
+		}
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.tableRef = &tableRef{schema: yyDollar[1].id, table: "tables"}
+		}
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.period = period{start: yyDollar[1].openPeriod, end: yyDollar[2].openPeriod}
 		}
-	case 139:
+	case 193:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 140:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 141:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 142:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 143:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 144:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 145:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: txInstant, exp: yyDollar[2].exp}
 		}
-	case 146:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: timeInstant, exp: yyDollar[1].exp}
 		}
-	case 147:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joins = nil
 		}
-	case 148:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = yyDollar[1].joins
 		}
-	case 149:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = []*JoinSpec{yyDollar[1].join}
 		}
-	case 150:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.joins = append([]*JoinSpec{yyDollar[1].join}, yyDollar[2].joins...)
 		}
-	case 151:
+	case 205:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[3].ds, indexOn: yyDollar[4].ids, cond: yyDollar[6].exp}
 		}
-	case 152:
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joinType = InnerJoin
 		}
-	case 153:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joinType = yyDollar[1].joinType
 		}
-	case 154:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 155:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 156:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.cols = nil
 		}
-	case 157:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = yyDollar[3].cols
 		}
-	case 158:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 159:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 160:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 161:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 162:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 163:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 164:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ordexps = nil
 		}
-	case 165:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ordexps = yyDollar[3].ordexps
 		}
-	case 166:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 167:
+	case 221:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[4].ids
 		}
-	case 168:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ordexps = []*OrdExp{{exp: yyDollar[1].exp, descOrder: yyDollar[2].opt_ord}}
 		}
-	case 169:
+	case 223:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ordexps = append(yyDollar[1].ordexps, &OrdExp{exp: yyDollar[3].exp, descOrder: yyDollar[4].opt_ord})
 		}
-	case 170:
+	case 224:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 171:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 172:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = true
 		}
-	case 173:
+	case 227:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.id = ""
 		}
-	case 174:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.id = yyDollar[1].id
 		}
-	case 175:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.id = yyDollar[2].id
 		}
-	case 176:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.check = CheckConstraint{exp: yyDollar[2].exp}
 		}
-	case 177:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.check = CheckConstraint{name: yyDollar[2].id, exp: yyDollar[4].exp}
 		}
-	case 178:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 179:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 180:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 181:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].binExp
 		}
-	case 182:
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NotBoolExp{exp: yyDollar[2].exp}
 		}
-	case 183:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			i, isInt := yyDollar[2].exp.(*Integer)
@@ -1909,37 +2243,37 @@ yydefault:
 				yyVAL.exp = &NumExp{left: &Integer{val: 0}, op: SUBSOP, right: yyDollar[2].exp}
 			}
 		}
-	case 184:
+	case 238:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: yyDollar[2].boolean, pattern: yyDollar[4].exp}
 		}
-	case 185:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: true, pattern: yyDollar[3].exp}
 		}
-	case 186:
+	case 240:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &ExistsBoolExp{q: (yyDollar[3].stmt).(DataSource)}
 		}
-	case 187:
+	case 241:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, q: yyDollar[5].stmt.(*SelectStmt)}
 		}
-	case 188:
+	case 242:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InListExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, values: yyDollar[5].values}
 		}
-	case 189:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 190:
+	case 244:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.exp = &CaseWhenExp{
@@ -1948,102 +2282,102 @@ yydefault:
 				elseExp:  yyDollar[4].exp,
 			}
 		}
-	case 191:
+	case 245:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.whenThenClauses = []whenThenClause{{when: yyDollar[2].exp, then: yyDollar[4].exp}}
 		}
-	case 192:
+	case 246:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.whenThenClauses = append(yyDollar[1].whenThenClauses, whenThenClause{when: yyDollar[3].exp, then: yyDollar[5].exp})
 		}
-	case 193:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 194:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 195:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].sel
 		}
-	case 196:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].value
 		}
-	case 197:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 198:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &Cast{val: yyDollar[1].exp, t: yyDollar[3].sqlType}
 		}
-	case 199:
+	case 253:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 200:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 201:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: ADDOP, right: yyDollar[3].exp}
 		}
-	case 202:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: SUBSOP, right: yyDollar[3].exp}
 		}
-	case 203:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: DIVOP, right: yyDollar[3].exp}
 		}
-	case 204:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: MULTOP, right: yyDollar[3].exp}
 		}
-	case 205:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: MODOP, right: yyDollar[3].exp}
 		}
-	case 206:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &BinBoolExp{left: yyDollar[1].exp, op: And, right: yyDollar[3].exp}
 		}
-	case 207:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &BinBoolExp{left: yyDollar[1].exp, op: Or, right: yyDollar[3].exp}
 		}
-	case 208:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, right: yyDollar[3].exp}
 		}
-	case 209:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: EQ, right: &NullValue{t: AnyType}}
 		}
-	case 210:
+	case 264:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: NE, right: &NullValue{t: AnyType}}
@@ -19,6 +19,7 @@ package sql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"time"
 
@@ -45,6 +46,8 @@ type SQLTx struct {
 
 	txHeader *store.TxHeader // header is set once tx is committed
 
+	cursors map[string]RowReader // cursors declared within the tx, closed when the tx ends
+
 	onCommittedCallbacks []onCommittedCallback
 }
 
@@ -119,13 +122,20 @@ func (sqlTx *SQLTx) getWithPrefix(ctx context.Context, prefix, neq []byte) (key
 func (sqlTx *SQLTx) Cancel() error {
 	defer sqlTx.removeTempFiles()
 
+	sqlTx.closeCursors()
+
 	return sqlTx.tx.Cancel()
 }
 
 func (sqlTx *SQLTx) Commit(ctx context.Context) error {
 	defer sqlTx.removeTempFiles()
 
-	err := sqlTx.tx.RequireMVCCOnFollowingTxs(sqlTx.mutatedCatalog)
+	err := sqlTx.closeCursors()
+	if err != nil {
+		return err
+	}
+
+	err = sqlTx.tx.RequireMVCCOnFollowingTxs(sqlTx.mutatedCatalog)
 	if err != nil {
 		return err
 	}
@@ -164,6 +174,43 @@ func (sqlTx *SQLTx) addOnCommittedCallback(callback onCommittedCallback) error {
 	return nil
 }
 
+func (sqlTx *SQLTx) addCursor(name string, rowReader RowReader) {
+	if sqlTx.cursors == nil {
+		sqlTx.cursors = make(map[string]RowReader)
+	}
+	sqlTx.cursors[name] = rowReader
+}
+
+func (sqlTx *SQLTx) cursor(name string) (RowReader, error) {
+	rowReader, exists := sqlTx.cursors[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrCursorDoesNotExist, name)
+	}
+	return rowReader, nil
+}
+
+func (sqlTx *SQLTx) closeCursor(name string) error {
+	rowReader, err := sqlTx.cursor(name)
+	if err != nil {
+		return err
+	}
+
+	delete(sqlTx.cursors, name)
+
+	return rowReader.Close()
+}
+
+func (sqlTx *SQLTx) closeCursors() error {
+	merr := multierr.NewMultiErr()
+
+	for name, rowReader := range sqlTx.cursors {
+		merr.Append(rowReader.Close())
+		delete(sqlTx.cursors, name)
+	}
+
+	return merr.Reduce()
+}
+
 func (sqlTx *SQLTx) createTempFile() (*os.File, error) {
 	tempFile, err := os.CreateTemp("", "immudb")
 	if err == nil {
@@ -193,3 +240,19 @@ func (sqlTx *SQLTx) ListUsers(ctx context.Context) ([]User, error) {
 	}
 	return sqlTx.engine.multidbHandler.ListUsers(ctx)
 }
+
+func (sqlTx *SQLTx) ListDatabases(ctx context.Context) ([]string, error) {
+	if sqlTx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+	return sqlTx.engine.multidbHandler.ListDatabases(ctx)
+}
+
+// LoggedUser returns the user on behalf of which statements are executed,
+// nil is returned when users are not managed by the engine.
+func (sqlTx *SQLTx) LoggedUser(ctx context.Context) (User, error) {
+	if sqlTx.engine.loggedUser == nil {
+		return nil, nil
+	}
+	return sqlTx.engine.loggedUser(ctx)
+}
@@ -40,6 +40,10 @@ const (
 	catalogIndexPrefix     = "CTL.INDEX."     // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
 	catalogCheckPrefix     = "CTL.CHECK."     // (key=CTL.CHECK.{1}{tableID}{checkID}, value={nameLen}{name}{expText})
 	catalogPrivilegePrefix = "CTL.PRIVILEGE." // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
+	catalogPolicyPrefix    = "CTL.POLICY."    // (key=CTL.POLICY.{1}{tableID}{policyID}, value={cmdLen}{cmd}{nameLen}{name}{expText})
+	catalogSequencePrefix  = "CTL.SEQUENCE."  // (key=CTL.SEQUENCE.{1}{seqID}, value={increment}{minValue}{maxValue}{start}{cycle}{seqNAME})
+
+	catalogSequenceValuePrefix = "CTL.SEQVAL." // (key=CTL.SEQVAL.{1}{seqID}, value={called}{lastValue})
 
 	RowPrefix    = "R." // (key=R.{1}{tableID}{0}({null}({pkVal}{padding}{pkValLen})?)+, value={count (colID valLen val)+})
 	MappedPrefix = "M." // (key=M.{tableID}{indexID}({null}({val}{padding}{valLen})?)*({pkVal}{padding}{pkValLen})+, value={count (colID valLen val)+})
@@ -53,6 +57,7 @@ const (
 const (
 	nullableFlag      byte = 1 << iota
 	autoIncrementFlag byte = 1 << iota
+	defaultValueFlag  byte = 1 << iota
 )
 
 const (
@@ -614,20 +619,36 @@ func (stmt *CreateTableStmt) primaryKeyCols() []string {
 }
 
 func persistColumn(tx *SQLTx, col *Column) error {
-	//{auto_incremental | nullable}{maxLen}{colNAME})
-	v := make([]byte, 1+4+len(col.colName))
+	var flags byte
 
 	if col.autoIncrement {
-		v[0] = v[0] | autoIncrementFlag
+		flags |= autoIncrementFlag
 	}
 
 	if col.notNull {
-		v[0] = v[0] | nullableFlag
+		flags |= nullableFlag
 	}
 
-	binary.BigEndian.PutUint32(v[1:], uint32(col.MaxLen()))
+	var v []byte
+
+	if col.defaultValue == nil {
+		//{auto_incremental | nullable}{maxLen}{colNAME})
+		v = make([]byte, 1+4+len(col.colName))
+		copy(v[5:], []byte(col.Name()))
+	} else {
+		//{auto_incremental | nullable | default}{maxLen}{nameLen}{colNAME}{defaultExp})
+		defaultExp := col.defaultValue.String()
+
+		flags |= defaultValueFlag
 
-	copy(v[5:], []byte(col.Name()))
+		v = make([]byte, 1+4+4+len(col.colName)+len(defaultExp))
+		binary.BigEndian.PutUint32(v[5:], uint32(len(col.colName)))
+		copy(v[9:], []byte(col.Name()))
+		copy(v[9+len(col.colName):], []byte(defaultExp))
+	}
+
+	v[0] = flags
+	binary.BigEndian.PutUint32(v[1:], uint32(col.MaxLen()))
 
 	mappedKey := MapKey(
 		tx.sqlPrefix(),
@@ -674,6 +695,7 @@ type ColSpec struct {
 	autoIncrement bool
 	notNull       bool
 	primaryKey    bool
+	defaultValue  ValueExp
 }
 
 func NewColSpec(name string, colType SQLValueType, maxLen int, autoIncrement bool, notNull bool) *ColSpec {
@@ -994,6 +1016,20 @@ func canDropColumn(tx *SQLTx, table *Table, col *Column) error {
 			return err
 		}
 	}
+
+	cols := make([]*Column, 0, len(table.cols)-1)
+	for _, c := range table.cols {
+		if c.id != col.id {
+			cols = append(cols, c)
+		}
+	}
+
+	for name, p := range table.policies {
+		err := validatePolicyExp(p.exp, table.name, cols)
+		if errors.Is(err, ErrColumnDoesNotExist) {
+			return fmt.Errorf("%w %s because %s policy requires it", ErrCannotDropColumn, col.Name(), name)
+		}
+	}
 	return nil
 }
 
@@ -1056,6 +1092,328 @@ func (stmt *DropConstraintStmt) inferParameters(ctx context.Context, tx *SQLTx,
 	return nil
 }
 
+type CreatePolicyStmt struct {
+	name  string
+	table string
+	cmd   SQLPrivilege
+	exp   ValueExp
+}
+
+// NewCreatePolicyStmt returns a statement creating a row-level security policy, rows of the table
+// can only be accessed through the specified command (SELECT, UPDATE, DELETE or empty for all of them)
+// when they satisfy the given condition.
+func NewCreatePolicyStmt(name, table string, cmd SQLPrivilege, exp ValueExp) *CreatePolicyStmt {
+	return &CreatePolicyStmt{name: name, table: table, cmd: cmd, exp: exp}
+}
+
+func (stmt *CreatePolicyStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreatePolicyStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeAlter}
+}
+
+func (stmt *CreatePolicyStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreatePolicyStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	switch stmt.cmd {
+	case "", SQLPrivilegeSelect, SQLPrivilegeUpdate, SQLPrivilegeDelete:
+	default:
+		return nil, fmt.Errorf("%w: policies can not be defined for %s commands", ErrInvalidPolicy, stmt.cmd)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validatePolicyExp(stmt.exp, table.name, table.cols)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPolicy, err)
+	}
+
+	p, err := table.newPolicy(stmt.name, stmt.cmd, stmt.exp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistPolicy(tx, table, p)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// validatePolicyExp checks the expression is a boolean condition over the given columns
+func validatePolicyExp(exp ValueExp, table string, cols []*Column) error {
+	colsBySel := make(map[string]ColDescriptor, len(cols))
+	for _, col := range cols {
+		colsBySel[EncodeSelector("", table, col.colName)] = ColDescriptor{
+			Table:  table,
+			Column: col.colName,
+			Type:   col.colType,
+		}
+	}
+
+	params := make(map[string]SQLValueType)
+
+	err := exp.requiresType(BooleanType, colsBySel, params, table)
+	if err != nil {
+		return err
+	}
+
+	if len(params) > 0 {
+		return fmt.Errorf("%w: parameters are not allowed", ErrIllegalArguments)
+	}
+	return nil
+}
+
+func persistPolicy(tx *SQLTx, table *Table, p *Policy) error {
+	if len(p.name) == 0 || len(p.name) > 256 {
+		return fmt.Errorf("policy name len: %w", ErrMaxLengthExceeded)
+	}
+
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogPolicyPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		EncodeID(p.id),
+	)
+
+	expText := p.exp.String()
+
+	val := make([]byte, 2+len(p.cmd)+len(p.name)+len(expText))
+
+	val[0] = byte(len(p.cmd))
+	copy(val[1:], []byte(p.cmd))
+
+	val[1+len(p.cmd)] = byte(len(p.name) - 1)
+	copy(val[2+len(p.cmd):], []byte(p.name))
+	copy(val[2+len(p.cmd)+len(p.name):], []byte(expText))
+
+	return tx.set(mappedKey, nil, val)
+}
+
+type DropPolicyStmt struct {
+	name  string
+	table string
+}
+
+func NewDropPolicyStmt(name, table string) *DropPolicyStmt {
+	return &DropPolicyStmt{name: name, table: table}
+}
+
+func (stmt *DropPolicyStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropPolicyStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeAlter}
+}
+
+func (stmt *DropPolicyStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropPolicyStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := table.deletePolicy(stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistPolicyDeletion(ctx, tx, table.id, p.id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func persistPolicyDeletion(ctx context.Context, tx *SQLTx, tableID uint32, policyID uint32) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogPolicyPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(tableID),
+		EncodeID(policyID),
+	)
+	return tx.delete(ctx, mappedKey)
+}
+
+// satisfiesPolicy checks the row can be written under the given row-level security condition
+func satisfiesPolicy(tx *SQLTx, cond ValueExp, row *Row, table string) error {
+	r, err := cond.reduce(tx, row, table)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPolicyViolation, err)
+	}
+
+	satisfies, ok := r.(*Bool)
+	if !ok || !satisfies.val {
+		return ErrPolicyViolation
+	}
+	return nil
+}
+
+// sequenceOptions holds the options specified when creating a sequence, unspecified ones are nil
+type sequenceOptions struct {
+	increment *int64
+	minValue  *int64
+	maxValue  *int64
+	start     *int64
+	cycle     *bool
+}
+
+func (opts *sequenceOptions) merge(other *sequenceOptions) *sequenceOptions {
+	if other.increment != nil {
+		opts.increment = other.increment
+	}
+	if other.minValue != nil {
+		opts.minValue = other.minValue
+	}
+	if other.maxValue != nil {
+		opts.maxValue = other.maxValue
+	}
+	if other.start != nil {
+		opts.start = other.start
+	}
+	if other.cycle != nil {
+		opts.cycle = other.cycle
+	}
+	return opts
+}
+
+type CreateSequenceStmt struct {
+	name        string
+	ifNotExists bool
+	opts        *sequenceOptions
+}
+
+func NewCreateSequenceStmt(name string, ifNotExists bool) *CreateSequenceStmt {
+	return &CreateSequenceStmt{name: name, ifNotExists: ifNotExists, opts: &sequenceOptions{}}
+}
+
+func (stmt *CreateSequenceStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateSequenceStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreateSequenceStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateSequenceStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.ifNotExists && tx.catalog.ExistSequence(stmt.name) {
+		return tx, nil
+	}
+
+	seq, err := tx.catalog.newSequence(stmt.name, stmt.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistSequence(tx, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func persistSequence(tx *SQLTx, seq *Sequence) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogSequencePrefix,
+		EncodeID(DatabaseID),
+		EncodeID(seq.id),
+	)
+
+	// v={increment}{minValue}{maxValue}{start}{cycle}{name}
+	v := make([]byte, 33+len(seq.name))
+
+	binary.BigEndian.PutUint64(v, uint64(seq.increment))
+	binary.BigEndian.PutUint64(v[8:], uint64(seq.minValue))
+	binary.BigEndian.PutUint64(v[16:], uint64(seq.maxValue))
+	binary.BigEndian.PutUint64(v[24:], uint64(seq.start))
+
+	if seq.cycle {
+		v[32] = 1
+	}
+
+	copy(v[33:], []byte(seq.name))
+
+	return tx.set(mappedKey, nil, v)
+}
+
+type DropSequenceStmt struct {
+	name string
+}
+
+func NewDropSequenceStmt(name string) *DropSequenceStmt {
+	return &DropSequenceStmt{name: name}
+}
+
+func (stmt *DropSequenceStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropSequenceStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropSequenceStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropSequenceStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	seq, err := tx.catalog.GetSequenceByName(stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogSequencePrefix,
+		EncodeID(DatabaseID),
+		EncodeID(seq.id),
+	)
+
+	err = tx.delete(ctx, mappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sequence may have never been used
+	err = tx.delete(ctx, seq.valueKey(tx))
+	if err != nil && !errors.Is(err, store.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	tx.catalog.deleteSequence(seq)
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
 type UpsertIntoStmt struct {
 	isInsert   bool
 	tableRef   *tableRef
@@ -1168,6 +1526,23 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		return nil, err
 	}
 
+	for _, privilege := range stmt.privileges() {
+		grants, err := grantedColumns(ctx, tx, table, privilege)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := grants.check(privilege, stmt.cols...); err != nil {
+			return nil, err
+		}
+	}
+
+	// overwriting an existing row is subject to the policies restricting updates
+	policyCond, err := table.policyCondition(ctx, tx, SQLPrivilegeUpdate)
+	if err != nil {
+		return nil, err
+	}
+
 	r := &Row{
 		ValuesByPosition: make([]TypedValue, len(table.cols)),
 		ValuesBySelector: make(map[string]TypedValue),
@@ -1198,8 +1573,7 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 		for colID, col := range table.colsByID {
 			colPos, specified := selPosByColID[colID]
-			if !specified {
-				// TODO: Default values
+			if !specified && col.defaultValue == nil {
 				if col.notNull && !col.autoIncrement {
 					return nil, fmt.Errorf("%w (%s)", ErrNotNullableColumnCannotBeNull, col.colName)
 				}
@@ -1221,8 +1595,11 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 				continue
 			}
 
-			// value was specified
-			cVal := row.ValuesByPosition[colPos]
+			// value was specified or the column has a default value
+			cVal := col.defaultValue
+			if specified {
+				cVal = row.ValuesByPosition[colPos]
+			}
 
 			val, err := cVal.substitute(params)
 			if err != nil {
@@ -1313,6 +1690,21 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			}
 		}
 
+		if err == nil && policyCond != nil {
+			currRow, err := tx.fetchPKRow(ctx, table, valuesByColID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := satisfiesPolicy(tx, policyCond, currRow, table.name); err != nil {
+				return nil, err
+			}
+
+			if err := satisfiesPolicy(tx, policyCond, r, table.name); err != nil {
+				return nil, err
+			}
+		}
+
 		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !stmt.isInsert)
 		if err != nil {
 			return nil, err
@@ -1642,7 +2034,7 @@ func (stmt *UpdateStmt) requiredPrivileges() []SQLPrivilege {
 
 func (stmt *UpdateStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	selectStmt := &SelectStmt{
-		ds:    stmt.tableRef,
+		ds:    stmt.tableRef.asWriteTarget(),
 		where: stmt.where,
 	}
 
@@ -1701,7 +2093,7 @@ func (stmt *UpdateStmt) validate(table *Table) error {
 
 func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
+		ds:      stmt.tableRef.asWriteTarget(),
 		where:   stmt.where,
 		indexOn: stmt.indexOn,
 		limit:   stmt.limit,
@@ -1721,6 +2113,27 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 		return nil, err
 	}
 
+	grants, err := grantedColumns(ctx, tx, table, SQLPrivilegeUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, update := range stmt.updates {
+		if err := grants.check(SQLPrivilegeUpdate, update.col); err != nil {
+			return nil, err
+		}
+	}
+
+	// rows not satisfying the policy are skipped while updated ones must still satisfy it
+	policyCond, err := table.policyCondition(ctx, tx, SQLPrivilegeUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	if policyCond != nil {
+		rowReader = newConditionalRowReader(rowReader, policyCond)
+	}
+
 	cols, err := rowReader.colsBySelector(ctx)
 	if err != nil {
 		return nil, err
@@ -1776,6 +2189,12 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 			return nil, err
 		}
 
+		if policyCond != nil {
+			if err := satisfiesPolicy(tx, policyCond, row, table.name); err != nil {
+				return nil, err
+			}
+		}
+
 		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
 		if err != nil {
 			return nil, err
@@ -1827,7 +2246,7 @@ func (stmt *DeleteFromStmt) requiredPrivileges() []SQLPrivilege {
 
 func (stmt *DeleteFromStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
+		ds:      stmt.tableRef.asWriteTarget(),
 		where:   stmt.where,
 		orderBy: stmt.orderBy,
 	}
@@ -1836,7 +2255,7 @@ func (stmt *DeleteFromStmt) inferParameters(ctx context.Context, tx *SQLTx, para
 
 func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
+		ds:      stmt.tableRef.asWriteTarget(),
 		where:   stmt.where,
 		indexOn: stmt.indexOn,
 		orderBy: stmt.orderBy,
@@ -1852,6 +2271,20 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 	table := rowReader.ScanSpecs().Index.table
 
+	_, err = grantedColumns(ctx, tx, table, SQLPrivilegeDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	policyCond, err := table.policyCondition(ctx, tx, SQLPrivilegeDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if policyCond != nil {
+		rowReader = newConditionalRowReader(rowReader, policyCond)
+	}
+
 	for {
 		row, err := rowReader.Read(ctx)
 		if errors.Is(err, ErrNoMoreRows) {
@@ -2234,6 +2667,10 @@ type Timestamp struct {
 	val time.Time
 }
 
+func NewTimestamp(val time.Time) *Timestamp {
+	return &Timestamp{val: val.Truncate(time.Microsecond).UTC()}
+}
+
 func (v *Timestamp) Type() SQLValueType {
 	return TimestampType
 }
@@ -2839,8 +3276,7 @@ func (c *Cast) substitute(params map[string]interface{}) (ValueExp, error) {
 	if err != nil {
 		return nil, err
 	}
-	c.val = val
-	return c, nil
+	return &Cast{val: val, t: c.t}, nil
 }
 
 func (c *Cast) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -3360,7 +3796,28 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		return nil, err
 	}
 
-	rowReader, err := stmt.ds.Resolve(ctx, tx, params, scanSpecs)
+	partitions, err := stmt.scanPartitions(ctx, tx, scanSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := stmt.containsAggregations() || len(stmt.groupBy) > 0
+
+	parallelScan := len(partitions) > 1
+
+	// when groups are read in index order, each partition computes its own
+	// partial aggregations which are merged afterwards
+	partialAggregations := parallelScan && grouped && len(scanSpecs.groupBySortExps) == 0
+
+	var rowReader RowReader
+
+	if parallelScan {
+		rowReader, err = newParallelRowReader(partitions, func(partitionSpecs *ScanSpecs) (RowReader, error) {
+			return stmt.resolvePartition(ctx, tx, params, partitionSpecs, partialAggregations)
+		})
+	} else {
+		rowReader, err = stmt.ds.Resolve(ctx, tx, params, scanSpecs)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -3379,14 +3836,14 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		rowReader = jointRowReader
 	}
 
-	if stmt.where != nil {
+	if stmt.where != nil && !parallelScan {
 		rowReader = newConditionalRowReader(rowReader, stmt.where)
 	}
 
-	if stmt.containsAggregations() || len(stmt.groupBy) > 0 {
+	if grouped {
 		if len(scanSpecs.groupBySortExps) > 0 {
 			var sortRowReader *sortRowReader
-			sortRowReader, err = newSortRowReader(rowReader, scanSpecs.groupBySortExps)
+			sortRowReader, err = newSortRowReader(ctx, rowReader, scanSpecs.groupBySortExps)
 			if err != nil {
 				return nil, err
 			}
@@ -3394,10 +3851,11 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		}
 
 		var groupedRowReader *groupedRowReader
-		groupedRowReader, err = newGroupedRowReader(rowReader, allAggregations(stmt.targets), stmt.extractGroupByCols(), stmt.groupBy)
+		groupedRowReader, err = newGroupedRowReader(ctx, rowReader, allAggregations(stmt.targets), stmt.extractGroupByCols(), stmt.groupBy)
 		if err != nil {
 			return nil, err
 		}
+		groupedRowReader.partials = partialAggregations
 		rowReader = groupedRowReader
 
 		if stmt.having != nil {
@@ -3407,7 +3865,7 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 
 	if len(scanSpecs.orderBySortExps) > 0 {
 		var sortRowReader *sortRowReader
-		sortRowReader, err = newSortRowReader(rowReader, stmt.orderBy)
+		sortRowReader, err = newSortRowReader(ctx, rowReader, stmt.orderBy)
 		if err != nil {
 			return nil, err
 		}
@@ -3454,7 +3912,49 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 			rowReader = newLimitRowReader(rowReader, limit)
 		}
 	}
-	return rowReader, nil
+	return rowReader, nil
+}
+
+// scanPartitions returns the partitions the table scan is split into to be read concurrently.
+// Only queries over a single table executed in read-only transactions are partitioned,
+// since read-write transactions keep track of the keys being read.
+func (stmt *SelectStmt) scanPartitions(ctx context.Context, tx *SQLTx, scanSpecs *ScanSpecs) ([]*ScanSpecs, error) {
+	tableRef, isTableRef := stmt.ds.(*tableRef)
+
+	if !isTableRef || stmt.joins != nil || tx.engine.parallelism < 2 || !tx.tx.IsReadOnly() || tableRef.resolver(tx) != nil {
+		return []*ScanSpecs{scanSpecs}, nil
+	}
+
+	table, err := tableRef.referencedTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return partitionScan(ctx, tx, table, scanSpecs, tx.engine.parallelism)
+}
+
+// resolvePartition returns the filtered rows of a single partition of a parallel scan,
+// grouped into partial aggregations when requested.
+func (stmt *SelectStmt) resolvePartition(ctx context.Context, tx *SQLTx, params map[string]interface{}, scanSpecs *ScanSpecs, partialAggregations bool) (RowReader, error) {
+	rowReader, err := stmt.ds.Resolve(ctx, tx, params, scanSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	if stmt.where != nil {
+		rowReader = newConditionalRowReader(rowReader, stmt.where)
+	}
+
+	if !partialAggregations {
+		return rowReader, nil
+	}
+
+	groupedRowReader, err := newGroupedRowReader(ctx, rowReader, false, stmt.extractGroupByCols(), stmt.groupBy)
+	if err != nil {
+		rowReader.Close()
+		return nil, err
+	}
+	return groupedRowReader, nil
 }
 
 func (stmt *SelectStmt) rearrangeOrdExps(groupByCols, orderByExps []*OrdExp) ([]*OrdExp, []*OrdExp) {
@@ -3587,14 +4087,15 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 		}, nil
 	}
 
+	if tableRef.resolver(tx) != nil {
+		return &ScanSpecs{
+			groupBySortExps: groupByCols,
+			orderBySortExps: orderByCols,
+		}, nil
+	}
+
 	table, err := tableRef.referencedTable(tx)
 	if err != nil {
-		if tx.engine.tableResolveFor(tableRef.table) != nil {
-			return &ScanSpecs{
-				groupBySortExps: groupByCols,
-				orderBySortExps: orderByCols,
-			}, nil
-		}
 		return nil, err
 	}
 
@@ -3769,6 +4270,149 @@ func (stmt *UnionStmt) Alias() string {
 	return ""
 }
 
+// DeclareCursorStmt opens a cursor over the rows of a query. Cursors can only be declared within
+// transactions and are closed once the transaction is committed or rolled back.
+type DeclareCursorStmt struct {
+	cursor string
+	query  DataSource
+}
+
+func (stmt *DeclareCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *DeclareCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return stmt.query.requiredPrivileges()
+}
+
+func (stmt *DeclareCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return stmt.query.inferParameters(ctx, tx, params)
+}
+
+func (stmt *DeclareCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: cursors can only be declared within transactions", ErrNoOngoingTx)
+	}
+
+	if _, exists := tx.cursors[stmt.cursor]; exists {
+		return nil, fmt.Errorf("%w (%s)", ErrCursorAlreadyExists, stmt.cursor)
+	}
+
+	_, err := stmt.query.execAt(ctx, tx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rowReader, err := stmt.query.Resolve(ctx, tx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.addCursor(stmt.cursor, rowReader)
+
+	return tx, nil
+}
+
+// FetchStmt returns the next rows of a cursor, all the remaining ones or the given number of them
+type FetchStmt struct {
+	cursor string
+	count  uint64
+	all    bool
+}
+
+func (stmt *FetchStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *FetchStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeSelect}
+}
+
+func (stmt *FetchStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *FetchStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	return tx, nil
+}
+
+func (stmt *FetchStmt) Resolve(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
+	rowReader, err := tx.cursor(stmt.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFetchRowReader(rowReader, stmt.count, stmt.all), nil
+}
+
+func (stmt *FetchStmt) Alias() string {
+	return ""
+}
+
+// MoveStmt skips the next rows of a cursor, all the remaining ones or the given number of them
+type MoveStmt struct {
+	cursor string
+	count  uint64
+	all    bool
+}
+
+func (stmt *MoveStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *MoveStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeSelect}
+}
+
+func (stmt *MoveStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *MoveStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	rowReader, err := tx.cursor(stmt.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	for n := uint64(0); stmt.all || n < stmt.count; n++ {
+		_, err := rowReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+// CloseCursorStmt closes a cursor, or all the cursors of the transaction
+type CloseCursorStmt struct {
+	cursor string
+	all    bool
+}
+
+func (stmt *CloseCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *CloseCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *CloseCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CloseCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.all {
+		return tx, tx.closeCursors()
+	}
+
+	return tx, tx.closeCursor(stmt.cursor)
+}
+
 func NewTableRef(table string, as string) *tableRef {
 	return &tableRef{
 		table: table,
@@ -3777,10 +4421,15 @@ func NewTableRef(table string, as string) *tableRef {
 }
 
 type tableRef struct {
+	schema  string
 	table   string
 	history bool
 	period  period
 	as      string
+
+	// writeTarget is set when rows are read to be updated or deleted,
+	// column privileges are then checked by the statement itself
+	writeTarget bool
 }
 
 func (ref *tableRef) readOnly() bool {
@@ -3916,15 +4565,65 @@ func (stmt *tableRef) Resolve(ctx context.Context, tx *SQLTx, params map[string]
 		return nil, ErrIllegalArguments
 	}
 
+	if resolver := stmt.resolver(tx); resolver != nil {
+		return resolver.Resolve(ctx, tx, stmt.Alias())
+	}
+
 	table, err := stmt.referencedTable(tx)
-	if err == nil {
-		return newRawRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
+	if err != nil {
+		return nil, err
 	}
 
-	if resolver := tx.engine.tableResolveFor(stmt.table); resolver != nil {
-		return resolver.Resolve(ctx, tx, stmt.Alias())
+	grants := &columnGrants{table: table, all: true}
+	if !stmt.writeTarget {
+		grants, err = grantedColumns(ctx, tx, table, SQLPrivilegeSelect)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return nil, err
+
+	var rowReader RowReader
+
+	rowReader, err = newRawRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	cond, err := table.policyCondition(ctx, tx, SQLPrivilegeSelect)
+	if err != nil {
+		rowReader.Close()
+		return nil, err
+	}
+
+	if cond != nil {
+		rowReader = newConditionalRowReader(rowReader, cond)
+	}
+
+	maskedReader, err := grants.maskColumns(ctx, rowReader, scanSpecs)
+	if err != nil {
+		rowReader.Close()
+		return nil, err
+	}
+	return maskedReader, nil
+}
+
+// asWriteTarget returns a copy of the reference used to read the rows to be updated or deleted
+func (stmt *tableRef) asWriteTarget() *tableRef {
+	ref := *stmt
+	ref.writeTarget = true
+	return &ref
+}
+
+// resolver returns the table resolver registered for the referenced table, if any.
+// Schema-qualified references (e.g. information_schema.tables) are looked up by their
+// qualified name first, falling back to the unqualified table name.
+func (stmt *tableRef) resolver(tx *SQLTx) TableResolver {
+	if stmt.schema != "" {
+		if resolver := tx.engine.tableResolveFor(stmt.schema + "." + stmt.table); resolver != nil {
+			return resolver
+		}
+	}
+	return tx.engine.tableResolveFor(stmt.table)
 }
 
 func (stmt *tableRef) Alias() string {
@@ -4341,10 +5040,7 @@ func (bexp *NumExp) substitute(params map[string]interface{}) (ValueExp, error)
 		return nil, err
 	}
 
-	bexp.left = rlexp
-	bexp.right = rrexp
-
-	return bexp, nil
+	return &NumExp{op: bexp.op, left: rlexp, right: rrexp}, nil
 }
 
 func (bexp *NumExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -4424,9 +5120,7 @@ func (bexp *NotBoolExp) substitute(params map[string]interface{}) (ValueExp, err
 		return nil, err
 	}
 
-	bexp.exp = rexp
-
-	return bexp, nil
+	return &NotBoolExp{exp: rexp}, nil
 }
 
 func (bexp *NotBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -4689,10 +5383,7 @@ func (bexp *CmpBoolExp) substitute(params map[string]interface{}) (ValueExp, err
 		return nil, err
 	}
 
-	bexp.left = rlexp
-	bexp.right = rrexp
-
-	return bexp, nil
+	return &CmpBoolExp{op: bexp.op, left: rlexp, right: rrexp}, nil
 }
 
 func (bexp *CmpBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -4923,10 +5614,7 @@ func (bexp *BinBoolExp) substitute(params map[string]interface{}) (ValueExp, err
 		return nil, err
 	}
 
-	bexp.left = rlexp
-	bexp.right = rrexp
-
-	return bexp, nil
+	return &BinBoolExp{op: bexp.op, left: rlexp, right: rrexp}, nil
 }
 
 func (bexp *BinBoolExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -5681,6 +6369,14 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 			Column: "privilege",
 			Type:   VarcharType,
 		},
+		{
+			Column: "table_name",
+			Type:   VarcharType,
+		},
+		{
+			Column: "column_name",
+			Type:   VarcharType,
+		},
 	}
 
 	var err error
@@ -5703,8 +6399,30 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 				values = append(values, []ValueExp{
 					&Varchar{val: user.Username()},
 					&Varchar{val: string(p)},
+					&NullValue{t: VarcharType},
+					&NullValue{t: VarcharType},
 				})
 			}
+
+			for _, p := range user.TablePrivileges() {
+				if len(p.Columns) == 0 {
+					values = append(values, []ValueExp{
+						&Varchar{val: user.Username()},
+						&Varchar{val: string(p.Privilege)},
+						&Varchar{val: p.Table},
+						&NullValue{t: VarcharType},
+					})
+				}
+
+				for _, col := range p.Columns {
+					values = append(values, []ValueExp{
+						&Varchar{val: user.Username()},
+						&Varchar{val: string(p.Privilege)},
+						&Varchar{val: p.Table},
+						&Varchar{val: col},
+					})
+				}
+			}
 		}
 	}
 
@@ -5793,6 +6511,13 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		}
 	}
 
+	// delete policies
+	for _, p := range table.policies {
+		if err := persistPolicyDeletion(ctx, tx, table.id, p.id); err != nil {
+			return nil, err
+		}
+	}
+
 	// delete indexes
 	for _, index := range table.indexes {
 		mappedKey := MapKey(
@@ -5943,6 +6668,29 @@ var allPrivileges = []SQLPrivilege{
 	SQLPrivilegeAlter,
 }
 
+// tablePrivileges are the privileges which can be granted on a single table
+var tablePrivileges = []SQLPrivilege{
+	SQLPrivilegeSelect,
+	SQLPrivilegeInsert,
+	SQLPrivilegeUpdate,
+	SQLPrivilegeDelete,
+}
+
+// columnPrivileges are the privileges which can be restricted to a subset of the columns of a table
+var columnPrivileges = []SQLPrivilege{
+	SQLPrivilegeSelect,
+	SQLPrivilegeInsert,
+	SQLPrivilegeUpdate,
+}
+
+// TablePrivilege is a privilege granted on a single table of the current database.
+// When Columns is empty the privilege applies to the whole table.
+type TablePrivilege struct {
+	Privilege SQLPrivilege
+	Table     string
+	Columns   []string
+}
+
 func DefaultSQLPrivilegesForPermission(p Permission) []SQLPrivilege {
 	switch p {
 	case PermissionSysAdmin, PermissionAdmin, PermissionReadWrite:
@@ -5955,6 +6703,8 @@ func DefaultSQLPrivilegesForPermission(p Permission) []SQLPrivilege {
 
 type AlterPrivilegesStmt struct {
 	database   string
+	table      string
+	columns    []string
 	user       string
 	privileges []SQLPrivilege
 	isGrant    bool
@@ -5977,6 +6727,10 @@ func (stmt *AlterPrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params m
 		return nil, ErrUnspecifiedMultiDBHandler
 	}
 
+	if stmt.table != "" {
+		return nil, stmt.alterTablePrivileges(ctx, tx)
+	}
+
 	var err error
 	if stmt.isGrant {
 		err = tx.engine.multidbHandler.GrantSQLPrivileges(ctx, stmt.database, stmt.user, stmt.privileges)
@@ -5986,6 +6740,161 @@ func (stmt *AlterPrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params m
 	return nil, err
 }
 
+func (stmt *AlterPrivilegesStmt) alterTablePrivileges(ctx context.Context, tx *SQLTx) error {
+	// privileges on a table which no longer exists can still be revoked
+	if stmt.isGrant {
+		table, err := tx.catalog.GetTableByName(stmt.table)
+		if err != nil {
+			return err
+		}
+
+		for _, col := range stmt.columns {
+			_, err := table.GetColumnByName(col)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	applicable := tablePrivileges
+	if len(stmt.columns) > 0 {
+		applicable = columnPrivileges
+	}
+
+	privileges := stmt.privileges
+	if hasAllPrivileges(privileges, allPrivileges) {
+		privileges = applicable
+	}
+
+	grants := make([]TablePrivilege, len(privileges))
+
+	for i, p := range privileges {
+		if !hasAllPrivileges(applicable, []SQLPrivilege{p}) {
+			if len(stmt.columns) > 0 {
+				return fmt.Errorf("%w: %s privilege can not be granted on columns", ErrInvalidPrivilege, p)
+			}
+			return fmt.Errorf("%w: %s privilege can not be granted on tables", ErrInvalidPrivilege, p)
+		}
+
+		grants[i] = TablePrivilege{
+			Privilege: p,
+			Table:     stmt.table,
+			Columns:   stmt.columns,
+		}
+	}
+
+	if stmt.isGrant {
+		return tx.engine.multidbHandler.GrantTablePrivileges(ctx, stmt.user, grants)
+	}
+	return tx.engine.multidbHandler.RevokeTablePrivileges(ctx, stmt.user, grants)
+}
+
+// columnGrants holds the columns of a table the logged user has been granted a privilege on
+type columnGrants struct {
+	table *Table
+	all   bool
+	cols  map[string]struct{}
+}
+
+// grantedColumns returns the columns of the table the logged user holds the privilege on,
+// privileges are only enforced when a multidb handler is specified.
+func grantedColumns(ctx context.Context, tx *SQLTx, table *Table, privilege SQLPrivilege) (*columnGrants, error) {
+	grants := &columnGrants{
+		table: table,
+		cols:  make(map[string]struct{}),
+	}
+
+	if tx.engine.multidbHandler == nil {
+		grants.all = true
+		return grants, nil
+	}
+
+	user, err := tx.engine.multidbHandler.GetLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasAllPrivileges(user.SQLPrivileges(), []SQLPrivilege{privilege}) {
+		grants.all = true
+		return grants, nil
+	}
+
+	for _, p := range user.TablePrivileges() {
+		if p.Privilege != privilege || p.Table != table.name {
+			continue
+		}
+
+		if len(p.Columns) == 0 {
+			grants.all = true
+			return grants, nil
+		}
+
+		for _, col := range p.Columns {
+			grants.cols[col] = struct{}{}
+		}
+	}
+
+	if len(grants.cols) == 0 {
+		return nil, fmt.Errorf("%w: %s privilege on table %s required", ErrAccessDenied, privilege, table.name)
+	}
+	return grants, nil
+}
+
+func (g *columnGrants) includes(col string) bool {
+	if g.all {
+		return true
+	}
+	_, ok := g.cols[col]
+	return ok
+}
+
+func (g *columnGrants) check(privilege SQLPrivilege, cols ...string) error {
+	for _, col := range cols {
+		if !g.includes(col) {
+			return fmt.Errorf("%w: %s privilege on column %s.%s required", ErrAccessDenied, privilege, g.table.name, col)
+		}
+	}
+	return nil
+}
+
+// maskColumns restricts the rows read from the table to the columns the user is allowed to select.
+// Scans driven by hidden columns are rejected, as they would reveal their values.
+func (g *columnGrants) maskColumns(ctx context.Context, rowReader RowReader, scanSpecs *ScanSpecs) (RowReader, error) {
+	if g.all {
+		return rowReader, nil
+	}
+
+	if scanSpecs != nil {
+		if scanSpecs.Index != nil && !scanSpecs.Index.IsPrimary() {
+			for _, col := range scanSpecs.Index.cols {
+				if err := g.check(SQLPrivilegeSelect, col.colName); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for colID := range scanSpecs.rangesByColID {
+			col, err := g.table.GetColumnByID(colID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := g.check(SQLPrivilegeSelect, col.colName); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	targets := make([]TargetEntry, 0, len(g.cols))
+
+	for _, col := range g.table.cols {
+		if g.includes(col.colName) {
+			targets = append(targets, TargetEntry{Exp: &ColSelector{col: col.colName}})
+		}
+	}
+	return newProjectedRowReader(ctx, rowReader, "", targets)
+}
+
 func (stmt *AlterPrivilegesStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	return nil
 }
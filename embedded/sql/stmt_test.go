@@ -840,6 +840,31 @@ func TestYetUnsupportedInSubQueryExp(t *testing.T) {
 	require.Nil(t, exp.selectorRanges(nil, "", nil, nil))
 }
 
+func TestSubstituteDoesNotMutateExp(t *testing.T) {
+	exp, err := ParseExpFromString("NOT (CAST(@a AS INTEGER) + 1 > @b AND @c)")
+	require.NoError(t, err)
+
+	original, err := ParseExpFromString("NOT (CAST(@a AS INTEGER) + 1 > @b AND @c)")
+	require.NoError(t, err)
+
+	// the same expression may be substituted concurrently with different parameters
+	rexp1, err := exp.substitute(map[string]interface{}{"a": "1", "b": int64(1), "c": true})
+	require.NoError(t, err)
+
+	rexp2, err := exp.substitute(map[string]interface{}{"a": "1", "b": int64(5), "c": true})
+	require.NoError(t, err)
+
+	require.Equal(t, original, exp)
+
+	v, err := rexp1.reduce(nil, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, &Bool{val: false}, v)
+
+	v, err = rexp2.reduce(nil, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, &Bool{val: true}, v)
+}
+
 func TestCaseWhenExp(t *testing.T) {
 	t.Run("simple case", func(t *testing.T) {
 		e, err := ParseExpFromString(
@@ -0,0 +1,160 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyRing(t *testing.T, path string) *encryption.KeyRing {
+	masterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	provider, err := encryption.NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	keyRing, err := encryption.OpenKeyRing(path, provider, 0600)
+	require.NoError(t, err)
+
+	return keyRing
+}
+
+func TestImmudbStoreEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	keyRing := newTestKeyRing(t, filepath.Join(t.TempDir(), "keyring"))
+
+	opts := DefaultOptions().
+		WithSynced(false).
+		WithFileSize(4096).
+		WithEncryption(appendable.XChaCha20Poly1305Encryption, keyRing)
+	opts.WithIndexOptions(opts.IndexOpts.WithFlushThld(10))
+
+	st, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	const txCount = 30
+
+	value := func(i int) []byte {
+		return []byte(fmt.Sprintf("sensitive-value-%04d", i))
+	}
+
+	commit := func(st *ImmuStore, from, to int) {
+		for i := from; i < to; i++ {
+			tx, err := st.NewWriteOnlyTx(context.Background())
+			require.NoError(t, err)
+
+			err = tx.Set([]byte(fmt.Sprintf("sensitive-key-%04d", i)), nil, value(i))
+			require.NoError(t, err)
+
+			_, err = tx.Commit(context.Background())
+			require.NoError(t, err)
+		}
+	}
+
+	requireContent := func(st *ImmuStore, count int) {
+		err := st.WaitForIndexingUpto(context.Background(), uint64(count))
+		require.NoError(t, err)
+
+		for i := 0; i < count; i++ {
+			valRef, err := st.Get(context.Background(), []byte(fmt.Sprintf("sensitive-key-%04d", i)))
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, value(i), val)
+		}
+
+		sourceTx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+		targetTx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err = st.ReadTx(1, false, sourceTx)
+		require.NoError(t, err)
+
+		err = st.ReadTx(uint64(count), false, targetTx)
+		require.NoError(t, err)
+
+		proof, err := st.DualProof(sourceTx.Header(), targetTx.Header())
+		require.NoError(t, err)
+		require.True(t, VerifyDualProof(proof, 1, uint64(count), sourceTx.Header().Alh(), targetTx.Header().Alh()))
+	}
+
+	commit(st, 0, txCount)
+	requireContent(st, txCount)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	// the data key is rotated without rewriting existing data
+	_, err = keyRing.RotateDataKey()
+	require.NoError(t, err)
+
+	st, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	commit(st, txCount, 2*txCount)
+	requireContent(st, 2*txCount)
+
+	_, err = st.CompactValueLogs(context.Background())
+	require.ErrorIs(t, err, ErrVLogGCNotSupported)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(content, []byte("sensitive-")), "plain data found in %s", path)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// encrypted files can not be read without the key ring
+	_, err = Open(dir, DefaultOptions())
+	require.Error(t, err)
+}
+
+func TestImmudbStoreEncryptionInvalidOptions(t *testing.T) {
+	keyRing := newTestKeyRing(t, filepath.Join(t.TempDir(), "keyring"))
+
+	_, err := Open(t.TempDir(), DefaultOptions().WithEncryption(appendable.AESGCMEncryption, nil))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = Open(t.TempDir(), DefaultOptions().WithEncryption(100, keyRing))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = Open(t.TempDir(), DefaultOptions().
+		WithEncryption(appendable.AESGCMEncryption, keyRing).
+		WithPreallocFiles(true))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
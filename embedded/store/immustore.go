@@ -97,6 +97,7 @@ var ErrInvalidPreconditionNull = fmt.Errorf("%w: null", ErrInvalidPrecondition)
 var ErrInvalidPreconditionNullKey = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrNullKey)
 var ErrInvalidPreconditionMaxKeyLenExceeded = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrMaxKeyLenExceeded)
 var ErrInvalidPreconditionInvalidTxID = fmt.Errorf("%w: invalid transaction ID", ErrInvalidPrecondition)
+var ErrInvalidPreconditionInvalidCountRange = fmt.Errorf("%w: invalid count range", ErrInvalidPrecondition)
 
 var ErrSourceTxNewerThanTargetTx = fmt.Errorf("%w: source tx is newer than target tx", ErrIllegalArguments)
 
@@ -110,12 +111,21 @@ var ErrMetadataUnsupported = errors.New(
 var ErrUnsupportedTxHeaderVersion = errors.New("missing tx header serialization method")
 var ErrIllegalTruncationArgument = fmt.Errorf("%w: invalid truncation info", ErrIllegalArguments)
 var ErrTruncationInfoNotPresentInMetadata = errors.New("truncation info not present in metadata")
+var ErrRedactionInfoNotPresentInMetadata = errors.New("redaction info not present in metadata")
+var ErrValueRedacted = errors.New("value redacted")
+var ErrRedactionNotSupported = errors.New("value redaction not supported")
+var ErrVLogGCNotSupported = errors.New("value-log garbage collection not supported")
 
 var ErrInvalidProof = errors.New("invalid proof")
 
 var ErrIndexNotFound = errors.New("index not found")
 var ErrIndexAlreadyInitialized = errors.New("index already initialized")
 
+var ErrMergeOperatorNotFound = errors.New("merge operator not found")
+var ErrMergeOperatorAlreadyRegistered = errors.New("merge operator already registered")
+var ErrInvalidMergeValue = errors.New("invalid value for merge operator")
+var ErrPendingMerge = errors.New("value is computed at commit time")
+
 const MaxKeyLen = 1024 // assumed to be not lower than hash size
 const MaxParallelIO = 127
 
@@ -222,6 +232,25 @@ type ImmuStore struct {
 	memSemaphore *semaphore.Semaphore // used by indexers to control amount acquired of memory
 	indexersMux  sync.RWMutex
 
+	mergeOperators    map[string]MergeOperator
+	mergeOperatorsMux sync.RWMutex
+
+	redactionLog      appendable.Appendable // redacted value offsets and pending redactions, created upon first redaction
+	redactedValues    map[int64]struct{}
+	pendingRedactions map[int64]*pendingRedaction
+	redactionMutex    sync.RWMutex
+	redactionCtx      context.Context // cancelled when closing, to stop waiting for redactions to be committed
+	redactionStop     context.CancelFunc
+	redactionWg       sync.WaitGroup
+
+	fileSize    int
+	vLogGCOpts  *VLogGCOptions
+	vLogGCMutex sync.Mutex
+	vLogGCStop  context.CancelFunc
+	vLogGCDone  chan struct{}
+	vLogGCLog   appendable.Appendable // progress of value-log garbage collection, opened upon first run
+	vLogGCState *vLogGCState
+
 	opts *Options
 
 	closed bool
@@ -272,6 +301,7 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 		WithAutoSync(true).
 		WithFileSize(opts.FileSize).
 		WithFileMode(opts.FileMode).
+		WithEncryption(opts.EncryptionAlgorithm, opts.KeyRing).
 		WithMetadata(metadata.Bytes())
 
 	appFactory := opts.appFactory
@@ -318,6 +348,7 @@ func Open(path string, opts *Options) (*ImmuStore, error) {
 		appendableOpts.WithPrealloc(false)
 		appendableOpts.WithCompressionFormat(opts.CompressionFormat)
 		appendableOpts.WithCompresionLevel(opts.CompressionLevel)
+		appendableOpts.WithCompressionDictionaries(opts.CompressionDictionaries...)
 		appendableOpts.WithMaxOpenedFiles(opts.VLogMaxOpenedFiles)
 
 		for i := 0; i < opts.MaxIOConcurrency; i++ {
@@ -596,7 +627,8 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		WithRetryableSync(opts.Synced).
 		WithAutoSync(true).
 		WithWriteBufferSize(opts.AHTOpts.WriteBufferSize).
-		WithSyncThld(opts.AHTOpts.SyncThld)
+		WithSyncThld(opts.AHTOpts.SyncThld).
+		WithEncryption(opts.EncryptionAlgorithm, opts.KeyRing)
 
 	if opts.appFactory != nil {
 		ahtOpts.WithAppFactory(func(rootPath, subPath string, appOpts *multiapp.Options) (appendable.Appendable, error) {
@@ -609,11 +641,41 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		return nil, fmt.Errorf("could not open aht: %w", err)
 	}
 
+	redactionLog, err := openRedactionLog(path, opts, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not open redaction log: %w", err)
+	}
+
+	redactedValues, pendingRedactions, redactionLogSize, err := readRedactionLog(redactionLog)
+	if err != nil {
+		return nil, fmt.Errorf("could not read redaction log: %w", err)
+	}
+
+	if redactionLog != nil && !opts.ReadOnly {
+		size, err := redactionLog.Size()
+		if err != nil {
+			return nil, fmt.Errorf("could not read redaction log: %w", err)
+		}
+
+		if size > redactionLogSize {
+			// will overwrite a partially written record
+			err = redactionLog.SetOffset(redactionLogSize)
+			if err != nil {
+				return nil, fmt.Errorf("could not read redaction log: %w", err)
+			}
+		}
+	}
+
 	txLogCache, err := cache.NewCache(opts.TxLogCacheSize) // TODO: optionally it could include up to opts.MaxActiveTransactions upon start
 	if err != nil {
 		return nil, err
 	}
 
+	vLogGCOpts := opts.VLogGCOpts
+	if vLogGCOpts == nil {
+		vLogGCOpts = DefaultVLogGCOptions()
+	}
+
 	store := &ImmuStore{
 		path:             path,
 		logger:           opts.logger,
@@ -657,6 +719,10 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		timeFunc:                   opts.TimeFunc,
 		multiIndexing:              opts.MultiIndexing,
 		indexers:                   make(map[[sha256.Size]byte]*indexer),
+		mergeOperators:             defaultMergeOperators(),
+		redactionLog:               redactionLog,
+		redactedValues:             redactedValues,
+		pendingRedactions:          pendingRedactions,
 		memSemaphore:               semaphore.New(uint64(opts.IndexOpts.MaxGlobalBufferedDataSize)),
 		useExternalCommitAllowance: opts.UseExternalCommitAllowance,
 		commitAllowedUpToTxID:      committedTxID,
@@ -670,11 +736,16 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		txPool: txPool,
 		_txbs:  txbs,
 
+		fileSize:   fileSize,
+		vLogGCOpts: vLogGCOpts,
+
 		opts: opts,
 
 		compactionDisabled: opts.CompactionDisabled,
 	}
 
+	store.redactionCtx, store.redactionStop = context.WithCancel(context.Background())
+
 	if store.aht.Size() > precommittedTxID {
 		err = store.aht.ResetSize(precommittedTxID)
 		if err != nil {
@@ -711,6 +782,12 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		return nil, err
 	}
 
+	err = store.resumePendingRedactions()
+	if err != nil {
+		store.Close()
+		return nil, fmt.Errorf("could not resume pending redactions: %w", err)
+	}
+
 	if !store.multiIndexing {
 		err := store.InitIndexing(&IndexSpec{})
 		if err != nil {
@@ -723,6 +800,14 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		go store.syncer()
 	}
 
+	if !store.readOnly && !store.embeddedValues && vLogGCOpts.Frequency > 0 {
+		var ctx context.Context
+		ctx, store.vLogGCStop = context.WithCancel(context.Background())
+		store.vLogGCDone = make(chan struct{})
+
+		go store.vLogGC(ctx)
+	}
+
 	return store, nil
 }
 
@@ -1088,6 +1173,41 @@ func (s *ImmuStore) NewTxHolderPool(poolSize int, preallocated bool) (TxPool, er
 	})
 }
 
+// countKeys returns the number of keys with the given prefix, not including deleted nor expired ones.
+// Counting stops once the limit is reached.
+func (s *ImmuStore) countKeys(ctx context.Context, prefix []byte, limit uint64) (uint64, error) {
+	snap, err := s.syncSnapshot(prefix)
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Close()
+
+	keyReader, err := snap.NewKeyReader(KeyReaderSpec{
+		Prefix:  prefix,
+		Filters: []FilterFn{IgnoreDeleted, IgnoreExpired},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer keyReader.Close()
+
+	var count uint64
+
+	for count < limit {
+		_, _, err := keyReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
 func (s *ImmuStore) syncSnapshot(prefix []byte) (*Snapshot, error) {
 	indexer, err := s.getIndexerFor(prefix)
 	if err != nil {
@@ -1598,51 +1718,17 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 
 	tx.header.NEntries = len(otx.entries)
 
-	doneWithValuesCh := make(chan appendableResult)
-	defer close(doneWithValuesCh)
-
-	go func() {
-		// value write is delayed to ensure values are inmediatelly followed by the associated tx header
-		if s.embeddedValues {
-			doneWithValuesCh <- appendableResult{nil, nil}
-			return
-		}
+	// values of entries with pending merges are only known once the commit lock is acquired
+	mustResolveMerges := hasMerges(otx.entries)
 
-		offsets, err := s.appendValuesIntoAnyVLog(otx.entries)
-		if err != nil {
-			doneWithValuesCh <- appendableResult{nil, err}
-			return
-		}
-
-		doneWithValuesCh <- appendableResult{offsets, nil}
-	}()
-
-	for i, e := range otx.entries {
-		txe := tx.entries[i]
-		txe.setKey(e.Key)
-		txe.md = e.Metadata
-		txe.vLen = len(e.Value)
-		if e.IsValueTruncated {
-			txe.hVal = e.HashValue
-		} else {
-			txe.hVal = sha256.Sum256(e.Value)
-		}
-	}
-
-	err = tx.BuildHashTree()
-	if err != nil {
-		<-doneWithValuesCh // wait for data to be written
-		return nil, err
-	}
-
-	valueWritingResult := <-doneWithValuesCh // wait for data to be written
-	if valueWritingResult.err != nil {
-		return nil, valueWritingResult.err
+	if mustResolveMerges && hdr != nil {
+		return nil, fmt.Errorf("%w: merges can not be replicated", ErrIllegalArguments)
 	}
 
-	if !s.embeddedValues {
-		for i := 0; i < tx.header.NEntries; i++ {
-			tx.entries[i].vOff = valueWritingResult.offsets[i]
+	if !mustResolveMerges {
+		err = s.writeTxEntries(tx, otx.entries)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -1742,6 +1828,24 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 		}
 	}
 
+	if mustResolveMerges {
+		// merges are always resolved against the latest committed values
+		err = s.WaitForIndexingUpto(ctx, currPrecomittedTxID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.resolveMerges(ctx, otx.entries)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.writeTxEntries(tx, otx.entries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.performPrecommit(tx, otx.entries, ts, blTxID)
 	if err != nil {
 		return nil, err
@@ -2178,6 +2282,11 @@ type KeyIndex interface {
 	GetWithPrefixAndFilters(ctx context.Context, prefix []byte, neq []byte, filters ...FilterFn) (key []byte, valRef ValueRef, err error)
 }
 
+// keyCounter is implemented by key indexes able to count the keys with a given prefix
+type keyCounter interface {
+	countKeys(ctx context.Context, prefix []byte, limit uint64) (uint64, error)
+}
+
 type unsafeIndex struct {
 	st *ImmuStore
 }
@@ -2202,6 +2311,10 @@ func (index *unsafeIndex) GetWithPrefixAndFilters(ctx context.Context, prefix []
 	return index.st.GetWithPrefixAndFilters(ctx, prefix, neq, filters...)
 }
 
+func (index *unsafeIndex) countKeys(ctx context.Context, prefix []byte, limit uint64) (uint64, error) {
+	return index.st.countKeys(ctx, prefix, limit)
+}
+
 func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64, index KeyIndex) ([]*EntrySpec, []Precondition, error)) (*TxHeader, error) {
 	if callback == nil {
 		return nil, ErrIllegalArguments
@@ -2249,12 +2362,14 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		return nil, err
 	}
 
-	if otx.hasPreconditions() {
+	mustResolveMerges := hasMerges(otx.entries)
+
+	if otx.hasPreconditions() || mustResolveMerges {
 		for _, indexer := range s.indexers {
 			indexer.Resume()
 		}
 
-		// Preconditions must be executed with up-to-date tree
+		// Preconditions and merges must be executed with up-to-date tree
 		err = s.WaitForIndexingUpto(ctx, lastPreCommittedTxID)
 		if err != nil {
 			return nil, err
@@ -2265,6 +2380,11 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 			return nil, err
 		}
 
+		err = s.resolveMerges(ctx, otx.entries)
+		if err != nil {
+			return nil, err
+		}
+
 		for _, indexer := range s.indexers {
 			indexer.Pause()
 		}
@@ -2279,17 +2399,33 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 	tx.header.Version = s.writeTxHeaderVersion
 	tx.header.NEntries = len(otx.entries)
 
+	err = s.writeTxEntries(tx, otx.entries)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.performPrecommit(tx, otx.entries, s.timeFunc().Unix(), s.aht.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Header(), nil
+}
+
+// writeTxEntries appends the values of the entries into the value logs
+// and builds the hash tree of the transaction
+func (s *ImmuStore) writeTxEntries(tx *Tx, entries []*EntrySpec) error {
 	doneWithValuesCh := make(chan appendableResult)
 	defer close(doneWithValuesCh)
 
 	go func() {
+		// value write is delayed to ensure values are inmediatelly followed by the associated tx header
 		if s.embeddedValues {
-			// value write is delayed to ensure values are inmediatelly followed by the associated tx header
 			doneWithValuesCh <- appendableResult{nil, nil}
 			return
 		}
 
-		offsets, err := s.appendValuesIntoAnyVLog(otx.entries)
+		offsets, err := s.appendValuesIntoAnyVLog(entries)
 		if err != nil {
 			doneWithValuesCh <- appendableResult{nil, err}
 			return
@@ -2298,7 +2434,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		doneWithValuesCh <- appendableResult{offsets, nil}
 	}()
 
-	for i, e := range otx.entries {
+	for i, e := range entries {
 		txe := tx.entries[i]
 		txe.setKey(e.Key)
 		txe.md = e.Metadata
@@ -2310,15 +2446,15 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		}
 	}
 
-	err = tx.BuildHashTree()
+	err := tx.BuildHashTree()
 	if err != nil {
 		<-doneWithValuesCh // wait for data to be written
-		return nil, err
+		return err
 	}
 
 	valueWritingResult := <-doneWithValuesCh // wait for data to be written
 	if valueWritingResult.err != nil {
-		return nil, valueWritingResult.err
+		return valueWritingResult.err
 	}
 
 	if !s.embeddedValues {
@@ -2327,12 +2463,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		}
 	}
 
-	err = s.performPrecommit(tx, otx.entries, s.timeFunc().Unix(), s.aht.Size())
-	if err != nil {
-		return nil, err
-	}
-
-	return tx.Header(), nil
+	return nil
 }
 
 type DualProofV2 struct {
@@ -2624,6 +2755,20 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 		return nil, err
 	}
 
+	// redacted values are exported as digests, as done with truncated ones
+	etx, err := s.exportTx(tx, skipIntegrityCheck, s.hasRedactedValues(tx))
+	if errors.Is(err, errPartiallyDiscardedTx) {
+		// some values were discarded by value-log garbage collection
+		return s.exportTx(tx, skipIntegrityCheck, true)
+	}
+
+	return etx, err
+}
+
+// errPartiallyDiscardedTx is returned when only some of the values of a transaction are available
+var errPartiallyDiscardedTx = fmt.Errorf("%w: partially truncated transaction", ErrCorruptedData)
+
+func (s *ImmuStore) exportTx(tx *Tx, skipIntegrityCheck bool, exportDigestsOnly bool) ([]byte, error) {
 	var buf bytes.Buffer
 
 	hdrBs, err := tx.Header().Bytes()
@@ -2691,7 +2836,11 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 			valBuf = s._valBs[:e.vLen]
 		}
 
-		_, err = s.readValueAt(valBuf, e.vOff, e.hVal, skipIntegrityCheck)
+		if exportDigestsOnly {
+			err = io.EOF
+		} else {
+			_, err = s.readValueAt(valBuf, e.vOff, e.hVal, skipIntegrityCheck)
+		}
 		if err != nil && !errors.Is(err, io.EOF) {
 			s._valBsMux.Unlock()
 			return nil, err
@@ -2700,7 +2849,8 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 		if err == nil {
 			// currently, either all the values are sent or none
 			if isValueTruncated {
-				return nil, fmt.Errorf("%w: partially truncated transaction", ErrCorruptedData)
+				s._valBsMux.Unlock()
+				return nil, errPartiallyDiscardedTx
 			}
 
 			// vLen
@@ -2723,7 +2873,8 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 
 			// currently, either all the values are sent or none
 			if !isValueTruncated && i > 0 {
-				return nil, fmt.Errorf("%w: partially truncated transaction", ErrCorruptedData)
+				s._valBsMux.Unlock()
+				return nil, errPartiallyDiscardedTx
 			}
 
 			isValueTruncated = true
@@ -2893,6 +3044,20 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 		}
 	}
 
+	var pendingRedaction *pendingRedaction
+
+	if hdr.Metadata != nil && hdr.Metadata.HasRedactedEntries() {
+		err = s.checkRedaction(hdr.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		pendingRedaction, err = s.registerPendingRedaction(hdr.ID-1, hdr.Metadata)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	txHdr, err := s.precommit(ctx, txSpec, hdr, skipIntegrityCheck)
 	if err != nil {
 		return nil, err
@@ -2911,6 +3076,10 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 	waitForCommit := !s.useExternalCommitAllowance
 	s.commitStateRWMutex.Unlock()
 
+	if pendingRedaction != nil && !waitForCommit {
+		s.applyPendingRedactionOnceCommitted(pendingRedaction, txHdr.ID)
+	}
+
 	if waitForCommit {
 		err = s.commitWHub.WaitFor(ctx, txHdr.ID)
 		if errors.Is(err, watchers.ErrAlreadyClosed) {
@@ -2920,6 +3089,14 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 			return nil, err
 		}
 
+		// as done by the primary, values are erased once the redaction is committed
+		if pendingRedaction != nil {
+			err = s.applyPendingRedaction(pendingRedaction)
+			if err != nil {
+				return txHdr, err
+			}
+		}
+
 		if waitForIndexing {
 			err = s.WaitForIndexingUpto(ctx, txHdr.ID)
 			if err != nil {
@@ -3187,6 +3364,10 @@ func (s *ImmuStore) readValueAt(b []byte, off int64, hvalue [sha256.Size]byte, s
 		return 0, io.EOF // it means value was not stored on any vlog i.e. a truncated transaction was replicated
 	}
 
+	if len(b) > 0 && s.isValueRedacted(off) {
+		return 0, ErrValueRedacted
+	}
+
 	if len(b) > 0 {
 		foundInTheCache := false
 
@@ -3258,6 +3439,21 @@ func (s *ImmuStore) validateEntries(entries []*EntrySpec) error {
 			return ErrMaxValueLenExceeded
 		}
 
+		for _, merge := range kv.Merges {
+			if merge == nil || kv.IsValueTruncated {
+				return ErrIllegalArguments
+			}
+
+			if len(merge.Operand) > s.maxValueLen {
+				return ErrMaxValueLenExceeded
+			}
+
+			_, err := s.MergeOperator(merge.Operator)
+			if err != nil {
+				return err
+			}
+		}
+
 		ks := slices.BytesToString(kv.Key)
 		if _, ok := m[ks]; ok {
 			return ErrDuplicatedKey
@@ -3422,6 +3618,20 @@ func (s *ImmuStore) Close() error {
 
 	s.closed = true
 
+	if s.vLogGCStop != nil {
+		s.vLogGCStop()
+		<-s.vLogGCDone
+	}
+
+	s.redactionMutex.Lock()
+	if s.redactionStop != nil {
+		s.redactionStop()
+	}
+	s.redactionMutex.Unlock()
+
+	// redactions being applied are completed, the ones waiting to be committed are resumed upon opening
+	s.redactionWg.Wait()
+
 	merr := multierr.NewMultiErr()
 
 	for _, indexer := range s.indexers {
@@ -3458,6 +3668,20 @@ func (s *ImmuStore) Close() error {
 	err = s.aht.Close()
 	merr.Append(err)
 
+	s.redactionMutex.Lock()
+	if s.redactionLog != nil {
+		err = s.redactionLog.Close()
+		merr.Append(err)
+	}
+	s.redactionMutex.Unlock()
+
+	s.vLogGCMutex.Lock()
+	if s.vLogGCLog != nil {
+		err = s.vLogGCLog.Close()
+		merr.Append(err)
+	}
+	s.vLogGCMutex.Unlock()
+
 	used, _, _ := s.txPool.Stats()
 	if used > 0 {
 		merr.Append(errors.New("not all tx holders were released"))
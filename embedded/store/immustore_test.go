@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -945,6 +946,46 @@ func TestImmudbStoreEdgeCases(t *testing.T) {
 		})
 		require.ErrorIs(t, err, ErrInvalidPrecondition)
 		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionValueDigestMustMatch{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionValueDigestMustMatch{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMetadataMustMatch{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMetadataMustMatch{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyCountInRange{
+				Prefix: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyCountInRange{
+				MinCount: 2,
+				MaxCount: 1,
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidCountRange)
 	})
 }
 
@@ -1812,6 +1853,164 @@ func TestImmudbStoreHistoricalValues(t *testing.T) {
 	wg.Wait()
 }
 
+func TestImmudbStoreIncrementalIndexCompaction(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
+	opts.WithIndexOptions(opts.IndexOpts.
+		WithFlushThld(10).
+		WithIncrementalCompaction(true).
+		WithIncrementalCompactionStepSize(1024).
+		WithIncrementalCompactionDelay(0))
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	txCount := 10
+	eCount := 10
+
+	// entries which are never updated, so that the index is not entirely outdated
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	for j := 0; j < 100; j++ {
+		err = tx.Set([]byte(fmt.Sprintf("static%d", j)), nil, []byte("value"))
+		require.NoError(t, err)
+	}
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	for i := 0; i < txCount; i++ {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		for j := 0; j < eCount; j++ {
+			k := make([]byte, 8)
+			binary.BigEndian.PutUint64(k, uint64(j))
+
+			v := make([]byte, 8)
+			binary.BigEndian.PutUint64(v, uint64(i))
+
+			err = tx.Set(k, nil, v)
+			require.NoError(t, err)
+		}
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), uint64(txCount+1))
+	require.NoError(t, err)
+
+	err = immuStore.FlushIndexes(0, true)
+	require.NoError(t, err)
+
+	err = immuStore.CompactIndexes()
+	require.NoError(t, err)
+
+	checkValues := func(immuStore *ImmuStore) {
+		for j := 0; j < eCount; j++ {
+			k := make([]byte, 8)
+			binary.BigEndian.PutUint64(k, uint64(j))
+
+			v := make([]byte, 8)
+			binary.BigEndian.PutUint64(v, uint64(txCount-1))
+
+			valRef, err := immuStore.Get(context.Background(), k)
+			require.NoError(t, err)
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, v, val)
+
+			_, hCount, err := immuStore.History(k, 0, false, txCount)
+			require.NoError(t, err)
+			require.EqualValues(t, txCount, hCount)
+		}
+	}
+
+	checkValues(immuStore)
+
+	// the index is not dumped into a new snapshot folder
+	_, err = os.Stat(filepath.Join(dir, indexDirname, "nodes"))
+	require.NoError(t, err)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	checkValues(immuStore)
+}
+
+func TestImmudbStoreIncrementalIndexCompactionInterruptedOnClose(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
+	opts.WithIndexOptions(opts.IndexOpts.
+		WithFlushThld(10).
+		WithIncrementalCompaction(true).
+		WithIncrementalCompactionStepSize(1024).
+		WithIncrementalCompactionDelay(time.Hour))
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		for j := 0; j < 100; j++ {
+			if i > 0 && j >= 10 {
+				// most entries are never updated, so that the index is not entirely outdated
+				break
+			}
+
+			err = tx.Set([]byte(fmt.Sprintf("key%03d", j)), nil, []byte(fmt.Sprintf("value%d", i)))
+			require.NoError(t, err)
+		}
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), 10)
+	require.NoError(t, err)
+
+	err = immuStore.FlushIndexes(0, true)
+	require.NoError(t, err)
+
+	indexer, err := immuStore.getIndexerFor([]byte("key000"))
+	require.NoError(t, err)
+
+	compacted := make(chan error)
+
+	go func() {
+		compacted <- immuStore.CompactIndexes()
+	}()
+
+	require.Eventually(t, indexer.index.IncrementalCompactionPending, 10*time.Second, 10*time.Millisecond)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+	require.ErrorIs(t, <-compacted, ErrAlreadyClosed)
+
+	// the compaction is resumed in background and interrupted again on close
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	indexer, err = immuStore.getIndexerFor([]byte("key000"))
+	require.NoError(t, err)
+	require.True(t, indexer.index.IncrementalCompactionPending())
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+}
+
 func TestImmudbStoreCompapactionDisabled(t *testing.T) {
 	opts := DefaultOptions().WithCompactionDisabled(true)
 	immuStore, err := Open(t.TempDir(), opts)
@@ -1925,6 +2124,37 @@ func TestImmudbStoreInclusionProof(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, value, v)
 		}
+
+		// keys are provided in reverse order, proven leaves are sorted by entry index
+		var keys [][]byte
+		var digests [][sha256.Size]byte
+
+		for j := len(txEntries) - 1; j >= 0; j -= 2 {
+			keys = append(keys, txEntries[j].key())
+		}
+
+		for j := len(txEntries) - 1 - 2*(len(keys)-1); j < len(txEntries); j += 2 {
+			value, err := immuStore.ReadValue(txEntries[j])
+			require.NoError(t, err)
+
+			eSpec := &EntrySpec{Key: txEntries[j].key(), Metadata: NewKVMetadata(), Value: value}
+			digests = append(digests, entrySpecDigest(eSpec))
+		}
+
+		multiProof, err := tx.MultiProof(keys)
+		require.NoError(t, err)
+		require.Len(t, multiProof.Leaves, len(keys))
+		require.True(t, VerifyMultiInclusion(multiProof, digests, tx.header.Eh))
+		require.False(t, VerifyMultiInclusion(multiProof, digests[1:], tx.header.Eh))
+
+		_, err = tx.MultiProof(nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = tx.MultiProof([][]byte{keys[0], keys[0]})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = tx.MultiProof([][]byte{keys[0], []byte("non-existent-key")})
+		require.ErrorIs(t, err, ErrKeyNotFound)
 	}
 
 	t.Run("reading value from non-readonly entry should fail", func(t *testing.T) {
@@ -2349,6 +2579,59 @@ func TestReOpeningWithCompressionEnabledImmudbStore(t *testing.T) {
 	}
 }
 
+func TestReOpeningWithDifferentCompressionFormats(t *testing.T) {
+	dir := t.TempDir()
+
+	formats := []int{
+		appendable.NoCompression,
+		appendable.FlateCompression,
+		appendable.ZStdCompression,
+		appendable.LZ4Compression,
+	}
+
+	txCount := 10
+
+	for it, format := range formats {
+		opts := DefaultOptions().
+			WithSynced(false).
+			WithFileSize(256).
+			WithCompressionFormat(format).
+			WithMaxConcurrency(1)
+
+		immuStore, err := Open(dir, opts)
+		require.NoError(t, err)
+
+		for i := 0; i < txCount; i++ {
+			tx, err := immuStore.NewWriteOnlyTx(context.Background())
+			require.NoError(t, err)
+
+			err = tx.Set([]byte(fmt.Sprintf("key_%d_%d", it, i)), nil, []byte(fmt.Sprintf(`{"format":%d,"value":%d}`, format, i)))
+			require.NoError(t, err)
+
+			_, err = tx.AsyncCommit(context.Background())
+			require.NoError(t, err)
+		}
+
+		err = immuStore.WaitForIndexingUpto(context.Background(), uint64((it+1)*txCount))
+		require.NoError(t, err)
+
+		// values written using previous formats remain readable
+		for prevIt := 0; prevIt <= it; prevIt++ {
+			for i := 0; i < txCount; i++ {
+				valRef, err := immuStore.Get(context.Background(), []byte(fmt.Sprintf("key_%d_%d", prevIt, i)))
+				require.NoError(t, err)
+
+				val, err := valRef.Resolve()
+				require.NoError(t, err)
+				require.Equal(t, []byte(fmt.Sprintf(`{"format":%d,"value":%d}`, formats[prevIt], i)), val)
+			}
+		}
+
+		err = immuStore.Close()
+		require.NoError(t, err)
+	}
+}
+
 func TestUncommittedTxOverwriting(t *testing.T) {
 	path := t.TempDir()
 
@@ -2873,6 +3156,87 @@ func TestImmudbStoreCommitWithPreconditions(t *testing.T) {
 		_, err = otx.Commit(context.Background())
 		require.ErrorIs(t, err, ErrPreconditionFailed)
 	})
+
+	commitWithPrecondition := func(c Precondition) error {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("otherKey"), nil, []byte("otherValue"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(c)
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		return err
+	}
+
+	t.Run("value digest constraint should pass only when the current value matches", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("key2"), Digest: sha256.Sum256([]byte("value2"))})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("key2"), Digest: sha256.Sum256([]byte("value3"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("key1"), Digest: sha256.Sum256([]byte("value1"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("expirableKey"), Digest: sha256.Sum256([]byte("expirableValue"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("value digest constraint with value prefix should pass only when the current value matches", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("key2"), ValuePrefix: []byte("val"), Digest: sha256.Sum256([]byte("ue2"))})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("key2"), ValuePrefix: []byte("val"), Digest: sha256.Sum256([]byte("value2"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionValueDigestMustMatch{Key: []byte("key2"), ValuePrefix: []byte("x"), Digest: sha256.Sum256([]byte("alue2"))})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("metadata constraint should be evaluated over the latest entry", func(t *testing.T) {
+		err := commitWithPrecondition(&PreconditionKeyMetadataMustMatch{Key: []byte("key2")})
+		require.NoError(t, err)
+
+		deletedMD := NewKVMetadata()
+		err = deletedMD.AsDeleted(true)
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyMetadataMustMatch{Key: []byte("key2"), Metadata: deletedMD})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionKeyMetadataMustMatch{Key: []byte("key1"), Metadata: deletedMD})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyMetadataMustMatch{Key: []byte("key1")})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionKeyMetadataMustMatch{Key: []byte("expirableKey"), Metadata: md})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyMetadataMustMatch{Key: []byte("nonExistentKey")})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("key count constraint should only consider live keys", func(t *testing.T) {
+		// key1 was deleted while key2, key3, key4 and key5 exist
+		err := commitWithPrecondition(&PreconditionKeyCountInRange{Prefix: []byte("key"), MinCount: 4, MaxCount: 4})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyCountInRange{Prefix: []byte("key"), MinCount: 0, MaxCount: 3})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionKeyCountInRange{Prefix: []byte("key"), MinCount: 5, MaxCount: math.MaxUint64})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = commitWithPrecondition(&PreconditionKeyCountInRange{Prefix: []byte("expirable"), MinCount: 0, MaxCount: 0})
+		require.NoError(t, err)
+
+		err = commitWithPrecondition(&PreconditionKeyCountInRange{MinCount: 1, MaxCount: math.MaxUint64})
+		require.NoError(t, err)
+	})
 }
 
 func BenchmarkSyncedAppend(b *testing.B) {
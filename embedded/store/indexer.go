@@ -69,6 +69,14 @@ type indexer struct {
 
 	closed bool
 
+	incrementalCompaction bool
+
+	// closing the indexer interrupts ongoing incremental compactions,
+	// including the ones resumed in background after opening the index
+	compactionCtx    context.Context
+	cancelCompaction context.CancelFunc
+	compactionWg     sync.WaitGroup
+
 	compactionMutex sync.Mutex
 	rwmutex         sync.RWMutex
 
@@ -133,7 +141,12 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		WithRenewSnapRootAfter(opts.IndexOpts.RenewSnapRootAfter).
 		WithCompactionThld(opts.IndexOpts.CompactionThld).
 		WithDelayDuringCompaction(opts.IndexOpts.DelayDuringCompaction).
+		WithIncrementalCompaction(opts.IndexOpts.IncrementalCompaction).
+		WithIncrementalCompactionStepSize(opts.IndexOpts.IncrementalCompactionStepSize).
+		WithIncrementalCompactionDelay(opts.IndexOpts.IncrementalCompactionDelay).
+		WithBloomFilterBitsPerKey(opts.IndexOpts.BloomFilterBitsPerKey).
 		WithMaxBufferedDataSize(opts.IndexOpts.MaxBufferedDataSize).
+		WithEncryption(opts.EncryptionAlgorithm, opts.KeyRing).
 		WithOnFlushFunc(func(releasedDataSize int) {
 			store.memSemaphore.Release(uint64(releasedDataSize))
 		})
@@ -170,6 +183,7 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		tx:                     tx,
 		maxBulkSize:            opts.IndexOpts.MaxBulkSize,
 		bulkPreparationTimeout: opts.IndexOpts.BulkPreparationTimeout,
+		incrementalCompaction:  opts.IndexOpts.IncrementalCompaction,
 		_kvs:                   kvs,
 		path:                   path,
 		index:                  index,
@@ -178,6 +192,8 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		stateCond:              sync.NewCond(&sync.Mutex{}),
 	}
 
+	indexer.compactionCtx, indexer.cancelCompaction = context.WithCancel(context.Background())
+
 	dbName := filepath.Base(store.path)
 	indexer.metricsLastIndexedTrx = metricsLastIndexedTrxId.WithLabelValues(dbName)
 	indexer.metricsLastCommittedTrx = metricsLastCommittedTrx.WithLabelValues(dbName)
@@ -192,6 +208,16 @@ func (idx *indexer) init(spec *IndexSpec) {
 	idx.spec = spec
 
 	idx.resume()
+
+	if idx.incrementalCompaction && idx.index.IncrementalCompactionPending() {
+		// resume the compaction interrupted when the index was closed
+		idx.compactionWg.Add(1)
+
+		go func() {
+			defer idx.compactionWg.Done()
+			idx.CompactIndex()
+		}()
+	}
 }
 
 func (idx *indexer) SourcePrefix() []byte {
@@ -250,8 +276,8 @@ func (idx *indexer) History(key []byte, offset uint64, descOrder bool, limit int
 }
 
 func (idx *indexer) Snapshot() (*tbtree.Snapshot, error) {
-	idx.compactionMutex.Lock()
-	defer idx.compactionMutex.Unlock()
+	idx.lockIndexReplacement()
+	defer idx.unlockIndexReplacement()
 
 	idx.rwmutex.RLock()
 	defer idx.rwmutex.RUnlock()
@@ -264,8 +290,8 @@ func (idx *indexer) Snapshot() (*tbtree.Snapshot, error) {
 }
 
 func (idx *indexer) SnapshotMustIncludeTxIDWithRenewalPeriod(ctx context.Context, txID uint64, renewalPeriod time.Duration) (*tbtree.Snapshot, error) {
-	idx.compactionMutex.Lock()
-	defer idx.compactionMutex.Unlock()
+	idx.lockIndexReplacement()
+	defer idx.unlockIndexReplacement()
 
 	idx.rwmutex.RLock()
 	defer idx.rwmutex.RUnlock()
@@ -300,6 +326,12 @@ func (idx *indexer) Sync() error {
 }
 
 func (idx *indexer) Close() error {
+	// an ongoing incremental compaction is interrupted and resumed when the index is reopened
+	if idx.cancelCompaction != nil {
+		idx.cancelCompaction()
+	}
+	defer idx.compactionWg.Wait()
+
 	idx.compactionMutex.Lock()
 	defer idx.compactionMutex.Unlock()
 
@@ -330,6 +362,20 @@ func (idx *indexer) WaitForIndexingUpto(ctx context.Context, txID uint64) error
 	return watchers.ErrMaxWaitessLimitExceeded
 }
 
+// lockIndexReplacement prevents the index from being replaced by a compaction meanwhile,
+// incremental compactions do not replace the index thus they do not block its usage
+func (idx *indexer) lockIndexReplacement() {
+	if !idx.incrementalCompaction {
+		idx.compactionMutex.Lock()
+	}
+}
+
+func (idx *indexer) unlockIndexReplacement() {
+	if !idx.incrementalCompaction {
+		idx.compactionMutex.Unlock()
+	}
+}
+
 func (idx *indexer) CompactIndex() (err error) {
 	idx.compactionMutex.Lock()
 	defer idx.compactionMutex.Unlock()
@@ -346,6 +392,15 @@ func (idx *indexer) CompactIndex() (err error) {
 		}
 	}()
 
+	if idx.incrementalCompaction {
+		// the indexer is being closed when the compaction gets interrupted
+		_, err = idx.index.CompactIncrementally(idx.compactionCtx)
+		if errors.Is(err, tbtree.ErrAlreadyClosed) || errors.Is(err, context.Canceled) {
+			return ErrAlreadyClosed
+		}
+		return err
+	}
+
 	_, err = idx.index.Compact()
 	if errors.Is(err, tbtree.ErrAlreadyClosed) {
 		return ErrAlreadyClosed
@@ -358,8 +413,8 @@ func (idx *indexer) CompactIndex() (err error) {
 }
 
 func (idx *indexer) FlushIndex(cleanupPercentage float32, synced bool) (err error) {
-	idx.compactionMutex.Lock()
-	defer idx.compactionMutex.Unlock()
+	idx.lockIndexReplacement()
+	defer idx.unlockIndexReplacement()
 
 	_, _, err = idx.index.FlushWith(cleanupPercentage, synced)
 	if errors.Is(err, tbtree.ErrAlreadyClosed) {
@@ -0,0 +1,184 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// IncrementMergeOperator adds a big-endian int64 operand to a big-endian int64 value
+	IncrementMergeOperator = "increment"
+	// AppendMergeOperator appends the operand to the current value
+	AppendMergeOperator = "append"
+)
+
+// MergeOperator computes the new value of a key given its latest value and an operand.
+// exists is false when the key was not found, or it was deleted or expired.
+// Merge operators are executed at commit time, while holding the commit lock,
+// thus they must be deterministic and must not access the store.
+type MergeOperator func(current []byte, exists bool, operand []byte) ([]byte, error)
+
+// MergeOp is a pending modification of an entry whose value is computed at commit time
+type MergeOp struct {
+	Operator string
+	Operand  []byte
+}
+
+func defaultMergeOperators() map[string]MergeOperator {
+	return map[string]MergeOperator{
+		IncrementMergeOperator: incrementMergeOperator,
+		AppendMergeOperator:    appendMergeOperator,
+	}
+}
+
+// EncodeInt64 returns the encoding of an integer value used by the increment merge operator
+func EncodeInt64(n int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(n))
+	return b[:]
+}
+
+// DecodeInt64 decodes an integer value encoded with EncodeInt64
+func DecodeInt64(b []byte) (int64, error) {
+	if len(b) != 8 {
+		return 0, fmt.Errorf("%w: integer values must be 8 bytes long", ErrInvalidMergeValue)
+	}
+	return int64(binary.BigEndian.Uint64(b)), nil
+}
+
+func incrementMergeOperator(current []byte, exists bool, operand []byte) ([]byte, error) {
+	delta, err := DecodeInt64(operand)
+	if err != nil {
+		return nil, err
+	}
+
+	var n int64
+
+	if exists {
+		n, err = DecodeInt64(current)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if (delta > 0 && n > math.MaxInt64-delta) || (delta < 0 && n < math.MinInt64-delta) {
+		return nil, fmt.Errorf("%w: integer overflow", ErrInvalidMergeValue)
+	}
+
+	return EncodeInt64(n + delta), nil
+}
+
+func appendMergeOperator(current []byte, exists bool, operand []byte) ([]byte, error) {
+	v := make([]byte, len(current)+len(operand))
+	copy(v, current)
+	copy(v[len(current):], operand)
+	return v, nil
+}
+
+// RegisterMergeOperator makes a merge operator available to transactions under the given name
+func (s *ImmuStore) RegisterMergeOperator(name string, op MergeOperator) error {
+	if name == "" || op == nil {
+		return ErrIllegalArguments
+	}
+
+	s.mergeOperatorsMux.Lock()
+	defer s.mergeOperatorsMux.Unlock()
+
+	if _, ok := s.mergeOperators[name]; ok {
+		return fmt.Errorf("%w: '%s'", ErrMergeOperatorAlreadyRegistered, name)
+	}
+
+	s.mergeOperators[name] = op
+
+	return nil
+}
+
+// MergeOperator returns the merge operator registered under the given name
+func (s *ImmuStore) MergeOperator(name string) (MergeOperator, error) {
+	s.mergeOperatorsMux.RLock()
+	defer s.mergeOperatorsMux.RUnlock()
+
+	op, ok := s.mergeOperators[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrMergeOperatorNotFound, name)
+	}
+
+	return op, nil
+}
+
+func hasMerges(entries []*EntrySpec) bool {
+	for _, e := range entries {
+		if len(e.Merges) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveMerges replaces pending merges with the values they produce.
+// It must be called with the commit lock acquired and an up-to-date index.
+func (s *ImmuStore) resolveMerges(ctx context.Context, entries []*EntrySpec) error {
+	for _, e := range entries {
+		if len(e.Merges) == 0 {
+			continue
+		}
+
+		var current []byte
+
+		valRef, err := s.GetWithFilters(ctx, e.Key, IgnoreExpired, IgnoreDeleted)
+		if err != nil && !errors.Is(err, ErrKeyNotFound) {
+			return err
+		}
+
+		exists := err == nil
+
+		if exists {
+			current, err = valRef.Resolve()
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, m := range e.Merges {
+			op, err := s.MergeOperator(m.Operator)
+			if err != nil {
+				return err
+			}
+
+			current, err = op(current, exists, m.Operand)
+			if err != nil {
+				return fmt.Errorf("error applying merge operator '%s': %w", m.Operator, err)
+			}
+
+			exists = true
+		}
+
+		if len(current) > s.maxValueLen {
+			return ErrMaxValueLenExceeded
+		}
+
+		e.Value = current
+		e.Merges = nil
+	}
+
+	return nil
+}
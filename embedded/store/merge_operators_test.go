@@ -0,0 +1,295 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementMergeOperator(t *testing.T) {
+	v, err := incrementMergeOperator(nil, false, EncodeInt64(5))
+	require.NoError(t, err)
+	require.Equal(t, EncodeInt64(5), v)
+
+	v, err = incrementMergeOperator(v, true, EncodeInt64(-7))
+	require.NoError(t, err)
+
+	n, err := DecodeInt64(v)
+	require.NoError(t, err)
+	require.Equal(t, int64(-2), n)
+
+	_, err = incrementMergeOperator([]byte{1}, true, EncodeInt64(1))
+	require.ErrorIs(t, err, ErrInvalidMergeValue)
+
+	_, err = incrementMergeOperator(nil, false, []byte{1})
+	require.ErrorIs(t, err, ErrInvalidMergeValue)
+
+	_, err = incrementMergeOperator(EncodeInt64(math.MaxInt64), true, EncodeInt64(1))
+	require.ErrorIs(t, err, ErrInvalidMergeValue)
+
+	_, err = incrementMergeOperator(EncodeInt64(math.MinInt64), true, EncodeInt64(-1))
+	require.ErrorIs(t, err, ErrInvalidMergeValue)
+}
+
+func TestRegisterMergeOperator(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	err = st.RegisterMergeOperator("", appendMergeOperator)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = st.RegisterMergeOperator("max", nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = st.RegisterMergeOperator(AppendMergeOperator, appendMergeOperator)
+	require.ErrorIs(t, err, ErrMergeOperatorAlreadyRegistered)
+
+	err = st.RegisterMergeOperator("max", func(current []byte, exists bool, operand []byte) ([]byte, error) {
+		if exists && bytes.Compare(current, operand) > 0 {
+			return current, nil
+		}
+		return operand, nil
+	})
+	require.NoError(t, err)
+
+	for _, v := range []string{"b", "c", "a"} {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Merge([]byte("key"), nil, "max", []byte(v))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	valRef, err := st.Get(context.Background(), []byte("key"))
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, []byte("c"), val)
+
+	tx, err := st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Merge([]byte("key"), nil, "min", []byte("a"))
+	require.ErrorIs(t, err, ErrMergeOperatorNotFound)
+
+	err = tx.Cancel()
+	require.NoError(t, err)
+}
+
+func TestOngoingTxMerge(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	get := func(key string) []byte {
+		valRef, err := st.Get(context.Background(), []byte(key))
+		require.NoError(t, err)
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+
+		return val
+	}
+
+	t.Run("increment and append missing keys", func(t *testing.T) {
+		tx, err := st.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = tx.Increment([]byte("counter"), nil, 3)
+		require.NoError(t, err)
+
+		err = tx.Increment([]byte("counter"), nil, 4)
+		require.NoError(t, err)
+
+		err = tx.Append([]byte("log"), nil, []byte("a"))
+		require.NoError(t, err)
+
+		_, err = tx.Get(context.Background(), []byte("counter"))
+		require.ErrorIs(t, err, ErrPendingMerge)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, EncodeInt64(7), get("counter"))
+		require.Equal(t, []byte("a"), get("log"))
+	})
+
+	t.Run("merge after set within the same transaction", func(t *testing.T) {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("log"), nil, []byte("x"))
+		require.NoError(t, err)
+
+		err = tx.Append([]byte("log"), nil, []byte("y"))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []byte("xy"), get("log"))
+	})
+
+	t.Run("set after merge within the same transaction", func(t *testing.T) {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Increment([]byte("counter"), nil, 1)
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("counter"), nil, EncodeInt64(100))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, EncodeInt64(100), get("counter"))
+	})
+
+	t.Run("merge into a deleted key", func(t *testing.T) {
+		tx, err := st.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = tx.Delete(context.Background(), []byte("counter"))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		tx, err = st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Increment([]byte("counter"), nil, -1)
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, EncodeInt64(-1), get("counter"))
+	})
+
+	t.Run("merge failing at commit time", func(t *testing.T) {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Increment([]byte("log"), nil, 1)
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrInvalidMergeValue)
+	})
+
+	t.Run("merge with commit callback", func(t *testing.T) {
+		_, err := st.CommitWith(context.Background(), func(txID uint64, index KeyIndex) ([]*EntrySpec, []Precondition, error) {
+			return []*EntrySpec{
+				{
+					Key:    []byte("counter"),
+					Merges: []*MergeOp{{Operator: IncrementMergeOperator, Operand: EncodeInt64(11)}},
+				},
+			}, nil, nil
+		}, true)
+		require.NoError(t, err)
+
+		require.Equal(t, EncodeInt64(10), get("counter"))
+
+		_, err = st.CommitWith(context.Background(), func(txID uint64, index KeyIndex) ([]*EntrySpec, []Precondition, error) {
+			return []*EntrySpec{
+				{
+					Key:    []byte("counter"),
+					Merges: []*MergeOp{{Operator: "unknown"}},
+				},
+			}, nil, nil
+		}, true)
+		require.ErrorIs(t, err, ErrMergeOperatorNotFound)
+	})
+}
+
+func TestConcurrentIncrements(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	const workers = 10
+	const increments = 20
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	errs := make(chan error, workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < increments; j++ {
+				tx, err := st.NewTx(context.Background(), DefaultTxOptions())
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				err = tx.Increment([]byte("counter"), nil, 1)
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				_, err = tx.AsyncCommit(context.Background())
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.False(t, errors.Is(err, ErrTxReadConflict))
+		require.NoError(t, err)
+	}
+
+	err = st.WaitForIndexingUpto(context.Background(), st.LastCommittedTxID())
+	require.NoError(t, err)
+
+	valRef, err := st.Get(context.Background(), []byte("counter"))
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+
+	n, err := DecodeInt64(val)
+	require.NoError(t, err)
+	require.Equal(t, int64(workers*increments), n)
+}
@@ -95,6 +95,10 @@ type EntrySpec struct {
 	// isValueTruncated is true if the value is
 	// truncated. This is used during replication.
 	IsValueTruncated bool
+	// Merges, when present, are applied at commit time
+	// to the latest value of the key and the result
+	// is used as the value of the entry.
+	Merges []*MergeOp
 }
 
 func newOngoingTx(ctx context.Context, s *ImmuStore, opts *TxOptions) (*OngoingTx, error) {
@@ -379,6 +383,91 @@ func (tx *OngoingTx) SetTransient(key []byte, md *KVMetadata, value []byte) erro
 	return tx.set(key, md, value, hashValue, false, true)
 }
 
+// Merge registers a modification of the key which is resolved at commit time by applying the named
+// merge operator to the latest committed value. Merges do not add the key to the MVCC read-set,
+// so concurrent transactions merging into the same key do not conflict with each other.
+// If the key was already set within the transaction, the operator is applied to the pending value.
+func (tx *OngoingTx) Merge(key []byte, md *KVMetadata, operator string, operand []byte) error {
+	if tx.closed {
+		return ErrAlreadyClosed
+	}
+
+	if tx.IsReadOnly() {
+		return ErrReadOnlyTx
+	}
+
+	if len(operand) > tx.st.maxValueLen {
+		return ErrMaxValueLenExceeded
+	}
+
+	op, err := tx.st.MergeOperator(operator)
+	if err != nil {
+		return err
+	}
+
+	merges := []*MergeOp{{Operator: operator, Operand: operand}}
+
+	keyRef, isKeyUpdate := tx.entriesByKey[sha256.Sum256(key)]
+	_, isTransient := tx.transientEntries[keyRef]
+
+	if isKeyUpdate && !isTransient {
+		e := tx.entries[keyRef]
+
+		if len(e.Merges) == 0 {
+			exists := e.Metadata == nil || !e.Metadata.Deleted()
+
+			var current []byte
+			if exists {
+				current = e.Value
+			}
+
+			value, err := op(current, exists, operand)
+			if err != nil {
+				return err
+			}
+
+			return tx.Set(key, md, value)
+		}
+
+		merges = append(e.Merges, merges...)
+	}
+
+	var hashValue [sha256.Size]byte
+
+	err = tx.set(key, md, nil, hashValue, false, false)
+	if err != nil {
+		return err
+	}
+
+	tx.entries[tx.entriesByKey[sha256.Sum256(key)]].Merges = merges
+
+	return nil
+}
+
+// Increment adds delta to the integer value of the key, encoded as with EncodeInt64.
+// Missing keys are considered to hold a zero value.
+func (tx *OngoingTx) Increment(key []byte, md *KVMetadata, delta int64) error {
+	return tx.Merge(key, md, IncrementMergeOperator, EncodeInt64(delta))
+}
+
+// Append appends the given bytes to the value of the key
+func (tx *OngoingTx) Append(key []byte, md *KVMetadata, value []byte) error {
+	return tx.Merge(key, md, AppendMergeOperator, value)
+}
+
+func (tx *OngoingTx) hasPendingMerges(key []byte) bool {
+	keyRef, ok := tx.entriesByKey[sha256.Sum256(key)]
+	if !ok {
+		return false
+	}
+
+	if _, isTransient := tx.transientEntries[keyRef]; isTransient {
+		return false
+	}
+
+	return len(tx.entries[keyRef].Merges) > 0
+}
+
 func (tx *OngoingTx) AddPrecondition(c Precondition) error {
 	if tx.closed {
 		return ErrAlreadyClosed
@@ -439,6 +528,10 @@ func (tx *OngoingTx) GetWithFilters(ctx context.Context, key []byte, filters ...
 		return nil, ErrWriteOnlyTx
 	}
 
+	if tx.hasPendingMerges(key) {
+		return nil, ErrPendingMerge
+	}
+
 	snap, err := tx.snap(key)
 	if err != nil {
 		if errors.Is(err, ErrIndexNotFound) {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/ahtree"
 	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 	"github.com/codenotary/immudb/embedded/appendable/multiapp"
 	"github.com/codenotary/immudb/embedded/cache"
 	"github.com/codenotary/immudb/embedded/logger"
@@ -44,6 +45,7 @@ const DefaultCompressionFormat = appendable.DefaultCompressionFormat
 const DefaultCompressionLevel = appendable.DefaultCompressionLevel
 const DefaultEmbeddedValues = false
 const DefaultPreallocFiles = false
+const DefaultEncryptionAlgorithm = appendable.DefaultEncryptionAlgorithm
 const DefaultTxLogCacheSize = 1000
 const DefaultVLogCacheSize = 0
 const DefaultMaxWaitees = 1000
@@ -58,6 +60,8 @@ const DefaultBulkPreparationTimeout = DefaultSyncFrequency
 const DefaultTruncationFrequency = 24 * time.Hour
 const MinimumRetentionPeriod = 24 * time.Hour
 const MinimumTruncationFrequency = 1 * time.Hour
+const DefaultVLogGCRetainedVersions = 1
+const DefaultVLogGCMinDiscardableRatio = 0.5
 
 const MaxFileSize = (1 << 31) - 1 // 2Gb
 
@@ -148,11 +152,21 @@ type Options struct {
 	EmbeddedValues    bool
 	PreallocFiles     bool
 
+	// zstd dictionaries, new value-log files are compressed using the first one unless it's nil
+	CompressionDictionaries [][]byte
+
+	// encryption of newly created files, existing files are read as they were written
+	EncryptionAlgorithm int
+	KeyRing             appendable.KeyRing
+
 	// options below affect indexing
 	IndexOpts *IndexOptions
 
 	// options below affect appendable hash tree
 	AHTOpts *AHTOptions
+
+	// options below affect value-log garbage collection
+	VLogGCOpts *VLogGCOptions
 }
 
 type IndexOptions struct {
@@ -192,6 +206,15 @@ type IndexOptions struct {
 	// Additional delay added during indexing when full compaction is in progress
 	DelayDuringCompaction time.Duration
 
+	// Compact the index by rewriting the subtrees referencing the oldest nodes instead of dumping the whole index
+	IncrementalCompaction bool
+
+	// Amount of nodes data (in bytes) released by each step of an incremental compaction
+	IncrementalCompactionStepSize int
+
+	// Delay between the steps of an incremental compaction
+	IncrementalCompactionDelay time.Duration
+
 	// Maximum number of simultaneously opened nodes files
 	NodesLogMaxOpenedFiles int
 
@@ -206,6 +229,9 @@ type IndexOptions struct {
 
 	// Maximum time waiting for more transactions to be committed and included into the same bulk
 	BulkPreparationTimeout time.Duration
+
+	// Number of bits per key of the bloom filter used to skip lookups of missing keys, zero disables it
+	BloomFilterBitsPerKey int
 }
 
 type AHTOptions struct {
@@ -216,6 +242,32 @@ type AHTOptions struct {
 	WriteBufferSize int
 }
 
+type VLogGCOptions struct {
+	// Number of most recent versions of each key whose values are retained
+	RetainedVersions int
+
+	// Values written within this period are retained regardless of the number of versions
+	RetentionPeriod time.Duration
+
+	// Minimum fraction of discardable data in a value-log file for it to be compacted
+	MinDiscardableRatio float64
+
+	// Time between automatic garbage collection runs, zero disables them
+	Frequency time.Duration
+
+	// Only values of keys with any of these prefixes are garbage collected, all keys if empty
+	KeyPrefixes [][]byte
+
+	// Resolves the values pinned by entries referencing a specific version of a key,
+	// pinned values are never garbage collected
+	PinnedValueFunc PinnedValueFunc
+}
+
+// PinnedValueFunc returns the key and transaction of the value pinned by an entry, if any,
+// e.g. a reference to a specific version of a key. A nil key is returned when no value is pinned.
+// value reads the value of the entry, it's only needed when the key does not suffice.
+type PinnedValueFunc func(key []byte, value func() ([]byte, error)) (pinnedKey []byte, atTx uint64, err error)
+
 func DefaultOptions() *Options {
 	return &Options{
 		ReadOnly:        false,
@@ -256,8 +308,11 @@ func DefaultOptions() *Options {
 		EmbeddedValues:    DefaultEmbeddedValues,
 		PreallocFiles:     DefaultPreallocFiles,
 
-		IndexOpts: DefaultIndexOptions(),
-		AHTOpts:   DefaultAHTOptions(),
+		EncryptionAlgorithm: DefaultEncryptionAlgorithm,
+
+		IndexOpts:  DefaultIndexOptions(),
+		AHTOpts:    DefaultAHTOptions(),
+		VLogGCOpts: DefaultVLogGCOptions(),
 	}
 }
 
@@ -279,8 +334,13 @@ func DefaultIndexOptions() *IndexOptions {
 		HistoryLogMaxOpenedFiles:  tbtree.DefaultHistoryLogMaxOpenedFiles,
 		CommitLogMaxOpenedFiles:   tbtree.DefaultCommitLogMaxOpenedFiles,
 
+		IncrementalCompaction:         false,
+		IncrementalCompactionStepSize: tbtree.DefaultIncrementalCompactionStepSize,
+		IncrementalCompactionDelay:    tbtree.DefaultIncrementalCompactionDelay,
+
 		MaxBulkSize:            DefaultIndexingMaxBulkSize,
 		BulkPreparationTimeout: DefaultBulkPreparationTimeout,
+		BloomFilterBitsPerKey:  tbtree.DefaultBloomFilterBitsPerKey,
 	}
 }
 
@@ -291,6 +351,13 @@ func DefaultAHTOptions() *AHTOptions {
 	}
 }
 
+func DefaultVLogGCOptions() *VLogGCOptions {
+	return &VLogGCOptions{
+		RetainedVersions:    DefaultVLogGCRetainedVersions,
+		MinDiscardableRatio: DefaultVLogGCMinDiscardableRatio,
+	}
+}
+
 func (opts *Options) Validate() error {
 	if opts == nil {
 		return fmt.Errorf("%w: nil options", ErrInvalidOptions)
@@ -370,13 +437,32 @@ func (opts *Options) Validate() error {
 	if opts.logger == nil {
 		return fmt.Errorf("%w: invalid log", ErrInvalidOptions)
 	}
+	if opts.EncryptionAlgorithm != appendable.NoEncryption && !encryption.IsSupportedAlgorithm(opts.EncryptionAlgorithm) {
+		return fmt.Errorf("%w: invalid EncryptionAlgorithm", ErrInvalidOptions)
+	}
+	if opts.EncryptionAlgorithm != appendable.NoEncryption && opts.KeyRing == nil {
+		return fmt.Errorf("%w: invalid KeyRing", ErrInvalidOptions)
+	}
+	if opts.EncryptionAlgorithm != appendable.NoEncryption && opts.PreallocFiles {
+		return fmt.Errorf("%w: PreallocFiles can not be used with encryption", ErrInvalidOptions)
+	}
 
 	err := opts.IndexOpts.Validate()
 	if err != nil {
 		return err
 	}
 
-	return opts.AHTOpts.Validate()
+	err = opts.AHTOpts.Validate()
+	if err != nil {
+		return err
+	}
+
+	// default vlog gc options are used when not specified
+	if opts.VLogGCOpts == nil {
+		return nil
+	}
+
+	return opts.VLogGCOpts.Validate()
 }
 
 func (opts *IndexOptions) Validate() error {
@@ -419,6 +505,12 @@ func (opts *IndexOptions) Validate() error {
 	if opts.DelayDuringCompaction < 0 {
 		return fmt.Errorf("%w: invalid index option DelayDuringCompaction", ErrInvalidOptions)
 	}
+	if opts.IncrementalCompactionStepSize <= 0 {
+		return fmt.Errorf("%w: invalid index option IncrementalCompactionStepSize", ErrInvalidOptions)
+	}
+	if opts.IncrementalCompactionDelay < 0 {
+		return fmt.Errorf("%w: invalid index option IncrementalCompactionDelay", ErrInvalidOptions)
+	}
 	if opts.RenewSnapRootAfter < 0 {
 		return fmt.Errorf("%w: invalid index option RenewSnapRootAfter", ErrInvalidOptions)
 	}
@@ -437,6 +529,9 @@ func (opts *IndexOptions) Validate() error {
 	if opts.CommitLogMaxOpenedFiles <= 0 {
 		return fmt.Errorf("%w: invalid index option CommitLogMaxOpenedFiles", ErrInvalidOptions)
 	}
+	if opts.BloomFilterBitsPerKey < 0 || opts.BloomFilterBitsPerKey > tbtree.MaxBloomFilterBitsPerKey {
+		return fmt.Errorf("%w: invalid index option BloomFilterBitsPerKey", ErrInvalidOptions)
+	}
 
 	return nil
 }
@@ -455,6 +550,26 @@ func (opts *AHTOptions) Validate() error {
 	return nil
 }
 
+func (opts *VLogGCOptions) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil vlog gc options ", ErrInvalidOptions)
+	}
+	if opts.RetainedVersions <= 0 {
+		return fmt.Errorf("%w: invalid vlog gc option RetainedVersions", ErrInvalidOptions)
+	}
+	if opts.RetentionPeriod < 0 {
+		return fmt.Errorf("%w: invalid vlog gc option RetentionPeriod", ErrInvalidOptions)
+	}
+	if opts.MinDiscardableRatio <= 0 || opts.MinDiscardableRatio > 1 {
+		return fmt.Errorf("%w: invalid vlog gc option MinDiscardableRatio", ErrInvalidOptions)
+	}
+	if opts.Frequency < 0 {
+		return fmt.Errorf("%w: invalid vlog gc option Frequency", ErrInvalidOptions)
+	}
+
+	return nil
+}
+
 func (opts *Options) WithReadOnly(readOnly bool) *Options {
 	opts.ReadOnly = readOnly
 	return opts
@@ -600,6 +715,11 @@ func (opts *Options) WithCompresionLevel(compressionLevel int) *Options {
 	return opts
 }
 
+func (opts *Options) WithCompressionDictionaries(dicts ...[]byte) *Options {
+	opts.CompressionDictionaries = dicts
+	return opts
+}
+
 func (opts *Options) WithEmbeddedValues(embeddedValues bool) *Options {
 	opts.EmbeddedValues = embeddedValues
 	return opts
@@ -610,11 +730,24 @@ func (opts *Options) WithPreallocFiles(preallocFiles bool) *Options {
 	return opts
 }
 
+// WithEncryption sets the algorithm used to encrypt newly created files and
+// the key ring holding the data keys used to encrypt and decrypt them
+func (opts *Options) WithEncryption(encryptionAlgorithm int, keyRing appendable.KeyRing) *Options {
+	opts.EncryptionAlgorithm = encryptionAlgorithm
+	opts.KeyRing = keyRing
+	return opts
+}
+
 func (opts *Options) WithIndexOptions(indexOptions *IndexOptions) *Options {
 	opts.IndexOpts = indexOptions
 	return opts
 }
 
+func (opts *Options) WithVLogGCOptions(vLogGCOptions *VLogGCOptions) *Options {
+	opts.VLogGCOpts = vLogGCOptions
+	return opts
+}
+
 func (opts *Options) WithAHTOptions(ahtOptions *AHTOptions) *Options {
 	opts.AHTOpts = ahtOptions
 	return opts
@@ -682,6 +815,21 @@ func (opts *IndexOptions) WithDelayDuringCompaction(delayDuringCompaction time.D
 	return opts
 }
 
+func (opts *IndexOptions) WithIncrementalCompaction(incrementalCompaction bool) *IndexOptions {
+	opts.IncrementalCompaction = incrementalCompaction
+	return opts
+}
+
+func (opts *IndexOptions) WithIncrementalCompactionStepSize(stepSize int) *IndexOptions {
+	opts.IncrementalCompactionStepSize = stepSize
+	return opts
+}
+
+func (opts *IndexOptions) WithIncrementalCompactionDelay(delay time.Duration) *IndexOptions {
+	opts.IncrementalCompactionDelay = delay
+	return opts
+}
+
 func (opts *IndexOptions) WithNodesLogMaxOpenedFiles(nodesLogMaxOpenedFiles int) *IndexOptions {
 	opts.NodesLogMaxOpenedFiles = nodesLogMaxOpenedFiles
 	return opts
@@ -697,6 +845,11 @@ func (opts *IndexOptions) WithCommitLogMaxOpenedFiles(commitLogMaxOpenedFiles in
 	return opts
 }
 
+func (opts *IndexOptions) WithBloomFilterBitsPerKey(bitsPerKey int) *IndexOptions {
+	opts.BloomFilterBitsPerKey = bitsPerKey
+	return opts
+}
+
 func (opts *IndexOptions) WithMaxBufferedDataSize(size int) *IndexOptions {
 	opts.MaxBufferedDataSize = size
 	return opts
@@ -718,3 +871,35 @@ func (opts *AHTOptions) WithSyncThld(syncThld int) *AHTOptions {
 	opts.SyncThld = syncThld
 	return opts
 }
+
+// VLogGCOptions
+
+func (opts *VLogGCOptions) WithRetainedVersions(retainedVersions int) *VLogGCOptions {
+	opts.RetainedVersions = retainedVersions
+	return opts
+}
+
+func (opts *VLogGCOptions) WithRetentionPeriod(retentionPeriod time.Duration) *VLogGCOptions {
+	opts.RetentionPeriod = retentionPeriod
+	return opts
+}
+
+func (opts *VLogGCOptions) WithMinDiscardableRatio(minDiscardableRatio float64) *VLogGCOptions {
+	opts.MinDiscardableRatio = minDiscardableRatio
+	return opts
+}
+
+func (opts *VLogGCOptions) WithFrequency(frequency time.Duration) *VLogGCOptions {
+	opts.Frequency = frequency
+	return opts
+}
+
+func (opts *VLogGCOptions) WithKeyPrefixes(keyPrefixes [][]byte) *VLogGCOptions {
+	opts.KeyPrefixes = keyPrefixes
+	return opts
+}
+
+func (opts *VLogGCOptions) WithPinnedValueFunc(pinnedValueFunc PinnedValueFunc) *VLogGCOptions {
+	opts.PinnedValueFunc = pinnedValueFunc
+	return opts
+}
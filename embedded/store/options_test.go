@@ -85,6 +85,9 @@ func TestInvalidIndexOptions(t *testing.T) {
 		{"NodesLogMaxOpenedFiles", DefaultIndexOptions().WithNodesLogMaxOpenedFiles(0)},
 		{"HistoryLogMaxOpenedFiles", DefaultIndexOptions().WithHistoryLogMaxOpenedFiles(0)},
 		{"CommitLogMaxOpenedFiles", DefaultIndexOptions().WithCommitLogMaxOpenedFiles(0)},
+		{"BloomFilterBitsPerKey", DefaultIndexOptions().WithBloomFilterBitsPerKey(-1)},
+		{"IncrementalCompactionStepSize", DefaultIndexOptions().WithIncrementalCompactionStepSize(0)},
+		{"IncrementalCompactionDelay", DefaultIndexOptions().WithIncrementalCompactionDelay(-1)},
 		{"MaxGlobalBufferedDataSize", DefaultIndexOptions().WithMaxGlobalBufferedDataSize(0)},
 		{"MaxGlobalBufferedDataSize", DefaultIndexOptions().WithMaxGlobalBufferedDataSize(DefaultIndexOptions().MaxBufferedDataSize - 1)},
 		{"MaxBufferedDataSize", DefaultIndexOptions().WithMaxBufferedDataSize(0)},
@@ -111,6 +114,24 @@ func TestInvalidAHTOptions(t *testing.T) {
 	}
 }
 
+func TestInvalidVLogGCOptions(t *testing.T) {
+	for _, d := range []struct {
+		n    string
+		opts *VLogGCOptions
+	}{
+		{"nil", nil},
+		{"empty", &VLogGCOptions{}},
+		{"RetainedVersions", DefaultVLogGCOptions().WithRetainedVersions(0)},
+		{"RetentionPeriod", DefaultVLogGCOptions().WithRetentionPeriod(-1)},
+		{"MinDiscardableRatio", DefaultVLogGCOptions().WithMinDiscardableRatio(1.5)},
+		{"Frequency", DefaultVLogGCOptions().WithFrequency(-1)},
+	} {
+		t.Run(d.n, func(t *testing.T) {
+			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
+		})
+	}
+}
+
 func TestDefaultOptions(t *testing.T) {
 	require.NoError(t, DefaultOptions().Validate())
 }
@@ -194,8 +215,12 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 10, indexOpts.WithNodesLogMaxOpenedFiles(10).NodesLogMaxOpenedFiles)
 	require.Equal(t, 11, indexOpts.WithHistoryLogMaxOpenedFiles(11).HistoryLogMaxOpenedFiles)
 	require.Equal(t, 12, indexOpts.WithCommitLogMaxOpenedFiles(12).CommitLogMaxOpenedFiles)
+	require.Equal(t, 16, indexOpts.WithBloomFilterBitsPerKey(16).BloomFilterBitsPerKey)
 	require.Equal(t, 3, indexOpts.WithCompactionThld(3).CompactionThld)
 	require.Equal(t, 1*time.Millisecond, indexOpts.WithDelayDuringCompaction(1*time.Millisecond).DelayDuringCompaction)
+	require.True(t, indexOpts.WithIncrementalCompaction(true).IncrementalCompaction)
+	require.Equal(t, 1024, indexOpts.WithIncrementalCompactionStepSize(1024).IncrementalCompactionStepSize)
+	require.Equal(t, 1*time.Millisecond, indexOpts.WithIncrementalCompactionDelay(1*time.Millisecond).IncrementalCompactionDelay)
 	require.Equal(t, 4096*2, indexOpts.WithFlushBufferSize(4096*2).FlushBufferSize)
 	require.Equal(t, float32(10), indexOpts.WithCleanupPercentage(10).CleanupPercentage)
 	require.Equal(t, int(10), indexOpts.WithMaxBufferedDataSize(10).MaxBufferedDataSize)
@@ -212,4 +237,16 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 10_000, ahtOpts.WithSyncThld(10_000).SyncThld)
 
 	require.NoError(t, opts.Validate())
+
+	vLogGCOpts := &VLogGCOptions{}
+	opts.WithVLogGCOptions(vLogGCOpts)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	require.Equal(t, 2, vLogGCOpts.WithRetainedVersions(2).RetainedVersions)
+	require.Equal(t, time.Hour, vLogGCOpts.WithRetentionPeriod(time.Hour).RetentionPeriod)
+	require.Equal(t, 0.25, vLogGCOpts.WithMinDiscardableRatio(0.25).MinDiscardableRatio)
+	require.Equal(t, time.Minute, vLogGCOpts.WithFrequency(time.Minute).Frequency)
+	require.Equal(t, [][]byte{{0}}, vLogGCOpts.WithKeyPrefixes([][]byte{{0}}).KeyPrefixes)
+
+	require.NoError(t, opts.Validate())
 }
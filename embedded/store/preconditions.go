@@ -17,8 +17,12 @@ limitations under the License.
 package store
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
+	"math"
 
 	"github.com/codenotary/immudb/embedded/tbtree"
 )
@@ -123,3 +127,139 @@ func (cs *PreconditionKeyNotModifiedAfterTx) Check(ctx context.Context, idx KeyI
 
 	return valRef.Tx() <= cs.TxID, nil
 }
+
+// PreconditionValueDigestMustMatch only succeeds if the key exists and the sha256 digest of its value
+// is equal to the given one. When a value prefix is specified, the value must start with it and the
+// digest is calculated over the remaining bytes.
+type PreconditionValueDigestMustMatch struct {
+	Key         []byte
+	ValuePrefix []byte
+	Digest      [sha256.Size]byte
+}
+
+func (cs *PreconditionValueDigestMustMatch) String() string { return "ValueDigestMustMatch" }
+
+func (cs *PreconditionValueDigestMustMatch) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionValueDigestMustMatch) Check(ctx context.Context, idx KeyIndex) (bool, error) {
+	valRef, err := idx.Get(ctx, cs.Key)
+	if errors.Is(err, ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if len(cs.ValuePrefix) == 0 {
+		// the digest of the value is already known, no need to read it
+		return valRef.HVal() == cs.Digest, nil
+	}
+
+	val, err := valRef.Resolve()
+	if err != nil {
+		return false, err
+	}
+
+	if !bytes.HasPrefix(val, cs.ValuePrefix) {
+		return false, nil
+	}
+
+	return sha256.Sum256(val[len(cs.ValuePrefix):]) == cs.Digest, nil
+}
+
+// PreconditionKeyMetadataMustMatch only succeeds if the metadata of the latest entry of the key,
+// even if it was deleted or expired, is equal to the given one. A nil metadata matches entries
+// which are neither deleted, expirable nor non-indexable.
+type PreconditionKeyMetadataMustMatch struct {
+	Key      []byte
+	Metadata *KVMetadata
+}
+
+func (cs *PreconditionKeyMetadataMustMatch) String() string { return "KeyMetadataMustMatch" }
+
+func (cs *PreconditionKeyMetadataMustMatch) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyMetadataMustMatch) Check(ctx context.Context, idx KeyIndex) (bool, error) {
+	// get the latest entry (it could be deleted or even expired)
+	valRef, err := idx.GetWithFilters(ctx, cs.Key)
+	if errors.Is(err, ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var expectedMD, currentMD []byte
+
+	if cs.Metadata != nil {
+		expectedMD = cs.Metadata.Bytes()
+	}
+
+	if valRef.KVMetadata() != nil {
+		currentMD = valRef.KVMetadata().Bytes()
+	}
+
+	return bytes.Equal(expectedMD, currentMD), nil
+}
+
+// PreconditionKeyCountInRange only succeeds if the number of keys with the given prefix,
+// not including deleted nor expired ones, is within the given bounds (both inclusive)
+type PreconditionKeyCountInRange struct {
+	Prefix   []byte
+	MinCount uint64
+	MaxCount uint64
+}
+
+func (cs *PreconditionKeyCountInRange) String() string { return "KeyCountInRange" }
+
+func (cs *PreconditionKeyCountInRange) Validate(st *ImmuStore) error {
+	if len(cs.Prefix) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	if cs.MinCount > cs.MaxCount {
+		return ErrInvalidPreconditionInvalidCountRange
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyCountInRange) Check(ctx context.Context, idx KeyIndex) (bool, error) {
+	counter, ok := idx.(keyCounter)
+	if !ok {
+		return false, fmt.Errorf("%w: keys can not be counted", ErrIllegalState)
+	}
+
+	limit := cs.MaxCount
+	if limit < math.MaxUint64 {
+		// there is no need to count beyond the upper bound
+		limit++
+	}
+
+	count, err := counter.countKeys(ctx, cs.Prefix, limit)
+	if err != nil {
+		return false, err
+	}
+
+	return count >= cs.MinCount && count <= cs.MaxCount, nil
+}
@@ -0,0 +1,668 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+)
+
+// redactionsDirname is the directory holding the offsets of the redacted values
+const redactionsDirname = "redactions"
+
+// openRedactionLog opens the log of redacted value offsets,
+// nil is returned when there is no such log and create is false
+func openRedactionLog(path string, opts *Options, create bool) (appendable.Appendable, error) {
+	if !create && (opts.appFactory == nil || opts.ReadOnly) {
+		_, err := os.Stat(filepath.Join(path, redactionsDirname))
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	appOpts := multiapp.DefaultOptions().
+		WithReadOnly(opts.ReadOnly).
+		WithRetryableSync(opts.Synced).
+		WithFileMode(opts.FileMode).
+		WithFileExt("red").
+		WithCompressionFormat(appendable.NoCompression).
+		WithEncryption(opts.EncryptionAlgorithm, opts.KeyRing)
+
+	if opts.appFactory != nil {
+		return opts.appFactory(path, redactionsDirname, appOpts)
+	}
+
+	return multiapp.Open(filepath.Join(path, redactionsDirname), appOpts)
+}
+
+// records of the redaction log
+const (
+	// pendingRedactionRecord is appended before committing a redaction transaction,
+	// it holds the metadata of the transaction and the last transaction precommitted before it
+	// i.e. [kind][sinceTxID][mdLen][md]
+	pendingRedactionRecord byte = 1
+
+	// appliedRedactionRecord resolves a pending redaction, it holds the offset of its record
+	// and the offsets of the values to be erased i.e. [kind][pendingOff][n][offsets]
+	appliedRedactionRecord byte = 2
+)
+
+// pendingRedaction is a redaction whose transaction may be committed but whose values
+// may not yet be erased
+type pendingRedaction struct {
+	off       int64 // offset of its record in the redaction log
+	sinceTxID uint64
+	md        *TxMetadata
+}
+
+// readRedactionLog loads the offsets of the redacted values and the pending redactions.
+// The size of the log up to the last complete record is returned as well.
+func readRedactionLog(redactionLog appendable.Appendable) (map[int64]struct{}, map[int64]*pendingRedaction, int64, error) {
+	redacted := make(map[int64]struct{})
+	pending := make(map[int64]*pendingRedaction)
+
+	if redactionLog == nil {
+		return redacted, pending, 0, nil
+	}
+
+	size, err := redactionLog.Size()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	r := appendable.NewReaderFrom(redactionLog, 0, 4096)
+
+	var off int64
+
+	// a partially written record is ignored as its redaction was not completed
+	for off < size {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		switch kind {
+		case pendingRedactionRecord:
+			sinceTxID, err := r.ReadUint64()
+			if errors.Is(err, io.EOF) {
+				return redacted, pending, off, nil
+			}
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			mdLen, err := r.ReadUint16()
+			if errors.Is(err, io.EOF) {
+				return redacted, pending, off, nil
+			}
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			mdBs := make([]byte, mdLen)
+
+			_, err = r.Read(mdBs)
+			if errors.Is(err, io.EOF) {
+				return redacted, pending, off, nil
+			}
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			md := NewTxMetadata()
+
+			err = md.ReadFrom(mdBs)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			if !md.HasRedactedEntries() {
+				return nil, nil, 0, fmt.Errorf("%w: invalid pending redaction", ErrCorruptedData)
+			}
+
+			pending[off] = &pendingRedaction{off: off, sinceTxID: sinceTxID, md: md}
+		case appliedRedactionRecord:
+			pendingOff, err := r.ReadUint64()
+			if errors.Is(err, io.EOF) {
+				return redacted, pending, off, nil
+			}
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			n, err := r.ReadUint16()
+			if errors.Is(err, io.EOF) {
+				return redacted, pending, off, nil
+			}
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			offs := make([]int64, n)
+
+			for i := range offs {
+				vOff, err := r.ReadUint64()
+				if errors.Is(err, io.EOF) {
+					return redacted, pending, off, nil
+				}
+				if err != nil {
+					return nil, nil, 0, err
+				}
+
+				offs[i] = int64(vOff)
+			}
+
+			for _, vOff := range offs {
+				redacted[vOff] = struct{}{}
+			}
+
+			delete(pending, int64(pendingOff))
+		default:
+			return nil, nil, 0, fmt.Errorf("%w: invalid redaction log record", ErrCorruptedData)
+		}
+
+		off = r.ReadCount()
+	}
+
+	return redacted, pending, off, nil
+}
+
+// RedactEntries erases the values of the entries of transaction txID with the given keys.
+// Entries are kept in the transaction, thus inclusion and consistency proofs remain
+// verifiable, but reading their values results in ErrValueRedacted.
+// The redaction is recorded as a new transaction whose metadata identifies the redacted entries,
+// it also holds a non-indexable entry without value for each of the given keys.
+// Values are erased once the transaction gets committed, if the store is closed before
+// they are erased the redaction is resumed upon opening it.
+// Stores of previous versions can not read nor replicate redaction transactions.
+func (s *ImmuStore) RedactEntries(ctx context.Context, txID uint64, keys [][]byte) (*TxHeader, error) {
+	if txID == 0 || len(keys) == 0 || len(keys) > MaxRedactedEntries {
+		return nil, fmt.Errorf("%w: invalid redaction arguments", ErrIllegalArguments)
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseAllocTx(tx)
+
+	err = s.ReadTx(txID, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]int, len(keys))
+
+	for i, key := range keys {
+		idx, err := tx.IndexOf(key)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries[:i] {
+			if e == idx {
+				return nil, fmt.Errorf("%w: duplicated key", ErrIllegalArguments)
+			}
+		}
+
+		entries[i] = idx
+	}
+
+	sort.Ints(entries)
+
+	md := NewTxMetadata()
+
+	err = md.WithRedactedEntries(txID, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	// the redaction is rejected before being committed if values can not be erased
+	_, err = s.redactableEntriesOf(tx, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	otx, err := s.NewWriteOnlyTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer otx.Cancel()
+
+	otx.WithMetadata(md)
+
+	for _, key := range keys {
+		emd := NewKVMetadata()
+
+		err = emd.AsNonIndexable(true)
+		if err != nil {
+			return nil, err
+		}
+
+		err = otx.Set(key, emd, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pending, err := s.registerPendingRedaction(s.LastPrecommittedTxID(), md)
+	if err != nil {
+		return nil, err
+	}
+
+	// values are erased only once the redaction is committed,
+	// if the commit fails the pending redaction is resolved upon opening the store
+	hdr, err := otx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.applyPendingRedaction(pending)
+	if err != nil {
+		return hdr, err
+	}
+
+	return hdr, nil
+}
+
+// checkRedaction validates the redaction recorded in the metadata can be applied
+// e.g. before replicating its transaction
+func (s *ImmuStore) checkRedaction(md *TxMetadata) error {
+	txID, entries, err := md.GetRedactedEntries()
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	err = s.readTx(txID, true, false, tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.redactableEntriesOf(tx, entries)
+	return err
+}
+
+// redactableEntriesOf returns the entries holding values to be erased,
+// ErrRedactionNotSupported is returned if any of them can not be overwritten
+func (s *ImmuStore) redactableEntriesOf(tx *Tx, entries []int) ([]*TxEntry, error) {
+	var redactable []*TxEntry
+
+	for _, i := range entries {
+		if i >= tx.header.NEntries {
+			return nil, fmt.Errorf("%w: invalid redaction info", ErrIllegalArguments)
+		}
+
+		e := tx.entries[i]
+
+		vLogID, _ := decodeOffset(e.vOff)
+
+		// empty values and values which were not stored (e.g. replicated truncated transactions) are skipped
+		if e.vLen == 0 || (!s.embeddedValues && vLogID == 0) {
+			continue
+		}
+
+		err := s.checkOverwritable(vLogID)
+		if err != nil {
+			return nil, err
+		}
+
+		redactable = append(redactable, e)
+	}
+
+	return redactable, nil
+}
+
+func (s *ImmuStore) checkOverwritable(vLogID byte) error {
+	vLog, err := s.fetchVLog(vLogID)
+	if err != nil {
+		return err
+	}
+	defer s.releaseVLog(vLogID)
+
+	_, ok := vLog.(appendable.Overwriter)
+	if !ok {
+		return ErrRedactionNotSupported
+	}
+
+	if vLog.CompressionFormat() != appendable.NoCompression {
+		return fmt.Errorf("%w: compressed values can not be overwritten", ErrRedactionNotSupported)
+	}
+
+	return nil
+}
+
+// registerPendingRedaction durably records the redaction before committing its transaction,
+// which is expected to be precommitted after sinceTxID
+func (s *ImmuStore) registerPendingRedaction(sinceTxID uint64, md *TxMetadata) (*pendingRedaction, error) {
+	s.redactionMutex.Lock()
+	defer s.redactionMutex.Unlock()
+
+	mdBs := md.Bytes()
+
+	b := make([]byte, 1+txIDSize+sszSize+len(mdBs))
+	b[0] = pendingRedactionRecord
+	binary.BigEndian.PutUint64(b[1:], sinceTxID)
+	binary.BigEndian.PutUint16(b[1+txIDSize:], uint16(len(mdBs)))
+	copy(b[1+txIDSize+sszSize:], mdBs)
+
+	off, err := s.appendRedactionRecord(b)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &pendingRedaction{off: off, sinceTxID: sinceTxID, md: md}
+
+	s.pendingRedactions[off] = pending
+
+	return pending, nil
+}
+
+// applyPendingRedaction erases the values of a pending redaction whose transaction was committed
+func (s *ImmuStore) applyPendingRedaction(pending *pendingRedaction) error {
+	txID, entries, err := pending.md.GetRedactedEntries()
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	err = s.readTx(txID, true, false, tx)
+	if err != nil {
+		return err
+	}
+
+	redactable, err := s.redactableEntriesOf(tx, entries)
+	if err != nil {
+		return err
+	}
+
+	return s.resolvePendingRedaction(pending, redactable)
+}
+
+// resolvePendingRedaction erases the values of the given entries and
+// durably records the redaction is no longer pending
+func (s *ImmuStore) resolvePendingRedaction(pending *pendingRedaction, entries []*TxEntry) error {
+	s.redactionMutex.Lock()
+	defer s.redactionMutex.Unlock()
+
+	if _, ok := s.pendingRedactions[pending.off]; !ok {
+		// already resolved
+		return nil
+	}
+
+	var redacted []*TxEntry
+
+	for _, e := range entries {
+		if _, alreadyRedacted := s.redactedValues[e.vOff]; !alreadyRedacted {
+			redacted = append(redacted, e)
+		}
+	}
+
+	// offsets are durably registered before overwriting the values,
+	// values of registered offsets are never read back
+	b := make([]byte, 1+offsetSize+sszSize+len(redacted)*offsetSize)
+	b[0] = appliedRedactionRecord
+	binary.BigEndian.PutUint64(b[1:], uint64(pending.off))
+	binary.BigEndian.PutUint16(b[1+offsetSize:], uint16(len(redacted)))
+
+	for i, e := range redacted {
+		binary.BigEndian.PutUint64(b[1+offsetSize+sszSize+i*offsetSize:], uint64(e.vOff))
+	}
+
+	_, err := s.appendRedactionRecord(b)
+	if err != nil {
+		return err
+	}
+
+	delete(s.pendingRedactions, pending.off)
+
+	for _, e := range redacted {
+		s.redactedValues[e.vOff] = struct{}{}
+	}
+
+	for _, e := range redacted {
+		err = s.overwriteValue(e.vOff, e.vLen)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendRedactionRecord durably appends the record to the redaction log, creating it if needed.
+// The caller must hold the redactionMutex lock.
+func (s *ImmuStore) appendRedactionRecord(b []byte) (int64, error) {
+	if s.redactionLog == nil {
+		redactionLog, err := openRedactionLog(s.path, s.opts, true)
+		if err != nil {
+			return 0, err
+		}
+
+		s.redactionLog = redactionLog
+	}
+
+	off, _, err := s.redactionLog.Append(b)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.redactionLog.Flush()
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.redactionLog.Sync()
+	if err != nil {
+		return 0, err
+	}
+
+	return off, nil
+}
+
+// applyPendingRedactionOnceCommitted erases the values of the pending redaction once
+// its transaction gets committed e.g. when the commit depends on an external allowance.
+// If the store is closed before, the redaction is resumed upon opening it.
+func (s *ImmuStore) applyPendingRedactionOnceCommitted(pending *pendingRedaction, txID uint64) {
+	s.redactionMutex.Lock()
+	defer s.redactionMutex.Unlock()
+
+	if s.redactionCtx.Err() != nil {
+		return
+	}
+
+	s.redactionWg.Add(1)
+
+	go func() {
+		defer s.redactionWg.Done()
+
+		err := s.commitWHub.WaitFor(s.redactionCtx, txID)
+		if err != nil {
+			return
+		}
+
+		err = s.applyPendingRedaction(pending)
+		if err != nil {
+			s.logger.Errorf("%v: while applying redaction of transaction %d at '%s'", err, txID, s.path)
+		}
+	}()
+}
+
+// resumePendingRedactions applies the redactions whose transactions were committed
+// but whose values may not have been erased, e.g. as the store was not properly closed.
+// Redactions whose transactions were not precommitted are discarded.
+func (s *ImmuStore) resumePendingRedactions() error {
+	if len(s.pendingRedactions) == 0 {
+		return nil
+	}
+
+	pendings := make([]*pendingRedaction, 0, len(s.pendingRedactions))
+
+	for _, pending := range s.pendingRedactions {
+		pendings = append(pendings, pending)
+	}
+
+	sort.Slice(pendings, func(i, j int) bool {
+		return pendings[i].off < pendings[j].off
+	})
+
+	committedTxID := s.LastCommittedTxID()
+	precommittedTxID := s.LastPrecommittedTxID()
+
+	for _, pending := range pendings {
+		txID, err := s.redactionTxOf(pending, precommittedTxID)
+		if err != nil {
+			return err
+		}
+
+		if s.readOnly {
+			// values can not be erased but they are not served
+			if txID > 0 && txID <= committedTxID {
+				err = s.markPendingRedaction(pending)
+			}
+		} else if txID == 0 {
+			err = s.resolvePendingRedaction(pending, nil)
+		} else if txID <= committedTxID {
+			err = s.applyPendingRedaction(pending)
+		} else {
+			s.applyPendingRedactionOnceCommitted(pending, txID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markPendingRedaction registers the values of the pending redaction as redacted without erasing them
+func (s *ImmuStore) markPendingRedaction(pending *pendingRedaction) error {
+	txID, entries, err := pending.md.GetRedactedEntries()
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	err = s.readTx(txID, true, false, tx)
+	if err != nil {
+		return err
+	}
+
+	s.redactionMutex.Lock()
+	defer s.redactionMutex.Unlock()
+
+	for _, i := range entries {
+		if i < tx.header.NEntries {
+			s.redactedValues[tx.entries[i].vOff] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// redactionTxOf returns the transaction of the pending redaction, zero if it was not precommitted
+func (s *ImmuStore) redactionTxOf(pending *pendingRedaction, precommittedTxID uint64) (uint64, error) {
+	for txID := pending.sinceTxID + 1; txID <= precommittedTxID; txID++ {
+		hdr, err := s.ReadTxHeader(txID, true, false)
+		if err != nil {
+			return 0, err
+		}
+
+		if hdr.Metadata != nil && hdr.Metadata.Equal(pending.md) {
+			return txID, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (s *ImmuStore) overwriteValue(off int64, vLen int) error {
+	vLogID, offset := decodeOffset(off)
+
+	vLog, err := s.fetchVLog(vLogID)
+	if err != nil {
+		return err
+	}
+	defer s.releaseVLog(vLogID)
+
+	ow, ok := vLog.(appendable.Overwriter)
+	if !ok {
+		return ErrRedactionNotSupported
+	}
+
+	_, err = ow.OverwriteAt(make([]byte, vLen), offset)
+	if errors.Is(err, io.EOF) {
+		// the value was already discarded by truncation
+		err = nil
+	}
+	if errors.Is(err, appendable.ErrOverwriteNotSupported) {
+		return fmt.Errorf("%w: %v", ErrRedactionNotSupported, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if s.vLogCache != nil {
+		s.vLogCache.Pop(off)
+	}
+
+	return nil
+}
+
+func (s *ImmuStore) isValueRedacted(off int64) bool {
+	s.redactionMutex.RLock()
+	defer s.redactionMutex.RUnlock()
+
+	_, ok := s.redactedValues[off]
+	return ok
+}
+
+func (s *ImmuStore) hasRedactedValues(tx *Tx) bool {
+	for _, e := range tx.Entries() {
+		if e.vLen > 0 && s.isValueRedacted(e.vOff) {
+			return true
+		}
+	}
+	return false
+}
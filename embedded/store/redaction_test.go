@@ -0,0 +1,432 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireNoFileContains(t *testing.T, dir string, data []byte) {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		bs, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if bytes.Contains(bs, data) {
+			return fmt.Errorf("'%s' found in file '%s'", data, path)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRedactEntries(t *testing.T) {
+	for _, embeddedValues := range []bool{false, true} {
+		t.Run(fmt.Sprintf("embeddedValues=%v", embeddedValues), func(t *testing.T) {
+			dir := t.TempDir()
+
+			opts := DefaultOptions().WithEmbeddedValues(embeddedValues)
+
+			st, err := Open(dir, opts)
+			require.NoError(t, err)
+
+			tx, err := st.NewWriteOnlyTx(context.Background())
+			require.NoError(t, err)
+
+			err = tx.Set([]byte("key1"), nil, []byte("personal-data"))
+			require.NoError(t, err)
+
+			err = tx.Set([]byte("key2"), nil, []byte("public-data"))
+			require.NoError(t, err)
+
+			hdr1, err := tx.Commit(context.Background())
+			require.NoError(t, err)
+
+			t.Run("invalid arguments", func(t *testing.T) {
+				_, err = st.RedactEntries(context.Background(), 0, [][]byte{[]byte("key1")})
+				require.ErrorIs(t, err, ErrIllegalArguments)
+
+				_, err = st.RedactEntries(context.Background(), hdr1.ID, nil)
+				require.ErrorIs(t, err, ErrIllegalArguments)
+
+				_, err = st.RedactEntries(context.Background(), hdr1.ID, [][]byte{[]byte("key1"), []byte("key1")})
+				require.ErrorIs(t, err, ErrIllegalArguments)
+
+				_, err = st.RedactEntries(context.Background(), hdr1.ID, [][]byte{[]byte("key3")})
+				require.ErrorIs(t, err, ErrKeyNotFound)
+
+				_, err = st.RedactEntries(context.Background(), hdr1.ID+1, [][]byte{[]byte("key1")})
+				require.ErrorIs(t, err, ErrTxNotFound)
+			})
+
+			hdr2, err := st.RedactEntries(context.Background(), hdr1.ID, [][]byte{[]byte("key1")})
+			require.NoError(t, err)
+			require.Equal(t, hdr1.ID+1, hdr2.ID)
+			require.Equal(t, 1, hdr2.NEntries)
+
+			redactedTxID, entries, err := hdr2.Metadata.GetRedactedEntries()
+			require.NoError(t, err)
+			require.Equal(t, hdr1.ID, redactedTxID)
+			require.Equal(t, []int{0}, entries)
+
+			// the redaction transaction holds a non-indexable entry without value for each redacted key
+			rtx := tempTxHolder(t, st)
+
+			err = st.ReadTx(hdr2.ID, false, rtx)
+			require.NoError(t, err)
+			require.Equal(t, []byte("key1"), rtx.Entries()[0].Key())
+			require.True(t, rtx.Entries()[0].Metadata().NonIndexable())
+			require.Zero(t, rtx.Entries()[0].VLen())
+
+			err = st.WaitForIndexingUpto(context.Background(), hdr2.ID)
+			require.NoError(t, err)
+
+			requireRedacted := func(st *ImmuStore) {
+				valRef, err := st.Get(context.Background(), []byte("key1"))
+				require.NoError(t, err)
+
+				_, err = valRef.Resolve()
+				require.ErrorIs(t, err, ErrValueRedacted)
+
+				valRef, err = st.Get(context.Background(), []byte("key2"))
+				require.NoError(t, err)
+
+				val, err := valRef.Resolve()
+				require.NoError(t, err)
+				require.Equal(t, []byte("public-data"), val)
+
+				// proofs for the redacted entry and its siblings remain valid
+				tx := tempTxHolder(t, st)
+
+				err = st.ReadTx(hdr1.ID, false, tx)
+				require.NoError(t, err)
+
+				entryDigest, err := tx.header.TxEntryDigest()
+				require.NoError(t, err)
+
+				for _, key := range [][]byte{[]byte("key1"), []byte("key2")} {
+					proof, err := tx.Proof(key)
+					require.NoError(t, err)
+
+					e, err := tx.EntryOf(key)
+					require.NoError(t, err)
+
+					digest, err := entryDigest(e)
+					require.NoError(t, err)
+
+					require.True(t, VerifyInclusion(proof, digest, tx.header.Eh))
+				}
+
+				lastHdr, err := st.ReadTxHeader(hdr2.ID, false, false)
+				require.NoError(t, err)
+
+				dualProof, err := st.DualProofV2(tx.Header(), lastHdr)
+				require.NoError(t, err)
+
+				err = VerifyDualProofV2(dualProof, hdr1.ID, hdr2.ID, hdr1.Alh(), hdr2.Alh())
+				require.NoError(t, err)
+			}
+
+			requireRedacted(st)
+
+			// redacting an already redacted entry only records the request
+			_, err = st.RedactEntries(context.Background(), hdr1.ID, [][]byte{[]byte("key1")})
+			require.NoError(t, err)
+
+			err = st.Close()
+			require.NoError(t, err)
+
+			requireNoFileContains(t, dir, []byte("personal-data"))
+
+			st, err = Open(dir, opts)
+			require.NoError(t, err)
+			defer immustoreClose(t, st)
+
+			requireRedacted(st)
+		})
+	}
+}
+
+func TestRedactEntriesNotSupportedWithCompression(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions().WithCompressionFormat(appendable.FlateCompression))
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	tx, err := st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	hdr, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	_, err = st.RedactEntries(context.Background(), hdr.ID, [][]byte{[]byte("key1")})
+	require.ErrorIs(t, err, ErrRedactionNotSupported)
+
+	// the redaction is not committed
+	require.Equal(t, hdr.ID, st.LastCommittedTxID())
+}
+
+func TestRedactEntriesResumedUponOpening(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := Open(dir, DefaultOptions())
+	require.NoError(t, err)
+
+	tx, err := st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("personal-data"))
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte("public-data"))
+	require.NoError(t, err)
+
+	hdr1, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// the store is closed after committing the redaction but before erasing the values
+	md := NewTxMetadata()
+
+	err = md.WithRedactedEntries(hdr1.ID, []int{0})
+	require.NoError(t, err)
+
+	_, err = st.registerPendingRedaction(st.LastPrecommittedTxID(), md)
+	require.NoError(t, err)
+
+	tx, err = st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	tx.WithMetadata(md)
+
+	emd := NewKVMetadata()
+
+	err = emd.AsNonIndexable(true)
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), emd, nil)
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// a redaction which was not committed is discarded
+	md = NewTxMetadata()
+
+	err = md.WithRedactedEntries(hdr1.ID, []int{1})
+	require.NoError(t, err)
+
+	_, err = st.registerPendingRedaction(st.LastPrecommittedTxID(), md)
+	require.NoError(t, err)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	st, err = Open(dir, DefaultOptions())
+	require.NoError(t, err)
+	require.Empty(t, st.pendingRedactions)
+
+	err = st.WaitForIndexingUpto(context.Background(), hdr1.ID+1)
+	require.NoError(t, err)
+
+	valRef, err := st.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	_, err = valRef.Resolve()
+	require.ErrorIs(t, err, ErrValueRedacted)
+
+	valRef, err = st.Get(context.Background(), []byte("key2"))
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, []byte("public-data"), val)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	requireNoFileContains(t, dir, []byte("personal-data"))
+
+	st, err = Open(dir, DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	require.Empty(t, st.pendingRedactions)
+}
+
+func TestExportAndReplicateRedactedTx(t *testing.T) {
+	primaryStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, primaryStore)
+
+	replicaDir := t.TempDir()
+
+	replicaStore, err := Open(replicaDir, DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, replicaStore)
+
+	tx, err := primaryStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("personal-data"))
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte("public-data"))
+	require.NoError(t, err)
+
+	hdr1, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	txholder := tempTxHolder(t, primaryStore)
+
+	// the replica receives the values before they are redacted
+	etx, err := primaryStore.ExportTx(hdr1.ID, false, false, txholder)
+	require.NoError(t, err)
+
+	_, err = replicaStore.ReplicateTx(context.Background(), etx, false, false)
+	require.NoError(t, err)
+
+	hdr2, err := primaryStore.RedactEntries(context.Background(), hdr1.ID, [][]byte{[]byte("key1")})
+	require.NoError(t, err)
+
+	// redacted transactions are exported without values
+	etx, err = primaryStore.ExportTx(hdr1.ID, false, false, txholder)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(etx, []byte("personal-data")))
+	require.False(t, bytes.Contains(etx, []byte("public-data")))
+
+	etx, err = primaryStore.ExportTx(hdr2.ID, false, false, txholder)
+	require.NoError(t, err)
+
+	rhdr, err := replicaStore.ReplicateTx(context.Background(), etx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, hdr2.Alh(), rhdr.Alh())
+
+	valRef, err := replicaStore.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	_, err = valRef.Resolve()
+	require.ErrorIs(t, err, ErrValueRedacted)
+
+	err = replicaStore.Close()
+	require.NoError(t, err)
+
+	requireNoFileContains(t, replicaDir, []byte("personal-data"))
+
+	replicaStore, err = Open(replicaDir, DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, replicaStore)
+
+	valRef, err = replicaStore.Get(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	_, err = valRef.Resolve()
+	require.ErrorIs(t, err, ErrValueRedacted)
+}
+
+func TestReplicateRedactedTxWithExternalCommitAllowance(t *testing.T) {
+	primaryStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, primaryStore)
+
+	replicaDir := t.TempDir()
+	replicaOpts := DefaultOptions().WithExternalCommitAllowance(true)
+
+	replicaStore, err := Open(replicaDir, replicaOpts)
+	require.NoError(t, err)
+
+	tx, err := primaryStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("personal-data"))
+	require.NoError(t, err)
+
+	hdr1, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	txholder := tempTxHolder(t, primaryStore)
+
+	// the replica receives the values before they are redacted
+	etx, err := primaryStore.ExportTx(hdr1.ID, false, false, txholder)
+	require.NoError(t, err)
+
+	_, err = replicaStore.ReplicateTx(context.Background(), etx, false, false)
+	require.NoError(t, err)
+
+	err = replicaStore.AllowCommitUpto(hdr1.ID)
+	require.NoError(t, err)
+
+	hdr2, err := primaryStore.RedactEntries(context.Background(), hdr1.ID, [][]byte{[]byte("key1")})
+	require.NoError(t, err)
+
+	etx, err = primaryStore.ExportTx(hdr2.ID, false, false, txholder)
+	require.NoError(t, err)
+
+	_, err = replicaStore.ReplicateTx(context.Background(), etx, false, false)
+	require.NoError(t, err)
+
+	rtx := tempTxHolder(t, replicaStore)
+
+	readValue := func() error {
+		err := replicaStore.ReadTx(hdr1.ID, false, rtx)
+		if err != nil {
+			return err
+		}
+
+		_, err = replicaStore.ReadValue(rtx.Entries()[0])
+		return err
+	}
+
+	// values are not erased while the redaction is not committed
+	require.NoError(t, readValue())
+
+	err = replicaStore.Close()
+	require.NoError(t, err)
+
+	// the pending redaction is resumed upon opening
+	replicaStore, err = Open(replicaDir, replicaOpts)
+	require.NoError(t, err)
+	defer immustoreClose(t, replicaStore)
+
+	require.Len(t, replicaStore.pendingRedactions, 1)
+	require.NoError(t, readValue())
+
+	err = replicaStore.AllowCommitUpto(hdr2.ID)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return errors.Is(readValue(), ErrValueRedacted)
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/htree"
@@ -385,6 +386,36 @@ func (tx *Tx) Proof(key []byte) (*htree.InclusionProof, error) {
 	return tx.htree.InclusionProof(kindex)
 }
 
+// MultiProof returns a single proof of inclusion for the entries of the given keys.
+// Proven leaves are sorted by their position in the transaction, so entry digests
+// must be provided in that same order when verifying the proof.
+func (tx *Tx) MultiProof(keys [][]byte) (*htree.MultiInclusionProof, error) {
+	if len(keys) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	kindexes := make([]int, len(keys))
+
+	for i, key := range keys {
+		kindex, err := tx.IndexOf(key)
+		if err != nil {
+			return nil, err
+		}
+
+		kindexes[i] = kindex
+	}
+
+	sort.Ints(kindexes)
+
+	for i := 1; i < len(kindexes); i++ {
+		if kindexes[i-1] == kindexes[i] {
+			return nil, fmt.Errorf("%w: duplicated key", ErrIllegalArguments)
+		}
+	}
+
+	return tx.htree.MultiInclusionProof(kindexes)
+}
+
 func (tx *Tx) readFrom(r *appendable.Reader, skipIntegrityCheck bool) error {
 	tdr := &txDataReader{r: r, skipIntegrityCheck: skipIntegrityCheck}
 
@@ -26,6 +26,7 @@ import (
 const (
 	truncatedUptoTxAttrCode attributeCode = 0
 	extraAttrCode           attributeCode = 1
+	redactedEntriesAttrCode attributeCode = 2
 )
 
 // attribute size is the size of the attribute in bytes.
@@ -34,10 +35,16 @@ const (
 )
 
 const maxTxMetadataLen = (attrCodeSize + truncatedUptoTxAttrSize) +
-	(attrCodeSize + sszSize + maxExtraLen)
+	(attrCodeSize + sszSize + maxExtraLen) +
+	(attrCodeSize + txIDSize + sszSize + MaxRedactedEntries*entryIndexSize)
 
 const maxExtraLen = 256
 
+// MaxRedactedEntries is the maximum number of entries that can be redacted in a single transaction
+const MaxRedactedEntries = 32
+
+const entryIndexSize = 4
+
 // truncatedUptoTxAttribute is used to identify that the transaction
 // stores the information up to which given transaction ID the
 // database was truncated.
@@ -98,6 +105,54 @@ func (a *extraAttribute) deserialize(b []byte) (int, error) {
 	return sszSize + len(a.extra), nil
 }
 
+// redactedEntriesAttribute is used to record which entries of
+// a given transaction had their values redacted.
+type redactedEntriesAttribute struct {
+	txID    uint64
+	entries []int
+}
+
+// code returns the attribute code.
+func (a *redactedEntriesAttribute) code() attributeCode {
+	return redactedEntriesAttrCode
+}
+
+// serialize returns the serialized attribute.
+func (a *redactedEntriesAttribute) serialize() []byte {
+	b := make([]byte, txIDSize+sszSize+len(a.entries)*entryIndexSize)
+
+	binary.BigEndian.PutUint64(b, a.txID)
+	binary.BigEndian.PutUint16(b[txIDSize:], uint16(len(a.entries)))
+
+	for i, e := range a.entries {
+		binary.BigEndian.PutUint32(b[txIDSize+sszSize+i*entryIndexSize:], uint32(e))
+	}
+
+	return b
+}
+
+// deserialize deserializes the attribute.
+func (a *redactedEntriesAttribute) deserialize(b []byte) (int, error) {
+	if len(b) < txIDSize+sszSize {
+		return 0, ErrCorruptedData
+	}
+
+	a.txID = binary.BigEndian.Uint64(b)
+
+	n := int(binary.BigEndian.Uint16(b[txIDSize:]))
+	if n == 0 || n > MaxRedactedEntries || len(b) < txIDSize+sszSize+n*entryIndexSize {
+		return 0, ErrCorruptedData
+	}
+
+	a.entries = make([]int, n)
+
+	for i := range a.entries {
+		a.entries[i] = int(binary.BigEndian.Uint32(b[txIDSize+sszSize+i*entryIndexSize:]))
+	}
+
+	return txIDSize + sszSize + n*entryIndexSize, nil
+}
+
 func getAttributeFrom(attrCode attributeCode) (attribute, error) {
 	switch attrCode {
 	case truncatedUptoTxAttrCode:
@@ -108,6 +163,10 @@ func getAttributeFrom(attrCode attributeCode) (attribute, error) {
 		{
 			return &extraAttribute{}, nil
 		}
+	case redactedEntriesAttrCode:
+		{
+			return &redactedEntriesAttribute{}, nil
+		}
 	default:
 		{
 			return nil, fmt.Errorf("error reading tx metadata attributes: %w", ErrCorruptedData)
@@ -145,7 +204,7 @@ func (md *TxMetadata) Equal(amd *TxMetadata) bool {
 func (md *TxMetadata) Bytes() []byte {
 	var b bytes.Buffer
 
-	for _, attrCode := range []attributeCode{truncatedUptoTxAttrCode, extraAttrCode} {
+	for _, attrCode := range []attributeCode{truncatedUptoTxAttrCode, extraAttrCode, redactedEntriesAttrCode} {
 		attr, ok := md.attributes[attrCode]
 		if ok {
 			b.WriteByte(byte(attr.code()))
@@ -251,3 +310,44 @@ func (md *TxMetadata) WithExtra(data []byte) error {
 
 	return nil
 }
+
+// HasRedactedEntries returns true if the transaction records
+// the redaction of values of a previous transaction.
+func (md *TxMetadata) HasRedactedEntries() bool {
+	_, ok := md.attributes[redactedEntriesAttrCode]
+	return ok
+}
+
+// GetRedactedEntries returns the transaction and the indexes of
+// its entries whose values were redacted.
+func (md *TxMetadata) GetRedactedEntries() (txID uint64, entries []int, err error) {
+	attr, ok := md.attributes[redactedEntriesAttrCode]
+	if !ok {
+		return 0, nil, ErrRedactionInfoNotPresentInMetadata
+	}
+
+	redacted := attr.(*redactedEntriesAttribute)
+
+	return redacted.txID, redacted.entries, nil
+}
+
+// WithRedactedEntries sets the attribute recording the redaction
+// of values of the given entries of a previous transaction.
+func (md *TxMetadata) WithRedactedEntries(txID uint64, entries []int) error {
+	if txID == 0 || len(entries) == 0 || len(entries) > MaxRedactedEntries {
+		return fmt.Errorf("%w: invalid redaction info", ErrIllegalArguments)
+	}
+
+	for _, e := range entries {
+		if e < 0 {
+			return fmt.Errorf("%w: invalid redaction info", ErrIllegalArguments)
+		}
+	}
+
+	md.attributes[redactedEntriesAttrCode] = &redactedEntriesAttribute{
+		txID:    txID,
+		entries: append([]int(nil), entries...),
+	}
+
+	return nil
+}
@@ -93,3 +93,56 @@ func TestTxMetadataWithAttributes(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, desmd.HasTruncatedTxID())
 }
+
+func TestTxMetadataWithRedactedEntries(t *testing.T) {
+	md := NewTxMetadata()
+	require.False(t, md.HasRedactedEntries())
+
+	_, _, err := md.GetRedactedEntries()
+	require.ErrorIs(t, err, ErrRedactionInfoNotPresentInMetadata)
+
+	err = md.WithRedactedEntries(0, []int{0})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = md.WithRedactedEntries(1, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = md.WithRedactedEntries(1, []int{-1})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = md.WithRedactedEntries(1, make([]int, MaxRedactedEntries+1))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	entries := make([]int, MaxRedactedEntries)
+	for i := range entries {
+		entries[i] = i * 100
+	}
+
+	err = md.WithRedactedEntries(10, entries)
+	require.NoError(t, err)
+	require.True(t, md.HasRedactedEntries())
+	require.False(t, md.IsEmpty())
+	require.False(t, md.HasExtraOnly())
+
+	md.WithTruncatedTxID(5)
+
+	err = md.WithExtra(make([]byte, maxExtraLen))
+	require.NoError(t, err)
+
+	bs := md.Bytes()
+	require.Len(t, bs, maxTxMetadataLen)
+
+	desmd := NewTxMetadata()
+
+	err = desmd.ReadFrom(bs)
+	require.NoError(t, err)
+	require.True(t, md.Equal(desmd))
+
+	txID, redacted, err := desmd.GetRedactedEntries()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), txID)
+	require.Equal(t, entries, redacted)
+
+	err = desmd.ReadFrom([]byte{byte(redactedEntriesAttrCode), 0, 0, 0, 0, 0, 0, 0, 1, 0, 0})
+	require.ErrorIs(t, err, ErrCorruptedData)
+}
@@ -29,6 +29,10 @@ func VerifyInclusion(proof *htree.InclusionProof, entryDigest, root [sha256.Size
 	return htree.VerifyInclusion(proof, entryDigest, root)
 }
 
+func VerifyMultiInclusion(proof *htree.MultiInclusionProof, entryDigests [][sha256.Size]byte, root [sha256.Size]byte) bool {
+	return htree.VerifyMultiInclusion(proof, entryDigests, root)
+}
+
 func advanceLinearHash(alh [sha256.Size]byte, txID uint64, term [sha256.Size]byte) [sha256.Size]byte {
 	var bs [txIDSize + 2*sha256.Size]byte
 	binary.BigEndian.PutUint64(bs[:], txID)
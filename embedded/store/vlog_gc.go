@@ -0,0 +1,905 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	metricsVLogGCReclaimedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_vlog_gc_reclaimed_bytes",
+		Help: "Number of bytes reclaimed by value-log garbage collection",
+	}, []string{
+		"db",
+	})
+	metricsVLogGCDiscardedValues = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_vlog_gc_discarded_values",
+		Help: "Number of values discarded by value-log garbage collection",
+	}, []string{
+		"db",
+	})
+)
+
+// VLogGCReport summarizes the outcome of a value-log garbage collection run
+type VLogGCReport struct {
+	// Number of value-log files rewritten
+	CompactedFiles int
+
+	// Number of values discarded from the rewritten files
+	DiscardedValues int
+
+	// Disk space reclaimed in bytes
+	ReclaimedBytes int64
+}
+
+// vLogGCDirname is the directory holding the progress of value-log garbage collection
+const vLogGCDirname = "vloggc"
+
+// vLogGCBatchSize is the number of transactions processed before discardable values
+// get compacted and the progress is persisted
+const vLogGCBatchSize = 10_000
+
+// discardableValues holds the values of a value-log file which are no longer reachable
+type discardableValues struct {
+	segments []appendable.Segment
+	size     int64
+	offsets  []int64
+	count    int // values starting within the file
+}
+
+// vLogGCState holds the progress of value-log garbage collection, it's persisted so that
+// each run only processes the transactions committed since the previous one
+type vLogGCState struct {
+	// latest processed transaction
+	txID uint64
+	// values pinned by entries referencing a specific version of a key, they are never discarded
+	pinned map[int64]struct{}
+	// values discarded but not yet compacted, e.g. when stored within the active value-log file
+	discardable map[int64]uint32
+	// keys whose latest version will expire, their retained values get discarded once expired
+	expirations []vLogGCExpiration
+}
+
+type vLogGCExpiration struct {
+	key       []byte
+	expiresAt int64
+}
+
+func newVLogGCState() *vLogGCState {
+	return &vLogGCState{
+		pinned:      make(map[int64]struct{}),
+		discardable: make(map[int64]uint32),
+	}
+}
+
+// openVLogGCLog opens the log holding the progress of value-log garbage collection
+func openVLogGCLog(path string, opts *Options) (appendable.Appendable, error) {
+	appOpts := multiapp.DefaultOptions().
+		WithRetryableSync(opts.Synced).
+		WithFileMode(opts.FileMode).
+		WithFileExt("gc").
+		WithCompressionFormat(appendable.NoCompression).
+		WithEncryption(opts.EncryptionAlgorithm, opts.KeyRing)
+
+	if opts.appFactory != nil {
+		return opts.appFactory(path, vLogGCDirname, appOpts)
+	}
+
+	return multiapp.Open(filepath.Join(path, vLogGCDirname), appOpts)
+}
+
+// loadVLogGCState returns the progress of value-log garbage collection, it's read from
+// the log on the first run. Collection starts over when the progress can not be read.
+func (s *ImmuStore) loadVLogGCState() (*vLogGCState, error) {
+	if s.vLogGCState != nil {
+		return s.vLogGCState, nil
+	}
+
+	if s.vLogGCLog == nil {
+		vLogGCLog, err := openVLogGCLog(s.path, s.opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not open value-log garbage collection log: %w", err)
+		}
+
+		s.vLogGCLog = vLogGCLog
+	}
+
+	state, err := readVLogGCState(s.vLogGCLog)
+	if err != nil {
+		s.logger.Warningf("value-log garbage collection at '%s' will start over, reading its progress returned: %v", s.path, err)
+		state = newVLogGCState()
+	}
+
+	s.vLogGCState = state
+
+	return state, nil
+}
+
+// saveVLogGCState overwrites the persisted progress, a partially written state is detected by
+// its checksum when read back, in such case garbage collection starts over
+func (s *ImmuStore) saveVLogGCState(state *vLogGCState) error {
+	b := state.bytes()
+
+	err := s.vLogGCLog.SetOffset(0)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.vLogGCLog.Append(b)
+	if err != nil {
+		return err
+	}
+
+	err = s.vLogGCLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	return s.vLogGCLog.Sync()
+}
+
+// bytes encodes the state as:
+// payloadLen(8) + sha256(payload) + txID(8) +
+// pinnedCount(4) + [vOff(8)] + discardableCount(4) + [vOff(8) + vLen(4)] +
+// expirationsCount(4) + [expiresAt(8) + keyLen(2) + key]
+func (state *vLogGCState) bytes() []byte {
+	size := txIDSize + 4 + len(state.pinned)*offsetSize + 4 + len(state.discardable)*(offsetSize+lszSize) + 4
+
+	for _, exp := range state.expirations {
+		size += tsSize + sszSize + len(exp.key)
+	}
+
+	b := make([]byte, 8+sha256.Size+size)
+	payload := b[8+sha256.Size:]
+
+	i := 0
+
+	binary.BigEndian.PutUint64(payload[i:], state.txID)
+	i += txIDSize
+
+	binary.BigEndian.PutUint32(payload[i:], uint32(len(state.pinned)))
+	i += 4
+
+	for vOff := range state.pinned {
+		binary.BigEndian.PutUint64(payload[i:], uint64(vOff))
+		i += offsetSize
+	}
+
+	binary.BigEndian.PutUint32(payload[i:], uint32(len(state.discardable)))
+	i += 4
+
+	for vOff, vLen := range state.discardable {
+		binary.BigEndian.PutUint64(payload[i:], uint64(vOff))
+		i += offsetSize
+
+		binary.BigEndian.PutUint32(payload[i:], vLen)
+		i += lszSize
+	}
+
+	binary.BigEndian.PutUint32(payload[i:], uint32(len(state.expirations)))
+	i += 4
+
+	for _, exp := range state.expirations {
+		binary.BigEndian.PutUint64(payload[i:], uint64(exp.expiresAt))
+		i += tsSize
+
+		binary.BigEndian.PutUint16(payload[i:], uint16(len(exp.key)))
+		i += sszSize
+
+		copy(payload[i:], exp.key)
+		i += len(exp.key)
+	}
+
+	binary.BigEndian.PutUint64(b, uint64(len(payload)))
+
+	checksum := sha256.Sum256(payload)
+	copy(b[8:], checksum[:])
+
+	return b
+}
+
+func readVLogGCState(vLogGCLog appendable.Appendable) (*vLogGCState, error) {
+	size, err := vLogGCLog.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	if size == 0 {
+		return newVLogGCState(), nil
+	}
+
+	var hdr [8 + sha256.Size]byte
+
+	if size < int64(len(hdr)) {
+		return nil, ErrCorruptedData
+	}
+
+	_, err = vLogGCLog.ReadAt(hdr[:], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadLen := binary.BigEndian.Uint64(hdr[:])
+	if payloadLen > uint64(size-int64(len(hdr))) {
+		return nil, ErrCorruptedData
+	}
+
+	payload := make([]byte, payloadLen)
+
+	_, err = vLogGCLog.ReadAt(payload, int64(len(hdr)))
+	if err != nil {
+		return nil, err
+	}
+
+	if sha256.Sum256(payload) != *(*[sha256.Size]byte)(hdr[8:]) {
+		return nil, ErrCorruptedData
+	}
+
+	state := newVLogGCState()
+
+	i := 0
+
+	if len(payload) < txIDSize+4 {
+		return nil, ErrCorruptedData
+	}
+
+	state.txID = binary.BigEndian.Uint64(payload[i:])
+	i += txIDSize
+
+	pinnedCount := int(binary.BigEndian.Uint32(payload[i:]))
+	i += 4
+
+	if len(payload) < i+pinnedCount*offsetSize+4 {
+		return nil, ErrCorruptedData
+	}
+
+	for j := 0; j < pinnedCount; j++ {
+		state.pinned[int64(binary.BigEndian.Uint64(payload[i:]))] = struct{}{}
+		i += offsetSize
+	}
+
+	discardableCount := int(binary.BigEndian.Uint32(payload[i:]))
+	i += 4
+
+	if len(payload) < i+discardableCount*(offsetSize+lszSize)+4 {
+		return nil, ErrCorruptedData
+	}
+
+	for j := 0; j < discardableCount; j++ {
+		vOff := int64(binary.BigEndian.Uint64(payload[i:]))
+		i += offsetSize
+
+		state.discardable[vOff] = binary.BigEndian.Uint32(payload[i:])
+		i += lszSize
+	}
+
+	expirationsCount := int(binary.BigEndian.Uint32(payload[i:]))
+	i += 4
+
+	for j := 0; j < expirationsCount; j++ {
+		if len(payload) < i+tsSize+sszSize {
+			return nil, ErrCorruptedData
+		}
+
+		expiresAt := int64(binary.BigEndian.Uint64(payload[i:]))
+		i += tsSize
+
+		keyLen := int(binary.BigEndian.Uint16(payload[i:]))
+		i += sszSize
+
+		if len(payload) < i+keyLen {
+			return nil, ErrCorruptedData
+		}
+
+		key := make([]byte, keyLen)
+		copy(key, payload[i:])
+		i += keyLen
+
+		state.expirations = append(state.expirations, vLogGCExpiration{key: key, expiresAt: expiresAt})
+	}
+
+	return state, nil
+}
+
+// CompactValueLogs reclaims the space used by values which are no longer reachable.
+// A value is discardable when it does not belong to the latest versions of its key,
+// the latest version of its key is deleted or expired, or it was redacted.
+// Values written within the retention period are always kept, as well as values pinned by
+// entries referencing a specific version of a key (see VLogGCOptions.PinnedValueFunc) which
+// were committed before the value got discarded.
+//
+// Progress is persisted, thus each run only processes the transactions committed since
+// the previous one, while values which can not yet be compacted (e.g. those stored within
+// the active value-log file) are kept pending for later runs.
+//
+// Value-log files with enough discardable data are rewritten without it. Offsets are
+// preserved, thus transactions and index entries keep referencing the retained values
+// and no index update is needed, while reading a discarded value results in io.EOF as
+// it happens with truncated values. Inclusion and consistency proofs are not affected.
+//
+// Only values of keys indexed without entry mapping are considered. Values stored within
+// the transaction log or compressed are not supported.
+func (s *ImmuStore) CompactValueLogs(ctx context.Context) (*VLogGCReport, error) {
+	s.mutex.Lock()
+
+	if s.closed {
+		s.mutex.Unlock()
+		return nil, ErrAlreadyClosed
+	}
+
+	s.mutex.Unlock()
+
+	return s.compactValueLogs(ctx)
+}
+
+func (s *ImmuStore) compactValueLogs(ctx context.Context) (*VLogGCReport, error) {
+	if s.readOnly {
+		return nil, ErrReadOnly
+	}
+
+	if s.embeddedValues {
+		return nil, fmt.Errorf("%w: values are embedded into the transaction log", ErrVLogGCNotSupported)
+	}
+
+	if s.opts.CompressionFormat != appendable.NoCompression {
+		return nil, fmt.Errorf("%w: compressed values can not be discarded", ErrVLogGCNotSupported)
+	}
+
+	if s.opts.EncryptionAlgorithm != appendable.NoEncryption {
+		return nil, fmt.Errorf("%w: encrypted values can not be discarded", ErrVLogGCNotSupported)
+	}
+
+	s.vLogGCMutex.Lock()
+	defer s.vLogGCMutex.Unlock()
+
+	state, err := s.loadVLogGCState()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VLogGCReport{}
+
+	now := s.timeFunc()
+
+	err = s.discardExpiredValues(state, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		processed, err := s.collectDiscardableValues(ctx, state, now)
+		if err != nil {
+			return report, err
+		}
+
+		err = s.compactDiscardableValues(ctx, state, report)
+		if err != nil {
+			return report, err
+		}
+
+		err = s.saveVLogGCState(state)
+		if err != nil {
+			return report, err
+		}
+
+		if processed < vLogGCBatchSize {
+			break
+		}
+	}
+
+	dbName := filepath.Base(s.path)
+	metricsVLogGCReclaimedBytes.WithLabelValues(dbName).Add(float64(report.ReclaimedBytes))
+	metricsVLogGCDiscardedValues.WithLabelValues(dbName).Add(float64(report.DiscardedValues))
+
+	return report, nil
+}
+
+// compactDiscardableValues rewrites the value-log files holding enough discardable data,
+// compacted values are removed from the state
+func (s *ImmuStore) compactDiscardableValues(ctx context.Context, state *vLogGCState, report *VLogGCReport) error {
+	for vLogID, files := range state.discardableFiles(int64(s.fileSize)) {
+		for fileID, values := range files {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if float64(values.size) < s.vLogGCOpts.MinDiscardableRatio*float64(s.fileSize) {
+				continue
+			}
+
+			reclaimed, compacted, err := s.compactVLogFile(vLogID, fileID, values.segments)
+			if err != nil {
+				return err
+			}
+
+			if !compacted {
+				continue
+			}
+
+			for _, off := range values.offsets {
+				delete(state.discardable, off)
+
+				if s.vLogCache != nil {
+					s.vLogCache.Pop(off)
+				}
+			}
+
+			if reclaimed == 0 {
+				// values were already discarded e.g. by a run interrupted before saving its progress
+				continue
+			}
+
+			report.CompactedFiles++
+			report.DiscardedValues += values.count
+			report.ReclaimedBytes += reclaimed
+		}
+	}
+
+	return nil
+}
+
+// discardableFiles groups the discardable values by value-log and value-log file
+func (state *vLogGCState) discardableFiles(fileSize int64) map[byte]map[int64]*discardableValues {
+	discardable := make(map[byte]map[int64]*discardableValues)
+
+	for vOff, vLen := range state.discardable {
+		vLogID, off := decodeOffset(vOff)
+
+		files, ok := discardable[vLogID]
+		if !ok {
+			files = make(map[int64]*discardableValues)
+			discardable[vLogID] = files
+		}
+
+		// values may span multiple value-log files
+		for segOff, end := off, off+int64(vLen); segOff < end; {
+			fileID := segOff / fileSize
+			fileEnd := (fileID + 1) * fileSize
+
+			size := end - segOff
+			if size > fileEnd-segOff {
+				size = fileEnd - segOff
+			}
+
+			values, ok := files[fileID]
+			if !ok {
+				values = &discardableValues{}
+				files[fileID] = values
+			}
+
+			values.segments = append(values.segments, appendable.Segment{Off: segOff, Size: size})
+			values.size += size
+			values.offsets = append(values.offsets, vOff)
+
+			if segOff == off {
+				values.count++
+			}
+
+			segOff += size
+		}
+	}
+
+	return discardable
+}
+
+// collectDiscardableValues processes the next batch of indexed transactions, values which
+// are no longer retained after them are added to the discardable ones.
+// The number of processed transactions is returned.
+func (s *ImmuStore) collectDiscardableValues(ctx context.Context, state *vLogGCState, now time.Time) (int, error) {
+	indexedTxID := s.minIndexedTxID()
+	if indexedTxID <= state.txID {
+		return 0, nil
+	}
+
+	retentionTs := now.Add(-s.vLogGCOpts.RetentionPeriod).Unix()
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return 0, err
+	}
+	defer s.releaseAllocTx(tx)
+
+	txr, err := s.newTxReader(state.txID+1, false, false, false, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	// number of new versions of each key indexed within the batch
+	newVersions := make(map[string]int)
+
+	txID := state.txID
+
+	for txID < indexedTxID && txID-state.txID < vLogGCBatchSize {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		tx, err := txr.Read()
+		if err != nil {
+			return 0, err
+		}
+
+		if tx.header.Ts > retentionTs {
+			// values written within the retention period are processed by later runs
+			break
+		}
+
+		if tx.header.Metadata != nil && tx.header.Metadata.HasRedactedEntries() {
+			err = s.discardRedactedValues(state, tx.header.Metadata)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		for _, e := range tx.entries[:tx.header.NEntries] {
+			err = s.pinValue(state, e)
+			if err != nil {
+				return 0, err
+			}
+
+			if s.isValueRedacted(e.vOff) {
+				state.discard(e.vOff, e.vLen)
+				continue
+			}
+
+			if (e.md != nil && e.md.NonIndexable()) || !s.isKeyCollectable(e.key()) {
+				continue
+			}
+
+			newVersions[string(e.key())]++
+		}
+
+		txID = tx.header.ID
+	}
+
+	for key, n := range newVersions {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		err := s.discardOutdatedVersions(state, []byte(key), n, txID, now)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	processed := int(txID - state.txID)
+
+	state.txID = txID
+
+	return processed, nil
+}
+
+// discardOutdatedVersions discards the values of the key which are no longer retained once
+// newVersions versions are added up to transaction txID, as the previous versions were
+// already processed only the ones retained until then need to be considered.
+// When the latest version is deleted or expired none of them is retained.
+func (s *ImmuStore) discardOutdatedVersions(state *vLogGCState, key []byte, newVersions int, txID uint64, now time.Time) error {
+	indexer := s.vLogGCIndexerFor(key)
+	if indexer == nil {
+		return nil
+	}
+
+	limit := s.vLogGCOpts.RetainedVersions + newVersions
+
+	var versions []ValueRef
+
+	// versions indexed after txID are skipped as they were not yet processed
+	for offset := uint64(0); len(versions) < limit; offset += uint64(limit) {
+		timedValues, hCount, err := indexer.History(key, offset, true, limit)
+		if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, timedValue := range timedValues {
+			if timedValue.Ts > txID || len(versions) == limit {
+				continue
+			}
+
+			valRef, err := s.valueRefFrom(timedValue.Ts, hCount-offset-uint64(i), timedValue.Value)
+			if err != nil {
+				return err
+			}
+
+			versions = append(versions, valRef)
+		}
+
+		if len(timedValues) < limit {
+			break
+		}
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+
+	retained := s.vLogGCOpts.RetainedVersions
+
+	if md := versions[0].KVMetadata(); md != nil {
+		if md.Deleted() || md.ExpiredAt(now) {
+			retained = 0
+		} else if md.IsExpirable() {
+			expiresAt, err := md.ExpirationTime()
+			if err != nil {
+				return err
+			}
+
+			state.expirations = append(state.expirations, vLogGCExpiration{key: key, expiresAt: expiresAt.Unix()})
+		}
+	}
+
+	if retained > len(versions) {
+		retained = len(versions)
+	}
+
+	for _, valRef := range versions[retained:] {
+		state.discard(valRef.VOff(), int(valRef.Len()))
+	}
+
+	return nil
+}
+
+// discardExpiredValues discards the retained values of the keys whose latest version expired
+func (s *ImmuStore) discardExpiredValues(state *vLogGCState, now time.Time) error {
+	var pending []vLogGCExpiration
+
+	for _, exp := range state.expirations {
+		if exp.expiresAt > now.Unix() {
+			pending = append(pending, exp)
+			continue
+		}
+
+		// the expiration is tracked again if the key was updated with an expirable version
+		err := s.discardOutdatedVersions(state, exp.key, 0, state.txID, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	state.expirations = pending
+
+	return nil
+}
+
+// discardRedactedValues discards the values erased by a redaction transaction
+func (s *ImmuStore) discardRedactedValues(state *vLogGCState, md *TxMetadata) error {
+	txID, entries, err := md.GetRedactedEntries()
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	err = s.readTx(txID, false, false, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range entries {
+		if i < tx.header.NEntries {
+			state.discard(tx.entries[i].vOff, tx.entries[i].vLen)
+		}
+	}
+
+	return nil
+}
+
+// pinValue registers the value pinned by the entry, if any, so that it's never discarded
+func (s *ImmuStore) pinValue(state *vLogGCState, e *TxEntry) error {
+	if s.vLogGCOpts.PinnedValueFunc == nil {
+		return nil
+	}
+
+	readValue := func() ([]byte, error) {
+		v := make([]byte, e.vLen)
+
+		_, err := s.readValueAt(v, e.vOff, e.hVal, false)
+		return v, err
+	}
+
+	key, atTx, err := s.vLogGCOpts.PinnedValueFunc(e.key(), readValue)
+	if errors.Is(err, io.EOF) || errors.Is(err, ErrValueRedacted) {
+		// the value of the entry was already discarded
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if key == nil || atTx == 0 {
+		return nil
+	}
+
+	pinned, _, err := s.ReadTxEntry(atTx, key, false)
+	if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrTxNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	state.pinned[pinned.vOff] = struct{}{}
+	delete(state.discardable, pinned.vOff)
+
+	return nil
+}
+
+// discard registers the value as discardable unless it's pinned,
+// empty values and values which were not stored (e.g. replicated truncated transactions) are skipped
+func (state *vLogGCState) discard(vOff int64, vLen int) {
+	vLogID, _ := decodeOffset(vOff)
+
+	if vLen == 0 || vLogID == 0 {
+		return
+	}
+
+	if _, ok := state.pinned[vOff]; ok {
+		return
+	}
+
+	state.discardable[vOff] = uint32(vLen)
+}
+
+func (s *ImmuStore) isKeyCollectable(key []byte) bool {
+	if len(s.vLogGCOpts.KeyPrefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range s.vLogGCOpts.KeyPrefixes {
+		if hasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vLogGCIndexerFor returns the indexer holding the versions of the key, as long as
+// values of the key are not referenced through mapped entries by any indexer
+func (s *ImmuStore) vLogGCIndexerFor(key []byte) *indexer {
+	s.indexersMux.RLock()
+	defer s.indexersMux.RUnlock()
+
+	var found *indexer
+
+	for _, indexer := range s.indexers {
+		if !hasPrefix(key, indexer.SourcePrefix()) && !hasPrefix(key, indexer.TargetPrefix()) {
+			continue
+		}
+
+		spec := indexer.spec
+
+		if spec.SourceEntryMapper != nil || spec.TargetEntryMapper != nil ||
+			!bytes.Equal(spec.SourcePrefix, spec.TargetPrefix) ||
+			spec.InitialTxID > 0 || spec.FinalTxID > 0 || spec.InitialTs > 0 || spec.FinalTs > 0 ||
+			found != nil {
+			return nil
+		}
+
+		found = indexer
+	}
+
+	return found
+}
+
+func (s *ImmuStore) minIndexedTxID() uint64 {
+	s.indexersMux.RLock()
+	defer s.indexersMux.RUnlock()
+
+	if len(s.indexers) == 0 {
+		return 0
+	}
+
+	minTxID := s.LastCommittedTxID()
+
+	for _, indexer := range s.indexers {
+		if indexer.Ts() < minTxID {
+			minTxID = indexer.Ts()
+		}
+	}
+
+	return minTxID
+}
+
+// compactVLogFile rewrites the value-log file without the discarded segments,
+// false is returned when the file is not compacted as it's the active one
+func (s *ImmuStore) compactVLogFile(vLogID byte, fileID int64, discard []appendable.Segment) (int64, bool, error) {
+	// the value-log is locked so no value is read while the file is being replaced
+	vLog, err := s.fetchVLog(vLogID)
+	if err != nil {
+		return 0, false, err
+	}
+	defer s.releaseVLog(vLogID)
+
+	compactor, ok := vLog.(appendable.Compactor)
+	if !ok {
+		return 0, false, ErrVLogGCNotSupported
+	}
+
+	size, err := vLog.Size()
+	if err != nil {
+		return 0, false, err
+	}
+
+	off := fileID * int64(s.fileSize)
+
+	// the active file is not compacted
+	if off+int64(s.fileSize) >= size {
+		return 0, false, nil
+	}
+
+	reclaimed, err := compactor.Compact(off, int64(s.fileSize), discard)
+	if errors.Is(err, appendable.ErrCompactionNotSupported) {
+		return 0, false, fmt.Errorf("%w: %v", ErrVLogGCNotSupported, err)
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return reclaimed, true, nil
+}
+
+// vLogGC periodically runs value-log garbage collection until the context is cancelled
+func (s *ImmuStore) vLogGC(ctx context.Context) {
+	defer close(s.vLogGCDone)
+
+	ticker := time.NewTicker(s.vLogGCOpts.Frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		report, err := s.compactValueLogs(ctx)
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		if err != nil {
+			s.logger.Warningf("value-log garbage collection failed at '%s': %v", s.path, err)
+			continue
+		}
+
+		if report.CompactedFiles > 0 {
+			s.logger.Infof("value-log garbage collection at '%s' discarded %d values reclaiming %d bytes",
+				s.path, report.DiscardedValues, report.ReclaimedBytes)
+		}
+	}
+}
@@ -0,0 +1,472 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+
+	"github.com/stretchr/testify/require"
+)
+
+func vLogGCTestValue(key string, version int) []byte {
+	return bytes.Repeat([]byte(fmt.Sprintf("%s-%04d;", key, version)), 10)
+}
+
+func TestCompactValueLogs(t *testing.T) {
+	for _, retainedVersions := range []int{1, 3} {
+		t.Run(fmt.Sprintf("retainedVersions=%d", retainedVersions), func(t *testing.T) {
+			dir := t.TempDir()
+
+			opts := DefaultOptions().
+				WithFileSize(1024).
+				WithVLogGCOptions(DefaultVLogGCOptions().WithRetainedVersions(retainedVersions))
+
+			st, err := Open(dir, opts)
+			require.NoError(t, err)
+
+			const versions = 50
+
+			var hdrs []*TxHeader
+
+			for i := 0; i < versions; i++ {
+				tx, err := st.NewWriteOnlyTx(context.Background())
+				require.NoError(t, err)
+
+				err = tx.Set([]byte("churn"), nil, vLogGCTestValue("churn", i))
+				require.NoError(t, err)
+
+				if i == 0 {
+					err = tx.Set([]byte("stable"), nil, vLogGCTestValue("stable", i))
+					require.NoError(t, err)
+				}
+
+				hdr, err := tx.Commit(context.Background())
+				require.NoError(t, err)
+
+				hdrs = append(hdrs, hdr)
+			}
+
+			err = st.WaitForIndexingUpto(context.Background(), hdrs[versions-1].ID)
+			require.NoError(t, err)
+
+			report, err := st.CompactValueLogs(context.Background())
+			require.NoError(t, err)
+			require.Positive(t, report.CompactedFiles)
+			require.Positive(t, report.DiscardedValues)
+			require.Positive(t, report.ReclaimedBytes)
+
+			// nothing left to reclaim
+			report, err = st.CompactValueLogs(context.Background())
+			require.NoError(t, err)
+			require.Zero(t, report.ReclaimedBytes)
+
+			requireCompacted := func(st *ImmuStore) {
+				valRef, err := st.Get(context.Background(), []byte("stable"))
+				require.NoError(t, err)
+
+				val, err := valRef.Resolve()
+				require.NoError(t, err)
+				require.Equal(t, vLogGCTestValue("stable", 0), val)
+
+				valRefs, _, err := st.History([]byte("churn"), 0, true, versions)
+				require.NoError(t, err)
+				require.Len(t, valRefs, versions)
+
+				for i, valRef := range valRefs {
+					val, err := valRef.Resolve()
+
+					if i < retainedVersions {
+						require.NoError(t, err)
+						require.Equal(t, vLogGCTestValue("churn", versions-1-i), val)
+					}
+
+					// the oldest version is located in the first value-log file which is compacted
+					if i == versions-1 {
+						require.ErrorIs(t, err, io.EOF)
+					}
+				}
+
+				// proofs remain valid
+				tx := tempTxHolder(t, st)
+
+				err = st.ReadTx(hdrs[0].ID, false, tx)
+				require.NoError(t, err)
+
+				entryDigest, err := tx.header.TxEntryDigest()
+				require.NoError(t, err)
+
+				proof, err := tx.Proof([]byte("churn"))
+				require.NoError(t, err)
+
+				e, err := tx.EntryOf([]byte("churn"))
+				require.NoError(t, err)
+
+				digest, err := entryDigest(e)
+				require.NoError(t, err)
+
+				require.True(t, VerifyInclusion(proof, digest, tx.header.Eh))
+
+				lastHdr, err := st.ReadTxHeader(hdrs[versions-1].ID, false, false)
+				require.NoError(t, err)
+
+				dualProof, err := st.DualProofV2(tx.Header(), lastHdr)
+				require.NoError(t, err)
+
+				err = VerifyDualProofV2(dualProof, hdrs[0].ID, lastHdr.ID, hdrs[0].Alh(), lastHdr.Alh())
+				require.NoError(t, err)
+
+				// transactions with discarded values are exported without values
+				etx, err := st.ExportTx(hdrs[0].ID, false, false, tx)
+				require.NoError(t, err)
+				require.False(t, bytes.Contains(etx, vLogGCTestValue("stable", 0)))
+			}
+
+			requireCompacted(st)
+
+			err = st.Close()
+			require.NoError(t, err)
+
+			st, err = Open(dir, opts)
+			require.NoError(t, err)
+			defer immustoreClose(t, st)
+
+			requireCompacted(st)
+		})
+	}
+}
+
+func TestCompactValueLogsOfDeletedKeys(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions().WithFileSize(1024))
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	for i := 0; i < 20; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key"), nil, vLogGCTestValue("key", i))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	tx, err := st.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = tx.Delete(context.Background(), []byte("key"))
+	require.NoError(t, err)
+
+	hdr, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// fill the active value-log file
+	tx, err = st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("other"), nil, make([]byte, 1024))
+	require.NoError(t, err)
+
+	hdr, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	err = st.WaitForIndexingUpto(context.Background(), hdr.ID)
+	require.NoError(t, err)
+
+	report, err := st.CompactValueLogs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 20, report.DiscardedValues)
+	require.Positive(t, report.ReclaimedBytes)
+
+	valRefs, _, err := st.History([]byte("key"), 0, false, 20)
+	require.NoError(t, err)
+
+	for _, valRef := range valRefs {
+		_, err = valRef.Resolve()
+		require.ErrorIs(t, err, io.EOF)
+	}
+}
+
+func TestCompactValueLogsWithinRetentionPeriod(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions().
+		WithFileSize(1024).
+		WithVLogGCOptions(DefaultVLogGCOptions().WithRetentionPeriod(time.Hour)))
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	var hdr *TxHeader
+
+	for i := 0; i < 20; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key"), nil, vLogGCTestValue("key", i))
+		require.NoError(t, err)
+
+		hdr, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	err = st.WaitForIndexingUpto(context.Background(), hdr.ID)
+	require.NoError(t, err)
+
+	report, err := st.CompactValueLogs(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, report.CompactedFiles)
+	require.Zero(t, report.ReclaimedBytes)
+}
+
+func TestCompactValueLogsInBackground(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions().
+		WithFileSize(1024).
+		WithVLogGCOptions(DefaultVLogGCOptions().WithFrequency(10*time.Millisecond)))
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	for i := 0; i < 20; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key"), nil, vLogGCTestValue("key", i))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	valRef, err := st.GetBetween(context.Background(), []byte("key"), 1, 1)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := valRef.Resolve()
+		return err == io.EOF
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestCompactValueLogsOfPinnedValues(t *testing.T) {
+	// entries with key "ref" pin the version of key "key" written at the tx encoded as their value
+	pinnedValue := func(key []byte, value func() ([]byte, error)) ([]byte, uint64, error) {
+		if !bytes.Equal(key, []byte("ref")) {
+			return nil, 0, nil
+		}
+
+		v, err := value()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return []byte("key"), binary.BigEndian.Uint64(v), nil
+	}
+
+	st, err := Open(t.TempDir(), DefaultOptions().
+		WithFileSize(1024).
+		WithVLogGCOptions(DefaultVLogGCOptions().WithPinnedValueFunc(pinnedValue)))
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	var hdrs []*TxHeader
+
+	for i := 0; i < 20; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key"), nil, vLogGCTestValue("key", i))
+		require.NoError(t, err)
+
+		if i == 1 {
+			var ref [8]byte
+			binary.BigEndian.PutUint64(ref[:], hdrs[0].ID)
+
+			err = tx.Set([]byte("ref"), nil, ref[:])
+			require.NoError(t, err)
+		}
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	err = st.WaitForIndexingUpto(context.Background(), hdrs[19].ID)
+	require.NoError(t, err)
+
+	report, err := st.CompactValueLogs(context.Background())
+	require.NoError(t, err)
+	require.Positive(t, report.ReclaimedBytes)
+
+	valRef, err := st.GetBetween(context.Background(), []byte("key"), hdrs[0].ID, hdrs[0].ID)
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, vLogGCTestValue("key", 0), val)
+
+	valRef, err = st.GetBetween(context.Background(), []byte("key"), hdrs[1].ID, hdrs[1].ID)
+	require.NoError(t, err)
+
+	_, err = valRef.Resolve()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestCompactValueLogsIncrementally(t *testing.T) {
+	dir := t.TempDir()
+
+	now := time.Now()
+
+	opts := DefaultOptions().
+		WithFileSize(1024).
+		WithTimeFunc(func() time.Time { return now }).
+		WithVLogGCOptions(DefaultVLogGCOptions().WithMinDiscardableRatio(0.01))
+
+	st, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	setVersions := func(st *ImmuStore, key string, md *KVMetadata, versions int) *TxHeader {
+		var hdr *TxHeader
+
+		for i := 0; i < versions; i++ {
+			tx, err := st.NewWriteOnlyTx(context.Background())
+			require.NoError(t, err)
+
+			err = tx.Set([]byte(key), md, vLogGCTestValue(key, i))
+			require.NoError(t, err)
+
+			hdr, err = tx.Commit(context.Background())
+			require.NoError(t, err)
+		}
+
+		err = st.WaitForIndexingUpto(context.Background(), hdr.ID)
+		require.NoError(t, err)
+
+		return hdr
+	}
+
+	md := NewKVMetadata()
+
+	err = md.ExpiresAt(now.Add(time.Hour))
+	require.NoError(t, err)
+
+	setVersions(st, "expirable", md, 20)
+
+	// the latest version of the expirable key is retained until it expires
+	setVersions(st, "key", nil, 20)
+
+	_, err = st.CompactValueLogs(context.Background())
+	require.NoError(t, err)
+
+	valRef, err := st.Get(context.Background(), []byte("expirable"))
+	require.NoError(t, err)
+
+	_, err = valRef.Resolve()
+	require.NoError(t, err)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	st, err = Open(dir, opts)
+	require.NoError(t, err)
+	defer immustoreClose(t, st)
+
+	t.Run("progress should be resumed after reopening the store", func(t *testing.T) {
+		hdr := setVersions(st, "other", nil, 20)
+
+		_, err = st.CompactValueLogs(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID, st.vLogGCState.txID)
+		require.Len(t, st.vLogGCState.expirations, 1)
+
+		state, err := readVLogGCState(st.vLogGCLog)
+		require.NoError(t, err)
+		require.Equal(t, st.vLogGCState, state)
+	})
+
+	t.Run("retained values should be discarded once expired", func(t *testing.T) {
+		expiredValRef, err := st.GetBetween(context.Background(), []byte("expirable"), 1, valRef.Tx())
+		require.NoError(t, err)
+
+		now = now.Add(2 * time.Hour)
+
+		// fill the active value-log file
+		setVersions(st, "filler", nil, 10)
+
+		_, err = st.CompactValueLogs(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, st.vLogGCState.expirations)
+
+		_, err = expiredValRef.Resolve()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("collection should start over when its progress is corrupted", func(t *testing.T) {
+		_, _, err := st.vLogGCLog.Append([]byte{1})
+		require.NoError(t, err)
+
+		err = st.vLogGCLog.SetOffset(0)
+		require.NoError(t, err)
+
+		_, _, err = st.vLogGCLog.Append([]byte{0, 0, 0, 0, 0, 0, 0, 1})
+		require.NoError(t, err)
+
+		_, err = readVLogGCState(st.vLogGCLog)
+		require.ErrorIs(t, err, ErrCorruptedData)
+
+		st.vLogGCState = nil
+
+		_, err = st.CompactValueLogs(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, st.LastCommittedTxID(), st.vLogGCState.txID)
+	})
+}
+
+func TestCompactValueLogsNotSupported(t *testing.T) {
+	t.Run("embedded values", func(t *testing.T) {
+		st, err := Open(t.TempDir(), DefaultOptions().WithEmbeddedValues(true))
+		require.NoError(t, err)
+		defer immustoreClose(t, st)
+
+		_, err = st.CompactValueLogs(context.Background())
+		require.ErrorIs(t, err, ErrVLogGCNotSupported)
+	})
+
+	t.Run("compressed values", func(t *testing.T) {
+		st, err := Open(t.TempDir(), DefaultOptions().WithCompressionFormat(appendable.FlateCompression))
+		require.NoError(t, err)
+		defer immustoreClose(t, st)
+
+		_, err = st.CompactValueLogs(context.Background())
+		require.ErrorIs(t, err, ErrVLogGCNotSupported)
+	})
+
+	t.Run("closed store", func(t *testing.T) {
+		st, err := Open(t.TempDir(), DefaultOptions())
+		require.NoError(t, err)
+
+		err = st.Close()
+		require.NoError(t, err)
+
+		_, err = st.CompactValueLogs(context.Background())
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+	})
+}
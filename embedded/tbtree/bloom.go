@@ -0,0 +1,506 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/codenotary/immudb/embedded/appendable"
+)
+
+// bloom filters are snapshot-specific as nodes and commit logs, thus the folder
+// is suffixed by the root logical timestamp except for initial trees
+const bloomFolderPrefix = "bloom"
+
+// record type, root logical timestamp and payload length
+const bloomRecordHeaderSize = 1 + 8 + 4
+
+const (
+	// bloomFullRecord holds the whole filter
+	bloomFullRecord byte = iota
+	// bloomDeltaRecord holds the hashes of the keys added since the previous record
+	bloomDeltaRecord
+)
+
+var ErrCorruptedBloomFilter = errors.New("tbtree: bloom filter is corrupted")
+
+// bloomFilter is a scalable bloom filter over every key inserted into the tree.
+// Keys are never removed from the tree, thus a filter built from all the inserted keys
+// can be safely used with any snapshot, the outcome of a positive check must still be
+// confirmed by descending the tree.
+// A new layer, doubling the capacity of the previous one, is added when the last one gets full.
+type bloomFilter struct {
+	bitsPerKey int
+	layers     []*bloomLayer
+
+	// hashes of the keys added since the filter was last persisted
+	pending []uint64
+
+	mutex sync.RWMutex
+}
+
+type bloomLayer struct {
+	capacity int
+	count    int
+	bits     []uint64
+}
+
+func newBloomFilter(bitsPerKey, capacity int) *bloomFilter {
+	return &bloomFilter{
+		bitsPerKey: bitsPerKey,
+		layers:     []*bloomLayer{newBloomLayer(bitsPerKey, capacity)},
+	}
+}
+
+func newBloomLayer(bitsPerKey, capacity int) *bloomLayer {
+	return &bloomLayer{
+		capacity: capacity,
+		bits:     make([]uint64, (capacity*bitsPerKey+63)/64),
+	}
+}
+
+func bloomHash(key []byte) uint64 {
+	return xxhash.Sum64(key)
+}
+
+// hashFunctions returns the number of hash functions minimizing the false positive rate
+func (bf *bloomFilter) hashFunctions() int {
+	k := int(math.Round(float64(bf.bitsPerKey) * math.Ln2))
+	if k < 1 {
+		return 1
+	}
+	return k
+}
+
+// bit positions are calculated using double hashing from a single 64-bit hash
+func (l *bloomLayer) add(h uint64, k int) {
+	m := uint64(len(l.bits)) * 64
+	h1, h2 := h, h>>32|h<<32|1
+
+	for i := 0; i < k; i++ {
+		b := (h1 + uint64(i)*h2) % m
+		l.bits[b/64] |= 1 << (b % 64)
+	}
+
+	l.count++
+}
+
+func (l *bloomLayer) mayContain(h uint64, k int) bool {
+	m := uint64(len(l.bits)) * 64
+	h1, h2 := h, h>>32|h<<32|1
+
+	for i := 0; i < k; i++ {
+		b := (h1 + uint64(i)*h2) % m
+		if l.bits[b/64]&(1<<(b%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (bf *bloomFilter) Add(key []byte) {
+	h := bloomHash(key)
+
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	if bf.addHash(h) {
+		bf.pending = append(bf.pending, h)
+	}
+}
+
+// addHash returns false when the hash may be already included, updated keys are
+// not counted twice so layers grow with the number of distinct keys
+func (bf *bloomFilter) addHash(h uint64) bool {
+	k := bf.hashFunctions()
+
+	if bf.mayContainHash(h, k) {
+		return false
+	}
+
+	last := bf.layers[len(bf.layers)-1]
+
+	if last.count >= last.capacity {
+		last = newBloomLayer(bf.bitsPerKey, last.capacity*2)
+		bf.layers = append(bf.layers, last)
+	}
+
+	last.add(h, k)
+
+	return true
+}
+
+func (bf *bloomFilter) MayContain(key []byte) bool {
+	h := bloomHash(key)
+
+	bf.mutex.RLock()
+	defer bf.mutex.RUnlock()
+
+	return bf.mayContainHash(h, bf.hashFunctions())
+}
+
+func (bf *bloomFilter) mayContainHash(h uint64, k int) bool {
+	for _, l := range bf.layers {
+		if l.mayContain(h, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyCount returns the number of distinct keys added to the filter
+func (bf *bloomFilter) KeyCount() int {
+	bf.mutex.RLock()
+	defer bf.mutex.RUnlock()
+
+	return bf.keyCount()
+}
+
+func (bf *bloomFilter) keyCount() int {
+	count := 0
+	for _, l := range bf.layers {
+		count += l.count
+	}
+	return count
+}
+
+// Size returns the size in bytes of the filter
+func (bf *bloomFilter) Size() int {
+	bf.mutex.RLock()
+	defer bf.mutex.RUnlock()
+
+	size := 0
+	for _, l := range bf.layers {
+		size += len(l.bits) * 8
+	}
+	return size
+}
+
+func (bf *bloomFilter) serialize() []byte {
+	size := 4 + 4
+	for _, l := range bf.layers {
+		size += 8 + 8 + 4 + len(l.bits)*8
+	}
+
+	b := make([]byte, size)
+	i := 0
+
+	binary.BigEndian.PutUint32(b[i:], uint32(bf.bitsPerKey))
+	i += 4
+
+	binary.BigEndian.PutUint32(b[i:], uint32(len(bf.layers)))
+	i += 4
+
+	for _, l := range bf.layers {
+		binary.BigEndian.PutUint64(b[i:], uint64(l.capacity))
+		i += 8
+
+		binary.BigEndian.PutUint64(b[i:], uint64(l.count))
+		i += 8
+
+		binary.BigEndian.PutUint32(b[i:], uint32(len(l.bits)))
+		i += 4
+
+		for _, w := range l.bits {
+			binary.BigEndian.PutUint64(b[i:], w)
+			i += 8
+		}
+	}
+
+	return b
+}
+
+func deserializeBloomFilter(b []byte) (*bloomFilter, error) {
+	if len(b) < 8 {
+		return nil, ErrCorruptedBloomFilter
+	}
+
+	i := 0
+
+	bf := &bloomFilter{
+		bitsPerKey: int(binary.BigEndian.Uint32(b[i:])),
+	}
+	i += 4
+
+	nLayers := int(binary.BigEndian.Uint32(b[i:]))
+	i += 4
+
+	if bf.bitsPerKey <= 0 || nLayers == 0 {
+		return nil, ErrCorruptedBloomFilter
+	}
+
+	for j := 0; j < nLayers; j++ {
+		if len(b)-i < 8+8+4 {
+			return nil, ErrCorruptedBloomFilter
+		}
+
+		l := &bloomLayer{}
+
+		l.capacity = int(binary.BigEndian.Uint64(b[i:]))
+		i += 8
+
+		l.count = int(binary.BigEndian.Uint64(b[i:]))
+		i += 8
+
+		nWords := int(binary.BigEndian.Uint32(b[i:]))
+		i += 4
+
+		if nWords == 0 || len(b)-i < nWords*8 {
+			return nil, ErrCorruptedBloomFilter
+		}
+
+		l.bits = make([]uint64, nWords)
+
+		for w := range l.bits {
+			l.bits[w] = binary.BigEndian.Uint64(b[i:])
+			i += 8
+		}
+
+		bf.layers = append(bf.layers, l)
+	}
+
+	return bf, nil
+}
+
+func bloomRecord(recordType byte, ts uint64, payload []byte) []byte {
+	b := make([]byte, bloomRecordHeaderSize+len(payload))
+
+	b[0] = recordType
+	binary.BigEndian.PutUint64(b[1:], ts)
+	binary.BigEndian.PutUint32(b[9:], uint32(len(payload)))
+	copy(b[bloomRecordHeaderSize:], payload)
+
+	return b
+}
+
+// readBloomFilter rebuilds the filter from the persisted records, the first one holding
+// the whole filter and the rest the keys added in every flush.
+// The logical timestamp of the last record and the size of the fully written records are returned.
+func readBloomFilter(bLog appendable.Appendable) (bf *bloomFilter, ts uint64, size int64, err error) {
+	bLogSize, err := bLog.Size()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var hdr [bloomRecordHeaderSize]byte
+
+	for size+bloomRecordHeaderSize <= bLogSize {
+		_, err = bLog.ReadAt(hdr[:], size)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		recordType := hdr[0]
+		recordTs := binary.BigEndian.Uint64(hdr[1:])
+		payloadLen := int64(binary.BigEndian.Uint32(hdr[9:]))
+
+		if size+bloomRecordHeaderSize+payloadLen > bLogSize {
+			// partially written record
+			break
+		}
+
+		payload := make([]byte, payloadLen)
+
+		// no keys are added when only the logical timestamp was increased
+		if payloadLen > 0 {
+			_, err = bLog.ReadAt(payload, size+bloomRecordHeaderSize)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return nil, 0, 0, err
+			}
+		}
+
+		switch {
+		case recordType == bloomFullRecord:
+			bf, err = deserializeBloomFilter(payload)
+			if err != nil {
+				return nil, 0, 0, err
+			}
+		case recordType == bloomDeltaRecord && bf != nil && payloadLen%8 == 0:
+			for i := 0; i < len(payload); i += 8 {
+				bf.addHash(binary.BigEndian.Uint64(payload[i:]))
+			}
+		default:
+			return nil, 0, 0, fmt.Errorf("%w: invalid record at offset %d", ErrCorruptedBloomFilter, size)
+		}
+
+		ts = recordTs
+		size += bloomRecordHeaderSize + payloadLen
+	}
+
+	if bf == nil {
+		return nil, 0, 0, fmt.Errorf("%w: no filter found", ErrCorruptedBloomFilter)
+	}
+
+	return bf, ts, size, nil
+}
+
+// persistBloomFilter appends the hashes of the keys added since the previous flush,
+// the whole filter is written when the log is empty
+func (t *TBtree) persistBloomFilter(sync bool) error {
+	if t.bloomFilter == nil || t.bLog == nil {
+		return nil
+	}
+
+	// will overwrite partially written data
+	err := t.bLog.SetOffset(t.committedBLogSize)
+	if err != nil {
+		return err
+	}
+
+	// keys are only added while holding the index lock, thus pending hashes
+	// are not changed while the filter is being persisted
+	t.bloomFilter.mutex.RLock()
+
+	var record []byte
+
+	if t.committedBLogSize == 0 {
+		record = bloomRecord(bloomFullRecord, t.root.ts(), t.bloomFilter.serialize())
+	} else {
+		payload := make([]byte, len(t.bloomFilter.pending)*8)
+		for i, h := range t.bloomFilter.pending {
+			binary.BigEndian.PutUint64(payload[i*8:], h)
+		}
+
+		record = bloomRecord(bloomDeltaRecord, t.root.ts(), payload)
+	}
+
+	t.bloomFilter.mutex.RUnlock()
+
+	_, _, err = t.bLog.Append(record)
+	if err != nil {
+		return err
+	}
+
+	err = t.bLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	if sync {
+		err = t.bLog.Sync()
+		if err != nil {
+			return err
+		}
+	}
+
+	t.committedBLogSize += int64(len(record))
+
+	t.bloomFilter.mutex.Lock()
+	t.bloomFilter.pending = nil
+	t.bloomFilter.mutex.Unlock()
+
+	metricsBloomFilterKeys.WithLabelValues(t.path).Set(float64(t.bloomFilter.KeyCount()))
+	metricsBloomFilterSize.WithLabelValues(t.path).Set(float64(t.bloomFilter.Size()))
+
+	return nil
+}
+
+// writeBloomFilter writes the whole filter as the only record of the log
+func writeBloomFilter(bLog appendable.Appendable, bf *bloomFilter, ts uint64) error {
+	err := bLog.SetOffset(0)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = bLog.Append(bloomRecord(bloomFullRecord, ts, bf.serialize()))
+	if err != nil {
+		return err
+	}
+
+	err = bLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	return bLog.Sync()
+}
+
+// loadBloomFilter returns the filter of the index, nil is returned when the filter
+// is not available as it was not persisted up to the loaded root, in such case
+// it will be rebuilt during the next compaction
+func (t *TBtree) loadBloomFilter(fresh bool) (*bloomFilter, error) {
+	if fresh {
+		if t.bLog != nil && !t.readOnly {
+			err := t.bLog.SetOffset(0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return newBloomFilter(t.bloomFilterBitsPerKey, t.bloomFilterCapacity), nil
+	}
+
+	if t.bLog == nil {
+		t.logger.Infof("bloom filter of index '%s' not found, it will be rebuilt during compaction", t.path)
+		return nil, nil
+	}
+
+	bf, ts, size, err := readBloomFilter(t.bLog)
+	if errors.Is(err, ErrCorruptedBloomFilter) {
+		t.logger.Warningf("bloom filter of index '%s' can not be used, it will be rebuilt during compaction: %v", t.path, err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if ts < t.root.ts() {
+		t.logger.Warningf("bloom filter of index '%s' {ts=%d} is behind the index {ts=%d}, it will be rebuilt during compaction",
+			t.path, ts, t.root.ts())
+		return nil, nil
+	}
+
+	if !t.readOnly {
+		err = t.bLog.SetOffset(size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.committedBLogSize = size
+
+	return bf, nil
+}
+
+// keyMayExist returns false only when the key was never inserted into the tree
+func (t *TBtree) keyMayExist(key []byte) bool {
+	if t.bloomFilter == nil {
+		return true
+	}
+
+	if t.bloomFilter.MayContain(key) {
+		t.bloomFilterPositives.Inc()
+		return true
+	}
+
+	t.bloomFilterNegatives.Inc()
+	return false
+}
+
+// checkBloomFilterOutcome accounts lookups of keys reported as present by the filter but not found
+func (t *TBtree) checkBloomFilterOutcome(err error) {
+	if t.bloomFilter != nil && errors.Is(err, ErrKeyNotFound) {
+		t.bloomFilterFalsePositives.Inc()
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	bf := newBloomFilter(DefaultBloomFilterBitsPerKey, 100)
+
+	keyCount := 1000
+
+	for i := 0; i < keyCount; i++ {
+		bf.Add([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	// updated keys are not counted twice
+	for i := 0; i < keyCount; i++ {
+		bf.Add([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	require.Greater(t, len(bf.layers), 1)
+	require.LessOrEqual(t, bf.KeyCount(), keyCount)
+	require.Greater(t, bf.KeyCount(), keyCount*95/100)
+
+	for i := 0; i < keyCount; i++ {
+		require.True(t, bf.MayContain([]byte(fmt.Sprintf("key%d", i))))
+	}
+
+	falsePositives := 0
+
+	for i := 0; i < keyCount; i++ {
+		if bf.MayContain([]byte(fmt.Sprintf("missing%d", i))) {
+			falsePositives++
+		}
+	}
+
+	require.Less(t, falsePositives, keyCount/20)
+
+	t.Run("serialization", func(t *testing.T) {
+		dbf, err := deserializeBloomFilter(bf.serialize())
+		require.NoError(t, err)
+		require.Equal(t, bf.KeyCount(), dbf.KeyCount())
+		require.Equal(t, bf.Size(), dbf.Size())
+
+		for i := 0; i < keyCount; i++ {
+			require.True(t, dbf.MayContain([]byte(fmt.Sprintf("key%d", i))))
+		}
+
+		_, err = deserializeBloomFilter(nil)
+		require.ErrorIs(t, err, ErrCorruptedBloomFilter)
+
+		b := bf.serialize()
+		_, err = deserializeBloomFilter(b[:len(b)-1])
+		require.ErrorIs(t, err, ErrCorruptedBloomFilter)
+	})
+}
+
+func TestTBtreeBloomFilter(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithCompactionThld(1).
+		WithBloomFilterCapacity(16)
+
+	tree, err := Open(dir, opts)
+	require.NoError(t, err)
+	require.NotNil(t, tree.bloomFilter)
+
+	keyCount := 100
+
+	for i := 0; i < keyCount; i++ {
+		err = tree.Insert([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		if i%10 == 0 {
+			_, _, err = tree.Flush()
+			require.NoError(t, err)
+		}
+	}
+
+	// only the logical timestamp is increased
+	err = tree.IncreaseTs(tree.Ts() + 1)
+	require.NoError(t, err)
+
+	checkKeys := func(tree *TBtree) {
+		for i := 0; i < keyCount; i++ {
+			_, _, _, err := tree.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+		}
+
+		_, _, _, err := tree.Get([]byte("missing"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, _, err = tree.History([]byte("missing"), 0, false, 1)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		snap, err := tree.Snapshot()
+		require.NoError(t, err)
+		defer snap.Close()
+
+		_, _, _, err = snap.Get([]byte("key0"))
+		require.NoError(t, err)
+
+		_, _, _, err = snap.GetBetween([]byte("missing"), 0, tree.Ts())
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		// keys set into the snapshot are found despite not being included into the filter
+		err = snap.Set([]byte("missing"), []byte("value"))
+		require.NoError(t, err)
+
+		_, _, _, err = snap.Get([]byte("missing"))
+		require.NoError(t, err)
+	}
+
+	checkKeys(tree)
+
+	err = tree.Close()
+	require.NoError(t, err)
+
+	t.Run("the filter should be loaded when reopening the index", func(t *testing.T) {
+		tree, err = Open(dir, opts)
+		require.NoError(t, err)
+		require.NotNil(t, tree.bloomFilter)
+		require.Equal(t, keyCount, tree.bloomFilter.KeyCount())
+
+		checkKeys(tree)
+	})
+
+	t.Run("the filter should be rebuilt during compaction", func(t *testing.T) {
+		_, err = tree.Compact()
+		require.NoError(t, err)
+
+		err = tree.Close()
+		require.NoError(t, err)
+
+		tree, err = Open(dir, opts)
+		require.NoError(t, err)
+		require.NotNil(t, tree.bloomFilter)
+		require.Len(t, tree.bloomFilter.layers, 1)
+		require.Equal(t, keyCount, tree.bloomFilter.KeyCount())
+
+		_, err = os.Stat(filepath.Join(dir, snapFolder(bloomFolderPrefix, tree.Ts())))
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(dir, bloomFolderPrefix))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		checkKeys(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("the filter should not be used when it's behind the index", func(t *testing.T) {
+		bLogPath := filepath.Join(dir, snapFolder(bloomFolderPrefix, tree.Ts()))
+
+		bLog, err := multiapp.Open(bLogPath, multiapp.DefaultOptions().WithFileExt("bf"))
+		require.NoError(t, err)
+
+		// only the whole filter written during compaction is kept
+		size, err := bLog.Size()
+		require.NoError(t, err)
+
+		bf, ts, _, err := readBloomFilter(bLog)
+		require.NoError(t, err)
+
+		err = bLog.SetOffset(0)
+		require.NoError(t, err)
+
+		_, _, err = bLog.Append(bloomRecord(bloomFullRecord, ts-1, bf.serialize()))
+		require.NoError(t, err)
+
+		newSize, err := bLog.Size()
+		require.NoError(t, err)
+		require.Equal(t, size, newSize)
+
+		err = bLog.Close()
+		require.NoError(t, err)
+
+		tree, err = Open(dir, opts)
+		require.NoError(t, err)
+		require.Nil(t, tree.bloomFilter)
+
+		checkKeys(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("the filter should not be used when disabled", func(t *testing.T) {
+		tree, err = Open(dir, opts.WithBloomFilterBitsPerKey(0))
+		require.NoError(t, err)
+		require.Nil(t, tree.bloomFilter)
+
+		checkKeys(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+	})
+}
+
+func TestReadBloomFilter(t *testing.T) {
+	bLog, err := multiapp.Open(t.TempDir(), multiapp.DefaultOptions().WithFileExt("bf"))
+	require.NoError(t, err)
+	defer bLog.Close()
+
+	_, _, _, err = readBloomFilter(bLog)
+	require.ErrorIs(t, err, ErrCorruptedBloomFilter)
+
+	_, _, err = bLog.Append(bloomRecord(bloomDeltaRecord, 1, make([]byte, 8)))
+	require.NoError(t, err)
+
+	_, _, _, err = readBloomFilter(bLog)
+	require.ErrorIs(t, err, ErrCorruptedBloomFilter)
+
+	err = bLog.SetOffset(0)
+	require.NoError(t, err)
+
+	bf := newBloomFilter(DefaultBloomFilterBitsPerKey, 16)
+
+	_, _, err = bLog.Append(bloomRecord(bloomFullRecord, 1, bf.serialize()))
+	require.NoError(t, err)
+
+	payload := make([]byte, 8)
+	payload[0] = 1
+
+	record := bloomRecord(bloomDeltaRecord, 2, payload)
+
+	_, _, err = bLog.Append(record)
+	require.NoError(t, err)
+
+	size, err := bLog.Size()
+	require.NoError(t, err)
+
+	// partially written records are ignored
+	_, _, err = bLog.Append(record[:len(record)-1])
+	require.NoError(t, err)
+
+	rbf, ts, rsize, err := readBloomFilter(bLog)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ts)
+	require.Equal(t, size, rsize)
+	require.Equal(t, 1, rbf.KeyCount())
+}
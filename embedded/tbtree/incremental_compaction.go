@@ -0,0 +1,406 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+)
+
+// the target of an incremental compaction refers to the nodes log of the snapshot,
+// thus the folder is snapshot-specific as nodes and commit logs
+const compactionFolderPrefix = "compaction"
+
+// each record holds the target offset of the incremental compaction started at that point,
+// a zero target is written once the compaction is completed
+const compactionRecordSize = 8
+
+// openCompactionLog opens the log holding the progress of incremental compactions,
+// nil is returned when incremental compactions are disabled or the log can not be opened,
+// in the latter case compactions are not resumed
+func openCompactionLog(path string, snapID uint64, appendableOpts *multiapp.Options, appFactory AppFactoryFunc, opts *Options) appendable.Appendable {
+	if !opts.incrementalCompaction {
+		return nil
+	}
+
+	appendableOpts.WithFileExt("ic")
+	appendableOpts.WithMaxOpenedFiles(1)
+
+	icLog, err := appFactory(path, snapFolder(compactionFolderPrefix, snapID), appendableOpts)
+	if err != nil {
+		opts.logger.Warningf("incremental compaction log of index '%s' can not be opened: %v", path, err)
+		return nil
+	}
+
+	return icLog
+}
+
+// readCompactionTarget returns the target of the latest incremental compaction and the size
+// of the log up to its latest complete record
+func readCompactionTarget(icLog appendable.Appendable) (target int64, size int64, err error) {
+	size, err = icLog.Size()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// a partially written record is ignored
+	size -= size % compactionRecordSize
+
+	if size == 0 {
+		return 0, 0, nil
+	}
+
+	var b [compactionRecordSize]byte
+
+	_, err = icLog.ReadAt(b[:], size-compactionRecordSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(b[:])), size, nil
+}
+
+func (t *TBtree) loadCompactionTarget(fresh bool) (int64, error) {
+	if t.icLog == nil {
+		return 0, nil
+	}
+
+	if fresh {
+		if !t.readOnly {
+			return 0, t.icLog.SetOffset(0)
+		}
+
+		return 0, nil
+	}
+
+	target, size, err := readCompactionTarget(t.icLog)
+	if err != nil {
+		return 0, err
+	}
+
+	actualSize, err := t.icLog.Size()
+	if err != nil {
+		return 0, err
+	}
+
+	if size < actualSize && !t.readOnly {
+		// will overwrite partially written record
+		err = t.icLog.SetOffset(size)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if target <= t.minOffset {
+		// already reached, it may happen if the log was not updated after the latest step
+		return 0, nil
+	}
+
+	t.logger.Infof("incremental compaction of index '%s' {min_offset=%d, target_offset=%d} will be resumed",
+		t.path, t.minOffset, target)
+
+	return target, nil
+}
+
+func (t *TBtree) setCompactionTarget(target int64) error {
+	if t.icLog != nil {
+		var b [compactionRecordSize]byte
+		binary.BigEndian.PutUint64(b[:], uint64(target))
+
+		_, _, err := t.icLog.Append(b[:])
+		if err != nil {
+			return err
+		}
+
+		err = t.icLog.Flush()
+		if err != nil {
+			return err
+		}
+
+		err = t.icLog.Sync()
+		if err != nil {
+			return err
+		}
+	}
+
+	t.compactionTarget = target
+
+	return nil
+}
+
+// IncrementalCompactionPending returns true when an incremental compaction
+// was started but not yet completed, e.g. when the index was closed meanwhile
+func (t *TBtree) IncrementalCompactionPending() bool {
+	t.rwmutex.RLock()
+	defer t.rwmutex.RUnlock()
+
+	return t.compactionTarget > 0
+}
+
+// CompactIncrementally reclaims the space taken by outdated nodes without dumping the whole index.
+// The nodes log can only be discarded from its beginning, thus the compaction covers the prefix
+// of the log where outdated nodes outweigh live ones (see selectCompactionTarget), each step
+// rewrites the live subtrees referencing nodes stored within the next
+// IncrementalCompactionStepSize bytes so that they can be discarded.
+// Steps are delayed by IncrementalCompactionDelay and the index is only locked while each step
+// is being done, thus insertions and lookups can still be made during the compaction.
+// A compaction covers the nodes written before it's started, its progress is persisted so an
+// interrupted compaction is resumed (instead of a new one being started) on the next call.
+// The compaction is interrupted once ctx is done, e.g. when the index is about to be closed.
+func (t *TBtree) CompactIncrementally(ctx context.Context) (uint64, error) {
+	t.rwmutex.Lock()
+
+	if t.closed {
+		t.rwmutex.Unlock()
+		return 0, ErrAlreadyClosed
+	}
+
+	if !t.incrementalCompaction {
+		t.rwmutex.Unlock()
+		return 0, fmt.Errorf("%w: incremental compaction is not enabled", ErrIllegalState)
+	}
+
+	if t.compacting || t.compactingIncrementally {
+		t.rwmutex.Unlock()
+		return 0, ErrCompactAlreadyInProgress
+	}
+
+	if t.compactionTarget == 0 {
+		if t.snapshotCount() < uint64(t.compactionThld) {
+			t.rwmutex.Unlock()
+			return 0, ErrCompactionThresholdNotReached
+		}
+
+		_, _, err := t.flushTree(0, false, false, "incrementalCompaction")
+		if err != nil {
+			t.rwmutex.Unlock()
+			return 0, err
+		}
+	}
+
+	t.compactingIncrementally = true
+
+	defer func() {
+		t.rwmutex.Lock()
+		t.compactingIncrementally = false
+		t.rwmutex.Unlock()
+	}()
+
+	if t.compactionTarget == 0 {
+		root := t.root
+		minOffset := t.minOffset
+		nLogSize := t.committedNLogSize
+
+		// flushed nodes are not modified by insertions, thus the tree can be walked without lock
+		t.rwmutex.Unlock()
+
+		target, err := t.selectCompactionTarget(root, minOffset, nLogSize)
+		if err != nil {
+			return 0, err
+		}
+
+		if target <= minOffset {
+			return 0, fmt.Errorf("%w: outdated nodes do not outweigh live ones in index '%s'",
+				ErrCompactionThresholdNotReached, t.path)
+		}
+
+		t.rwmutex.Lock()
+
+		if t.closed {
+			t.rwmutex.Unlock()
+			return 0, ErrAlreadyClosed
+		}
+
+		err = t.setCompactionTarget(target)
+		if err != nil {
+			t.rwmutex.Unlock()
+			return 0, t.wrapNwarn("starting incremental compaction of index '%s' {ts=%d} returned: %v",
+				t.path, t.root.ts(), err)
+		}
+	}
+
+	target := t.compactionTarget
+
+	t.logger.Infof("compacting index '%s' incrementally {ts=%d, min_offset=%d, target_offset=%d}...",
+		t.path, t.root.ts(), t.minOffset, target)
+
+	t.rwmutex.Unlock()
+
+	for {
+		if ctx.Err() != nil {
+			t.logger.Infof("incremental compaction of index '%s' {target_offset=%d} interrupted", t.path, target)
+			return 0, ctx.Err()
+		}
+
+		done, err := t.incrementalCompactionStep(target)
+		if err != nil {
+			return 0, err
+		}
+
+		if done {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(t.incrementalCompactionDelay):
+		}
+	}
+
+	t.rwmutex.Lock()
+	defer t.rwmutex.Unlock()
+
+	if t.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	err := t.setCompactionTarget(0)
+	if err != nil {
+		return 0, t.wrapNwarn("completing incremental compaction of index '%s' {ts=%d} returned: %v",
+			t.path, t.root.ts(), err)
+	}
+
+	t.logger.Infof("index '%s' {ts=%d, min_offset=%d} successfully compacted", t.path, t.root.ts(), t.minOffset)
+
+	return t.root.ts(), nil
+}
+
+// selectCompactionTarget returns the offset up to which the nodes log is worth being compacted.
+// The log is split in steps of IncrementalCompactionStepSize bytes, the nodes reachable from root
+// are live while the rest of each step is taken by outdated nodes. Compacting up to a step
+// requires rewriting the live nodes stored before it, thus the target is the end of the steps
+// maximizing the space reclaimed in excess of the space rewritten, so that the subtrees holding
+// mostly outdated nodes get compacted while the mostly live ones are left untouched.
+// minOffset is returned when no prefix of the log holds more outdated than live nodes.
+func (t *TBtree) selectCompactionTarget(root node, minOffset, nLogSize int64) (int64, error) {
+	if nLogSize <= minOffset {
+		return minOffset, nil
+	}
+
+	stepSize := int64(t.incrementalCompactionStepSize)
+	steps := (nLogSize - minOffset + stepSize - 1) / stepSize
+
+	liveBytes := make([]int64, steps)
+
+	err := t.sumLiveBytes(root, minOffset, nLogSize, liveBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	target := minOffset
+
+	var gain, maxGain int64
+
+	for i, live := range liveBytes {
+		stepOffset := minOffset + int64(i+1)*stepSize
+		if stepOffset > nLogSize {
+			stepOffset = nLogSize
+		}
+
+		stepLen := stepOffset - (minOffset + int64(i)*stepSize)
+
+		gain += (stepLen - live) - live
+
+		if gain > maxGain {
+			maxGain = gain
+			target = stepOffset
+		}
+	}
+
+	return target, nil
+}
+
+// sumLiveBytes accumulates the size of the nodes reachable from n which are stored
+// between minOffset and nLogSize, grouped by the step of the nodes log holding them
+func (t *TBtree) sumLiveBytes(n node, minOffset, nLogSize int64, liveBytes []int64) error {
+	if n.mutated() || n.minOffset() >= nLogSize {
+		// no node of the subtree is stored within the compacted range
+		return nil
+	}
+
+	if r, ok := n.(*nodeRef); ok {
+		rn, err := t.nodeAt(r.off, false)
+		if err != nil {
+			return err
+		}
+
+		n = rn
+	}
+
+	off := n.offset()
+
+	if off >= minOffset && off < nLogSize {
+		size, err := n.size()
+		if err != nil {
+			return err
+		}
+
+		liveBytes[(off-minOffset)/int64(t.incrementalCompactionStepSize)] += int64(size)
+	}
+
+	inner, ok := n.(*innerNode)
+	if !ok {
+		return nil
+	}
+
+	for _, child := range inner.nodes {
+		err := t.sumLiveBytes(child, minOffset, nLogSize, liveBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// incrementalCompactionStep rewrites the nodes stored within the next step of the nodes log,
+// true is returned once the target offset is reached
+func (t *TBtree) incrementalCompactionStep(target int64) (bool, error) {
+	t.rwmutex.Lock()
+	defer t.rwmutex.Unlock()
+
+	if t.closed {
+		return false, ErrAlreadyClosed
+	}
+
+	if t.minOffset >= target {
+		return true, nil
+	}
+
+	stepOffset := t.minOffset + int64(t.incrementalCompactionStepSize)
+	if stepOffset > target {
+		stepOffset = target
+	}
+
+	prevMinOffset := t.minOffset
+
+	_, _, err := t.flushTreeUpto(stepOffset, 0, true, false, "incrementalCompaction")
+	if err != nil {
+		return false, err
+	}
+
+	t.logger.Infof("compacting index '%s' incrementally {ts=%d} progress: %d/%d bytes",
+		t.path, t.root.ts(), t.minOffset, target)
+
+	// no progress can be made e.g. when the tree holds no nodes
+	return t.minOffset >= target || t.minOffset <= prevMinOffset, nil
+}
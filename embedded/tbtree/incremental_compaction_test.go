@@ -0,0 +1,245 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalCompaction(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithCompactionThld(2).
+		WithFileSize(1024).
+		WithIncrementalCompaction(true).
+		WithIncrementalCompactionStepSize(2048).
+		WithIncrementalCompactionDelay(0)
+
+	tree, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	_, err = tree.CompactIncrementally(context.Background())
+	require.ErrorIs(t, err, ErrCompactionThresholdNotReached)
+
+	keyCount := 100
+
+	for r := 0; r < 5; r++ {
+		for i := 0; i < keyCount; i++ {
+			err = tree.Insert([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d_%d", i, r)))
+			require.NoError(t, err)
+
+			if i%10 == 0 {
+				_, _, err = tree.Flush()
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	checkKeys := func(tree *TBtree) {
+		for i := 0; i < keyCount; i++ {
+			v, _, _, err := tree.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d_%d", i, 4)), v)
+		}
+	}
+
+	_, _, err = tree.Flush()
+	require.NoError(t, err)
+
+	target := tree.committedNLogSize
+
+	t.Run("an interrupted compaction should be resumed after reopening the index", func(t *testing.T) {
+		tree.rwmutex.Lock()
+		err = tree.setCompactionTarget(target)
+		tree.rwmutex.Unlock()
+		require.NoError(t, err)
+
+		// only a single step is made before closing the index
+		done, err := tree.incrementalCompactionStep(target)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		require.True(t, tree.IncrementalCompactionPending())
+
+		err = tree.Close()
+		require.NoError(t, err)
+
+		tree, err = Open(dir, opts)
+		require.NoError(t, err)
+		require.True(t, tree.IncrementalCompactionPending())
+		require.Equal(t, target, tree.compactionTarget)
+
+		checkKeys(tree)
+	})
+
+	t.Run("concurrent compactions should not be allowed", func(t *testing.T) {
+		tree.compactingIncrementally = true
+
+		_, err = tree.CompactIncrementally(context.Background())
+		require.ErrorIs(t, err, ErrCompactAlreadyInProgress)
+
+		_, err = tree.Compact()
+		require.ErrorIs(t, err, ErrCompactAlreadyInProgress)
+
+		tree.compactingIncrementally = false
+	})
+
+	t.Run("a compaction should be interrupted once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = tree.CompactIncrementally(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+		require.True(t, tree.IncrementalCompactionPending())
+	})
+
+	t.Run("nodes written before the compaction should be discarded", func(t *testing.T) {
+		_, err = tree.CompactIncrementally(context.Background())
+		require.NoError(t, err)
+		require.False(t, tree.IncrementalCompactionPending())
+		require.GreaterOrEqual(t, tree.minOffset, target)
+
+		_, err = os.Stat(filepath.Join(dir, nodesFolderPrefix, "00000000.n"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		checkKeys(tree)
+
+		err = tree.Close()
+		require.NoError(t, err)
+
+		tree, err = Open(dir, opts)
+		require.NoError(t, err)
+		require.False(t, tree.IncrementalCompactionPending())
+
+		checkKeys(tree)
+	})
+
+	err = tree.Close()
+	require.NoError(t, err)
+
+	_, err = tree.CompactIncrementally(context.Background())
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
+
+func TestIncrementalCompactionTarget(t *testing.T) {
+	opts := DefaultOptions().
+		WithCompactionThld(1).
+		WithFileSize(1024).
+		WithIncrementalCompaction(true).
+		WithIncrementalCompactionStepSize(1024).
+		WithIncrementalCompactionDelay(0)
+
+	tree, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+	defer tree.Close()
+
+	keyCount := 1000
+
+	for i := 0; i < keyCount; i++ {
+		err = tree.Insert([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	_, _, err = tree.Flush()
+	require.NoError(t, err)
+
+	t.Run("a compaction should not be started when no node is outdated", func(t *testing.T) {
+		target, err := tree.selectCompactionTarget(tree.root, tree.minOffset, tree.committedNLogSize)
+		require.NoError(t, err)
+		require.Equal(t, tree.minOffset, target)
+
+		_, err = tree.CompactIncrementally(context.Background())
+		require.ErrorIs(t, err, ErrCompactionThresholdNotReached)
+		require.False(t, tree.IncrementalCompactionPending())
+	})
+
+	t.Run("the compaction should cover the nodes outdated by later insertions", func(t *testing.T) {
+		// the first keys are updated many times, leaving the nodes holding the rest live
+		for r := 0; r < 20; r++ {
+			for i := 0; i < 10; i++ {
+				err = tree.Insert([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d_%d", i, r)))
+				require.NoError(t, err)
+			}
+
+			_, _, err = tree.Flush()
+			require.NoError(t, err)
+		}
+
+		nLogSize := tree.committedNLogSize
+
+		target, err := tree.selectCompactionTarget(tree.root, tree.minOffset, nLogSize)
+		require.NoError(t, err)
+		require.Greater(t, target, tree.minOffset)
+		// the live nodes written by the latest flush are not rewritten
+		require.Less(t, target, nLogSize)
+
+		_, err = tree.CompactIncrementally(context.Background())
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, tree.minOffset, target)
+
+		for i := 0; i < keyCount; i++ {
+			_, _, _, err := tree.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestIncrementalCompactionDisabled(t *testing.T) {
+	dir := t.TempDir()
+
+	tree, err := Open(dir, DefaultOptions().WithCompactionThld(1))
+	require.NoError(t, err)
+	defer tree.Close()
+
+	_, err = tree.CompactIncrementally(context.Background())
+	require.ErrorIs(t, err, ErrIllegalState)
+
+	// the progress of incremental compactions is not persisted
+	_, err = os.Stat(filepath.Join(dir, compactionFolderPrefix))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestReadCompactionTarget(t *testing.T) {
+	icLog, err := multiapp.Open(t.TempDir(), multiapp.DefaultOptions().WithFileExt("ic"))
+	require.NoError(t, err)
+	defer icLog.Close()
+
+	target, size, err := readCompactionTarget(icLog)
+	require.NoError(t, err)
+	require.Zero(t, target)
+	require.Zero(t, size)
+
+	_, _, err = icLog.Append([]byte{0, 0, 0, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+
+	// partially written records are ignored
+	_, _, err = icLog.Append([]byte{0, 0, 0, 0})
+	require.NoError(t, err)
+
+	target, size, err = readCompactionTarget(icLog)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), target)
+	require.Equal(t, int64(compactionRecordSize), size)
+}
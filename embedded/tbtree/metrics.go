@@ -117,3 +117,18 @@ var metricsBtreeNodesDataEndOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Name: "immudb_btree_nodes_data_end",
 	Help: "End offset for btree nodes data appendable",
 }, []string{"id"})
+
+var metricsBloomFilterChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_btree_bloom_filter_checks",
+	Help: "Number of btree lookups checked against the bloom filter by outcome, negative outcomes avoid descending the btree",
+}, []string{"id", "outcome"})
+
+var metricsBloomFilterKeys = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "immudb_btree_bloom_filter_keys",
+	Help: "Number of distinct keys included in the btree bloom filter",
+}, []string{"id"})
+
+var metricsBloomFilterSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "immudb_btree_bloom_filter_size",
+	Help: "Size in bytes of the btree bloom filter",
+}, []string{"id"})
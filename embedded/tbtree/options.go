@@ -44,12 +44,19 @@ const (
 	DefaultMaxValueSize                  = 512
 	DefaultCompactionThld                = 2
 	DefaultDelayDuringCompaction         = time.Duration(10) * time.Millisecond
+	DefaultBloomFilterBitsPerKey         = 10
+	DefaultBloomFilterCapacity           = 1 << 16
+
+	DefaultIncrementalCompactionStepSize = 1 << 26 // 64Mb
+	DefaultIncrementalCompactionDelay    = time.Duration(100) * time.Millisecond
 
 	DefaultNodesLogMaxOpenedFiles   = 10
 	DefaultHistoryLogMaxOpenedFiles = 1
 	DefaultCommitLogMaxOpenedFiles  = 1
 
 	MinCacheSize = 1
+
+	MaxBloomFilterBitsPerKey = 64
 )
 
 type AppFactoryFunc func(
@@ -85,12 +92,27 @@ type Options struct {
 	compactionThld        int
 	delayDuringCompaction time.Duration
 
+	// progress of incremental compactions is only persisted when enabled
+	incrementalCompaction bool
+	// amount of the nodes log (in bytes) released by each step of an incremental compaction
+	incrementalCompactionStepSize int
+	// delay between the steps of an incremental compaction
+	incrementalCompactionDelay time.Duration
+
+	// bits per key of the bloom filter used to skip lookups of missing keys, zero disables it
+	bloomFilterBitsPerKey int
+	// number of keys of the initial bloom filter, the filter is extended as more keys are inserted
+	bloomFilterCapacity int
+
 	// options below are only set during initialization and stored as metadata
 	maxNodeSize  int
 	maxKeySize   int
 	maxValueSize int
 	fileSize     int
 
+	encryptionAlgorithm int
+	keyRing             appendable.KeyRing
+
 	appFactory AppFactoryFunc
 	appRemove  AppRemoveFunc
 	onFlush    OnFlushFunc
@@ -112,6 +134,11 @@ func DefaultOptions() *Options {
 		fileMode:              DefaultFileMode,
 		compactionThld:        DefaultCompactionThld,
 		delayDuringCompaction: DefaultDelayDuringCompaction,
+		bloomFilterBitsPerKey: DefaultBloomFilterBitsPerKey,
+		bloomFilterCapacity:   DefaultBloomFilterCapacity,
+
+		incrementalCompactionStepSize: DefaultIncrementalCompactionStepSize,
+		incrementalCompactionDelay:    DefaultIncrementalCompactionDelay,
 
 		nodesLogMaxOpenedFiles:   DefaultNodesLogMaxOpenedFiles,
 		historyLogMaxOpenedFiles: DefaultHistoryLogMaxOpenedFiles,
@@ -194,6 +221,22 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid CompactionThld", ErrInvalidOptions)
 	}
 
+	if opts.incrementalCompactionStepSize <= 0 {
+		return fmt.Errorf("%w: invalid IncrementalCompactionStepSize", ErrInvalidOptions)
+	}
+
+	if opts.incrementalCompactionDelay < 0 {
+		return fmt.Errorf("%w: invalid IncrementalCompactionDelay", ErrInvalidOptions)
+	}
+
+	if opts.bloomFilterBitsPerKey < 0 || opts.bloomFilterBitsPerKey > MaxBloomFilterBitsPerKey {
+		return fmt.Errorf("%w: invalid BloomFilterBitsPerKey", ErrInvalidOptions)
+	}
+
+	if opts.bloomFilterBitsPerKey > 0 && opts.bloomFilterCapacity <= 0 {
+		return fmt.Errorf("%w: invalid BloomFilterCapacity", ErrInvalidOptions)
+	}
+
 	if opts.logger == nil {
 		return fmt.Errorf("%w: invalid Logger", ErrInvalidOptions)
 	}
@@ -211,6 +254,12 @@ func (opts *Options) WithAppFactory(appFactory AppFactoryFunc) *Options {
 	return opts
 }
 
+func (opts *Options) WithEncryption(encryptionAlgorithm int, keyRing appendable.KeyRing) *Options {
+	opts.encryptionAlgorithm = encryptionAlgorithm
+	opts.keyRing = keyRing
+	return opts
+}
+
 func (opts *Options) WithAppRemoveFunc(AppRemove AppRemoveFunc) *Options {
 	opts.appRemove = AppRemove
 	return opts
@@ -311,6 +360,31 @@ func (opts *Options) WithDelayDuringCompaction(delay time.Duration) *Options {
 	return opts
 }
 
+func (opts *Options) WithIncrementalCompaction(incrementalCompaction bool) *Options {
+	opts.incrementalCompaction = incrementalCompaction
+	return opts
+}
+
+func (opts *Options) WithIncrementalCompactionStepSize(stepSize int) *Options {
+	opts.incrementalCompactionStepSize = stepSize
+	return opts
+}
+
+func (opts *Options) WithIncrementalCompactionDelay(delay time.Duration) *Options {
+	opts.incrementalCompactionDelay = delay
+	return opts
+}
+
+func (opts *Options) WithBloomFilterBitsPerKey(bitsPerKey int) *Options {
+	opts.bloomFilterBitsPerKey = bitsPerKey
+	return opts
+}
+
+func (opts *Options) WithBloomFilterCapacity(capacity int) *Options {
+	opts.bloomFilterCapacity = capacity
+	return opts
+}
+
 func (opts *Options) WithIdentifier(id uint16) *Options {
 	opts.ID = id
 	return opts
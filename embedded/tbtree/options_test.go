@@ -50,6 +50,10 @@ func TestInvalidOptions(t *testing.T) {
 		{"NodesLogMaxOpenedFiles", DefaultOptions().WithNodesLogMaxOpenedFiles(0)},
 		{"HistoryLogMaxOpenedFiles", DefaultOptions().WithHistoryLogMaxOpenedFiles(0)},
 		{"CommitLogMaxOpenedFiles", DefaultOptions().WithCommitLogMaxOpenedFiles(0)},
+		{"BloomFilterBitsPerKey", DefaultOptions().WithBloomFilterBitsPerKey(MaxBloomFilterBitsPerKey + 1)},
+		{"BloomFilterCapacity", DefaultOptions().WithBloomFilterCapacity(0)},
+		{"IncrementalCompactionStepSize", DefaultOptions().WithIncrementalCompactionStepSize(0)},
+		{"IncrementalCompactionDelay", DefaultOptions().WithIncrementalCompactionDelay(-1)},
 	} {
 		t.Run(d.n, func(t *testing.T) {
 			require.ErrorIs(t, d.opts.Validate(), ErrInvalidOptions)
@@ -81,6 +85,9 @@ func TestValidOptions(t *testing.T) {
 
 	require.Equal(t, 1, opts.WithCompactionThld(1).compactionThld)
 	require.Equal(t, time.Duration(1)*time.Millisecond, opts.WithDelayDuringCompaction(time.Duration(1)*time.Millisecond).delayDuringCompaction)
+	require.True(t, opts.WithIncrementalCompaction(true).incrementalCompaction)
+	require.Equal(t, 1024, opts.WithIncrementalCompactionStepSize(1024).incrementalCompactionStepSize)
+	require.Equal(t, time.Duration(1)*time.Millisecond, opts.WithIncrementalCompactionDelay(time.Duration(1)*time.Millisecond).incrementalCompactionDelay)
 	require.False(t, opts.WithReadOnly(false).readOnly)
 	require.NotNil(t, opts.WithLogger(DefaultOptions().logger))
 
@@ -88,6 +95,9 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 3, opts.WithHistoryLogMaxOpenedFiles(3).historyLogMaxOpenedFiles)
 	require.Equal(t, 1, opts.WithCommitLogMaxOpenedFiles(1).commitLogMaxOpenedFiles)
 
+	require.Equal(t, 8, opts.WithBloomFilterBitsPerKey(8).bloomFilterBitsPerKey)
+	require.Equal(t, 1024, opts.WithBloomFilterCapacity(1024).bloomFilterCapacity)
+
 	require.NoError(t, opts.Validate())
 
 	require.True(t, opts.WithReadOnly(true).readOnly)
@@ -43,6 +43,9 @@ type Snapshot struct {
 	maxReaderID int
 	closed      bool
 
+	// keys set into the snapshot are not included in the bloom filter of the tree
+	written bool
+
 	_buf []byte
 
 	mutex sync.RWMutex
@@ -92,6 +95,7 @@ func (s *Snapshot) Set(key, value []byte) error {
 
 	// Update the root node
 	s.root = nodes[0]
+	s.written = true
 
 	// Update B-tree depth metric
 	metricsBtreeDepth.WithLabelValues(s.t.path).Set(float64(depth))
@@ -120,8 +124,15 @@ func (s *Snapshot) Get(key []byte) (value []byte, ts uint64, hc uint64, err erro
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	// Skip the retrieval when the key was never inserted
+	if !s.keyMayExist(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	// Delegate the retrieval to the root node
 	v, ts, hc, err := s.root.get(key)
+	s.t.checkBloomFilterOutcome(err)
+
 	return cp(v), ts, hc, err
 }
 
@@ -137,10 +148,18 @@ func (s *Snapshot) GetBetween(key []byte, initialTs, finalTs uint64) (value []by
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !s.keyMayExist(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	v, ts, hc, err := s.root.getBetween(key, initialTs, finalTs)
 	return cp(v), ts, hc, err
 }
 
+func (s *Snapshot) keyMayExist(key []byte) bool {
+	return s.written || s.t.keyMayExist(key)
+}
+
 // History retrieves the history of a key in the snapshot.
 // It locks the snapshot for reading, and delegates the history retrieval to the root node.
 // The method returns an array of timestamps, the hash count, and an error.
@@ -167,8 +186,16 @@ func (s *Snapshot) History(key []byte, offset uint64, descOrder bool, limit int)
 		return nil, 0, ErrIllegalArguments
 	}
 
+	// Skip the retrieval when the key was never inserted
+	if !s.keyMayExist(key) {
+		return nil, 0, ErrKeyNotFound
+	}
+
 	// Delegate the history retrieval to the root node
-	return s.root.history(key, offset, descOrder, limit)
+	timedValues, hCount, err = s.root.history(key, offset, descOrder, limit)
+	s.t.checkBloomFilterOutcome(err)
+
+	return timedValues, hCount, err
 }
 
 // Ts returns the timestamp associated with the root node of the snapshot.
@@ -406,6 +433,7 @@ func (n *innerNode) writeTo(nw, hw io.Writer, writeOpts *WriteOpts, buf []byte)
 		commitLog:      writeOpts.commitLog,
 		reportProgress: writeOpts.reportProgress,
 		MinOffset:      writeOpts.MinOffset,
+		bloomFilter:    writeOpts.bloomFilter,
 	}
 
 	offsets := make([]int64, len(n.nodes))
@@ -513,6 +541,10 @@ func (l *leafNode) writeTo(nw, hw io.Writer, writeOpts *WriteOpts, buf []byte) (
 	for _, v := range l.values {
 		timedValue := v.timedValues[0]
 
+		if writeOpts.bloomFilter != nil {
+			writeOpts.bloomFilter.addHash(bloomHash(v.key))
+		}
+
 		binary.BigEndian.PutUint16(buf[bi:], uint16(len(v.key)))
 		bi += 2
 
@@ -179,6 +179,18 @@ type TBtree struct {
 
 	cLog appendable.Appendable
 
+	// bloom filter over the inserted keys, nil when disabled or not yet rebuilt
+	bloomFilter *bloomFilter
+	bLog        appendable.Appendable
+
+	// bloom filter check counters, resolved once as they are updated on every lookup
+	bloomFilterPositives      prometheus.Counter
+	bloomFilterNegatives      prometheus.Counter
+	bloomFilterFalsePositives prometheus.Counter
+
+	// log holding the target of the incremental compaction in progress, if any
+	icLog appendable.Appendable
+
 	root node
 
 	maxNodeSize                int
@@ -200,9 +212,13 @@ type TBtree struct {
 	maxValueSize               int
 	compactionThld             int
 	delayDuringCompaction      time.Duration
+	bloomFilterBitsPerKey      int
+	bloomFilterCapacity        int
 	nodesLogMaxOpenedFiles     int
 	historyLogMaxOpenedFiles   int
 	commitLogMaxOpenedFiles    int
+	encryptionAlgorithm        int
+	keyRing                    appendable.KeyRing
 	appFactory                 AppFactoryFunc
 	appRemove                  AppRemoveFunc
 
@@ -216,9 +232,19 @@ type TBtree struct {
 	committedLogSize  int64
 	committedNLogSize int64
 	committedHLogSize int64
+	committedBLogSize int64
 	minOffset         int64
 
-	compacting bool
+	// nodes log offset up to which the incremental compaction in progress rewrites nodes,
+	// zero when no incremental compaction is in progress
+	compactionTarget int64
+
+	incrementalCompaction         bool
+	incrementalCompactionStepSize int
+	incrementalCompactionDelay    time.Duration
+
+	compacting              bool
+	compactingIncrementally bool
 
 	closed  bool
 	rwmutex sync.RWMutex
@@ -257,6 +283,7 @@ type WriteOpts struct {
 	commitLog      bool
 	reportProgress writeProgressOutputFunc
 	MinOffset      int64
+	bloomFilter    *bloomFilter // keys of written leaf nodes are added into it
 }
 
 type innerNode struct {
@@ -327,6 +354,7 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		WithFileSize(opts.fileSize).
 		WithFileMode(opts.fileMode).
 		WithWriteBufferSize(opts.flushBufferSize).
+		WithEncryption(opts.encryptionAlgorithm, opts.keyRing).
 		WithMetadata(metadata.Bytes())
 
 	appFactory := opts.appFactory
@@ -386,6 +414,9 @@ func Open(path string, opts *Options) (*TBtree, error) {
 			continue
 		}
 
+		bLog := openBloomLog(path, snapID, appendableOpts, appFactory, opts)
+		icLog := openCompactionLog(path, snapID, appendableOpts, appFactory, opts)
+
 		var t *TBtree
 		var discardSnapshotsFolder bool
 
@@ -396,7 +427,7 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		}
 		if err == nil && !discardSnapshotsFolder {
 			// TODO: semantic validation and further amendment procedures may be done instead of a full initialization
-			t, err = OpenWith(path, nLog, hLog, cLog, opts)
+			t, err = openWith(path, nLog, hLog, cLog, bLog, icLog, opts)
 		}
 		if err != nil {
 			opts.logger.Infof("skipping snapshots at '%s', opening btree returned: %v", snapPath, err)
@@ -407,6 +438,14 @@ func Open(path string, opts *Options) (*TBtree, error) {
 			nLog.Close()
 			cLog.Close()
 
+			if bLog != nil {
+				bLog.Close()
+			}
+
+			if icLog != nil {
+				icLog.Close()
+			}
+
 			err = discardSnapshots(path, snapIDs[i-1:i], appRemove, opts.logger)
 			if err != nil {
 				opts.logger.Warningf("discarding snapshots at '%s' returned: %v", path, err)
@@ -447,7 +486,29 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		return nil, err
 	}
 
-	return OpenWith(path, nLog, hLog, cLog, opts)
+	bLog := openBloomLog(path, 0, appendableOpts, appFactory, opts)
+	icLog := openCompactionLog(path, 0, appendableOpts, appFactory, opts)
+
+	return openWith(path, nLog, hLog, cLog, bLog, icLog, opts)
+}
+
+// openBloomLog opens the log holding the bloom filter of the snapshot,
+// nil is returned when the filter is disabled or can not be opened
+func openBloomLog(path string, snapID uint64, appendableOpts *multiapp.Options, appFactory AppFactoryFunc, opts *Options) appendable.Appendable {
+	if opts.bloomFilterBitsPerKey == 0 {
+		return nil
+	}
+
+	appendableOpts.WithFileExt("bf")
+	appendableOpts.WithMaxOpenedFiles(1)
+
+	bLog, err := appFactory(path, snapFolder(bloomFolderPrefix, snapID), appendableOpts)
+	if err != nil {
+		opts.logger.Warningf("bloom filter of index '%s' can not be opened: %v", path, err)
+		return nil
+	}
+
+	return bLog
 }
 
 func snapFolder(folder string, snapID uint64) string {
@@ -501,13 +562,30 @@ func discardSnapshots(path string, snapIDs []uint64, appRemove AppRemoveFunc, lo
 			return err
 		}
 
+		err = appRemove(path, snapFolder(bloomFolderPrefix, snapID))
+		if err != nil {
+			return err
+		}
+
+		err = appRemove(path, snapFolder(compactionFolderPrefix, snapID))
+		if err != nil {
+			return err
+		}
+
 		logger.Infof("snapshot with id=%d at '%s' has been discarded, %d", snapID, path)
 	}
 
 	return nil
 }
 
+// OpenWith opens the index using the provided appendables, as no bloom filter log is provided
+// the filter is only available for empty indexes and it's not persisted.
+// Incremental compactions are not resumed as their progress is not persisted either
 func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options) (*TBtree, error) {
+	return openWith(path, nLog, hLog, cLog, nil, nil, opts)
+}
+
+func openWith(path string, nLog, hLog, cLog, bLog, icLog appendable.Appendable, opts *Options) (*TBtree, error) {
 	if nLog == nil || hLog == nil || cLog == nil {
 		return nil, ErrIllegalArguments
 	}
@@ -575,6 +653,8 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		nLog:                     nLog,
 		hLog:                     hLog,
 		cLog:                     cLog,
+		bLog:                     bLog,
+		icLog:                    icLog,
 		cache:                    nodeCache,
 		maxNodeSize:              maxNodeSize,
 		maxKeySize:               maxKeySize,
@@ -592,13 +672,25 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		fileMode:                 opts.fileMode,
 		compactionThld:           opts.compactionThld,
 		delayDuringCompaction:    opts.delayDuringCompaction,
+		bloomFilterBitsPerKey:    opts.bloomFilterBitsPerKey,
+		bloomFilterCapacity:      opts.bloomFilterCapacity,
 		nodesLogMaxOpenedFiles:   opts.nodesLogMaxOpenedFiles,
 		historyLogMaxOpenedFiles: opts.historyLogMaxOpenedFiles,
 		commitLogMaxOpenedFiles:  opts.commitLogMaxOpenedFiles,
 		readOnly:                 opts.readOnly,
+		encryptionAlgorithm:      opts.encryptionAlgorithm,
+		keyRing:                  opts.keyRing,
 		appFactory:               opts.appFactory,
 		appRemove:                opts.appRemove,
 		snapshots:                make(map[uint64]*Snapshot),
+
+		incrementalCompaction:         opts.incrementalCompaction,
+		incrementalCompactionStepSize: opts.incrementalCompactionStepSize,
+		incrementalCompactionDelay:    opts.incrementalCompactionDelay,
+
+		bloomFilterPositives:      metricsBloomFilterChecks.WithLabelValues(path, "positive"),
+		bloomFilterNegatives:      metricsBloomFilterChecks.WithLabelValues(path, "negative"),
+		bloomFilterFalsePositives: metricsBloomFilterChecks.WithLabelValues(path, "false_positive"),
 	}
 
 	var validatedCLogEntry *cLogEntry
@@ -679,6 +771,23 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		t.minOffset = t.root.minOffset()
 	}
 
+	if t.bloomFilterBitsPerKey > 0 {
+		t.bloomFilter, err = t.loadBloomFilter(validatedCLogEntry == nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: while loading bloom filter of index '%s'", err, path)
+		}
+	}
+
+	t.compactionTarget, err = t.loadCompactionTarget(validatedCLogEntry == nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: while loading incremental compaction progress of index '%s'", err, path)
+	}
+
+	if t.bloomFilter != nil {
+		metricsBloomFilterKeys.WithLabelValues(t.path).Set(float64(t.bloomFilter.KeyCount()))
+		metricsBloomFilterSize.WithLabelValues(t.path).Set(float64(t.bloomFilter.Size()))
+	}
+
 	metricsBtreeNodesDataBeginOffset.WithLabelValues(t.path).Set(float64(t.minOffset))
 	metricsBtreeNodesDataEndOffset.WithLabelValues(t.path).Set(float64(t.committedNLogSize))
 
@@ -737,9 +846,15 @@ func (t *TBtree) GetOptions() *Options {
 		WithRenewSnapRootAfter(t.renewSnapRootAfter).
 		WithCompactionThld(t.compactionThld).
 		WithDelayDuringCompaction(t.delayDuringCompaction).
+		WithIncrementalCompaction(t.incrementalCompaction).
+		WithIncrementalCompactionStepSize(t.incrementalCompactionStepSize).
+		WithIncrementalCompactionDelay(t.incrementalCompactionDelay).
+		WithBloomFilterBitsPerKey(t.bloomFilterBitsPerKey).
+		WithBloomFilterCapacity(t.bloomFilterCapacity).
 		WithNodesLogMaxOpenedFiles(t.nodesLogMaxOpenedFiles).
 		WithHistoryLogMaxOpenedFiles(t.historyLogMaxOpenedFiles).
 		WithCommitLogMaxOpenedFiles(t.commitLogMaxOpenedFiles).
+		WithEncryption(t.encryptionAlgorithm, t.keyRing).
 		WithAppFactory(t.appFactory).
 		WithAppRemoveFunc(t.appRemove)
 }
@@ -975,7 +1090,13 @@ func (t *TBtree) Get(key []byte) (value []byte, ts uint64, hc uint64, err error)
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !t.keyMayExist(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	v, ts, hc, err := t.root.get(key)
+	t.checkBloomFilterOutcome(err)
+
 	return cp(v), ts, hc, err
 }
 
@@ -991,6 +1112,10 @@ func (t *TBtree) GetBetween(key []byte, initialTs, finalTs uint64) (value []byte
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !t.keyMayExist(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	return t.root.getBetween(key, initialTs, finalTs)
 }
 
@@ -1010,7 +1135,14 @@ func (t *TBtree) History(key []byte, offset uint64, descOrder bool, limit int) (
 		return nil, 0, ErrIllegalArguments
 	}
 
-	return t.root.history(key, offset, descOrder, limit)
+	if !t.keyMayExist(key) {
+		return nil, 0, ErrKeyNotFound
+	}
+
+	tvs, hCount, err = t.root.history(key, offset, descOrder, limit)
+	t.checkBloomFilterOutcome(err)
+
+	return tvs, hCount, err
 }
 
 func (t *TBtree) GetWithPrefix(prefix []byte, neq []byte) (key []byte, value []byte, ts uint64, hc uint64, err error) {
@@ -1084,6 +1216,12 @@ func (t *TBtree) wrapNwarn(formattedMessage string, args ...interface{}) error {
 }
 
 func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceCleanup bool, src string) (wN int64, wH int64, err error) {
+	return t.flushTreeUpto(0, cleanupPercentageHint, forceSync, forceCleanup, src)
+}
+
+// flushTreeUpto flushes the tree rewriting, besides mutated nodes, the nodes stored below the
+// greatest of minOffsetHint and the offset resulting from the cleanup percentage
+func (t *TBtree) flushTreeUpto(minOffsetHint int64, cleanupPercentageHint float32, forceSync bool, forceCleanup bool, src string) (wN int64, wH int64, err error) {
 	if cleanupPercentageHint < 0 || cleanupPercentageHint > 100 {
 		return 0, 0, fmt.Errorf("%w: invalid cleanupPercentage", ErrIllegalArguments)
 	}
@@ -1098,7 +1236,7 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 		src,
 	)
 
-	if !t.root.mutated() && cleanupPercentage == 0 {
+	if !t.root.mutated() && cleanupPercentage == 0 && minOffsetHint <= t.minOffset {
 		t.logger.Infof("flushing not needed at '%s' {ts=%d, cleanup_percentage=%.2f}", t.path, t.root.ts(), cleanupPercentage)
 		return 0, 0, nil
 	}
@@ -1117,18 +1255,37 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 		return 0, 0, err
 	}
 
-	progressOutputFunc, finishOutputFunc := t.buildWriteProgressOutput(
-		metricsFlushedNodesLastCycle,
-		metricsFlushedNodesTotal,
-		metricsFlushedEntriesLastCycle,
-		metricsFlushedEntriesTotal,
-		"flushing",
-		t.root.ts(),
-		time.Minute,
-	)
+	var progressOutputFunc writeProgressOutputFunc
+	var finishOutputFunc writeFinnishOutputFunc
+
+	if minOffsetHint > t.minOffset {
+		// nodes are rewritten as part of an incremental compaction
+		progressOutputFunc, finishOutputFunc = t.buildWriteProgressOutput(
+			metricsCompactedNodesLastCycle,
+			metricsCompactedNodesTotal,
+			metricsCompactedEntriesLastCycle,
+			metricsCompactedEntriesTotal,
+			"compacting",
+			t.root.ts(),
+			time.Minute,
+		)
+	} else {
+		progressOutputFunc, finishOutputFunc = t.buildWriteProgressOutput(
+			metricsFlushedNodesLastCycle,
+			metricsFlushedNodesTotal,
+			metricsFlushedEntriesLastCycle,
+			metricsFlushedEntriesTotal,
+			"flushing",
+			t.root.ts(),
+			time.Minute,
+		)
+	}
 	defer finishOutputFunc()
 
 	expectedNewMinOffset := t.minOffset + int64((float64(t.committedNLogSize-t.minOffset)*float64(cleanupPercentage))/100)
+	if minOffsetHint > expectedNewMinOffset {
+		expectedNewMinOffset = minOffsetHint
+	}
 
 	wopts := &WriteOpts{
 		OnlyMutated:    true,
@@ -1173,6 +1330,13 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 		}
 	}
 
+	// bloom filter is persisted before committing so to be never behind the index
+	err = t.persistBloomFilter(sync)
+	if err != nil {
+		return 0, 0, t.wrapNwarn("flushing bloom filter of index '%s' {ts=%d} returned: %v",
+			t.path, t.root.ts(), err)
+	}
+
 	// will overwrite partially written and uncommitted data
 	err = t.cLog.SetOffset(t.committedLogSize)
 	if err != nil {
@@ -1378,7 +1542,7 @@ func (t *TBtree) Compact() (uint64, error) {
 		return 0, ErrAlreadyClosed
 	}
 
-	if t.compacting {
+	if t.compacting || t.compactingIncrementally {
 		return 0, ErrCompactAlreadyInProgress
 	}
 
@@ -1436,6 +1600,7 @@ func (t *TBtree) fullDump(snap *Snapshot, progressOutput writeProgressOutputFunc
 		WithFileSize(t.fileSize).
 		WithFileMode(t.fileMode).
 		WithWriteBufferSize(t.flushBufferSize).
+		WithEncryption(t.encryptionAlgorithm, t.keyRing).
 		WithMetadata(t.cLog.Metadata())
 
 	appendableOpts.WithFileExt("n")
@@ -1464,10 +1629,25 @@ func (t *TBtree) fullDump(snap *Snapshot, progressOutput writeProgressOutputFunc
 		cLog.Close()
 	}()
 
-	return t.fullDumpTo(snap, nLog, cLog, progressOutput)
+	var bLog appendable.Appendable
+
+	if t.bloomFilterBitsPerKey > 0 {
+		appendableOpts.WithFileExt("bf")
+		bLogPath := filepath.Join(t.path, snapFolder(bloomFolderPrefix, snap.Ts()))
+
+		bLog, err = multiapp.Open(bLogPath, appendableOpts)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			bLog.Close()
+		}()
+	}
+
+	return t.fullDumpTo(snap, nLog, cLog, bLog, progressOutput)
 }
 
-func (t *TBtree) fullDumpTo(snapshot *Snapshot, nLog, cLog appendable.Appendable, progressOutput writeProgressOutputFunc) error {
+func (t *TBtree) fullDumpTo(snapshot *Snapshot, nLog, cLog, bLog appendable.Appendable, progressOutput writeProgressOutputFunc) error {
 	wopts := &WriteOpts{
 		OnlyMutated:    false,
 		BaseNLogOffset: 0,
@@ -1475,6 +1655,16 @@ func (t *TBtree) fullDumpTo(snapshot *Snapshot, nLog, cLog appendable.Appendable
 		reportProgress: progressOutput,
 	}
 
+	if bLog != nil {
+		// the filter is rebuilt from the keys of the dumped leaf nodes, sized to hold all of them
+		capacity := t.bloomFilterCapacity
+		if t.bloomFilter != nil && t.bloomFilter.KeyCount() > capacity {
+			capacity = t.bloomFilter.KeyCount()
+		}
+
+		wopts.bloomFilter = newBloomFilter(t.bloomFilterBitsPerKey, capacity)
+	}
+
 	_, _, wN, _, err := snapshot.WriteTo(&appendableWriter{nLog}, nil, wopts)
 	if err != nil {
 		return err
@@ -1490,6 +1680,13 @@ func (t *TBtree) fullDumpTo(snapshot *Snapshot, nLog, cLog appendable.Appendable
 		return err
 	}
 
+	if bLog != nil {
+		err = writeBloomFilter(bLog, wopts.bloomFilter, snapshot.Ts())
+		if err != nil {
+			return err
+		}
+	}
+
 	// history log is not dumped but to ensure it's fully synced
 	err = t.hLog.Sync()
 	if err != nil {
@@ -1575,6 +1772,16 @@ func (t *TBtree) Close() error {
 	err = t.cLog.Close()
 	merrors.Append(err)
 
+	if t.bLog != nil {
+		err = t.bLog.Close()
+		merrors.Append(err)
+	}
+
+	if t.icLog != nil {
+		err = t.icLog.Close()
+		merrors.Append(err)
+	}
+
 	err = merrors.Reduce()
 	if err != nil {
 		return t.wrapNwarn("closing index '%s' {ts=%d} returned: %v", t.path, t.root.ts(), err)
@@ -1759,6 +1966,12 @@ func (t *TBtree) bulkInsert(kvts []*KVT) error {
 
 	t.root = nodes[0]
 
+	if t.bloomFilter != nil {
+		for _, kvt := range immutableKVTs {
+			t.bloomFilter.Add(kvt.K)
+		}
+	}
+
 	metricsBtreeDepth.WithLabelValues(t.path).Set(float64(depth))
 
 	t.insertionCountSinceFlush += len(immutableKVTs)
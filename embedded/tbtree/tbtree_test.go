@@ -781,7 +781,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		nLog.AppendFn = func(bs []byte) (off int64, n int, err error) {
 			return 0, 0, injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -798,7 +798,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		cLog.AppendFn = func(bs []byte) (off int64, n int, err error) {
 			return 0, 0, injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -812,7 +812,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		nLog.FlushFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -829,7 +829,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		nLog.SyncFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -849,7 +849,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		cLog.FlushFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 
@@ -872,7 +872,7 @@ func TestTBTreeCompactionEdgeCases(t *testing.T) {
 		cLog.SyncFn = func() error {
 			return injectedError
 		}
-		err = tree.fullDumpTo(snap, nLog, cLog, func(int, int, int) {})
+		err = tree.fullDumpTo(snap, nLog, cLog, nil, func(int, int, int) {})
 		require.ErrorIs(t, err, injectedError)
 	})
 }
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/htree"
+	"github.com/codenotary/immudb/embedded/mtreap"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -128,6 +129,84 @@ func InclusionProofFromProto(iproof *InclusionProof) *htree.InclusionProof {
 	}
 }
 
+func MultiInclusionProofToProto(mproof *htree.MultiInclusionProof) *MultiInclusionProof {
+	leaves := make([]int32, len(mproof.Leaves))
+
+	for i, leaf := range mproof.Leaves {
+		leaves[i] = int32(leaf)
+	}
+
+	return &MultiInclusionProof{
+		Leaves: leaves,
+		Width:  int32(mproof.Width),
+		Terms:  DigestsToProto(mproof.Terms),
+	}
+}
+
+func MultiInclusionProofFromProto(mproof *MultiInclusionProof) *htree.MultiInclusionProof {
+	leaves := make([]int, len(mproof.Leaves))
+
+	for i, leaf := range mproof.Leaves {
+		leaves[i] = int(leaf)
+	}
+
+	return &htree.MultiInclusionProof{
+		Leaves: leaves,
+		Width:  int(mproof.Width),
+		Terms:  DigestsFromProto(mproof.Terms),
+	}
+}
+
+func KeyIndexInclusionProofToProto(iproof *mtreap.InclusionProof) *KeyIndexInclusionProof {
+	path := make([]*KeyIndexProofStep, len(iproof.Path))
+
+	for i, step := range iproof.Path {
+		path[i] = &KeyIndexProofStep{
+			Right:       step.Right,
+			Digest:      step.Digest[:],
+			SiblingSize: step.SiblingSize,
+			SiblingHash: step.SiblingHash[:],
+		}
+	}
+
+	return &KeyIndexInclusionProof{
+		Leaf:      iproof.Leaf,
+		Width:     iproof.Width,
+		LeftSize:  iproof.LeftSize,
+		LeftHash:  iproof.LeftHash[:],
+		RightSize: iproof.RightSize,
+		RightHash: iproof.RightHash[:],
+		Path:      path,
+	}
+}
+
+func KeyIndexInclusionProofFromProto(iproof *KeyIndexInclusionProof) *mtreap.InclusionProof {
+	path := make([]*mtreap.ProofStep, len(iproof.Path))
+
+	for i, step := range iproof.Path {
+		if step == nil {
+			continue
+		}
+
+		path[i] = &mtreap.ProofStep{
+			Right:       step.Right,
+			Digest:      DigestFromProto(step.Digest),
+			SiblingSize: step.SiblingSize,
+			SiblingHash: DigestFromProto(step.SiblingHash),
+		}
+	}
+
+	return &mtreap.InclusionProof{
+		Leaf:      iproof.Leaf,
+		Width:     iproof.Width,
+		LeftSize:  iproof.LeftSize,
+		LeftHash:  DigestFromProto(iproof.LeftHash),
+		RightSize: iproof.RightSize,
+		RightHash: DigestFromProto(iproof.RightHash),
+		Path:      path,
+	}
+}
+
 func DualProofToProto(dualProof *store.DualProof) *DualProof {
 	return &DualProof{
 		SourceTxHeader:     TxHeaderToProto(dualProof.SourceTxHeader),
@@ -181,6 +260,17 @@ func TxMetadataToProto(md *store.TxMetadata) *TxMetadata {
 
 	txmd.Extra = md.Extra()
 
+	if md.HasRedactedEntries() {
+		txID, entries, _ := md.GetRedactedEntries()
+
+		txmd.RedactedTxID = txID
+		txmd.RedactedEntries = make([]uint32, len(entries))
+
+		for i, e := range entries {
+			txmd.RedactedEntries[i] = uint32(e)
+		}
+	}
+
 	return txmd
 }
 
@@ -258,6 +348,17 @@ func TxMetadataFromProto(md *TxMetadata) *store.TxMetadata {
 
 	txmd.WithExtra(md.Extra)
 
+	if md.RedactedTxID > 0 {
+		entries := make([]int, len(md.RedactedEntries))
+
+		for i, e := range md.RedactedEntries {
+			entries[i] = int(e)
+		}
+
+		// invalid redaction info is discarded, thus the resulting header won't be verifiable
+		txmd.WithRedactedEntries(md.RedactedTxID, entries)
+	}
+
 	return txmd
 }
 
@@ -61,6 +61,18 @@ func (m *ExecAllRequest) Validate() error {
 				return ErrDuplicatedReferencesNotSupported
 			}
 			mops[mk] = struct{}{}
+		case *Op_Increment:
+			mk := sha256.Sum256(x.Increment.Key)
+			if _, ok := mops[mk]; ok {
+				return fmt.Errorf("%w: key/reference '%s'", ErrDuplicatedKeysNotSupported, x.Increment.Key)
+			}
+			mops[mk] = struct{}{}
+		case *Op_Append:
+			mk := sha256.Sum256(x.Append.Key)
+			if _, ok := mops[mk]; ok {
+				return fmt.Errorf("%w: key/reference '%s'", ErrDuplicatedKeysNotSupported, x.Append.Key)
+			}
+			mops[mk] = struct{}{}
 		case nil:
 			return status.New(codes.InvalidArgument, "operation is not set").Err()
 		default:
@@ -59,6 +59,52 @@ func TestOps_ValidateErrDuplicatedKeysNotSupported(t *testing.T) {
 
 }
 
+func TestOps_ValidateErrDuplicatedMergeKeysNotSupported(t *testing.T) {
+	aOps := &ExecAllRequest{
+		Operations: []*Op{
+			{
+				Operation: &Op_Increment{
+					Increment: &IncrementRequest{
+						Key:   []byte(`key`),
+						Delta: 1,
+					},
+				},
+			},
+			{
+				Operation: &Op_Append{
+					Append: &AppendRequest{
+						Key:   []byte(`key`),
+						Value: []byte(`val`),
+					},
+				},
+			},
+		},
+	}
+	err := aOps.Validate()
+	require.ErrorIs(t, err, ErrDuplicatedKeysNotSupported)
+
+	aOps.Operations[1] = &Op{
+		Operation: &Op_Increment{
+			Increment: &IncrementRequest{
+				Key:   []byte(`key`),
+				Delta: 2,
+			},
+		},
+	}
+	err = aOps.Validate()
+	require.ErrorIs(t, err, ErrDuplicatedKeysNotSupported)
+
+	aOps.Operations[1] = &Op{
+		Operation: &Op_Append{
+			Append: &AppendRequest{
+				Key:   []byte(`key2`),
+				Value: []byte(`val`),
+			},
+		},
+	}
+	require.NoError(t, aOps.Validate())
+}
+
 func TestOps_ValidateErrDuplicateZAddNotSupported(t *testing.T) {
 	aOps := &ExecAllRequest{
 		Operations: []*Op{
@@ -46,3 +46,48 @@ func PreconditionKeyNotModifiedAfterTX(key []byte, txID uint64) *Precondition {
 		},
 	}
 }
+
+func PreconditionValueMustMatch(key []byte, value []byte) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_ValueMustMatch{
+			ValueMustMatch: &Precondition_ValueMustMatchPrecondition{
+				Key:   key,
+				Value: value,
+			},
+		},
+	}
+}
+
+func PreconditionValueDigestMustMatch(key []byte, digest []byte) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_ValueDigestMustMatch{
+			ValueDigestMustMatch: &Precondition_ValueDigestMustMatchPrecondition{
+				Key:    key,
+				Digest: digest,
+			},
+		},
+	}
+}
+
+func PreconditionKeyMetadataMustMatch(key []byte, md *KVMetadata) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_KeyMetadataMustMatch{
+			KeyMetadataMustMatch: &Precondition_KeyMetadataMustMatchPrecondition{
+				Key:      key,
+				Metadata: md,
+			},
+		},
+	}
+}
+
+func PreconditionKeyCountInRange(prefix []byte, minCount, maxCount uint64) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_KeyCountInRange{
+			KeyCountInRange: &Precondition_KeyCountInRangePrecondition{
+				Prefix:   prefix,
+				MinCount: minCount,
+				MaxCount: maxCount,
+			},
+		},
+	}
+}
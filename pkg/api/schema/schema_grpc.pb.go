@@ -45,10 +45,14 @@ type ImmuServiceClient interface {
 	VerifiableSet(ctx context.Context, in *VerifiableSetRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	Get(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Entry, error)
 	VerifiableGet(ctx context.Context, in *VerifiableGetRequest, opts ...grpc.CallOption) (*VerifiableEntry, error)
+	CommitKeyIndex(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TxHeader, error)
+	RedactEntries(ctx context.Context, in *RedactEntriesRequest, opts ...grpc.CallOption) (*TxHeader, error)
+	KeyIndexProof(ctx context.Context, in *KeyIndexProofRequest, opts ...grpc.CallOption) (*VerifiableKeyIndexProof, error)
 	Delete(ctx context.Context, in *DeleteKeysRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	GetAll(ctx context.Context, in *KeyListRequest, opts ...grpc.CallOption) (*Entries, error)
 	ExecAll(ctx context.Context, in *ExecAllRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*Entries, error)
+	VerifiableScan(ctx context.Context, in *VerifiableScanRequest, opts ...grpc.CallOption) (*VerifiableEntries, error)
 	// NOT YET SUPPORTED
 	Count(ctx context.Context, in *KeyPrefix, opts ...grpc.CallOption) (*EntryCount, error)
 	// NOT YET SUPPORTED
@@ -94,12 +98,14 @@ type ImmuServiceClient interface {
 	GetDatabaseSettingsV2(ctx context.Context, in *DatabaseSettingsRequest, opts ...grpc.CallOption) (*DatabaseSettingsResponse, error)
 	FlushIndex(ctx context.Context, in *FlushIndexRequest, opts ...grpc.CallOption) (*FlushIndexResponse, error)
 	CompactIndex(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CompactValueLog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CompactValueLogResponse, error)
 	// Streams
 	StreamGet(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (ImmuService_StreamGetClient, error)
 	StreamSet(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamSetClient, error)
 	StreamVerifiableGet(ctx context.Context, in *VerifiableGetRequest, opts ...grpc.CallOption) (ImmuService_StreamVerifiableGetClient, error)
 	StreamVerifiableSet(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamVerifiableSetClient, error)
 	StreamScan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (ImmuService_StreamScanClient, error)
+	StreamVerifiableScan(ctx context.Context, in *VerifiableScanRequest, opts ...grpc.CallOption) (ImmuService_StreamVerifiableScanClient, error)
 	StreamZScan(ctx context.Context, in *ZScanRequest, opts ...grpc.CallOption) (ImmuService_StreamZScanClient, error)
 	StreamHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (ImmuService_StreamHistoryClient, error)
 	StreamExecAll(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamExecAllClient, error)
@@ -107,6 +113,8 @@ type ImmuServiceClient interface {
 	ExportTx(ctx context.Context, in *ExportTxRequest, opts ...grpc.CallOption) (ImmuService_ExportTxClient, error)
 	ReplicateTx(ctx context.Context, opts ...grpc.CallOption) (ImmuService_ReplicateTxClient, error)
 	StreamExportTx(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamExportTxClient, error)
+	// Change streams
+	SubscribeChanges(ctx context.Context, in *SubscribeChangesRequest, opts ...grpc.CallOption) (ImmuService_SubscribeChangesClient, error)
 	SQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*SQLExecResult, error)
 	// For backward compatibility with the grpc-gateway API
 	UnarySQLQuery(ctx context.Context, in *SQLQueryRequest, opts ...grpc.CallOption) (*SQLQueryResult, error)
@@ -350,6 +358,33 @@ func (c *immuServiceClient) VerifiableGet(ctx context.Context, in *VerifiableGet
 	return out, nil
 }
 
+func (c *immuServiceClient) CommitKeyIndex(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TxHeader, error) {
+	out := new(TxHeader)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CommitKeyIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) RedactEntries(ctx context.Context, in *RedactEntriesRequest, opts ...grpc.CallOption) (*TxHeader, error) {
+	out := new(TxHeader)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/RedactEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) KeyIndexProof(ctx context.Context, in *KeyIndexProofRequest, opts ...grpc.CallOption) (*VerifiableKeyIndexProof, error) {
+	out := new(VerifiableKeyIndexProof)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/KeyIndexProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Delete(ctx context.Context, in *DeleteKeysRequest, opts ...grpc.CallOption) (*TxHeader, error) {
 	out := new(TxHeader)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Delete", in, out, opts...)
@@ -386,6 +421,15 @@ func (c *immuServiceClient) Scan(ctx context.Context, in *ScanRequest, opts ...g
 	return out, nil
 }
 
+func (c *immuServiceClient) VerifiableScan(ctx context.Context, in *VerifiableScanRequest, opts ...grpc.CallOption) (*VerifiableEntries, error) {
+	out := new(VerifiableEntries)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Count(ctx context.Context, in *KeyPrefix, opts ...grpc.CallOption) (*EntryCount, error) {
 	out := new(EntryCount)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Count", in, out, opts...)
@@ -661,6 +705,15 @@ func (c *immuServiceClient) CompactIndex(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *immuServiceClient) CompactValueLog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CompactValueLogResponse, error) {
+	out := new(CompactValueLogResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CompactValueLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) StreamGet(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (ImmuService_StreamGetClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[1], "/immudb.schema.ImmuService/streamGet", opts...)
 	if err != nil {
@@ -825,8 +878,40 @@ func (x *immuServiceStreamScanClient) Recv() (*Chunk, error) {
 	return m, nil
 }
 
+func (c *immuServiceClient) StreamVerifiableScan(ctx context.Context, in *VerifiableScanRequest, opts ...grpc.CallOption) (ImmuService_StreamVerifiableScanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[6], "/immudb.schema.ImmuService/streamVerifiableScan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &immuServiceStreamVerifiableScanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ImmuService_StreamVerifiableScanClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type immuServiceStreamVerifiableScanClient struct {
+	grpc.ClientStream
+}
+
+func (x *immuServiceStreamVerifiableScanClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *immuServiceClient) StreamZScan(ctx context.Context, in *ZScanRequest, opts ...grpc.CallOption) (ImmuService_StreamZScanClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[6], "/immudb.schema.ImmuService/streamZScan", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[7], "/immudb.schema.ImmuService/streamZScan", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -858,7 +943,7 @@ func (x *immuServiceStreamZScanClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (ImmuService_StreamHistoryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[7], "/immudb.schema.ImmuService/streamHistory", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[8], "/immudb.schema.ImmuService/streamHistory", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -890,7 +975,7 @@ func (x *immuServiceStreamHistoryClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamExecAll(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamExecAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[8], "/immudb.schema.ImmuService/streamExecAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[9], "/immudb.schema.ImmuService/streamExecAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -924,7 +1009,7 @@ func (x *immuServiceStreamExecAllClient) CloseAndRecv() (*TxHeader, error) {
 }
 
 func (c *immuServiceClient) ExportTx(ctx context.Context, in *ExportTxRequest, opts ...grpc.CallOption) (ImmuService_ExportTxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[9], "/immudb.schema.ImmuService/exportTx", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[10], "/immudb.schema.ImmuService/exportTx", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -956,7 +1041,7 @@ func (x *immuServiceExportTxClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) ReplicateTx(ctx context.Context, opts ...grpc.CallOption) (ImmuService_ReplicateTxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[10], "/immudb.schema.ImmuService/replicateTx", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[11], "/immudb.schema.ImmuService/replicateTx", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -990,7 +1075,7 @@ func (x *immuServiceReplicateTxClient) CloseAndRecv() (*TxHeader, error) {
 }
 
 func (c *immuServiceClient) StreamExportTx(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamExportTxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[11], "/immudb.schema.ImmuService/streamExportTx", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[12], "/immudb.schema.ImmuService/streamExportTx", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1020,6 +1105,38 @@ func (x *immuServiceStreamExportTxClient) Recv() (*Chunk, error) {
 	return m, nil
 }
 
+func (c *immuServiceClient) SubscribeChanges(ctx context.Context, in *SubscribeChangesRequest, opts ...grpc.CallOption) (ImmuService_SubscribeChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[13], "/immudb.schema.ImmuService/subscribeChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &immuServiceSubscribeChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ImmuService_SubscribeChangesClient interface {
+	Recv() (*VerifiableTx, error)
+	grpc.ClientStream
+}
+
+type immuServiceSubscribeChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *immuServiceSubscribeChangesClient) Recv() (*VerifiableTx, error) {
+	m := new(VerifiableTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *immuServiceClient) SQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*SQLExecResult, error) {
 	out := new(SQLExecResult)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/SQLExec", in, out, opts...)
@@ -1039,7 +1156,7 @@ func (c *immuServiceClient) UnarySQLQuery(ctx context.Context, in *SQLQueryReque
 }
 
 func (c *immuServiceClient) SQLQuery(ctx context.Context, in *SQLQueryRequest, opts ...grpc.CallOption) (ImmuService_SQLQueryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[12], "/immudb.schema.ImmuService/SQLQuery", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[14], "/immudb.schema.ImmuService/SQLQuery", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,10 +1253,14 @@ type ImmuServiceServer interface {
 	VerifiableSet(context.Context, *VerifiableSetRequest) (*VerifiableTx, error)
 	Get(context.Context, *KeyRequest) (*Entry, error)
 	VerifiableGet(context.Context, *VerifiableGetRequest) (*VerifiableEntry, error)
+	CommitKeyIndex(context.Context, *emptypb.Empty) (*TxHeader, error)
+	RedactEntries(context.Context, *RedactEntriesRequest) (*TxHeader, error)
+	KeyIndexProof(context.Context, *KeyIndexProofRequest) (*VerifiableKeyIndexProof, error)
 	Delete(context.Context, *DeleteKeysRequest) (*TxHeader, error)
 	GetAll(context.Context, *KeyListRequest) (*Entries, error)
 	ExecAll(context.Context, *ExecAllRequest) (*TxHeader, error)
 	Scan(context.Context, *ScanRequest) (*Entries, error)
+	VerifiableScan(context.Context, *VerifiableScanRequest) (*VerifiableEntries, error)
 	// NOT YET SUPPORTED
 	Count(context.Context, *KeyPrefix) (*EntryCount, error)
 	// NOT YET SUPPORTED
@@ -1185,12 +1306,14 @@ type ImmuServiceServer interface {
 	GetDatabaseSettingsV2(context.Context, *DatabaseSettingsRequest) (*DatabaseSettingsResponse, error)
 	FlushIndex(context.Context, *FlushIndexRequest) (*FlushIndexResponse, error)
 	CompactIndex(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	CompactValueLog(context.Context, *emptypb.Empty) (*CompactValueLogResponse, error)
 	// Streams
 	StreamGet(*KeyRequest, ImmuService_StreamGetServer) error
 	StreamSet(ImmuService_StreamSetServer) error
 	StreamVerifiableGet(*VerifiableGetRequest, ImmuService_StreamVerifiableGetServer) error
 	StreamVerifiableSet(ImmuService_StreamVerifiableSetServer) error
 	StreamScan(*ScanRequest, ImmuService_StreamScanServer) error
+	StreamVerifiableScan(*VerifiableScanRequest, ImmuService_StreamVerifiableScanServer) error
 	StreamZScan(*ZScanRequest, ImmuService_StreamZScanServer) error
 	StreamHistory(*HistoryRequest, ImmuService_StreamHistoryServer) error
 	StreamExecAll(ImmuService_StreamExecAllServer) error
@@ -1198,6 +1321,8 @@ type ImmuServiceServer interface {
 	ExportTx(*ExportTxRequest, ImmuService_ExportTxServer) error
 	ReplicateTx(ImmuService_ReplicateTxServer) error
 	StreamExportTx(ImmuService_StreamExportTxServer) error
+	// Change streams
+	SubscribeChanges(*SubscribeChangesRequest, ImmuService_SubscribeChangesServer) error
 	SQLExec(context.Context, *SQLExecRequest) (*SQLExecResult, error)
 	// For backward compatibility with the grpc-gateway API
 	UnarySQLQuery(context.Context, *SQLQueryRequest) (*SQLQueryResult, error)
@@ -1278,6 +1403,15 @@ func (UnimplementedImmuServiceServer) Get(context.Context, *KeyRequest) (*Entry,
 func (UnimplementedImmuServiceServer) VerifiableGet(context.Context, *VerifiableGetRequest) (*VerifiableEntry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifiableGet not implemented")
 }
+func (UnimplementedImmuServiceServer) CommitKeyIndex(context.Context, *emptypb.Empty) (*TxHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitKeyIndex not implemented")
+}
+func (UnimplementedImmuServiceServer) RedactEntries(context.Context, *RedactEntriesRequest) (*TxHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedactEntries not implemented")
+}
+func (UnimplementedImmuServiceServer) KeyIndexProof(context.Context, *KeyIndexProofRequest) (*VerifiableKeyIndexProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyIndexProof not implemented")
+}
 func (UnimplementedImmuServiceServer) Delete(context.Context, *DeleteKeysRequest) (*TxHeader, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -1290,6 +1424,9 @@ func (UnimplementedImmuServiceServer) ExecAll(context.Context, *ExecAllRequest)
 func (UnimplementedImmuServiceServer) Scan(context.Context, *ScanRequest) (*Entries, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (UnimplementedImmuServiceServer) VerifiableScan(context.Context, *VerifiableScanRequest) (*VerifiableEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableScan not implemented")
+}
 func (UnimplementedImmuServiceServer) Count(context.Context, *KeyPrefix) (*EntryCount, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
 }
@@ -1380,6 +1517,9 @@ func (UnimplementedImmuServiceServer) FlushIndex(context.Context, *FlushIndexReq
 func (UnimplementedImmuServiceServer) CompactIndex(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompactIndex not implemented")
 }
+func (UnimplementedImmuServiceServer) CompactValueLog(context.Context, *emptypb.Empty) (*CompactValueLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactValueLog not implemented")
+}
 func (UnimplementedImmuServiceServer) StreamGet(*KeyRequest, ImmuService_StreamGetServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamGet not implemented")
 }
@@ -1395,6 +1535,9 @@ func (UnimplementedImmuServiceServer) StreamVerifiableSet(ImmuService_StreamVeri
 func (UnimplementedImmuServiceServer) StreamScan(*ScanRequest, ImmuService_StreamScanServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamScan not implemented")
 }
+func (UnimplementedImmuServiceServer) StreamVerifiableScan(*VerifiableScanRequest, ImmuService_StreamVerifiableScanServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamVerifiableScan not implemented")
+}
 func (UnimplementedImmuServiceServer) StreamZScan(*ZScanRequest, ImmuService_StreamZScanServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamZScan not implemented")
 }
@@ -1413,6 +1556,9 @@ func (UnimplementedImmuServiceServer) ReplicateTx(ImmuService_ReplicateTxServer)
 func (UnimplementedImmuServiceServer) StreamExportTx(ImmuService_StreamExportTxServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamExportTx not implemented")
 }
+func (UnimplementedImmuServiceServer) SubscribeChanges(*SubscribeChangesRequest, ImmuService_SubscribeChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChanges not implemented")
+}
 func (UnimplementedImmuServiceServer) SQLExec(context.Context, *SQLExecRequest) (*SQLExecResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SQLExec not implemented")
 }
@@ -1845,6 +1991,60 @@ func _ImmuService_VerifiableGet_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_CommitKeyIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CommitKeyIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CommitKeyIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CommitKeyIndex(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_RedactEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedactEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).RedactEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/RedactEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).RedactEntries(ctx, req.(*RedactEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_KeyIndexProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyIndexProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).KeyIndexProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/KeyIndexProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).KeyIndexProof(ctx, req.(*KeyIndexProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKeysRequest)
 	if err := dec(in); err != nil {
@@ -1917,6 +2117,24 @@ func _ImmuService_Scan_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_VerifiableScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableScan(ctx, req.(*VerifiableScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyPrefix)
 	if err := dec(in); err != nil {
@@ -2457,6 +2675,24 @@ func _ImmuService_CompactIndex_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_CompactValueLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CompactValueLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CompactValueLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CompactValueLog(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_StreamGet_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(KeyRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2572,6 +2808,27 @@ func (x *immuServiceStreamScanServer) Send(m *Chunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ImmuService_StreamVerifiableScan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VerifiableScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImmuServiceServer).StreamVerifiableScan(m, &immuServiceStreamVerifiableScanServer{stream})
+}
+
+type ImmuService_StreamVerifiableScanServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type immuServiceStreamVerifiableScanServer struct {
+	grpc.ServerStream
+}
+
+func (x *immuServiceStreamVerifiableScanServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ImmuService_StreamZScan_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ZScanRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2713,6 +2970,27 @@ func (x *immuServiceStreamExportTxServer) Recv() (*ExportTxRequest, error) {
 	return m, nil
 }
 
+func _ImmuService_SubscribeChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImmuServiceServer).SubscribeChanges(m, &immuServiceSubscribeChangesServer{stream})
+}
+
+type ImmuService_SubscribeChangesServer interface {
+	Send(*VerifiableTx) error
+	grpc.ServerStream
+}
+
+type immuServiceSubscribeChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *immuServiceSubscribeChangesServer) Send(m *VerifiableTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ImmuService_SQLExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SQLExecRequest)
 	if err := dec(in); err != nil {
@@ -2933,6 +3211,18 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifiableGet",
 			Handler:    _ImmuService_VerifiableGet_Handler,
 		},
+		{
+			MethodName: "CommitKeyIndex",
+			Handler:    _ImmuService_CommitKeyIndex_Handler,
+		},
+		{
+			MethodName: "RedactEntries",
+			Handler:    _ImmuService_RedactEntries_Handler,
+		},
+		{
+			MethodName: "KeyIndexProof",
+			Handler:    _ImmuService_KeyIndexProof_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ImmuService_Delete_Handler,
@@ -2949,6 +3239,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _ImmuService_Scan_Handler,
 		},
+		{
+			MethodName: "VerifiableScan",
+			Handler:    _ImmuService_VerifiableScan_Handler,
+		},
 		{
 			MethodName: "Count",
 			Handler:    _ImmuService_Count_Handler,
@@ -3069,6 +3363,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompactIndex",
 			Handler:    _ImmuService_CompactIndex_Handler,
 		},
+		{
+			MethodName: "CompactValueLog",
+			Handler:    _ImmuService_CompactValueLog_Handler,
+		},
 		{
 			MethodName: "SQLExec",
 			Handler:    _ImmuService_SQLExec_Handler,
@@ -3125,6 +3423,11 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ImmuService_StreamScan_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "streamVerifiableScan",
+			Handler:       _ImmuService_StreamVerifiableScan_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "streamZScan",
 			Handler:       _ImmuService_StreamZScan_Handler,
@@ -3156,6 +3459,11 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "subscribeChanges",
+			Handler:       _ImmuService_SubscribeChanges_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SQLQuery",
 			Handler:       _ImmuService_SQLQuery_Handler,
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// ErrInvalidVerifier is returned when a stored password verifier can not be parsed
+var ErrInvalidVerifier = errors.New("invalid password verifier")
+
+const (
+	scramSHA256Prefix     = "SCRAM-SHA-256"
+	scramSHA256Iterations = 4096
+	scramSaltLen          = 16
+
+	md5Prefix = "md5"
+)
+
+// SCRAMVerifier holds the information needed by a server to authenticate
+// a user through the SCRAM-SHA-256 mechanism (RFC 7677) without knowing its password
+type SCRAMVerifier struct {
+	Iterations int
+	Salt       []byte
+	StoredKey  []byte
+	ServerKey  []byte
+}
+
+// NewSCRAMVerifier derives the SCRAM-SHA-256 verifier of the password using a random salt
+func NewSCRAMVerifier(plainPassword []byte) (*SCRAMVerifier, error) {
+	salt := make([]byte, scramSaltLen)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return SCRAMVerifierFor(plainPassword, salt, scramSHA256Iterations), nil
+}
+
+// MockSCRAMVerifier returns the verifier presented to a user that doesn't exist, so it can not be told apart
+// from existing ones. As done by PostgreSQL, the salt is derived from the username and a server secret, thus it
+// doesn't change across attempts, while the password is random so the authentication can never succeed
+func MockSCRAMVerifier(username string, secret []byte) (*SCRAMVerifier, error) {
+	salt := hmacSHA256(secret, []byte(username))[:scramSaltLen]
+
+	plainPassword := make([]byte, scramSaltLen)
+
+	_, err := rand.Read(plainPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return SCRAMVerifierFor(plainPassword, salt, scramSHA256Iterations), nil
+}
+
+// SCRAMVerifierFor derives the SCRAM-SHA-256 verifier of the password using the given salt and iterations
+func SCRAMVerifierFor(plainPassword []byte, salt []byte, iterations int) *SCRAMVerifier {
+	saltedPassword := pbkdf2.Key(plainPassword, salt, iterations, sha256.Size, sha256.New)
+
+	clientKey := hmacSHA256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+
+	return &SCRAMVerifier{
+		Iterations: iterations,
+		Salt:       salt,
+		StoredKey:  storedKey[:],
+		ServerKey:  hmacSHA256(saltedPassword, []byte("Server Key")),
+	}
+}
+
+// String encodes the verifier using the same format PostgreSQL uses to store it:
+// SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>
+func (v *SCRAMVerifier) String() string {
+	return fmt.Sprintf("%s$%d:%s$%s:%s",
+		scramSHA256Prefix,
+		v.Iterations,
+		base64.StdEncoding.EncodeToString(v.Salt),
+		base64.StdEncoding.EncodeToString(v.StoredKey),
+		base64.StdEncoding.EncodeToString(v.ServerKey),
+	)
+}
+
+// ParseSCRAMVerifier decodes a verifier encoded with the String method
+func ParseSCRAMVerifier(s string) (*SCRAMVerifier, error) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 3 || parts[0] != scramSHA256Prefix {
+		return nil, ErrInvalidVerifier
+	}
+
+	iterationsAndSalt := strings.Split(parts[1], ":")
+	keys := strings.Split(parts[2], ":")
+
+	if len(iterationsAndSalt) != 2 || len(keys) != 2 {
+		return nil, ErrInvalidVerifier
+	}
+
+	iterations, err := strconv.Atoi(iterationsAndSalt[0])
+	if err != nil || iterations <= 0 {
+		return nil, ErrInvalidVerifier
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(iterationsAndSalt[1])
+	if err != nil {
+		return nil, ErrInvalidVerifier
+	}
+
+	storedKey, err := base64.StdEncoding.DecodeString(keys[0])
+	if err != nil || len(storedKey) != sha256.Size {
+		return nil, ErrInvalidVerifier
+	}
+
+	serverKey, err := base64.StdEncoding.DecodeString(keys[1])
+	if err != nil || len(serverKey) != sha256.Size {
+		return nil, ErrInvalidVerifier
+	}
+
+	return &SCRAMVerifier{
+		Iterations: iterations,
+		Salt:       salt,
+		StoredKey:  storedKey,
+		ServerKey:  serverKey,
+	}, nil
+}
+
+// MD5Verifier returns the verifier used by the PostgreSQL MD5 authentication method,
+// computed as "md5" followed by the hex encoded md5 hash of the password concatenated with the username
+func MD5Verifier(username string, plainPassword []byte) string {
+	h := md5.Sum(append(append([]byte{}, plainPassword...), []byte(username)...))
+	return md5Prefix + hex.EncodeToString(h[:])
+}
+
+// SaltedMD5Response returns the response a client is expected to send when authenticating
+// through the PostgreSQL MD5 method, given the verifier of its password and the salt sent by the server
+func SaltedMD5Response(verifier string, salt []byte) (string, error) {
+	if !strings.HasPrefix(verifier, md5Prefix) || len(verifier) != len(md5Prefix)+2*md5.Size {
+		return "", ErrInvalidVerifier
+	}
+
+	h := md5.Sum(append([]byte(verifier[len(md5Prefix):]), salt...))
+	return md5Prefix + hex.EncodeToString(h[:]), nil
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSCRAMVerifier(t *testing.T) {
+	// test vector from RFC 7677
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+
+	v := SCRAMVerifierFor([]byte("pencil"), salt, 4096)
+
+	require.Equal(t,
+		"SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		v.String(),
+	)
+
+	parsed, err := ParseSCRAMVerifier(v.String())
+	require.NoError(t, err)
+	require.Equal(t, v, parsed)
+
+	v1, err := NewSCRAMVerifier([]byte("pencil"))
+	require.NoError(t, err)
+	require.Len(t, v1.Salt, scramSaltLen)
+	require.Equal(t, scramSHA256Iterations, v1.Iterations)
+
+	v2, err := NewSCRAMVerifier([]byte("pencil"))
+	require.NoError(t, err)
+	require.NotEqual(t, v1.Salt, v2.Salt)
+	require.NotEqual(t, v1.StoredKey, v2.StoredKey)
+
+	m1, err := MockSCRAMVerifier("unknown", []byte("secret"))
+	require.NoError(t, err)
+	require.Len(t, m1.Salt, scramSaltLen)
+	require.Equal(t, scramSHA256Iterations, m1.Iterations)
+
+	m2, err := MockSCRAMVerifier("unknown", []byte("secret"))
+	require.NoError(t, err)
+	require.Equal(t, m1.Salt, m2.Salt)
+	require.NotEqual(t, m1.StoredKey, m2.StoredKey)
+
+	m3, err := MockSCRAMVerifier("another", []byte("secret"))
+	require.NoError(t, err)
+	require.NotEqual(t, m1.Salt, m3.Salt)
+
+	m4, err := MockSCRAMVerifier("unknown", []byte("another secret"))
+	require.NoError(t, err)
+	require.NotEqual(t, m1.Salt, m4.Salt)
+
+	for _, invalid := range []string{
+		"",
+		"SCRAM-SHA-1$4096:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$4096$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$0:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$W22ZaJ0SNY7soEsUEjb6gQ==:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=",
+	} {
+		_, err := ParseSCRAMVerifier(invalid)
+		require.ErrorIs(t, err, ErrInvalidVerifier)
+	}
+}
+
+func TestMD5Verifier(t *testing.T) {
+	v := MD5Verifier("postgres", []byte("secret"))
+	require.Equal(t, "md553f48b7c4b76a86ce72276c5755f217d", v)
+
+	res, err := SaltedMD5Response(v, []byte{1, 2, 3, 4})
+	require.NoError(t, err)
+	require.Equal(t, "md5bb41a296aab6baccb36ff243a562abff", res)
+
+	_, err = SaltedMD5Response("53f48b7c4b76a86ce72276c5755f217d", []byte{1, 2, 3, 4})
+	require.ErrorIs(t, err, ErrInvalidVerifier)
+
+	_, err = SaltedMD5Response("md553f48b7c", []byte{1, 2, 3, 4})
+	require.ErrorIs(t, err, ErrInvalidVerifier)
+}
+
+func TestSetPasswordVerifiers(t *testing.T) {
+	u := &User{Username: "postgres"}
+
+	_, err := u.SetPassword([]byte("secret"))
+	require.NoError(t, err)
+
+	require.NoError(t, u.ComparePasswords([]byte("secret")))
+	require.Equal(t, "md553f48b7c4b76a86ce72276c5755f217d", u.MD5)
+
+	v, err := ParseSCRAMVerifier(u.SCRAMSHA256)
+	require.NoError(t, err)
+	require.Equal(t, SCRAMVerifierFor([]byte("secret"), v.Salt, v.Iterations), v)
+}
@@ -17,54 +17,59 @@ limitations under the License.
 package auth
 
 var maintenanceMethods = map[string]struct{}{
-	"Get":                 {},
-	"VerifiableGet":       {},
-	"StreamGet":           {},
-	"StreamVerifiableGet": {},
-	"GetAll":              {},
-	"ZScan":               {},
-	"StreamZScan":         {},
-	"VerifiableTxByID":    {},
-	"IScan":               {},
-	"Scan":                {},
-	"StreamScan":          {},
-	"History":             {},
-	"StreamHistory":       {},
-	"TxByID":              {},
-	"TxScan":              {},
-	"ExportTx":            {},
-	"ReplicateTx":         {},
-	"Count":               {},
-	"CountAll":            {},
-	"DatabaseList":        {},
-	"CurrentState":        {},
-	"UseSnapshot":         {},
-	"SQLQuery":            {},
-	"ListTables":          {},
-	"DescribeTable":       {},
-	"VerifiableSQLGet":    {},
-	"CreateCollection":    {},
-	"GetCollection":       {},
-	"GetCollections":      {},
-	"UpdateCollection":    {},
-	"DeleteCollection":    {},
-	"AddField":            {},
-	"RemoveField":         {},
-	"CreateIndex":         {},
-	"DeleteIndex":         {},
-	"InsertDocuments":     {},
-	"ReplaceDocuments":    {},
-	"DeleteDocuments":     {},
-	"SearchDocuments":     {},
-	"CountDocuments":      {},
-	"AuditDocument":       {},
-	"ProofDocument":       {},
+	"Get":                  {},
+	"VerifiableGet":        {},
+	"StreamGet":            {},
+	"StreamVerifiableGet":  {},
+	"KeyIndexProof":        {},
+	"GetAll":               {},
+	"ZScan":                {},
+	"StreamZScan":          {},
+	"VerifiableTxByID":     {},
+	"IScan":                {},
+	"Scan":                 {},
+	"StreamScan":           {},
+	"VerifiableScan":       {},
+	"StreamVerifiableScan": {},
+	"History":              {},
+	"StreamHistory":        {},
+	"TxByID":               {},
+	"TxScan":               {},
+	"SubscribeChanges":     {},
+	"ExportTx":             {},
+	"ReplicateTx":          {},
+	"Count":                {},
+	"CountAll":             {},
+	"DatabaseList":         {},
+	"CurrentState":         {},
+	"UseSnapshot":          {},
+	"SQLQuery":             {},
+	"ListTables":           {},
+	"DescribeTable":        {},
+	"VerifiableSQLGet":     {},
+	"CreateCollection":     {},
+	"GetCollection":        {},
+	"GetCollections":       {},
+	"UpdateCollection":     {},
+	"DeleteCollection":     {},
+	"AddField":             {},
+	"RemoveField":          {},
+	"CreateIndex":          {},
+	"DeleteIndex":          {},
+	"InsertDocuments":      {},
+	"ReplaceDocuments":     {},
+	"DeleteDocuments":      {},
+	"SearchDocuments":      {},
+	"CountDocuments":       {},
+	"AuditDocument":        {},
+	"ProofDocument":        {},
 
 	// admin methods
-	"ListUsers":    {},
-	"Dump":         {},
-	"FlushIndex":   {},
-	"CompactIndex": {},
+	"ListUsers":       {},
+	"Dump":            {},
+	"FlushIndex":      {},
+	"CompactIndex":    {},
+	"CompactValueLog": {},
 }
 
 // PermissionSysAdmin the admin permission byte
@@ -84,6 +89,7 @@ var methodsPermissions = map[string][]uint32{
 	// readwrite methods
 	"Set":                    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"Delete":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"RedactEntries":          {PermissionSysAdmin, PermissionAdmin},
 	"VerifiableSet":          {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"StreamSet":              {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"StreamVerifiableSet":    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
@@ -91,6 +97,8 @@ var methodsPermissions = map[string][]uint32{
 	"VerifiableGet":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamGet":              {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamVerifiableGet":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CommitKeyIndex":         {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"KeyIndexProof":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"GetAll":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"ExecAll":                {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"StreamExecAll":          {PermissionSysAdmin, PermissionAdmin, PermissionRW},
@@ -104,10 +112,13 @@ var methodsPermissions = map[string][]uint32{
 	"IScan":                  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"Scan":                   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamScan":             {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableScan":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"StreamVerifiableScan":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"History":                {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamHistory":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"TxByID":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"TxScan":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"SubscribeChanges":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"Count":                  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"CountAll":               {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"DatabaseList":           {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
@@ -154,6 +165,7 @@ var methodsPermissions = map[string][]uint32{
 	"Dump":             {PermissionSysAdmin, PermissionAdmin},
 	"FlushIndex":       {PermissionSysAdmin, PermissionAdmin},
 	"CompactIndex":     {PermissionSysAdmin, PermissionAdmin},
+	"CompactValueLog":  {PermissionSysAdmin, PermissionAdmin},
 	"ExportTx":         {PermissionSysAdmin, PermissionAdmin},
 	"ReplicateTx":      {PermissionSysAdmin, PermissionAdmin},
 }
@@ -35,17 +35,28 @@ type SQLPrivilege struct {
 	Database  string `json:"database"`  // database to which the privilege applies
 }
 
+// TablePrivilege is a sql privilege granted on a single table
+type TablePrivilege struct {
+	Privilege string   `json:"privilege"`         // sql privilege
+	Database  string   `json:"database"`          // database the table belongs to
+	Table     string   `json:"table"`             // table to which the privilege applies
+	Columns   []string `json:"columns,omitempty"` // columns to which the privilege is restricted, if any
+}
+
 // User ...
 type User struct {
-	Username       string         `json:"username"`
-	HashedPassword []byte         `json:"hashedpassword"`
-	Permissions    []Permission   `json:"permissions"`
-	SQLPrivileges  []SQLPrivilege `json:"sqlPrivileges"`
-	HasPrivileges  bool           `json:"hasPrivileges"` // needed for backward compatibility
-	Active         bool           `json:"active"`
-	IsSysAdmin     bool           `json:"-"`         // for the sysadmin we'll use this instead of adding all db and permissions to Permissions, to save some cpu cycles
-	CreatedBy      string         `json:"createdBy"` // user which created this user
-	CreatedAt      time.Time      `json:"createdat"` // time in which this user is created/updated
+	Username        string           `json:"username"`
+	HashedPassword  []byte           `json:"hashedpassword"`
+	SCRAMSHA256     string           `json:"scramSha256,omitempty"` // SCRAM-SHA-256 verifier used by the pgsql server
+	MD5             string           `json:"md5,omitempty"`         // MD5 verifier used by the pgsql server
+	Permissions     []Permission     `json:"permissions"`
+	SQLPrivileges   []SQLPrivilege   `json:"sqlPrivileges"`
+	TablePrivileges []TablePrivilege `json:"tablePrivileges,omitempty"`
+	HasPrivileges   bool             `json:"hasPrivileges"` // needed for backward compatibility
+	Active          bool             `json:"active"`
+	IsSysAdmin      bool             `json:"-"`         // for the sysadmin we'll use this instead of adding all db and permissions to Permissions, to save some cpu cycles
+	CreatedBy       string           `json:"createdBy"` // user which created this user
+	CreatedAt       time.Time        `json:"createdat"` // time in which this user is created/updated
 }
 
 var (
@@ -56,7 +67,9 @@ var (
 	SysAdminPassword = SysAdminUsername
 )
 
-// SetPassword Hashes and salts the password and assigns it to hashedPassword of User
+// SetPassword Hashes and salts the password and assigns it to hashedPassword of User.
+// SCRAM-SHA-256 and MD5 verifiers are derived as well, the latter depends on the username
+// so it must be set before calling this method
 func (u *User) SetPassword(plainPassword []byte) ([]byte, error) {
 	if len(plainPassword) == 0 {
 		return nil, fmt.Errorf("password is empty")
@@ -65,10 +78,32 @@ func (u *User) SetPassword(plainPassword []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = u.SetPasswordVerifiers(plainPassword)
+	if err != nil {
+		return nil, err
+	}
 	u.HashedPassword = hashedPassword
 	return plainPassword, nil
 }
 
+// SetPasswordVerifiers derives the SCRAM-SHA-256 and MD5 verifiers used by the pgsql server,
+// users created before they were introduced get them the first time their password is provided
+func (u *User) SetPasswordVerifiers(plainPassword []byte) error {
+	scramVerifier, err := NewSCRAMVerifier(plainPassword)
+	if err != nil {
+		return err
+	}
+	u.SCRAMSHA256 = scramVerifier.String()
+	u.MD5 = MD5Verifier(u.Username, plainPassword)
+	return nil
+}
+
+// HasPasswordVerifiers returns true if the user can be authenticated through the pgsql server
+// without sending its password in cleartext
+func (u *User) HasPasswordVerifiers() bool {
+	return u.SCRAMSHA256 != "" && u.MD5 != ""
+}
+
 // ComparePasswords ...
 func (u *User) ComparePasswords(plainPassword []byte) error {
 	return ComparePasswords(u.HashedPassword, plainPassword)
@@ -173,6 +208,87 @@ func (u *User) RevokeSQLPrivileges(database string, privileges []string) bool {
 	return true
 }
 
+// GrantTablePrivilege grants sql privilege on the specified table, or only on the given columns if any
+func (u *User) GrantTablePrivilege(database, table, privilege string, columns []string) {
+	idx := u.indexOfTablePrivilege(database, table, privilege)
+	if idx < 0 {
+		u.TablePrivileges = append(u.TablePrivileges, TablePrivilege{
+			Privilege: privilege,
+			Database:  database,
+			Table:     table,
+			Columns:   append([]string(nil), columns...),
+		})
+		return
+	}
+
+	p := &u.TablePrivileges[idx]
+
+	// the privilege is already granted on the whole table
+	if len(p.Columns) == 0 {
+		return
+	}
+
+	if len(columns) == 0 {
+		p.Columns = nil
+		return
+	}
+
+	for _, col := range columns {
+		if indexOf(p.Columns, col) < 0 {
+			p.Columns = append(p.Columns, col)
+		}
+	}
+}
+
+// RevokeTablePrivilege revokes sql privilege on the specified table, or only on the given columns if any.
+// Revoking columns does not affect a privilege granted on the whole table.
+func (u *User) RevokeTablePrivilege(database, table, privilege string, columns []string) {
+	idx := u.indexOfTablePrivilege(database, table, privilege)
+	if idx < 0 {
+		return
+	}
+
+	p := &u.TablePrivileges[idx]
+
+	if len(columns) > 0 {
+		if len(p.Columns) == 0 {
+			return
+		}
+
+		granted := p.Columns[:0]
+		for _, col := range p.Columns {
+			if indexOf(columns, col) < 0 {
+				granted = append(granted, col)
+			}
+		}
+		p.Columns = granted
+
+		if len(granted) > 0 {
+			return
+		}
+	}
+
+	u.TablePrivileges = append(u.TablePrivileges[:idx], u.TablePrivileges[idx+1:]...)
+}
+
+func (u *User) indexOfTablePrivilege(database, table, privilege string) int {
+	for i, p := range u.TablePrivileges {
+		if p.Database == database && p.Table == table && p.Privilege == privilege {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
 // SetSQLPrivileges sets user default privileges. Required to guarantee backward compatibility.
 func (u *User) SetSQLPrivileges() {
 	if u.HasPrivileges {
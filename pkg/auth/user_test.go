@@ -70,3 +70,31 @@ func TestUser(t *testing.T) {
 		t.Errorf("WhichPermission sysadmin fail")
 	}
 }
+
+func TestUserTablePrivileges(t *testing.T) {
+	u := User{Username: "analyst"}
+
+	u.GrantTablePrivilege("immudb", "payments", "SELECT", []string{"id"})
+	u.GrantTablePrivilege("immudb", "payments", "SELECT", []string{"id", "amount"})
+	require.Len(t, u.TablePrivileges, 1)
+	require.Equal(t, []string{"id", "amount"}, u.TablePrivileges[0].Columns)
+
+	u.GrantTablePrivilege("immudb", "payments", "DELETE", nil)
+	require.Len(t, u.TablePrivileges, 2)
+
+	u.RevokeTablePrivilege("immudb", "payments", "DELETE", []string{"id"})
+	require.Len(t, u.TablePrivileges, 2)
+
+	u.RevokeTablePrivilege("immudb", "payments", "SELECT", []string{"id"})
+	require.Equal(t, []string{"amount"}, u.TablePrivileges[0].Columns)
+
+	u.GrantTablePrivilege("immudb", "payments", "SELECT", nil)
+	require.Empty(t, u.TablePrivileges[0].Columns)
+
+	u.RevokeTablePrivilege("immudb", "payments", "SELECT", nil)
+	require.Len(t, u.TablePrivileges, 1)
+	require.Equal(t, "DELETE", u.TablePrivileges[0].Privilege)
+
+	u.RevokeTablePrivilege("otherdb", "payments", "DELETE", nil)
+	require.Len(t, u.TablePrivileges, 1)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+)
+
+// ChangesReader provides the transactions streamed by a subscription to committed changes.
+type ChangesReader interface {
+	// Next blocks until the subsequent transaction holding matching entries is received.
+	//
+	// Entries are provided in a raw form i.e. keys include the prefix of the type of the entry.
+	// The subscription can be resumed by setting the initial transaction to the one following
+	// the latest received transaction.
+	Next() (*schema.Tx, error)
+
+	// Close cancels the subscription. Subsequent calls to Next() will return an error.
+	Close() error
+}
+
+type changesReader struct {
+	c *immuClient
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	stream schema.ImmuService_SubscribeChangesClient
+
+	withProofs bool
+
+	// latest verified state, only used when proofs are requested
+	state *schema.ImmutableState
+}
+
+// SubscribeChanges streams the transactions as soon as they get committed, starting from the one specified
+// in the request and including only the entries matching its filter, in a raw, unprocessed form.
+//
+// When proofs are requested, each transaction is verified against the local state, which gets updated.
+// If verification does not succeed the store.ErrCorruptedData error is returned by the reader.
+func (c *immuClient) SubscribeChanges(ctx context.Context, req *schema.SubscribeChangesRequest) (ChangesReader, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	r := &changesReader{
+		c:          c,
+		withProofs: req.WithProofs,
+	}
+
+	if req.WithProofs {
+		state, err := c.lockedState(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		r.state = state
+		req.ProveSinceTx = state.TxId
+	}
+
+	r.ctx, r.cancel = context.WithCancel(ctx)
+
+	stream, err := c.ServiceClient.SubscribeChanges(r.ctx, req)
+	if err != nil {
+		r.cancel()
+		return nil, errors.FromError(err)
+	}
+
+	r.stream = stream
+
+	return r, nil
+}
+
+// lockedState returns the local state of the current database
+func (c *immuClient) lockedState(ctx context.Context) (*schema.ImmutableState, error) {
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	return c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+}
+
+func (r *changesReader) Next() (*schema.Tx, error) {
+	vTx, err := r.stream.Recv()
+	if err != nil {
+		return nil, errors.FromError(err)
+	}
+
+	if !r.withProofs {
+		return vTx.Tx, nil
+	}
+
+	err = r.verify(vTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return vTx.Tx, nil
+}
+
+// verify verifies the transaction is linked to the latest verified state and includes the streamed entries,
+// the local state is updated afterwards
+func (r *changesReader) verify(vTx *schema.VerifiableTx) error {
+	if vTx.Tx == nil || vTx.Tx.Header == nil {
+		return store.ErrCorruptedData
+	}
+
+	tx := vTx.Tx.Header.Id
+
+	newState, txHdr, err := r.c.verifyTxSinceState(r.ctx, r.state, tx, vTx)
+	if err != nil {
+		return err
+	}
+
+	if txHdr.ID != tx {
+		return store.ErrCorruptedData
+	}
+
+	err = verifyTxEntries(vTx, txHdr)
+	if err != nil {
+		return err
+	}
+
+	r.state = newState
+
+	err = r.c.StateService.CacheLock()
+	if err != nil {
+		return err
+	}
+	defer r.c.StateService.CacheUnlock()
+
+	// the local state may have been updated meanwhile
+	state, err := r.c.StateService.GetState(r.ctx, r.c.Options.CurrentDatabase)
+	if err != nil {
+		return err
+	}
+
+	if state.TxId >= newState.TxId {
+		return nil
+	}
+
+	return r.c.StateService.SetState(r.c.Options.CurrentDatabase, newState)
+}
+
+func (r *changesReader) Close() error {
+	r.cancel()
+	return nil
+}
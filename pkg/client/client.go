@@ -277,6 +277,11 @@ type ImmuClient interface {
 	// cleanupPercentage database option.
 	CompactIndex(ctx context.Context, req *empty.Empty) error
 
+	// CompactValueLog reclaims disk space used by values that are no longer reachable,
+	// i.e. values of overwritten, deleted or expired keys.
+	// This call requires SysAdmin or Admin permission to given database.
+	CompactValueLog(ctx context.Context) (*schema.CompactValueLogResponse, error)
+
 	// ServerInfo returns information about the server instance.
 	ServerInfo(ctx context.Context, req *schema.ServerInfoRequest) (*schema.ServerInfoResponse, error)
 
@@ -350,6 +355,18 @@ type ImmuClient interface {
 	// You can use this function if you want to have visibility on the verification data
 	VerifiableGet(ctx context.Context, in *schema.VerifiableGetRequest, opts ...grpc.CallOption) (*schema.VerifiableEntry, error)
 
+	// CommitKeyIndex commits the authenticated key index covering all the transactions committed so far.
+	// Proofs returned by VerifiedGetLatest are generated against the last commitment.
+	CommitKeyIndex(ctx context.Context) (*schema.TxHeader, error)
+
+	// VerifiedGetLatest reads the latest entry of a key as of the last key index commitment.
+	//
+	// The server provides a proof that the returned entry is the latest one for the key
+	// or, when the key does not exist, a proof of its absence. Both are verified against
+	// the key index commitment, which is in turn verified against the local state.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedGetLatest(ctx context.Context, key []byte) (*LatestEntry, error)
+
 	// History returns history for a single key.
 	History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
 
@@ -383,6 +400,14 @@ type ImmuClient interface {
 	// Scan iterates over the set of keys in a topological order.
 	Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error)
 
+	// VerifiedScan iterates over the set of keys in a topological order as of the last key index commitment.
+	//
+	// This function also requests a server-generated proof that the returned entries are the latest ones
+	// and that no key within the scanned range was left out, up to the specified limit.
+	// Offsets are not supported.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedScan(ctx context.Context, req *schema.ScanRequest) (*VerifiedEntries, error)
+
 	// ZScan iterates over the elements of sorted set ordered by their score.
 	ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error)
 
@@ -396,12 +421,28 @@ type ImmuClient interface {
 	// and performs verification of the server-provided proof for the whole transaction.
 	VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx, error)
 
+	// VerifiedTxEntries retrieves the entries of the given keys (in a raw, unprocessed form)
+	// from given transaction and verifies all of them with a single server-provided proof.
+	//
+	// Returned entries are sorted by their position in the transaction.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	// If any of the entries was redacted, the verified entries are returned along with
+	// the ErrValueRedacted error, redacted values are omitted.
+	VerifiedTxEntries(ctx context.Context, tx uint64, keys [][]byte) (*schema.Tx, error)
+
 	// TxByIDWithSpec retrieves entries from given transaction according to given spec.
 	TxByIDWithSpec(ctx context.Context, req *schema.TxRequest) (*schema.Tx, error)
 
 	// TxScan returns raw entries for a range of transactions.
 	TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error)
 
+	// SubscribeChanges streams the transactions as soon as they get committed, starting from the one specified
+	// in the request and including only the entries matching its filter, in a raw, unprocessed form.
+	//
+	// When proofs are requested, each transaction is verified against the local state, which gets updated.
+	// If verification does not succeed the store.ErrCorruptedData error is returned by the reader.
+	SubscribeChanges(ctx context.Context, req *schema.SubscribeChangesRequest) (ChangesReader, error)
+
 	// Count returns count of key-value entries with given prefix.
 	//
 	// Note: This feature is not implemented yet.
@@ -421,10 +462,23 @@ type ImmuClient interface {
 	// Delete performs a logical deletion for a list of keys marking them as deleted.
 	Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error)
 
+	// RedactEntries erases the values of the given keys as set in the given transaction.
+	// Proofs remain verifiable as entry digests are kept, but the values can no longer be read.
+	// It requires admin permission on the database.
+	RedactEntries(ctx context.Context, tx uint64, keys [][]byte) (*schema.TxHeader, error)
+
 	// ExecAll performs multiple write operations (values, references, sorted set entries)
 	// in a single transaction.
 	ExecAll(ctx context.Context, in *schema.ExecAllRequest) (*schema.TxHeader, error)
 
+	// Increment atomically adds delta to the integer value of the key.
+	// The value is encoded as a big-endian 64-bit signed integer and a missing key is considered to be zero.
+	// Concurrent increments of the same key do not conflict with each other.
+	Increment(ctx context.Context, key []byte, delta int64) (*schema.TxHeader, error)
+
+	// Append atomically appends the given bytes to the value of the key.
+	Append(ctx context.Context, key []byte, value []byte) (*schema.TxHeader, error)
+
 	// SetReference creates a reference to another key's value.
 	//
 	// Note: references can only be created to non-reference keys.
@@ -467,6 +521,10 @@ type ImmuClient interface {
 	// StreamScan scans for keys with given prefix, using stream API to overcome limits of large keys and values.
 	StreamScan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error)
 
+	// StreamVerifiedScan is the streaming variant of VerifiedScan, values are received using stream API
+	// to overcome limits of large values.
+	StreamVerifiedScan(ctx context.Context, req *schema.ScanRequest) (*VerifiedEntries, error)
+
 	// StreamZScan scans entries from given sorted set, using stream API to overcome limits of large keys and values.
 	StreamZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error)
 
@@ -1464,6 +1522,30 @@ func (c *immuClient) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*
 	return txhdr, nil
 }
 
+// Increment atomically adds delta to the integer value of the key.
+// The value is encoded as a big-endian 64-bit signed integer and a missing key is considered to be zero.
+// Concurrent increments of the same key do not conflict with each other.
+func (c *immuClient) Increment(ctx context.Context, key []byte, delta int64) (*schema.TxHeader, error) {
+	return c.ExecAll(ctx, &schema.ExecAllRequest{
+		Operations: []*schema.Op{{
+			Operation: &schema.Op_Increment{
+				Increment: &schema.IncrementRequest{Key: key, Delta: delta},
+			},
+		}},
+	})
+}
+
+// Append atomically appends the given bytes to the value of the key.
+func (c *immuClient) Append(ctx context.Context, key []byte, value []byte) (*schema.TxHeader, error) {
+	return c.ExecAll(ctx, &schema.ExecAllRequest{
+		Operations: []*schema.Op{{
+			Operation: &schema.Op_Append{
+				Append: &schema.AppendRequest{Key: key, Value: value},
+			},
+		}},
+	})
+}
+
 // GetAll retrieves multiple entries in a single call.
 func (c *immuClient) GetAll(ctx context.Context, keys [][]byte) (*schema.Entries, error) {
 	if !c.IsConnected() {
@@ -1489,6 +1571,22 @@ func (c *immuClient) Delete(ctx context.Context, req *schema.DeleteKeysRequest)
 	return c.ServiceClient.Delete(ctx, req)
 }
 
+// RedactEntries erases the values of the given keys as set in the given transaction.
+// Proofs remain verifiable as entry digests are kept, but the values can no longer be read.
+func (c *immuClient) RedactEntries(ctx context.Context, tx uint64, keys [][]byte) (*schema.TxHeader, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	start := time.Now()
+	defer c.debugElapsedTime("RedactEntries", start)
+
+	return c.ServiceClient.RedactEntries(ctx, &schema.RedactEntriesRequest{
+		Tx:   tx,
+		Keys: keys,
+	})
+}
+
 // TxByID retrieves all entries (in a raw, unprocessed form) for given transaction.
 //
 // Note: In order to read keys and values, it is necessary to parse returned entries
@@ -1538,34 +1636,183 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 	start := time.Now()
 	defer c.debugElapsedTime("VerifiedTxByID", start)
 
-	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	vTx, _, err := c.verifiableTxByID(ctx, &schema.VerifiableTxRequest{Tx: tx})
 	if err != nil {
 		return nil, err
 	}
 
-	vTx, err := c.ServiceClient.VerifiableTxById(ctx, &schema.VerifiableTxRequest{
-		Tx:           tx,
-		ProveSinceTx: state.TxId,
-	})
+	decodeTxEntries(vTx.Tx.Entries)
+
+	return vTx.Tx, nil
+}
+
+// VerifiedTxEntries retrieves the entries of the given keys (in a raw, unprocessed form) from
+// given transaction and verifies all of them with a single server-provided proof of inclusion.
+func (c *immuClient) VerifiedTxEntries(ctx context.Context, tx uint64, keys [][]byte) (*schema.Tx, error) {
+	if len(keys) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	err := c.StateService.CacheLock()
 	if err != nil {
 		return nil, err
 	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	start := time.Now()
+	defer c.debugElapsedTime("VerifiedTxEntries", start)
+
+	vTx, txHdr, err := c.verifiableTxByID(ctx, &schema.VerifiableTxRequest{Tx: tx, Keys: keys})
+	if err != nil {
+		return nil, err
+	}
+
+	pendingKeys := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		pendingKeys[string(database.EncodeKey(key))] = struct{}{}
+	}
+
+	for _, e := range vTx.Tx.Entries {
+		_, requested := pendingKeys[string(e.Key)]
+		if !requested {
+			return nil, store.ErrCorruptedData
+		}
+		delete(pendingKeys, string(e.Key))
+	}
+
+	if len(pendingKeys) > 0 {
+		return nil, store.ErrCorruptedData
+	}
+
+	err = verifyTxEntries(vTx, txHdr)
+	if err != nil {
+		return nil, err
+	}
+
+	decodeTxEntries(vTx.Tx.Entries)
+
+	for _, e := range vTx.Tx.Entries {
+		if e.Redacted {
+			return vTx.Tx, ErrValueRedacted
+		}
+	}
+
+	return vTx.Tx, nil
+}
+
+// verifyTxEntries verifies the entries of the transaction, in a raw form, are included in it
+// according to the server-provided proof of inclusion
+func verifyTxEntries(vTx *schema.VerifiableTx, txHdr *store.TxHeader) error {
+	if vTx.EntriesProof == nil || len(vTx.Tx.Entries) != len(vTx.EntriesProof.Leaves) {
+		return store.ErrCorruptedData
+	}
+
+	txEntryDigest, err := txHdr.TxEntryDigest()
+	if err != nil {
+		return err
+	}
+
+	digests := make([][sha256.Size]byte, len(vTx.Tx.Entries))
+
+	now := time.Now()
+
+	for i, e := range vTx.Tx.Entries {
+		hVal := schema.DigestFromProto(e.HValue)
+		md := schema.KVMetadataFromProto(e.Metadata)
+
+		// values of redacted entries are omitted, only their digest is proven.
+		// Values of expired entries may be omitted as well.
+		valueOmitted := len(e.Value) == 0 && e.VLen > 0
+
+		if e.Redacted && !valueOmitted {
+			return store.ErrCorruptedData
+		}
+
+		if !e.Redacted && !(valueOmitted && md != nil && md.ExpiredAt(now)) && hVal != sha256.Sum256(e.Value) {
+			return store.ErrCorruptedData
+		}
+
+		txEntry := store.NewTxEntry(e.Key, md, int(e.VLen), hVal, 0)
+
+		digests[i], err = txEntryDigest(txEntry)
+		if err != nil {
+			return err
+		}
+	}
+
+	entriesProof := schema.MultiInclusionProofFromProto(vTx.EntriesProof)
+
+	// leaf positions are only meaningful within a tree of the width of the transaction,
+	// a narrower tree may still lead to the same root as odd nodes are promoted unchanged
+	if entriesProof.Width != txHdr.NEntries {
+		return store.ErrCorruptedData
+	}
+
+	if !store.VerifyMultiInclusion(entriesProof, digests, txHdr.Eh) {
+		return store.ErrCorruptedData
+	}
+
+	return nil
+}
+
+// verifiableTxByID requests the transaction proven since the current state, verifies the
+// dual proof and updates the state. The verified header of the transaction is returned.
+func (c *immuClient) verifiableTxByID(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, *store.TxHeader, error) {
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := req.Tx
+	req.ProveSinceTx = state.TxId
+
+	vTx, err := c.ServiceClient.VerifiableTxById(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newState, txHdr, err := c.verifyTxSinceState(ctx, state, tx, vTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vTx, txHdr, nil
+}
+
+// verifyTxSinceState verifies the dual proof linking the transaction to the given state.
+// The state to be kept after the verification and the verified header of the transaction are returned.
+func (c *immuClient) verifyTxSinceState(ctx context.Context, state *schema.ImmutableState, tx uint64, vTx *schema.VerifiableTx) (*schema.ImmutableState, *store.TxHeader, error) {
+	if vTx.DualProof == nil {
+		return nil, nil, store.ErrCorruptedData
+	}
 
 	dualProof := schema.DualProofFromProto(vTx.DualProof)
 
 	var sourceID, targetID uint64
 	var sourceAlh, targetAlh [sha256.Size]byte
+	var txHdr *store.TxHeader
 
 	if state.TxId <= tx {
 		sourceID = state.TxId
 		sourceAlh = schema.DigestFromProto(state.TxHash)
 		targetID = tx
 		targetAlh = dualProof.TargetTxHeader.Alh()
+		txHdr = dualProof.TargetTxHeader
 	} else {
 		sourceID = tx
 		sourceAlh = dualProof.SourceTxHeader.Alh()
 		targetID = state.TxId
 		targetAlh = schema.DigestFromProto(state.TxHash)
+		txHdr = dualProof.SourceTxHeader
 	}
 
 	if state.TxId > 0 {
@@ -1578,7 +1825,7 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 			targetAlh,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -1592,18 +1839,11 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 	if c.serverSigningPubKey != nil {
 		err := newState.CheckSignature(c.serverSigningPubKey)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
-	if err != nil {
-		return nil, err
-	}
-
-	decodeTxEntries(vTx.Tx.Entries)
-
-	return vTx.Tx, nil
+	return newState, txHdr, nil
 }
 
 // TxScan returns raw entries for a range of transactions.
@@ -2229,6 +2469,23 @@ func (c *immuClient) CompactIndex(ctx context.Context, req *empty.Empty) error {
 	return err
 }
 
+// CompactValueLog reclaims disk space used by values that are no longer reachable,
+// i.e. values of overwritten, deleted or expired keys.
+// This call requires SysAdmin or Admin permission to given database.
+func (c *immuClient) CompactValueLog(ctx context.Context) (*schema.CompactValueLogResponse, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	res, err := c.ServiceClient.CompactValueLog(ctx, &empty.Empty{})
+
+	c.Logger.Debugf("CompactValueLog finished in %s", time.Since(start))
+
+	return res, err
+}
+
 // ChangePermission grants or revokes permission to one database for given user.
 //
 // This call requires SysAdmin or admin permission to the database where we grant permissions.
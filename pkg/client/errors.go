@@ -44,6 +44,10 @@ var (
 
 	// ErrSessionAlreadyOpen is used when trying to create a new session but there's a valid session already set up.
 	ErrSessionAlreadyOpen = errors.New("session already opened")
+
+	// ErrValueRedacted is used when verified entries are returned without values as they were redacted.
+	// Only the digests of their values are proven.
+	ErrValueRedacted = errors.New("value redacted")
 )
 
 // Server errors mapping
@@ -0,0 +1,382 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/mtreap"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/database"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// LatestEntry holds the verified state of a key as of the last key index commitment.
+type LatestEntry struct {
+	// IndexedTx is the last transaction covered by the key index commitment,
+	// entries committed afterwards are not taken into account.
+	IndexedTx uint64
+
+	// Entry is the latest entry of the key as of IndexedTx, it's nil if the key did not exist.
+	Entry *schema.Entry
+}
+
+// CommitKeyIndex commits the authenticated key index covering all the transactions committed so far.
+// Proofs returned by VerifiedGetLatest are generated against the last commitment, which servers
+// also renew in the background once enough transactions were committed since the previous one.
+func (c *immuClient) CommitKeyIndex(ctx context.Context) (*schema.TxHeader, error) {
+	start := time.Now()
+	defer c.debugElapsedTime("CommitKeyIndex", start)
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	return c.ServiceClient.CommitKeyIndex(ctx, &emptypb.Empty{})
+}
+
+// VerifiedGetLatest reads the latest entry of a key as of the last key index commitment.
+//
+// The server provides a proof that the returned entry is the latest one for the key
+// or, when the key does not exist, a proof of its absence. Both are verified against
+// the key index commitment, which is in turn verified against the local state.
+// A commitment newer than the local state becomes the new local state, while an older one
+// is proven to be consistent with it. In both cases the entry is the latest one as of
+// the returned IndexedTx, which may precede the last committed transaction.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedGetLatest(ctx context.Context, key []byte) (*LatestEntry, error) {
+	start := time.Now()
+	defer c.debugElapsedTime("VerifiedGetLatest", start)
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := c.ServiceClient.KeyIndexProof(ctx, &schema.KeyIndexProofRequest{
+		Key:          key,
+		ProveSinceTx: state.TxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vc, err := c.verifyKeyIndexCommitment(
+		ctx,
+		state,
+		proof.CommitmentTx,
+		proof.CommitmentInclusionProof,
+		proof.IndexedTx,
+		proof.Width,
+		proof.Root,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if proof.Entry == nil {
+		err = verifyKeyIndexAbsence(key, vc.commitment, proof)
+	} else {
+		err = c.verifyKeyIndexEntry(ctx, key, &schema.KeyIndexEntry{
+			Entry:          proof.Entry,
+			Leaf:           proof.Leaf,
+			InclusionProof: proof.EntryInclusionProof,
+			DualProof:      proof.EntryDualProof,
+		}, vc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, vc.newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LatestEntry{
+		IndexedTx: vc.commitment.IndexedTx,
+		Entry:     proof.Entry,
+	}, nil
+}
+
+// verifiedKeyIndexCommitment holds a key index commitment verified against the local state
+type verifiedKeyIndexCommitment struct {
+	commitment *database.KeyIndexCommitment
+
+	txID uint64
+	alh  [sha256.Size]byte
+
+	// newState is the state to be stored once the whole proof gets verified
+	newState *schema.ImmutableState
+}
+
+func (c *immuClient) verifyKeyIndexCommitment(
+	ctx context.Context,
+	state *schema.ImmutableState,
+	commitmentTx *schema.VerifiableTx,
+	commitmentInclusionProof *schema.InclusionProof,
+	indexedTx uint64,
+	width uint64,
+	root []byte,
+) (*verifiedKeyIndexCommitment, error) {
+	if commitmentTx == nil || commitmentTx.Tx == nil || commitmentTx.Tx.Header == nil || commitmentTx.DualProof == nil ||
+		commitmentInclusionProof == nil || len(root) != sha256.Size {
+		return nil, store.ErrCorruptedData
+	}
+
+	commitment := &database.KeyIndexCommitment{
+		IndexedTx: indexedTx,
+		Width:     width,
+	}
+	copy(commitment.Root[:], root)
+
+	commitmentTxID := commitmentTx.Tx.Header.Id
+
+	// the commitment can only index transactions preceding the one holding it
+	if commitment.IndexedTx >= commitmentTxID {
+		return nil, store.ErrCorruptedData
+	}
+
+	dualProof := schema.DualProofFromProto(commitmentTx.DualProof)
+
+	// the commitment is proven against the local state, either as its target
+	// or, when the commitment is older than the local state, as its source
+	newer := commitmentTxID >= state.TxId
+
+	// only the header within the dual proof is bound to the accumulative hash
+	commitmentTxHdr := dualProof.TargetTxHeader
+	if !newer {
+		commitmentTxHdr = dualProof.SourceTxHeader
+	}
+	commitmentAlh := commitmentTxHdr.Alh()
+
+	if commitmentTxHdr.ID != commitmentTxID || schema.TxHeaderFromProto(commitmentTx.Tx.Header).Alh() != commitmentAlh {
+		return nil, store.ErrCorruptedData
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(commitmentTxHdr.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &store.EntrySpec{
+		Key:   database.KeyIndexCommitmentKey(),
+		Value: database.EncodeKeyIndexCommitment(commitment),
+	}
+
+	verifies := store.VerifyInclusion(
+		schema.InclusionProofFromProto(commitmentInclusionProof),
+		entrySpecDigest(e),
+		commitmentTxHdr.Eh)
+	if !verifies {
+		return nil, store.ErrCorruptedData
+	}
+
+	if !newer {
+		err := c.verifyDualProof(
+			ctx,
+			dualProof,
+			commitmentTxID,
+			state.TxId,
+			commitmentAlh,
+			schema.DigestFromProto(state.TxHash),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// the local state is kept as it's more recent than the commitment
+		return &verifiedKeyIndexCommitment{
+			commitment: commitment,
+			txID:       commitmentTxID,
+			alh:        commitmentAlh,
+			newState:   state,
+		}, nil
+	}
+
+	if state.TxId > 0 {
+		err := c.verifyDualProof(
+			ctx,
+			dualProof,
+			state.TxId,
+			commitmentTxID,
+			schema.DigestFromProto(state.TxHash),
+			commitmentAlh,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newState := &schema.ImmutableState{
+		Db:        c.currentDatabase(),
+		TxId:      commitmentTxID,
+		TxHash:    commitmentAlh[:],
+		Signature: commitmentTx.Signature,
+	}
+
+	if c.serverSigningPubKey != nil {
+		err := newState.CheckSignature(c.serverSigningPubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &verifiedKeyIndexCommitment{
+		commitment: commitment,
+		txID:       commitmentTxID,
+		alh:        commitmentAlh,
+		newState:   newState,
+	}, nil
+}
+
+func verifyKeyIndexLeaf(leaf *schema.KeyIndexLeaf, commitment *database.KeyIndexCommitment) bool {
+	if leaf == nil || leaf.InclusionProof == nil || leaf.Tx > commitment.IndexedTx {
+		return false
+	}
+
+	iproof := schema.KeyIndexInclusionProofFromProto(leaf.InclusionProof)
+
+	if iproof.Leaf >= commitment.Width || iproof.Width != commitment.Width {
+		return false
+	}
+
+	return mtreap.VerifyInclusion(iproof, leaf.Key, database.KeyIndexLeafValue(leaf.Tx), commitment.Root)
+}
+
+func verifyKeyIndexAbsence(key []byte, commitment *database.KeyIndexCommitment, proof *schema.VerifiableKeyIndexProof) error {
+	if proof.Leaf != nil {
+		return store.ErrCorruptedData
+	}
+
+	if commitment.Width == 0 {
+		if proof.Predecessor != nil || proof.Successor != nil || commitment.Root != mtreap.EmptyRoot {
+			return store.ErrCorruptedData
+		}
+		return nil
+	}
+
+	pred := proof.Predecessor
+	succ := proof.Successor
+
+	if pred == nil && succ == nil {
+		return store.ErrCorruptedData
+	}
+
+	if pred != nil {
+		if !verifyKeyIndexLeaf(pred, commitment) || bytes.Compare(pred.Key, key) >= 0 {
+			return store.ErrCorruptedData
+		}
+
+		// the greatest key in the index must be lower than the requested one
+		if succ == nil && pred.InclusionProof.Leaf != commitment.Width-1 {
+			return store.ErrCorruptedData
+		}
+	}
+
+	if succ != nil {
+		if !verifyKeyIndexLeaf(succ, commitment) || bytes.Compare(succ.Key, key) <= 0 {
+			return store.ErrCorruptedData
+		}
+
+		// the lowest key in the index must be greater than the requested one
+		if pred == nil && succ.InclusionProof.Leaf != 0 {
+			return store.ErrCorruptedData
+		}
+	}
+
+	// keys are sorted within the index thus no other key may exist between adjacent leaves
+	if pred != nil && succ != nil && succ.InclusionProof.Leaf != pred.InclusionProof.Leaf+1 {
+		return store.ErrCorruptedData
+	}
+
+	return nil
+}
+
+// verifyKeyIndexEntry verifies the entry is the latest one of the key as of the key index commitment
+func (c *immuClient) verifyKeyIndexEntry(ctx context.Context, key []byte, e *schema.KeyIndexEntry, vc *verifiedKeyIndexCommitment) error {
+	if e == nil || e.Entry == nil || e.InclusionProof == nil || e.DualProof == nil {
+		return store.ErrCorruptedData
+	}
+
+	leaf := e.Leaf
+
+	if !verifyKeyIndexLeaf(leaf, vc.commitment) || !bytes.Equal(leaf.Key, key) {
+		return store.ErrCorruptedData
+	}
+
+	var entrySpec *store.EntrySpec
+
+	if e.Entry.ReferencedBy == nil {
+		if e.Entry.Tx != leaf.Tx || !bytes.Equal(e.Entry.Key, key) {
+			return store.ErrCorruptedData
+		}
+
+		entrySpec = database.EncodeEntrySpec(key, schema.KVMetadataFromProto(e.Entry.Metadata), e.Entry.Value)
+	} else {
+		ref := e.Entry.ReferencedBy
+
+		if ref.Tx != leaf.Tx || !bytes.Equal(ref.Key, key) {
+			return store.ErrCorruptedData
+		}
+
+		entrySpec = database.EncodeReference(key, schema.KVMetadataFromProto(ref.Metadata), e.Entry.Key, ref.AtTx)
+	}
+
+	dualProof := schema.DualProofFromProto(e.DualProof)
+
+	if dualProof.SourceTxHeader.ID != leaf.Tx || dualProof.TargetTxHeader.ID != vc.txID {
+		return store.ErrCorruptedData
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(dualProof.SourceTxHeader.Version)
+	if err != nil {
+		return err
+	}
+
+	verifies := store.VerifyInclusion(
+		schema.InclusionProofFromProto(e.InclusionProof),
+		entrySpecDigest(entrySpec),
+		dualProof.SourceTxHeader.Eh)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	return c.verifyDualProof(
+		ctx,
+		dualProof,
+		leaf.Tx,
+		vc.txID,
+		dualProof.SourceTxHeader.Alh(),
+		vc.alh,
+	)
+}
@@ -64,6 +64,13 @@ func (c *immuClient) StreamScan(ctx context.Context, req *schema.ScanRequest) (*
 	return entries, errors.FromError(err)
 }
 
+// StreamVerifiedScan scans the entries within the range of a scan as of the last key index commitment,
+// using stream API to overcome limits of large values. The completeness of the result is verified.
+func (c *immuClient) StreamVerifiedScan(ctx context.Context, req *schema.ScanRequest) (*VerifiedEntries, error) {
+	entries, err := c._streamVerifiedScan(ctx, req)
+	return entries, errors.FromError(err)
+}
+
 // StreamZScan scans entries from given sorted set, using stream API to overcome limits of large keys and values.
 func (c *immuClient) StreamZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error) {
 	entries, err := c._streamZScan(ctx, req)
@@ -427,6 +434,49 @@ func (c *immuClient) _streamScan(ctx context.Context, req *schema.ScanRequest) (
 	return &schema.Entries{Entries: entries}, nil
 }
 
+func (c *immuClient) _streamVerifiedScan(ctx context.Context, req *schema.ScanRequest) (*VerifiedEntries, error) {
+	if req == nil || req.Offset > 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	gs, err := c.streamVerifiableScan(ctx, &schema.VerifiableScanRequest{
+		ScanRequest:  req,
+		ProveSinceTx: state.TxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vssr := c.StreamServiceFactory.NewVScanStreamReceiver(c.StreamServiceFactory.NewMsgReceiver(gs))
+
+	proofWithoutValuesProto, vr, err := vssr.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := stream.ParseVerifiableEntries(proofWithoutValuesProto, vr, c.Options.StreamChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.verifyScan(ctx, state, req, proof)
+}
+
 func (c *immuClient) _streamZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error) {
 	gs, err := c.streamZScan(ctx, req)
 	if err != nil {
@@ -531,6 +581,13 @@ func (c *immuClient) streamScan(ctx context.Context, in *schema.ScanRequest) (sc
 	return c.ServiceClient.StreamScan(ctx, in)
 }
 
+func (c *immuClient) streamVerifiableScan(ctx context.Context, in *schema.VerifiableScanRequest) (schema.ImmuService_StreamVerifiableScanClient, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+	return c.ServiceClient.StreamVerifiableScan(ctx, in)
+}
+
 func (c *immuClient) streamZScan(ctx context.Context, in *schema.ZScanRequest) (schema.ImmuService_StreamZScanClient, error) {
 	if !c.IsConnected() {
 		return nil, errors.FromError(ErrNotConnected)
@@ -0,0 +1,170 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+// VerifiedEntries holds the verified result of a scan as of the last key index commitment.
+type VerifiedEntries struct {
+	// IndexedTx is the last transaction covered by the key index commitment,
+	// entries committed afterwards are not taken into account.
+	IndexedTx uint64
+
+	// Entries are the latest entries of every key within the scanned range as of IndexedTx.
+	Entries []*schema.Entry
+}
+
+// VerifiedScan scans the entries within the range of a scan as of the last key index commitment.
+//
+// The server provides a proof that the returned entries are the latest ones for their keys
+// and that no key within the range was left out, up to the specified limit.
+// The proof is verified against the key index commitment, which is in turn verified
+// against the local state. As for VerifiedGetLatest, a commitment older than the local state
+// is proven to be consistent with it and the returned IndexedTx tells how recent the result is.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedScan(ctx context.Context, req *schema.ScanRequest) (*VerifiedEntries, error) {
+	start := time.Now()
+	defer c.debugElapsedTime("VerifiedScan", start)
+
+	if req == nil || req.Offset > 0 {
+		return nil, errors.FromError(ErrIllegalArguments)
+	}
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := c.ServiceClient.VerifiableScan(ctx, &schema.VerifiableScanRequest{
+		ScanRequest:  req,
+		ProveSinceTx: state.TxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.verifyScan(ctx, state, req, proof)
+}
+
+// verifyScan verifies the proof of a scan and updates the local state accordingly
+func (c *immuClient) verifyScan(
+	ctx context.Context,
+	state *schema.ImmutableState,
+	req *schema.ScanRequest,
+	proof *schema.VerifiableEntries,
+) (*VerifiedEntries, error) {
+	vc, err := c.verifyKeyIndexCommitment(
+		ctx,
+		state,
+		proof.CommitmentTx,
+		proof.CommitmentInclusionProof,
+		proof.IndexedTx,
+		proof.Width,
+		proof.Root,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r := database.NewKeyIndexRange(req)
+
+	// leaves are visited following the order of the scan
+	step := int64(1)
+	next := int64(0)
+
+	if req.Desc {
+		step = -1
+		next = int64(vc.commitment.Width) - 1
+	}
+
+	if proof.StartBoundary != nil {
+		if !verifyKeyIndexLeaf(proof.StartBoundary, vc.commitment) || !r.BeforeStart(proof.StartBoundary.Key) {
+			return nil, store.ErrCorruptedData
+		}
+
+		next = int64(proof.StartBoundary.InclusionProof.Leaf) + step
+	}
+
+	entries := make([]*schema.Entry, len(proof.Entries))
+
+	for i, e := range proof.Entries {
+		if e == nil || e.Leaf == nil || !r.Contains(e.Leaf.Key) {
+			return nil, store.ErrCorruptedData
+		}
+
+		err := c.verifyKeyIndexEntry(ctx, e.Leaf.Key, e, vc)
+		if err != nil {
+			return nil, err
+		}
+
+		// keys within the range are contiguous in the key index
+		if int64(e.Leaf.InclusionProof.Leaf) != next {
+			return nil, store.ErrCorruptedData
+		}
+
+		next += step
+
+		entries[i] = e.Entry
+	}
+
+	if proof.EndBoundary != nil {
+		if !verifyKeyIndexLeaf(proof.EndBoundary, vc.commitment) ||
+			int64(proof.EndBoundary.InclusionProof.Leaf) != next ||
+			!r.AfterEnd(proof.EndBoundary.Key) {
+			return nil, store.ErrCorruptedData
+		}
+	} else {
+		exhausted := next < 0 || next >= int64(vc.commitment.Width)
+		limitReached := req.Limit > 0 && uint64(len(entries)) == req.Limit
+
+		if !exhausted && !limitReached {
+			return nil, store.ErrCorruptedData
+		}
+	}
+
+	if req.Limit > 0 && uint64(len(entries)) > req.Limit {
+		return nil, store.ErrCorruptedData
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, vc.newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifiedEntries{
+		IndexedTx: vc.commitment.IndexedTx,
+		Entries:   entries,
+	}, nil
+}
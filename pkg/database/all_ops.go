@@ -28,6 +28,8 @@ import (
 // ExecAll like Set it permits many insertions at once.
 // The difference is that is possible to to specify a list of a mix of key value set and zAdd insertions.
 // If zAdd reference is not yet present on disk it's possible to add it as a regular key value and the reference is done onFly
+// Increment and append operations are resolved at commit time against the latest value of the key,
+// thus concurrent updates of the same key do not conflict with each other
 func (d *db) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	if req == nil {
 		return nil, store.ErrIllegalArguments
@@ -80,6 +82,34 @@ func (d *db) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.T
 					x.Kv.Value,
 				)
 
+			case *schema.Op_Increment:
+				if len(x.Increment.Key) == 0 {
+					return nil, nil, store.ErrIllegalArguments
+				}
+
+				kmap[sha256.Sum256(x.Increment.Key)] = true
+
+				e = EncodeMerge(
+					x.Increment.Key,
+					schema.KVMetadataFromProto(x.Increment.Metadata),
+					IncrementMergeOperator,
+					store.EncodeInt64(x.Increment.Delta),
+				)
+
+			case *schema.Op_Append:
+				if len(x.Append.Key) == 0 {
+					return nil, nil, store.ErrIllegalArguments
+				}
+
+				kmap[sha256.Sum256(x.Append.Key)] = true
+
+				e = EncodeMerge(
+					x.Append.Key,
+					schema.KVMetadataFromProto(x.Append.Metadata),
+					AppendMergeOperator,
+					x.Append.Value,
+				)
+
 			case *schema.Op_Ref:
 				if len(x.Ref.Key) == 0 || len(x.Ref.ReferencedKey) == 0 {
 					return nil, nil, store.ErrIllegalArguments
@@ -284,6 +284,108 @@ func TestExecAllOps(t *testing.T) {
 	require.Len(t, zList.Entries, batchCount*batchSize)
 }
 
+func TestExecAllMergeOps(t *testing.T) {
+	db := makeDb(t)
+
+	increment := func(key string, delta int64) *schema.Op {
+		return &schema.Op{
+			Operation: &schema.Op_Increment{
+				Increment: &schema.IncrementRequest{Key: []byte(key), Delta: delta},
+			},
+		}
+	}
+
+	appendOp := func(key, value string) *schema.Op {
+		return &schema.Op{
+			Operation: &schema.Op_Append{
+				Append: &schema.AppendRequest{Key: []byte(key), Value: []byte(value)},
+			},
+		}
+	}
+
+	t.Run("concurrent increments do not conflict", func(t *testing.T) {
+		const workers = 5
+		const increments = 10
+
+		var wg sync.WaitGroup
+		wg.Add(workers)
+
+		errs := make(chan error, workers*increments)
+
+		for i := 0; i < workers; i++ {
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < increments; j++ {
+					_, err := db.ExecAll(context.Background(), &schema.ExecAllRequest{
+						Operations: []*schema.Op{increment("counter", 2)},
+					})
+					errs <- err
+				}
+			}()
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("counter")})
+		require.NoError(t, err)
+
+		n, err := store.DecodeInt64(entry.Value)
+		require.NoError(t, err)
+		require.Equal(t, int64(2*workers*increments), n)
+	})
+
+	t.Run("append together with other operations", func(t *testing.T) {
+		_, err := db.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{Key: []byte("log"), Value: []byte("a")}},
+		})
+		require.NoError(t, err)
+
+		_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+			Operations: []*schema.Op{
+				appendOp("log", "b"),
+				increment("counter2", -1),
+				{
+					Operation: &schema.Op_Ref{
+						Ref: &schema.ReferenceRequest{Key: []byte("log-ref"), ReferencedKey: []byte("log")},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("log-ref")})
+		require.NoError(t, err)
+		require.Equal(t, []byte("ab"), entry.Value)
+
+		entry, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("counter2")})
+		require.NoError(t, err)
+		require.Equal(t, store.EncodeInt64(-1), entry.Value)
+	})
+
+	t.Run("merge operations fail over invalid values", func(t *testing.T) {
+		_, err := db.ExecAll(context.Background(), &schema.ExecAllRequest{
+			Operations: []*schema.Op{increment("log", 1)},
+		})
+		require.ErrorIs(t, err, store.ErrInvalidMergeValue)
+
+		_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+			Operations: []*schema.Op{appendOp("log-ref", "c")},
+		})
+		require.ErrorIs(t, err, store.ErrInvalidMergeValue)
+
+		_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+			Operations: []*schema.Op{increment("", 1)},
+		})
+		require.ErrorIs(t, err, store.ErrIllegalArguments)
+	})
+}
+
 func TestExecAllOpsZAddOnMixedAlreadyPersitedNotPersistedItems(t *testing.T) {
 	db := makeDb(t)
 
@@ -0,0 +1,174 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// SubscribeChanges sends the transactions as soon as they get committed, starting from the specified one.
+// Only the entries matching the filter of the request are included, with their raw keys and values,
+// transactions not holding any matching entry are skipped.
+// When proofs are requested, each transaction includes a proof of inclusion of its entries and a dual proof
+// linking it to the latest proven transaction, i.e. the specified one for the first transaction
+// and the previously sent one afterwards.
+// It blocks until the context is done or an error occurs, including the ones returned by send.
+func (d *db) SubscribeChanges(ctx context.Context, req *schema.SubscribeChangesRequest, send func(*schema.VerifiableTx) error) error {
+	if req == nil || send == nil {
+		return ErrIllegalArguments
+	}
+
+	prefix, err := d.changesPrefix(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	lastTxID, _ := d.st.CommittedAlh()
+	if req.WithProofs && lastTxID < req.ProveSinceTx {
+		return fmt.Errorf("%w: latest txID=%d is lower than specified as initial tx=%d", ErrIllegalState, lastTxID, req.ProveSinceTx)
+	}
+
+	txID := req.InitialTx
+	if txID == 0 {
+		txID = lastTxID + 1
+	}
+
+	proveSinceTx := req.ProveSinceTx
+
+	tx, err := d.allocTx()
+	if err != nil {
+		return err
+	}
+	defer d.releaseTx(tx)
+
+	for ; ; txID++ {
+		err := d.WaitForTx(ctx, txID, false)
+		if err != nil {
+			return err
+		}
+
+		err = d.st.ReadTx(txID, false, tx)
+		if err != nil {
+			return err
+		}
+
+		var entries []*store.TxEntry
+
+		for _, e := range tx.Entries() {
+			if bytes.HasPrefix(e.Key(), prefix) {
+				entries = append(entries, e)
+			}
+		}
+
+		if len(entries) == 0 {
+			continue
+		}
+
+		vtx, err := d.serializeChanges(tx, entries, req.WithProofs, proveSinceTx)
+		if err != nil {
+			return err
+		}
+
+		err = send(vtx)
+		if err != nil {
+			return err
+		}
+
+		if req.WithProofs && proveSinceTx < txID {
+			proveSinceTx = txID
+		}
+	}
+}
+
+// changesPrefix returns the prefix of the keys of the entries to be streamed,
+// at most one filter can be specified
+func (d *db) changesPrefix(ctx context.Context, req *schema.SubscribeChangesRequest) ([]byte, error) {
+	filters := 0
+
+	if len(req.Prefix) > 0 {
+		filters++
+	}
+	if req.Table != "" {
+		filters++
+	}
+	if req.Collection != "" {
+		filters++
+	}
+
+	if filters > 1 {
+		return nil, fmt.Errorf("%w: prefix, table and collection filters can not be combined", ErrIllegalArguments)
+	}
+
+	switch {
+	case len(req.Prefix) > 0:
+		return EncodeKey(req.Prefix), nil
+	case req.Table != "":
+		return d.sqlEngine.TableRowsPrefix(ctx, req.Table)
+	case req.Collection != "":
+		return d.documentEngine.CollectionKeyPrefix(ctx, req.Collection)
+	}
+
+	// all the entries are streamed
+	return nil, nil
+}
+
+func (d *db) serializeChanges(tx *store.Tx, entries []*store.TxEntry, withProofs bool, proveSinceTx uint64) (*schema.VerifiableTx, error) {
+	if !withProofs {
+		stx := &schema.Tx{
+			Header:  schema.TxHeaderToProto(tx.Header()),
+			Entries: make([]*schema.TxEntry, len(entries)),
+		}
+
+		for i, e := range entries {
+			var err error
+
+			stx.Entries[i], err = d.rawTxEntryToProto(e)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &schema.VerifiableTx{Tx: stx}, nil
+	}
+
+	encKeys := make([][]byte, len(entries))
+
+	for i, e := range entries {
+		encKeys[i] = e.Key()
+	}
+
+	stx, entriesProof, err := d.serializeEncodedTxEntries(tx, encKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	dualProof, err := d.dualProofSince(tx.Header(), proveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.VerifiableTx{
+		Tx:           stx,
+		DualProof:    schema.DualProofToProto(dualProof),
+		EntriesProof: schema.MultiInclusionProofToProto(entriesProof),
+	}, nil
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/document"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var errEnoughChanges = errors.New("enough changes")
+
+// subscribeChanges returns the first n transactions streamed by a subscription
+func subscribeChanges(t *testing.T, db *db, req *schema.SubscribeChangesRequest, n int) []*schema.VerifiableTx {
+	var vtxs []*schema.VerifiableTx
+
+	err := db.SubscribeChanges(context.Background(), req, func(vtx *schema.VerifiableTx) error {
+		vtxs = append(vtxs, vtx)
+
+		if len(vtxs) == n {
+			return errEnoughChanges
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errEnoughChanges)
+
+	return vtxs
+}
+
+func TestSubscribeChanges(t *testing.T) {
+	db := makeDb(t)
+
+	_, _, err := db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+		Sql: "CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id)",
+	})
+	require.NoError(t, err)
+
+	_, err = db.CreateCollection(context.Background(), "admin", &protomodel.CreateCollectionRequest{
+		Name:   "collection1",
+		Fields: []*protomodel.Field{{Name: "title", Type: protomodel.FieldType_STRING}},
+	})
+	require.NoError(t, err)
+
+	firstHdr, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("user:1"), Value: []byte("alice")},
+		{Key: []byte("order:1"), Value: []byte("order1")},
+	}})
+	require.NoError(t, err)
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+		Sql: "INSERT INTO table1(id, title) VALUES (1, 'title1')",
+	})
+	require.NoError(t, err)
+
+	_, err = db.InsertDocuments(context.Background(), "admin", &protomodel.InsertDocumentsRequest{
+		CollectionName: "collection1",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"title": structpb.NewStringValue("doc1")}},
+		},
+	})
+	require.NoError(t, err)
+
+	lastHdr, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("user:2"), Value: []byte("bob")},
+	}})
+	require.NoError(t, err)
+
+	t.Run("invalid requests should fail", func(t *testing.T) {
+		err := db.SubscribeChanges(context.Background(), nil, func(*schema.VerifiableTx) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = db.SubscribeChanges(context.Background(), &schema.SubscribeChangesRequest{}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = db.SubscribeChanges(context.Background(), &schema.SubscribeChangesRequest{
+			Prefix: []byte("user:"),
+			Table:  "table1",
+		}, func(*schema.VerifiableTx) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = db.SubscribeChanges(context.Background(), &schema.SubscribeChangesRequest{
+			Table: "missing",
+		}, func(*schema.VerifiableTx) error { return nil })
+		require.ErrorIs(t, err, sql.ErrTableDoesNotExist)
+
+		err = db.SubscribeChanges(context.Background(), &schema.SubscribeChangesRequest{
+			Collection: "missing",
+		}, func(*schema.VerifiableTx) error { return nil })
+		require.ErrorIs(t, err, document.ErrCollectionDoesNotExist)
+
+		err = db.SubscribeChanges(context.Background(), &schema.SubscribeChangesRequest{
+			WithProofs:   true,
+			ProveSinceTx: lastHdr.Id + 1,
+		}, func(*schema.VerifiableTx) error { return nil })
+		require.ErrorIs(t, err, ErrIllegalState)
+	})
+
+	t.Run("entries with the specified prefix should be streamed", func(t *testing.T) {
+		vtxs := subscribeChanges(t, db, &schema.SubscribeChangesRequest{
+			InitialTx: 1,
+			Prefix:    []byte("user:"),
+		}, 2)
+
+		require.Equal(t, firstHdr.Id, vtxs[0].Tx.Header.Id)
+		require.Len(t, vtxs[0].Tx.Entries, 1)
+		require.Equal(t, EncodeKey([]byte("user:1")), vtxs[0].Tx.Entries[0].Key)
+		require.Equal(t, WrapWithPrefix([]byte("alice"), PlainValuePrefix), vtxs[0].Tx.Entries[0].Value)
+		require.Nil(t, vtxs[0].DualProof)
+		require.Nil(t, vtxs[0].EntriesProof)
+
+		require.Equal(t, lastHdr.Id, vtxs[1].Tx.Header.Id)
+		require.Equal(t, EncodeKey([]byte("user:2")), vtxs[1].Tx.Entries[0].Key)
+	})
+
+	t.Run("a subscription should be resumed from the specified transaction", func(t *testing.T) {
+		vtxs := subscribeChanges(t, db, &schema.SubscribeChangesRequest{
+			InitialTx: firstHdr.Id + 1,
+			Prefix:    []byte("user:"),
+		}, 1)
+
+		require.Equal(t, lastHdr.Id, vtxs[0].Tx.Header.Id)
+	})
+
+	t.Run("changes to the specified table should be streamed", func(t *testing.T) {
+		vtxs := subscribeChanges(t, db, &schema.SubscribeChangesRequest{
+			InitialTx: 1,
+			Table:     "table1",
+		}, 1)
+
+		require.Equal(t, firstHdr.Id+1, vtxs[0].Tx.Header.Id)
+		require.Len(t, vtxs[0].Tx.Entries, 1)
+		require.True(t, bytes.HasPrefix(vtxs[0].Tx.Entries[0].Key, []byte{SQLPrefix, 'R', '.'}))
+	})
+
+	t.Run("changes to the specified collection should be streamed", func(t *testing.T) {
+		vtxs := subscribeChanges(t, db, &schema.SubscribeChangesRequest{
+			InitialTx:  1,
+			Collection: "collection1",
+		}, 1)
+
+		require.Equal(t, firstHdr.Id+2, vtxs[0].Tx.Header.Id)
+		require.Len(t, vtxs[0].Tx.Entries, 1)
+		require.True(t, bytes.HasPrefix(vtxs[0].Tx.Entries[0].Key, []byte{DocumentPrefix, 'R', '.'}))
+	})
+
+	t.Run("proofs should link each transaction to the previous one", func(t *testing.T) {
+		provenHdr, err := db.st.ReadTxHeader(1, false, false)
+		require.NoError(t, err)
+
+		vtxs := subscribeChanges(t, db, &schema.SubscribeChangesRequest{
+			InitialTx:    1,
+			Prefix:       []byte("user:"),
+			WithProofs:   true,
+			ProveSinceTx: provenHdr.ID,
+		}, 2)
+
+		for _, vtx := range vtxs {
+			hdr := schema.TxHeaderFromProto(vtx.Tx.Header)
+
+			dualProof := schema.DualProofFromProto(vtx.DualProof)
+			require.True(t, store.VerifyDualProof(dualProof, provenHdr.ID, hdr.ID, provenHdr.Alh(), hdr.Alh()))
+
+			txEntryDigest, err := hdr.TxEntryDigest()
+			require.NoError(t, err)
+
+			digests := make([][sha256.Size]byte, len(vtx.Tx.Entries))
+
+			for i, e := range vtx.Tx.Entries {
+				digests[i], err = txEntryDigest(store.NewTxEntry(e.Key, schema.KVMetadataFromProto(e.Metadata), int(e.VLen), schema.DigestFromProto(e.HValue), 0))
+				require.NoError(t, err)
+			}
+
+			entriesProof := schema.MultiInclusionProofFromProto(vtx.EntriesProof)
+			require.True(t, store.VerifyMultiInclusion(entriesProof, digests, hdr.Eh))
+
+			provenHdr = hdr
+		}
+	})
+
+	t.Run("transactions should be streamed as soon as they get committed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		received := make(chan *schema.VerifiableTx)
+		done := make(chan error)
+
+		go func() {
+			done <- db.SubscribeChanges(ctx, &schema.SubscribeChangesRequest{
+				InitialTx: lastHdr.Id + 1,
+				Prefix:    []byte("user:"),
+			}, func(vtx *schema.VerifiableTx) error {
+				received <- vtx
+				return nil
+			})
+		}()
+
+		hdr, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("user:3"), Value: []byte("carol")},
+		}})
+		require.NoError(t, err)
+
+		vtx := <-received
+		require.Equal(t, hdr.Id, vtx.Tx.Header.Id)
+
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+}
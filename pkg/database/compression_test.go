@@ -0,0 +1,72 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseWithCompressionDictionary(t *testing.T) {
+	rootPath := t.TempDir()
+
+	dictFile := filepath.Join("..", "..", "embedded", "appendable", "singleapp", "testdata", "zstd.dict")
+
+	options := DefaultOptions().
+		WithDBRootPath(rootPath).
+		WithCompressionDictionaryFile(dictFile)
+
+	options.GetStoreOptions().WithCompressionFormat(appendable.ZStdCompression)
+
+	db, err := NewDB("db", &dummyMultidbHandler{}, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte(`{"name":"value1"}`)}},
+	})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	dicts, err := os.ReadDir(filepath.Join(rootPath, "db", compressionDictionariesDirname))
+	require.NoError(t, err)
+	require.Len(t, dicts, 1)
+
+	// values compressed with the dictionary remain readable once it's no longer configured
+	options = DefaultOptions().WithDBRootPath(rootPath)
+	options.GetStoreOptions().WithCompressionFormat(appendable.LZ4Compression)
+
+	db, err = OpenDB("db", &dummyMultidbHandler{}, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("key1")})
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"name":"value1"}`), entry.Value)
+
+	_, err = NewDB("db1", &dummyMultidbHandler{}, DefaultOptions().WithDBRootPath(rootPath).WithCompressionDictionaryFile("compression_test.go"), logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.ErrorIs(t, err, ErrInvalidCompressionDict)
+}
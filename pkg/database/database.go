@@ -28,13 +28,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 	"github.com/codenotary/immudb/embedded/document"
+	"github.com/codenotary/immudb/embedded/htree"
+	"github.com/codenotary/immudb/embedded/mtreap"
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/pgsql/pgschema"
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
@@ -42,6 +47,9 @@ const (
 	MaxKeyScanLimit       = 2500
 )
 
+const keyRingFilename = "keyring"
+const compressionDictionariesDirname = "dictionaries"
+
 var (
 	ErrKeyResolutionLimitReached  = errors.New("key resolution limit reached. It may be due to cyclic references")
 	ErrResultSizeLimitExceeded    = errors.New("result size limit exceeded")
@@ -52,6 +60,8 @@ var (
 	ErrNotReplica                 = errors.New("database is NOT a replica")
 	ErrReplicaDivergedFromPrimary = errors.New("replica diverged from primary")
 	ErrInvalidRevision            = errors.New("invalid key revision number")
+	ErrMissingKeyProvider         = errors.New("database is encrypted but no key provider was specified")
+	ErrInvalidCompressionDict     = errors.New("invalid compression dictionary")
 )
 
 type DB interface {
@@ -87,11 +97,16 @@ type DB interface {
 	GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error)
 
 	Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error)
+	RedactEntries(ctx context.Context, req *schema.RedactEntriesRequest) (*schema.TxHeader, error)
+
+	CommitKeyIndex(ctx context.Context) (*schema.TxHeader, error)
+	KeyIndexProof(ctx context.Context, req *schema.KeyIndexProofRequest) (*schema.VerifiableKeyIndexProof, error)
 
 	SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error)
 	VerifiableSetReference(ctx context.Context, req *schema.VerifiableReferenceRequest) (*schema.VerifiableTx, error)
 
 	Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error)
+	VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error)
 
 	History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
 
@@ -136,6 +151,7 @@ type DB interface {
 
 	VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error)
 	TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error)
+	SubscribeChanges(ctx context.Context, req *schema.SubscribeChangesRequest, send func(*schema.VerifiableTx) error) error
 
 	// Truncation
 	FindTruncationPoint(ctx context.Context, until time.Time) (*schema.TxHeader, error)
@@ -144,6 +160,7 @@ type DB interface {
 	// Maintenance
 	FlushIndex(req *schema.FlushIndexRequest) error
 	CompactIndex() error
+	CompactValueLog(ctx context.Context) (*store.VLogGCReport, error)
 
 	IsClosed() bool
 	Close() error
@@ -177,6 +194,13 @@ type db struct {
 
 	replicaStates      map[string]*replicaState
 	replicaStatesMutex sync.Mutex
+
+	keyIndex            *mtreap.MTreap
+	keyIndexMutex       sync.Mutex
+	keyIndexCommitMutex sync.Mutex
+
+	keyIndexCommitterCancel context.CancelFunc
+	keyIndexCommitterDone   chan struct{}
 }
 
 // OpenDB Opens an existing Database from disk
@@ -218,12 +242,24 @@ func OpenDB(
 		WithMultiIndexing(true).
 		WithExternalCommitAllowance(opts.syncReplication)
 
+	stOpts.WithVLogGCOptions(vLogGCOptionsFor(stOpts))
+
+	err = applyEncryptionOptions(dbDir, opts, stOpts)
+	if err != nil {
+		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
+	}
+
+	err = applyCompressionDictionaries(dbDir, opts, stOpts)
+	if err != nil {
+		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
+	}
+
 	dbi.st, err = store.Open(dbDir, stOpts)
 	if err != nil {
 		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
 	}
 
-	for _, prefix := range []byte{SetKeyPrefix, SortedSetKeyPrefix} {
+	for _, prefix := range []byte{SetKeyPrefix, SortedSetKeyPrefix, KeyIndexPrefix} {
 		err := dbi.st.InitIndexing(&store.IndexSpec{
 			SourcePrefix: []byte{prefix},
 			TargetPrefix: []byte{prefix},
@@ -234,6 +270,12 @@ func OpenDB(
 		}
 	}
 
+	err = registerMergeOperators(dbi.st)
+	if err != nil {
+		dbi.st.Close()
+		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
+	}
+
 	dbi.Logger.Infof("loading sql-engine for database '%s' {replica = %v}...", dbName, opts.replica)
 
 	sqlOpts := sql.DefaultOptions().
@@ -250,7 +292,7 @@ func OpenDB(
 
 	dbi.Logger.Infof("sql-engine ready for database '%s' {replica = %v}", dbName, opts.replica)
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, documentEngineOptions(multidbHandler))
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +304,8 @@ func OpenDB(
 	}
 	dbi.txPool = txPool
 
+	dbi.startKeyIndexCommitter()
+
 	if opts.replica {
 		dbi.Logger.Infof("database '%s' {replica = %v} successfully opened", dbName, opts.replica)
 		return dbi, nil
@@ -301,6 +345,16 @@ func (d *db) releaseTx(tx *store.Tx) {
 	d.txPool.Release(tx)
 }
 
+func documentEngineOptions(multidbHandler sql.MultiDBHandler) *document.Options {
+	opts := document.DefaultOptions().WithPrefix([]byte{DocumentPrefix})
+
+	// collection policies are evaluated against the user logged into the database
+	if multidbHandler != nil {
+		opts.WithLoggedUserFunc(multidbHandler.GetLoggedUser)
+	}
+	return opts
+}
+
 // NewDB Creates a new Database along with it's directories and files
 func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log logger.Logger) (DB, error) {
 	if dbName == "" {
@@ -340,12 +394,24 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log
 		WithMultiIndexing(true).
 		WithLogger(log)
 
+	stOpts.WithVLogGCOptions(vLogGCOptionsFor(stOpts))
+
+	err = applyEncryptionOptions(dbDir, opts, stOpts)
+	if err != nil {
+		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
+	}
+
+	err = applyCompressionDictionaries(dbDir, opts, stOpts)
+	if err != nil {
+		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
+	}
+
 	dbi.st, err = store.Open(dbDir, stOpts)
 	if err != nil {
 		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
 	}
 
-	for _, prefix := range []byte{SetKeyPrefix, SortedSetKeyPrefix} {
+	for _, prefix := range []byte{SetKeyPrefix, SortedSetKeyPrefix, KeyIndexPrefix} {
 		err := dbi.st.InitIndexing(&store.IndexSpec{
 			SourcePrefix: []byte{prefix},
 			TargetPrefix: []byte{prefix},
@@ -356,6 +422,12 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log
 		}
 	}
 
+	err = registerMergeOperators(dbi.st)
+	if err != nil {
+		dbi.st.Close()
+		return nil, logErr(dbi.Logger, "unable to open database: %s", err)
+	}
+
 	txPool, err := dbi.st.NewTxHolderPool(opts.readTxPoolSize, false)
 	if err != nil {
 		return nil, logErr(dbi.Logger, "unable to create tx pool: %s", err)
@@ -377,12 +449,14 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log
 	}
 	dbi.Logger.Infof("sql-engine ready for database '%s' {replica = %v}", dbName, opts.replica)
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, documentEngineOptions(multidbHandler))
 	if err != nil {
 		return nil, logErr(dbi.Logger, "Unable to open database: %s", err)
 	}
 	dbi.Logger.Infof("document-engine ready for database '%s' {replica = %v}", dbName, opts.replica)
 
+	dbi.startKeyIndexCommitter()
+
 	dbi.Logger.Infof("database '%s' successfully created {replica = %v}", dbName, opts.replica)
 
 	return dbi, nil
@@ -404,6 +478,170 @@ func (d *db) CompactIndex() error {
 	return d.st.CompactIndexes()
 }
 
+// CompactValueLog discards values no longer reachable from the value-log
+func (d *db) CompactValueLog(ctx context.Context) (*store.VLogGCReport, error) {
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	return d.st.CompactValueLogs(ctx)
+}
+
+// applyEncryptionOptions opens the key ring of the database, holding the data keys wrapped with
+// the master keys of the key provider, and sets the encryption options of the store
+func applyEncryptionOptions(dbDir string, opts *Options, stOpts *store.Options) error {
+	keyRingPath := filepath.Join(dbDir, keyRingFilename)
+
+	_, err := os.Stat(keyRingPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	keyRingExists := err == nil
+
+	if opts.keyProvider == nil && keyRingExists {
+		return ErrMissingKeyProvider
+	}
+
+	// the key ring is only created once data is encrypted
+	if opts.keyProvider == nil || (!keyRingExists && opts.encryptionAlgorithm == appendable.NoEncryption) {
+		return nil
+	}
+
+	keyRing, err := encryption.OpenKeyRing(keyRingPath, opts.keyProvider, 0600)
+	if err != nil {
+		return err
+	}
+
+	stOpts.WithEncryption(opts.encryptionAlgorithm, keyRing)
+
+	return nil
+}
+
+// applyCompressionDictionaries copies the configured dictionary into the database directory and
+// sets the dictionaries of the store, the configured one being used to compress new value files
+func applyCompressionDictionaries(dbDir string, opts *Options, stOpts *store.Options) error {
+	dictsDir := filepath.Join(dbDir, compressionDictionariesDirname)
+
+	var currentDict []byte
+
+	if opts.compressionDictionaryFile != "" {
+		dict, err := os.ReadFile(opts.compressionDictionaryFile)
+		if err != nil {
+			return err
+		}
+
+		d, err := zstd.InspectDictionary(dict)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidCompressionDict, err)
+		}
+
+		err = os.MkdirAll(dictsDir, 0700)
+		if err != nil {
+			return err
+		}
+
+		dictPath := filepath.Join(dictsDir, fmt.Sprintf("%d.zdict", d.ID()))
+
+		_, err = os.Stat(dictPath)
+		if os.IsNotExist(err) {
+			err = os.WriteFile(dictPath, dict, 0600)
+		}
+		if err != nil {
+			return err
+		}
+
+		currentDict = dict
+	}
+
+	entries, err := os.ReadDir(dictsDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dicts := make([][]byte, 0, 1+len(entries))
+
+	if currentDict != nil {
+		dicts = append(dicts, currentDict)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".zdict" {
+			continue
+		}
+
+		dict, err := os.ReadFile(filepath.Join(dictsDir, e.Name()))
+		if err != nil {
+			return err
+		}
+
+		dicts = append(dicts, dict)
+	}
+
+	if currentDict == nil {
+		// previous dictionaries are only used to read existing values
+		dicts = append([][]byte{nil}, dicts...)
+	}
+
+	stOpts.WithCompressionDictionaries(dicts...)
+
+	return nil
+}
+
+// vLogGCOptionsFor restricts value-log garbage collection to plain key-value entries,
+// as values of other entries may be referenced through additional indexes (e.g. SQL rows).
+// Values referenced at a specific transaction by references or sorted sets are retained.
+func vLogGCOptionsFor(stOpts *store.Options) *store.VLogGCOptions {
+	vLogGCOpts := *store.DefaultVLogGCOptions()
+
+	if stOpts.VLogGCOpts != nil {
+		vLogGCOpts = *stOpts.VLogGCOpts
+	}
+
+	return vLogGCOpts.
+		WithKeyPrefixes([][]byte{{SetKeyPrefix}}).
+		WithPinnedValueFunc(pinnedValue)
+}
+
+// pinnedValue returns the key and transaction referenced by references and sorted set entries,
+// entries resolved at the latest version of the referenced key pin no value
+func pinnedValue(key []byte, value func() ([]byte, error)) ([]byte, uint64, error) {
+	if len(key) == 0 {
+		return nil, 0, nil
+	}
+
+	switch key[0] {
+	case SetKeyPrefix:
+		val, err := value()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if len(val) < 1+8 || val[0] != ReferenceValuePrefix {
+			return nil, 0, nil
+		}
+
+		return val[1+8:], binary.BigEndian.Uint64(TrimPrefix(val)), nil
+	case SortedSetKeyPrefix:
+		// zKey = [1+setLenLen+set+scoreLen+keyLenLen+1+key+txIDLen]
+		if len(key) < 1+setLenLen {
+			return nil, 0, nil
+		}
+
+		keyOff := 1 + setLenLen + int(binary.BigEndian.Uint64(key[1:])) + scoreLen + keyLenLen
+		if keyOff < 0 || len(key) < keyOff+txIDLen {
+			return nil, 0, nil
+		}
+
+		return key[keyOff : len(key)-txIDLen], binary.BigEndian.Uint64(key[len(key)-txIDLen:]), nil
+	}
+
+	return nil, 0, nil
+}
+
 // Set ...
 func (d *db) Set(ctx context.Context, req *schema.SetRequest) (*schema.TxHeader, error) {
 	d.mutex.RLock()
@@ -955,6 +1193,39 @@ func (d *db) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema
 	return schema.TxHeaderToProto(hdr), nil
 }
 
+// RedactEntries erases the values of the given keys as set in the specified transaction.
+// Entries remain in the transaction thus proofs can still be verified but their values
+// can no longer be read. The redaction is recorded in a new transaction.
+func (d *db) RedactEntries(ctx context.Context, req *schema.RedactEntriesRequest) (*schema.TxHeader, error) {
+	if req == nil || req.Tx == 0 || len(req.Keys) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	keys := make([][]byte, len(req.Keys))
+
+	for i, k := range req.Keys {
+		if len(k) == 0 {
+			return nil, ErrIllegalArguments
+		}
+
+		keys[i] = EncodeKey(k)
+	}
+
+	hdr, err := d.st.RedactEntries(ctx, req.Tx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.TxHeaderToProto(hdr), nil
+}
+
 // GetAll ...
 func (d *db) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	snap, err := d.snapshotSince(ctx, []byte{SetKeyPrefix}, req.SinceTx)
@@ -1103,6 +1374,16 @@ func (d *db) serializeTx(ctx context.Context, tx *store.Tx, spec *schema.Entries
 				if errors.Is(err, store.ErrExpiredEntry) {
 					break
 				}
+				if errors.Is(err, store.ErrValueRedacted) {
+					if spec.KvEntriesSpec.Action == schema.EntryTypeAction_RAW_VALUE {
+						// only the digest of redacted values is available
+						kve := schema.TxEntryToProto(e)
+						kve.Redacted = true
+
+						stx.Entries = append(stx.Entries, kve)
+					}
+					break
+				}
 				if err != nil {
 					return nil, err
 				}
@@ -1146,12 +1427,13 @@ func (d *db) serializeTx(ctx context.Context, tx *store.Tx, spec *schema.Entries
 					if errors.Is(err, store.ErrExpiredEntry) {
 						break
 					}
-					if err != nil {
+					if err != nil && !errors.Is(err, store.ErrValueRedacted) {
 						return nil, err
 					}
 
 					kve := schema.TxEntryToProto(e)
 					kve.Value = v
+					kve.Redacted = errors.Is(err, store.ErrValueRedacted)
 					stx.Entries = append(stx.Entries, kve)
 					break
 				}
@@ -1212,12 +1494,13 @@ func (d *db) serializeTx(ctx context.Context, tx *store.Tx, spec *schema.Entries
 					if errors.Is(err, store.ErrExpiredEntry) {
 						break
 					}
-					if err != nil {
+					if err != nil && !errors.Is(err, store.ErrValueRedacted) {
 						return nil, err
 					}
 
 					kve := schema.TxEntryToProto(e)
 					kve.Value = v
+					kve.Redacted = errors.Is(err, store.ErrValueRedacted)
 					stx.Entries = append(stx.Entries, kve)
 					break
 				}
@@ -1464,6 +1747,15 @@ func (d *db) VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxReque
 		return nil, ErrIllegalArguments
 	}
 
+	if len(req.Keys) > 0 && req.EntriesSpec != nil {
+		return nil, fmt.Errorf("%w: keys can not be specified together with entries spec", ErrIllegalArguments)
+	}
+
+	if len(req.Keys) > d.maxResultSize {
+		return nil, fmt.Errorf("%w: the number of keys (%d) is larger than the maximum allowed one (%d)",
+			ErrResultSizeLimitExceeded, len(req.Keys), d.maxResultSize)
+	}
+
 	lastTxID, _ := d.st.CommittedAlh()
 	if lastTxID < req.ProveSinceTx {
 		return nil, fmt.Errorf("%w: latest txID=%d is lower than specified as initial tx=%d", ErrIllegalState, lastTxID, req.ProveSinceTx)
@@ -1472,7 +1764,7 @@ func (d *db) VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxReque
 	var snap *store.Snapshot
 	var err error
 
-	if !req.KeepReferencesUnresolved {
+	if !req.KeepReferencesUnresolved && len(req.Keys) == 0 {
 		snap, err = d.snapshotSince(ctx, []byte{SetKeyPrefix}, req.SinceTx)
 		if err != nil {
 			return nil, err
@@ -1491,40 +1783,110 @@ func (d *db) VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxReque
 		return nil, err
 	}
 
+	dualProof, err := d.dualProofSince(reqTx.Header(), req.ProveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Keys) > 0 {
+		sReqTx, entriesProof, err := d.serializeTxEntries(reqTx, req.Keys)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.VerifiableTx{
+			Tx:           sReqTx,
+			DualProof:    schema.DualProofToProto(dualProof),
+			EntriesProof: schema.MultiInclusionProofToProto(entriesProof),
+		}, nil
+	}
+
+	sReqTx, err := d.serializeTx(ctx, reqTx, req.EntriesSpec, snap, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.VerifiableTx{
+		Tx:        sReqTx,
+		DualProof: schema.DualProofToProto(dualProof),
+	}, nil
+}
+
+// dualProofSince returns the proof linking the transaction to the one of the specified state,
+// the transaction is proven from its own header when no state is specified
+func (d *db) dualProofSince(txHdr *store.TxHeader, proveSinceTx uint64) (*store.DualProof, error) {
 	var sourceTxHdr, targetTxHdr *store.TxHeader
 	var rootTxHdr *store.TxHeader
+	var err error
 
-	if req.ProveSinceTx == 0 {
-		rootTxHdr = reqTx.Header()
+	if proveSinceTx == 0 {
+		rootTxHdr = txHdr
 	} else {
-		rootTxHdr, err = d.st.ReadTxHeader(req.ProveSinceTx, false, false)
+		rootTxHdr, err = d.st.ReadTxHeader(proveSinceTx, false, false)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if req.ProveSinceTx <= req.Tx {
+	if proveSinceTx <= txHdr.ID {
 		sourceTxHdr = rootTxHdr
-		targetTxHdr = reqTx.Header()
+		targetTxHdr = txHdr
 	} else {
-		sourceTxHdr = reqTx.Header()
+		sourceTxHdr = txHdr
 		targetTxHdr = rootTxHdr
 	}
 
-	dualProof, err := d.st.DualProof(sourceTxHdr, targetTxHdr)
-	if err != nil {
-		return nil, err
+	return d.st.DualProof(sourceTxHdr, targetTxHdr)
+}
+
+// serializeTxEntries returns the transaction including only the entries of the specified keys,
+// sorted by their position in the transaction, and a proof of inclusion for all of them
+func (d *db) serializeTxEntries(tx *store.Tx, keys [][]byte) (*schema.Tx, *htree.MultiInclusionProof, error) {
+	encKeys := make([][]byte, len(keys))
+
+	for i, key := range keys {
+		encKeys[i] = EncodeKey(key)
 	}
 
-	sReqTx, err := d.serializeTx(ctx, reqTx, req.EntriesSpec, snap, true)
+	return d.serializeEncodedTxEntries(tx, encKeys)
+}
+
+// serializeEncodedTxEntries is the same as serializeTxEntries but the keys are specified
+// as stored i.e. including the prefix of the entry type
+func (d *db) serializeEncodedTxEntries(tx *store.Tx, encKeys [][]byte) (*schema.Tx, *htree.MultiInclusionProof, error) {
+	entriesProof, err := tx.MultiProof(encKeys)
 	if err != nil {
+		return nil, nil, err
+	}
+
+	stx := &schema.Tx{
+		Header:  schema.TxHeaderToProto(tx.Header()),
+		Entries: make([]*schema.TxEntry, len(entriesProof.Leaves)),
+	}
+
+	for i, leaf := range entriesProof.Leaves {
+		stx.Entries[i], err = d.rawTxEntryToProto(tx.Entries()[leaf])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return stx, entriesProof, nil
+}
+
+// rawTxEntryToProto returns the entry including its raw value
+func (d *db) rawTxEntryToProto(e *store.TxEntry) (*schema.TxEntry, error) {
+	v, err := d.st.ReadValue(e)
+	if err != nil && !errors.Is(err, store.ErrValueRedacted) && !errors.Is(err, store.ErrExpiredEntry) {
 		return nil, err
 	}
 
-	return &schema.VerifiableTx{
-		Tx:        sReqTx,
-		DualProof: schema.DualProofToProto(dualProof),
-	}, nil
+	// redacted and expired entries are proven by their digest, their values are omitted
+	te := schema.TxEntryToProto(e)
+	te.Value = v
+	te.Redacted = errors.Is(err, store.ErrValueRedacted)
+
+	return te, nil
 }
 
 // TxScan ...
@@ -1668,7 +2030,16 @@ func (d *db) Close() (err error) {
 		}
 	}()
 
-	return d.st.Close()
+	d.stopKeyIndexCommitter()
+
+	keyIndexErr := d.closeKeyIndex()
+
+	err = d.st.Close()
+	if err != nil {
+		return err
+	}
+
+	return keyIndexErr
 }
 
 // GetName ...
@@ -107,6 +107,10 @@ func (u *mockUser) SQLPrivileges() []sql.SQLPrivilege {
 	return sql.DefaultSQLPrivilegesForPermission(sql.PermissionAdmin)
 }
 
+func (u *mockUser) TablePrivileges() []sql.TablePrivilege {
+	return nil
+}
+
 func (h *dummyMultidbHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
 	return &mockUser{}, nil
 }
@@ -131,6 +135,14 @@ func (h *dummyMultidbHandler) RevokeSQLPrivileges(ctx context.Context, database,
 	return sql.ErrNoSupported
 }
 
+func (h *dummyMultidbHandler) GrantTablePrivileges(ctx context.Context, username string, privileges []sql.TablePrivilege) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) RevokeTablePrivileges(ctx context.Context, username string, privileges []sql.TablePrivilege) error {
+	return sql.ErrNoSupported
+}
+
 func (h *dummyMultidbHandler) DropUser(ctx context.Context, username string) error {
 	return sql.ErrNoSupported
 }
@@ -422,6 +434,104 @@ func TestDelete(t *testing.T) {
 	require.Empty(t, tx.KvEntries)
 }
 
+func TestRedactEntries(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.RedactEntries(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	hdr, err := db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte("personal-data")},
+			{Key: []byte("key2"), Value: []byte("public-data")},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = db.RedactEntries(context.Background(), &schema.RedactEntriesRequest{
+		Tx:   hdr.Id,
+		Keys: [][]byte{nil},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.RedactEntries(context.Background(), &schema.RedactEntriesRequest{
+		Tx:   hdr.Id,
+		Keys: [][]byte{[]byte("key3")},
+	})
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+	redactionHdr, err := db.RedactEntries(context.Background(), &schema.RedactEntriesRequest{
+		Tx:   hdr.Id,
+		Keys: [][]byte{[]byte("key1")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, hdr.Id, redactionHdr.Metadata.RedactedTxID)
+	require.Equal(t, []uint32{0}, redactionHdr.Metadata.RedactedEntries)
+
+	_, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("key1"), SinceTx: redactionHdr.Id})
+	require.ErrorIs(t, err, store.ErrValueRedacted)
+
+	entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("key2")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("public-data"), entry.Value)
+
+	t.Run("redacted values should be replaced by their digest", func(t *testing.T) {
+		tx, err := db.TxByID(context.Background(), &schema.TxRequest{
+			Tx: hdr.Id,
+			EntriesSpec: &schema.EntriesSpec{
+				KvEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RAW_VALUE},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, tx.Entries, 2)
+		require.Nil(t, tx.Entries[0].Value)
+		require.True(t, tx.Entries[0].Redacted)
+		require.NotNil(t, tx.Entries[1].Value)
+		require.False(t, tx.Entries[1].Redacted)
+
+		tx, err = db.TxByID(context.Background(), &schema.TxRequest{
+			Tx: hdr.Id,
+			EntriesSpec: &schema.EntriesSpec{
+				KvEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RESOLVE},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, tx.KvEntries, 1)
+		require.Equal(t, []byte("key2"), tx.KvEntries[0].Key)
+	})
+
+	t.Run("redacted entries should remain verifiable", func(t *testing.T) {
+		vtx, err := db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+			Tx:           hdr.Id,
+			ProveSinceTx: redactionHdr.Id,
+			Keys:         [][]byte{[]byte("key1")},
+		})
+		require.NoError(t, err)
+		require.Len(t, vtx.Tx.Entries, 1)
+		require.Nil(t, vtx.Tx.Entries[0].Value)
+		require.True(t, vtx.Tx.Entries[0].Redacted)
+
+		txHdr := schema.TxHeaderFromProto(vtx.Tx.Header)
+
+		txEntryDigest, err := txHdr.TxEntryDigest()
+		require.NoError(t, err)
+
+		e := vtx.Tx.Entries[0]
+
+		require.Equal(t, sha256.Sum256(WrapWithPrefix([]byte("personal-data"), PlainValuePrefix)), schema.DigestFromProto(e.HValue))
+
+		digest, err := txEntryDigest(store.NewTxEntry(e.Key, schema.KVMetadataFromProto(e.Metadata), int(e.VLen), schema.DigestFromProto(e.HValue), 0))
+		require.NoError(t, err)
+
+		entriesProof := schema.MultiInclusionProofFromProto(vtx.EntriesProof)
+		require.True(t, store.VerifyMultiInclusion(entriesProof, [][sha256.Size]byte{digest}, txHdr.Eh))
+
+		redactionTxHdr := schema.TxHeaderFromProto(vtx.DualProof.TargetTxHeader)
+		require.Equal(t, schema.TxHeaderFromProto(redactionHdr).Alh(), redactionTxHdr.Alh())
+		require.True(t, store.VerifyDualProof(schema.DualProofFromProto(vtx.DualProof), hdr.Id, redactionHdr.Id, txHdr.Alh(), redactionTxHdr.Alh()))
+	})
+}
+
 func TestCurrentState(t *testing.T) {
 	db := makeDb(t)
 
@@ -901,6 +1011,60 @@ func TestVerifiableTxByID(t *testing.T) {
 		require.NotNil(t, vtx)
 		require.Len(t, vtx.Tx.Entries, 1)
 		require.Len(t, vtx.Tx.Entries[0].Value, 0)
+		require.Nil(t, vtx.EntriesProof)
+	})
+
+	t.Run("only the entries of the requested keys should be returned with a single proof", func(t *testing.T) {
+		txhdr, err := db.Set(context.Background(), &schema.SetRequest{KVs: kvs})
+		require.NoError(t, err)
+
+		vtx, err := db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+			Tx:   txhdr.Id,
+			Keys: [][]byte{kvs[2].Key, kvs[0].Key},
+		})
+		require.NoError(t, err)
+		require.Len(t, vtx.Tx.Entries, 2)
+		require.NotNil(t, vtx.EntriesProof)
+		require.Equal(t, []int32{0, 2}, vtx.EntriesProof.Leaves)
+
+		hdr := schema.TxHeaderFromProto(vtx.Tx.Header)
+
+		txEntryDigest, err := hdr.TxEntryDigest()
+		require.NoError(t, err)
+
+		digests := make([][sha256.Size]byte, len(vtx.Tx.Entries))
+
+		for i, e := range vtx.Tx.Entries {
+			require.Equal(t, EncodeKey(kvs[2*i].Key), e.Key)
+			require.Equal(t, WrapWithPrefix(kvs[2*i].Value, PlainValuePrefix), e.Value)
+
+			digests[i], err = txEntryDigest(store.NewTxEntry(e.Key, schema.KVMetadataFromProto(e.Metadata), int(e.VLen), schema.DigestFromProto(e.HValue), 0))
+			require.NoError(t, err)
+		}
+
+		entriesProof := schema.MultiInclusionProofFromProto(vtx.EntriesProof)
+		require.True(t, store.VerifyMultiInclusion(entriesProof, digests, hdr.Eh))
+	})
+
+	t.Run("invalid keys should fail", func(t *testing.T) {
+		_, err := db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+			Tx:          txhdr.Id,
+			Keys:        [][]byte{kvs[0].Key},
+			EntriesSpec: &schema.EntriesSpec{},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+			Tx:   txhdr.Id,
+			Keys: [][]byte{[]byte("non-existent-key")},
+		})
+		require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+		_, err = db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+			Tx:   txhdr.Id,
+			Keys: make([][]byte, db.maxResultSize+1),
+		})
+		require.ErrorIs(t, err, ErrResultSizeLimitExceeded)
 	})
 }
 
@@ -1269,6 +1433,100 @@ func TestPreconditionedSet(t *testing.T) {
 		"did not fail when too many preconditions were given")
 }
 
+func TestValueAndMetadataPreconditions(t *testing.T) {
+	db := makeDb(t)
+
+	setWith := func(key, value []byte, preconditions ...*schema.Precondition) error {
+		_, err := db.Set(context.Background(), &schema.SetRequest{
+			KVs:           []*schema.KeyValue{{Key: key, Value: value}},
+			Preconditions: preconditions,
+		})
+		return err
+	}
+
+	err := setWith([]byte("key"), []byte("value1"))
+	require.NoError(t, err)
+
+	t.Run("value must match", func(t *testing.T) {
+		err := setWith([]byte("key"), []byte("value2"), schema.PreconditionValueMustMatch([]byte("key"), []byte("value1")))
+		require.NoError(t, err)
+
+		err = setWith([]byte("key"), []byte("value3"), schema.PreconditionValueMustMatch([]byte("key"), []byte("value1")))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+		err = setWith([]byte("key"), []byte("value3"), schema.PreconditionValueMustMatch([]byte("missing"), nil))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+	})
+
+	t.Run("value digest must match", func(t *testing.T) {
+		digest := sha256.Sum256([]byte("value2"))
+
+		err := setWith([]byte("key"), []byte("value3"), schema.PreconditionValueDigestMustMatch([]byte("key"), digest[:]))
+		require.NoError(t, err)
+
+		err = setWith([]byte("key"), []byte("value4"), schema.PreconditionValueDigestMustMatch([]byte("key"), digest[:]))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+	})
+
+	t.Run("value of a reference must not match the referenced value", func(t *testing.T) {
+		_, err := db.SetReference(context.Background(), &schema.ReferenceRequest{
+			Key:           []byte("ref"),
+			ReferencedKey: []byte("key"),
+		})
+		require.NoError(t, err)
+
+		err = setWith([]byte("key"), []byte("value4"), schema.PreconditionValueMustMatch([]byte("ref"), []byte("value3")))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+	})
+
+	t.Run("key metadata must match", func(t *testing.T) {
+		err := setWith([]byte("key"), []byte("value4"), schema.PreconditionKeyMetadataMustMatch([]byte("key"), nil))
+		require.NoError(t, err)
+
+		err = setWith([]byte("key"), []byte("value5"), schema.PreconditionKeyMetadataMustMatch([]byte("key"), &schema.KVMetadata{Deleted: true}))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+		_, err = db.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key")}})
+		require.NoError(t, err)
+
+		err = setWith([]byte("key"), []byte("value5"), schema.PreconditionKeyMetadataMustMatch([]byte("key"), &schema.KVMetadata{Deleted: true}))
+		require.NoError(t, err)
+	})
+
+	t.Run("key count must be in range", func(t *testing.T) {
+		err := setWith([]byte("key"), []byte("value6"), schema.PreconditionKeyCountInRange([]byte("k"), 1, 1))
+		require.NoError(t, err)
+
+		err = setWith([]byte("key"), []byte("value7"), schema.PreconditionKeyCountInRange([]byte("missing"), 1, math.MaxUint64))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+		err = setWith([]byte("key"), []byte("value7"), schema.PreconditionKeyCountInRange(nil, 2, 1))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+	})
+
+	t.Run("preconditions should be checked by ExecAll", func(t *testing.T) {
+		_, err := db.ExecAll(context.Background(), &schema.ExecAllRequest{
+			Operations: []*schema.Op{{
+				Operation: &schema.Op_Kv{Kv: &schema.KeyValue{Key: []byte("key"), Value: []byte("value8")}},
+			}},
+			Preconditions: []*schema.Precondition{
+				schema.PreconditionValueMustMatch([]byte("key"), []byte("value1")),
+			},
+		})
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+		_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+			Operations: []*schema.Op{{
+				Operation: &schema.Op_Kv{Kv: &schema.KeyValue{Key: []byte("key"), Value: []byte("value8")}},
+			}},
+			Preconditions: []*schema.Precondition{
+				schema.PreconditionValueMustMatch([]byte("key"), []byte("value6")),
+			},
+		})
+		require.NoError(t, err)
+	})
+}
+
 func TestPreconditionedSetParallel(t *testing.T) {
 	db := makeDb(t)
 
@@ -1376,6 +1634,52 @@ func TestPreconditionedSetParallel(t *testing.T) {
 			require.EqualValues(t, parallelism-1, failCount)
 			require.Zero(t, badError)
 		})
+
+		t.Run("ValueMustMatch", func(t *testing.T) {
+
+			_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{
+				Key:   []byte(`key`),
+				Value: []byte(`base value`),
+			}}})
+			require.NoError(t, err)
+
+			failCount, successCount, badError := runInParallel(func(i int) error {
+				_, err := db.Set(context.Background(), &schema.SetRequest{
+					KVs: []*schema.KeyValue{{
+						Key:   []byte(`key`),
+						Value: []byte(fmt.Sprintf("goroutine: %d", i)),
+					}},
+					Preconditions: []*schema.Precondition{
+						schema.PreconditionValueMustMatch([]byte(`key`), []byte(`base value`)),
+					},
+				})
+				return err
+			})
+
+			require.EqualValues(t, 1, successCount)
+			require.EqualValues(t, parallelism-1, failCount)
+			require.Zero(t, badError)
+		})
+
+		t.Run("KeyCountInRange", func(t *testing.T) {
+
+			failCount, successCount, badError := runInParallel(func(i int) error {
+				_, err := db.Set(context.Background(), &schema.SetRequest{
+					KVs: []*schema.KeyValue{{
+						Key:   []byte(fmt.Sprintf("quota/%d", i)),
+						Value: []byte(fmt.Sprintf("goroutine: %d", i)),
+					}},
+					Preconditions: []*schema.Precondition{
+						schema.PreconditionKeyCountInRange([]byte(`quota/`), 0, 2),
+					},
+				})
+				return err
+			})
+
+			require.EqualValues(t, 3, successCount)
+			require.EqualValues(t, parallelism-3, failCount)
+			require.Zero(t, badError)
+		})
 	})
 
 	t.Run("Reference", func(t *testing.T) {
@@ -19,6 +19,8 @@ package database
 import (
 	"time"
 
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -41,6 +43,16 @@ type Options struct {
 	readTxPoolSize int
 	maxResultSize  int
 
+	// number of transactions after which the key index gets committed, zero disables background commitments
+	keyIndexCommitThreshold int
+
+	// path of a trained zstd dictionary used to compress new value files
+	compressionDictionaryFile string
+
+	// encryption of newly created files, data keys are wrapped with master keys supplied by the key provider
+	encryptionAlgorithm int
+	keyProvider         encryption.KeyProvider
+
 	// TruncationFrequency determines how frequently to truncate data from the database.
 	TruncationFrequency time.Duration
 
@@ -55,6 +67,7 @@ func DefaultOptions() *Options {
 		storeOpts:           store.DefaultOptions(),
 		maxResultSize:       MaxKeyScanLimit,
 		readTxPoolSize:      DefaultReadTxPoolSize,
+		encryptionAlgorithm: appendable.NoEncryption,
 		TruncationFrequency: DefaultTruncationFrequency,
 	}
 }
@@ -116,6 +129,30 @@ func (o *Options) WithRetentionPeriod(c time.Duration) *Options {
 	return o
 }
 
+// WithCompressionDictionaryFile sets the trained zstd dictionary used to compress new value files.
+// Dictionaries are copied into the database directory, thus values compressed using
+// previous dictionaries remain readable after the dictionary is replaced.
+func (o *Options) WithCompressionDictionaryFile(path string) *Options {
+	o.compressionDictionaryFile = path
+	return o
+}
+
+// WithEncryption sets the algorithm used to encrypt newly created files and the provider
+// of the master keys wrapping the data keys of the database.
+// A key provider is required to open encrypted databases, even when new files are not encrypted.
+func (o *Options) WithEncryption(encryptionAlgorithm int, keyProvider encryption.KeyProvider) *Options {
+	o.encryptionAlgorithm = encryptionAlgorithm
+	o.keyProvider = keyProvider
+	return o
+}
+
+// WithKeyIndexCommitThreshold sets the number of transactions committed since the last key index commitment
+// after which the key index gets committed in the background. Zero disables background commitments.
+func (o *Options) WithKeyIndexCommitThreshold(threshold int) *Options {
+	o.keyIndexCommitThreshold = threshold
+	return o
+}
+
 func (o *Options) WithMaxResultSize(maxResultSize int) *Options {
 	o.maxResultSize = maxResultSize
 	return o
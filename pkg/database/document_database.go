@@ -404,6 +404,34 @@ func (d *db) ProofDocument(ctx context.Context, req *protomodel.ProofDocumentReq
 		return nil, err
 	}
 
+	if req.CompactProof {
+		// only the entry of the document is included, together with its proof of inclusion
+		docKey, err := document.EncodedKeyForDocument([]byte{DocumentPrefix}, collectionID, docID)
+		if err != nil {
+			return nil, err
+		}
+
+		entriesProof, err := tx.MultiProof([][]byte{docKey})
+		if err != nil {
+			return nil, err
+		}
+
+		return &protomodel.ProofDocumentResponse{
+			Database:            d.name,
+			CollectionId:        collectionID,
+			DocumentIdFieldName: documentIdFieldName,
+			EncodedDocument:     docAudit.EncodedDocument,
+			VerifiableTx: &schema.VerifiableTxV2{
+				Tx: &schema.Tx{
+					Header:  schema.TxHeaderToProto(tx.Header()),
+					Entries: []*schema.TxEntry{schema.TxEntryToProto(tx.Entries()[entriesProof.Leaves[0]])},
+				},
+				DualProof: schema.DualProofV2ToProto(dualProof),
+			},
+			EntriesProof: schema.MultiInclusionProofToProto(entriesProof),
+		}, nil
+	}
+
 	return &protomodel.ProofDocumentResponse{
 		Database:            d.name,
 		CollectionId:        collectionID,
@@ -17,6 +17,7 @@ package database
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"os"
 	"testing"
@@ -480,6 +481,32 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 		require.Equal(t, proofRes.VerifiableTx.DualProof.TargetTxHeader.Id, knownState.TxId)
 	})
 
+	t.Run("should pass when querying documents with compact proof", func(t *testing.T) {
+		proofRes, err := db.ProofDocument(context.Background(), &protomodel.ProofDocumentRequest{
+			CollectionName: collectionName,
+			DocumentId:     docID,
+			CompactProof:   true,
+		})
+		require.NoError(t, err)
+		require.Len(t, proofRes.VerifiableTx.Tx.Entries, 1)
+		require.NotNil(t, proofRes.EntriesProof)
+
+		state, err := verification.VerifyDocument(context.Background(), proofRes, doc, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, knownState.TxId, state.TxId)
+
+		proofRes.EntriesProof.Terms = append(proofRes.EntriesProof.Terms, make([]byte, sha256.Size))
+
+		_, err = verification.VerifyDocument(context.Background(), proofRes, doc, nil, nil)
+		require.ErrorIs(t, err, store.ErrInvalidProof)
+
+		proofRes.EntriesProof.Terms = proofRes.EntriesProof.Terms[:len(proofRes.EntriesProof.Terms)-1]
+		proofRes.EntriesProof.Width++
+
+		_, err = verification.VerifyDocument(context.Background(), proofRes, doc, nil, nil)
+		require.ErrorIs(t, err, store.ErrInvalidProof)
+	})
+
 	var updatedDoc *structpb.Struct
 
 	t.Run("should pass when replacing document", func(t *testing.T) {
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedDatabase(t *testing.T) {
+	rootPath := t.TempDir()
+
+	masterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	provider, err := encryption.NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	options := DefaultOptions().
+		WithDBRootPath(rootPath).
+		WithEncryption(appendable.AESGCMEncryption, provider)
+
+	db, err := NewDB("db", &dummyMultidbHandler{}, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}},
+	})
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(rootPath, "db", keyRingFilename))
+
+	_, err = OpenDB("db", &dummyMultidbHandler{}, DefaultOptions().WithDBRootPath(rootPath), logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.ErrorIs(t, err, ErrMissingKeyProvider)
+
+	// the master key is rotated, the previous one is only needed to re-wrap data keys
+	newMasterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	provider, err = encryption.NewStaticKeyProvider(newMasterKey, masterKey)
+	require.NoError(t, err)
+
+	db, err = OpenDB("db", &dummyMultidbHandler{}, options.WithEncryption(appendable.AESGCMEncryption, provider), logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	err = db.Close()
+	require.NoError(t, err)
+
+	provider, err = encryption.NewStaticKeyProvider(newMasterKey)
+	require.NoError(t, err)
+
+	db, err = OpenDB("db", &dummyMultidbHandler{}, options.WithEncryption(appendable.AESGCMEncryption, provider), logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("key1")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), entry.Value)
+}
+
+func TestUnencryptedDatabaseWithKeyProvider(t *testing.T) {
+	rootPath := t.TempDir()
+
+	masterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	provider, err := encryption.NewStaticKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	options := DefaultOptions().
+		WithDBRootPath(rootPath).
+		WithEncryption(appendable.NoEncryption, provider)
+
+	db := makeDbWith(t, "db", options)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}},
+	})
+	require.NoError(t, err)
+
+	// no key ring is created while data is not encrypted
+	require.NoFileExists(t, filepath.Join(rootPath, "db", keyRingFilename))
+}
@@ -0,0 +1,625 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/mtreap"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+var ErrNoKeyIndexCommitment = errors.New("key index has not been committed")
+var ErrInvalidKeyIndexCommitment = fmt.Errorf("%w: invalid key index commitment", store.ErrCorruptedData)
+
+const keyIndexCommitmentSize = 2*8 + sha256.Size
+
+const keyIndexDirname = "keyindex"
+
+// keyIndexCommitRetryDelay is the time to wait before committing the key index again after a failure
+const keyIndexCommitRetryDelay = time.Second
+
+// The key index is a Merkle treap built over the keys of the database sorted in lexicographical order.
+// Each leaf binds a key to the transaction holding its latest entry, deleted keys are not part of the index.
+// Commitments of the key index (the last indexed transaction, the number of keys and the root hash) are
+// stored as regular entries, thus they are covered by the accumulative hash of the transactions committing them.
+// Key index commitments are made in the background once a number of transactions have been committed since
+// the last one, proofs are generated against the last commitment.
+//
+// The shape of the treap only depends on the keys it holds, thus any key index commitment can be audited
+// by building the index up to its indexed transaction. Versions of the key index are persisted as they get committed,
+// each new version being derived from the previous one by applying the transactions committed since then.
+
+// KeyIndexCommitment holds the information required to verify proofs against a key index
+type KeyIndexCommitment struct {
+	IndexedTx uint64
+	Width     uint64
+	Root      [sha256.Size]byte
+}
+
+// KeyIndexCommitmentKey returns the key under which key index commitments are stored
+func KeyIndexCommitmentKey() []byte {
+	return []byte{KeyIndexPrefix}
+}
+
+func EncodeKeyIndexCommitment(c *KeyIndexCommitment) []byte {
+	var b [keyIndexCommitmentSize]byte
+
+	binary.BigEndian.PutUint64(b[:], c.IndexedTx)
+	binary.BigEndian.PutUint64(b[8:], c.Width)
+	copy(b[16:], c.Root[:])
+
+	return b[:]
+}
+
+func DecodeKeyIndexCommitment(b []byte) (*KeyIndexCommitment, error) {
+	if len(b) != keyIndexCommitmentSize {
+		return nil, ErrInvalidKeyIndexCommitment
+	}
+
+	c := &KeyIndexCommitment{
+		IndexedTx: binary.BigEndian.Uint64(b),
+		Width:     binary.BigEndian.Uint64(b[8:]),
+	}
+	copy(c.Root[:], b[16:])
+
+	return c, nil
+}
+
+// KeyIndexLeafValue returns the value bound to a key by its leaf in the key index,
+// being the transaction holding its latest entry
+func KeyIndexLeafValue(tx uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], tx)
+	return b[:]
+}
+
+// KeyIndexRange locates keys in relation to the range of a scan, following the order of the scan.
+// Keys of the key index within the range of a scan are contiguous, thus a scan is complete
+// when it includes the consecutive leaves delimited by keys out of its range.
+type KeyIndexRange struct {
+	req *schema.ScanRequest
+}
+
+func NewKeyIndexRange(req *schema.ScanRequest) *KeyIndexRange {
+	return &KeyIndexRange{req: req}
+}
+
+// compare compares keys following the order of the scan
+func (r *KeyIndexRange) compare(a, b []byte) int {
+	if r.req.Desc {
+		return bytes.Compare(b, a)
+	}
+	return bytes.Compare(a, b)
+}
+
+// BeforeStart returns true if the key precedes the range of the scan
+func (r *KeyIndexRange) BeforeStart(key []byte) bool {
+	if !bytes.HasPrefix(key, r.req.Prefix) && r.compare(key, r.req.Prefix) < 0 {
+		return true
+	}
+
+	if len(r.req.SeekKey) == 0 {
+		return false
+	}
+
+	c := r.compare(key, r.req.SeekKey)
+
+	return c < 0 || (c == 0 && !r.req.InclusiveSeek)
+}
+
+// AfterEnd returns true if the key follows the range of the scan
+func (r *KeyIndexRange) AfterEnd(key []byte) bool {
+	if !bytes.HasPrefix(key, r.req.Prefix) && r.compare(key, r.req.Prefix) > 0 {
+		return true
+	}
+
+	if len(r.req.EndKey) == 0 {
+		return false
+	}
+
+	c := r.compare(key, r.req.EndKey)
+
+	return c > 0 || (c == 0 && !r.req.InclusiveEnd)
+}
+
+// Contains returns true if the key is within the range of the scan
+func (r *KeyIndexRange) Contains(key []byte) bool {
+	return !r.BeforeStart(key) && !r.AfterEnd(key)
+}
+
+func keyIndexLeaf(snap *mtreap.Snapshot, i uint64) (*schema.KeyIndexLeaf, error) {
+	key, value, err := snap.At(i)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(value) != 8 {
+		return nil, fmt.Errorf("%w: invalid key index leaf", store.ErrCorruptedData)
+	}
+
+	proof, err := snap.InclusionProof(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.KeyIndexLeaf{
+		Key:            key,
+		Tx:             binary.BigEndian.Uint64(value),
+		InclusionProof: schema.KeyIndexInclusionProofToProto(proof),
+	}, nil
+}
+
+// openKeyIndex opens the key index the first time it's needed
+func (d *db) openKeyIndex() (*mtreap.MTreap, error) {
+	if d.keyIndex != nil {
+		return d.keyIndex, nil
+	}
+
+	if d.st.IsClosed() {
+		return nil, store.ErrAlreadyClosed
+	}
+
+	stOpts := d.options.GetStoreOptions()
+
+	opts := mtreap.DefaultOptions().
+		WithFileMode(stOpts.FileMode).
+		WithEncryption(stOpts.EncryptionAlgorithm, stOpts.KeyRing)
+
+	t, err := mtreap.Open(filepath.Join(d.Path(), keyIndexDirname), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := t.Version()
+	if err != nil {
+		t.Close()
+		return nil, err
+	}
+
+	// versions built upon transactions which were not durably committed are discarded
+	lastTxID := d.st.LastCommittedTxID()
+
+	if version > lastTxID {
+		err = t.Rollback(lastTxID)
+		if err != nil {
+			t.Close()
+			return nil, err
+		}
+	}
+
+	d.keyIndex = t
+
+	return t, nil
+}
+
+func (d *db) closeKeyIndex() error {
+	d.keyIndexMutex.Lock()
+	defer d.keyIndexMutex.Unlock()
+
+	if d.keyIndex == nil {
+		return nil
+	}
+
+	err := d.keyIndex.Close()
+
+	d.keyIndex = nil
+
+	return err
+}
+
+// keyIndexAt returns the key index as of the specified transaction, which must be committed.
+// Once the first version of the key index gets built, newer versions are derived from the last one
+// by applying the transactions committed since then, which takes O(log N) for each updated key.
+func (d *db) keyIndexAt(ctx context.Context, indexedTx uint64) (*mtreap.Snapshot, error) {
+	d.keyIndexMutex.Lock()
+	defer d.keyIndexMutex.Unlock()
+
+	t, err := d.openKeyIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := t.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	if version < indexedTx {
+		err = d.advanceKeyIndex(ctx, t, version, indexedTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t.SnapshotAt(indexedTx)
+}
+
+// advanceKeyIndex applies the transactions following the last version of the key index up to the specified one
+func (d *db) advanceKeyIndex(ctx context.Context, t *mtreap.MTreap, version, indexedTx uint64) (err error) {
+	tx, err := d.allocTx()
+	if err != nil {
+		return err
+	}
+	defer d.releaseTx(tx)
+
+	defer func() {
+		if err != nil {
+			// changes applied so far are discarded
+			t.Rollback(version)
+		}
+	}()
+
+	for txID := version + 1; txID <= indexedTx; txID++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		err := d.st.ReadTx(txID, false, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range tx.Entries() {
+			if len(e.Key()) == 0 || e.Key()[0] != SetKeyPrefix {
+				continue
+			}
+
+			md := e.Metadata()
+			if md != nil && md.NonIndexable() {
+				continue
+			}
+
+			if md != nil && md.Deleted() {
+				err = t.Delete(TrimPrefix(e.Key()))
+			} else {
+				err = t.Set(TrimPrefix(e.Key()), KeyIndexLeafValue(txID))
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return t.Commit(indexedTx)
+}
+
+// CommitKeyIndex commits the key index covering all the transactions committed so far
+func (d *db) CommitKeyIndex(ctx context.Context) (*schema.TxHeader, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	// commitments follow the order of their indexed transactions
+	d.keyIndexCommitMutex.Lock()
+	defer d.keyIndexCommitMutex.Unlock()
+
+	snap, err := d.keyIndexAt(ctx, d.st.LastCommittedTxID())
+	if err != nil {
+		return nil, err
+	}
+
+	c := &KeyIndexCommitment{
+		IndexedTx: snap.Version(),
+		Width:     snap.Size(),
+		Root:      snap.Root(),
+	}
+
+	tx, err := d.newWriteOnlyTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Cancel()
+
+	err = tx.Set(KeyIndexCommitmentKey(), nil, EncodeKeyIndexCommitment(c))
+	if err != nil {
+		return nil, err
+	}
+
+	hdr, err := tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.TxHeaderToProto(hdr), nil
+}
+
+// startKeyIndexCommitter starts committing the key index in the background, unless disabled
+func (d *db) startKeyIndexCommitter() {
+	if d.options.keyIndexCommitThreshold <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	d.keyIndexCommitterCancel = cancel
+	d.keyIndexCommitterDone = make(chan struct{})
+
+	go func() {
+		defer close(d.keyIndexCommitterDone)
+		d.commitKeyIndexPeriodically(ctx, uint64(d.options.keyIndexCommitThreshold))
+	}()
+}
+
+func (d *db) stopKeyIndexCommitter() {
+	if d.keyIndexCommitterCancel == nil {
+		return
+	}
+
+	d.keyIndexCommitterCancel()
+	<-d.keyIndexCommitterDone
+
+	d.keyIndexCommitterCancel = nil
+}
+
+// commitKeyIndexPeriodically commits the key index every time the specified number of transactions
+// have been committed since the last commitment, until the context gets cancelled
+func (d *db) commitKeyIndexPeriodically(ctx context.Context, threshold uint64) {
+	var lastCommitmentTx uint64
+
+	valRef, _, err := d.readKeyIndexCommitment(ctx)
+	if err == nil {
+		lastCommitmentTx = valRef.Tx()
+	} else if !errors.Is(err, ErrNoKeyIndexCommitment) {
+		d.Logger.Warningf("%v: while reading the key index commitment of database '%s'", err, d.name)
+	}
+
+	for {
+		err := d.WaitForTx(ctx, lastCommitmentTx+threshold, false)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// commitments of replicas are replicated from the primary database
+		if err == nil && d.IsReplica() {
+			lastCommitmentTx = d.st.LastCommittedTxID()
+			continue
+		}
+
+		var hdr *schema.TxHeader
+
+		if err == nil {
+			hdr, err = d.CommitKeyIndex(ctx)
+		}
+		if err == nil {
+			lastCommitmentTx = hdr.Id
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		d.Logger.Warningf("%v: while committing the key index of database '%s'", err, d.name)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(keyIndexCommitRetryDelay):
+		}
+	}
+}
+
+// readKeyIndexCommitment returns the last committed key index commitment together with the reference to its entry
+func (d *db) readKeyIndexCommitment(ctx context.Context) (store.ValueRef, *KeyIndexCommitment, error) {
+	valRef, err := d.st.Get(ctx, KeyIndexCommitmentKey())
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, nil, ErrNoKeyIndexCommitment
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	val, err := valRef.Resolve()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := DecodeKeyIndexCommitment(val)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the commitment can not cover the transaction holding it
+	if c.IndexedTx >= valRef.Tx() {
+		return nil, nil, ErrInvalidKeyIndexCommitment
+	}
+
+	return valRef, c, nil
+}
+
+type keyIndexCommitmentProof struct {
+	snap           *mtreap.Snapshot
+	commitment     *KeyIndexCommitment
+	txHdr          *store.TxHeader
+	verifiableTx   *schema.VerifiableTx
+	inclusionProof *schema.InclusionProof
+}
+
+// lastKeyIndexCommitment returns the last committed key index together with the proof of its commitment.
+// The commitment transaction may precede the specified one, in which case it's proven against it.
+func (d *db) lastKeyIndexCommitment(ctx context.Context, proveSinceTx uint64) (*keyIndexCommitmentProof, error) {
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < proveSinceTx {
+		return nil, ErrIllegalState
+	}
+
+	valRef, c, err := d.readKeyIndexCommitment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := d.keyIndexAt(ctx, c.IndexedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if snap.Size() != c.Width || snap.Root() != c.Root {
+		return nil, fmt.Errorf("%w: key index does not match its commitment", store.ErrCorruptedData)
+	}
+
+	// key index commitment inclusion proof
+	commitmentTx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(commitmentTx)
+
+	err = d.st.ReadTx(valRef.Tx(), false, commitmentTx)
+	if err != nil {
+		return nil, err
+	}
+
+	inclusionProof, err := commitmentTx.Proof(KeyIndexCommitmentKey())
+	if err != nil {
+		return nil, err
+	}
+
+	commitmentTxHdr, err := d.st.ReadTxHeader(valRef.Tx(), false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dualProof, err := d.dualProofSince(commitmentTxHdr, proveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyIndexCommitmentProof{
+		snap:       snap,
+		commitment: c,
+		txHdr:      commitmentTxHdr,
+		verifiableTx: &schema.VerifiableTx{
+			Tx:        schema.TxToProto(commitmentTx),
+			DualProof: schema.DualProofToProto(dualProof),
+		},
+		inclusionProof: schema.InclusionProofToProto(inclusionProof),
+	}, nil
+}
+
+// KeyIndexProof proves the latest entry of a key, or its non-existence, as of the last key index commitment
+func (d *db) KeyIndexProof(ctx context.Context, req *schema.KeyIndexProofRequest) (*schema.VerifiableKeyIndexProof, error) {
+	if req == nil || len(req.Key) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	cp, err := d.lastKeyIndexCommitment(ctx, req.ProveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := cp.snap
+
+	proof := &schema.VerifiableKeyIndexProof{
+		CommitmentTx:             cp.verifiableTx,
+		CommitmentInclusionProof: cp.inclusionProof,
+		IndexedTx:                cp.commitment.IndexedTx,
+		Width:                    cp.commitment.Width,
+		Root:                     cp.commitment.Root[:],
+	}
+
+	i, found, err := snap.Rank(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		e, err := d.keyIndexEntry(ctx, snap, i, cp.txHdr)
+		if err != nil {
+			return nil, err
+		}
+
+		proof.Entry = e.Entry
+		proof.EntryInclusionProof = e.InclusionProof
+		proof.EntryDualProof = e.DualProof
+		proof.Leaf = e.Leaf
+
+		return proof, nil
+	}
+
+	if i > 0 {
+		proof.Predecessor, err = keyIndexLeaf(snap, i-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if i < snap.Size() {
+		proof.Successor, err = keyIndexLeaf(snap, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return proof, nil
+}
+
+// keyIndexEntry returns the latest entry of the i-th key of the index,
+// proving its inclusion in the key index and in the transaction holding it
+func (d *db) keyIndexEntry(ctx context.Context, snap *mtreap.Snapshot, i uint64, commitmentTxHdr *store.TxHeader) (*schema.KeyIndexEntry, error) {
+	leaf, err := keyIndexLeaf(snap, i)
+	if err != nil {
+		return nil, err
+	}
+
+	key := leaf.Key
+	txID := leaf.Tx
+
+	e, err := d.getAtTx(ctx, EncodeKey(key), txID, 0, d.st, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	err = d.st.ReadTx(txID, false, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	inclusionProof, err := tx.Proof(EncodeKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	dualProof, err := d.st.DualProof(tx.Header(), commitmentTxHdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.KeyIndexEntry{
+		Entry:          e,
+		Leaf:           leaf,
+		InclusionProof: schema.InclusionProofToProto(inclusionProof),
+		DualProof:      schema.DualProofToProto(dualProof),
+	}, nil
+}
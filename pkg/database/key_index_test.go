@@ -0,0 +1,337 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/mtreap"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func requireKeyIndexLeaf(t *testing.T, proof *schema.VerifiableKeyIndexProof, leaf *schema.KeyIndexLeaf) {
+	require.NotNil(t, leaf)
+
+	var root [sha256.Size]byte
+	copy(root[:], proof.Root)
+
+	iproof := schema.KeyIndexInclusionProofFromProto(leaf.InclusionProof)
+	require.Equal(t, proof.Width, iproof.Width)
+	require.True(t, mtreap.VerifyInclusion(iproof, leaf.Key, KeyIndexLeafValue(leaf.Tx), root))
+}
+
+func TestKeyIndexCommitmentEncoding(t *testing.T) {
+	c := &KeyIndexCommitment{
+		IndexedTx: 10,
+		Width:     3,
+		Root:      sha256.Sum256([]byte("root")),
+	}
+
+	decoded, err := DecodeKeyIndexCommitment(EncodeKeyIndexCommitment(c))
+	require.NoError(t, err)
+	require.Equal(t, c, decoded)
+
+	_, err = DecodeKeyIndexCommitment([]byte{1, 2, 3})
+	require.ErrorIs(t, err, ErrInvalidKeyIndexCommitment)
+	require.ErrorIs(t, err, store.ErrCorruptedData)
+}
+
+func TestKeyIndexProof(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.KeyIndexProof(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key1")})
+	require.ErrorIs(t, err, ErrNoKeyIndexCommitment)
+
+	t.Run("empty key index", func(t *testing.T) {
+		commitmentHdr, err := db.CommitKeyIndex(context.Background())
+		require.NoError(t, err)
+
+		proof, err := db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key1")})
+		require.NoError(t, err)
+		require.Equal(t, commitmentHdr.Id, proof.CommitmentTx.Tx.Header.Id)
+		require.Equal(t, commitmentHdr.Id-1, proof.IndexedTx)
+		require.Zero(t, proof.Width)
+		require.Equal(t, mtreap.EmptyRoot[:], proof.Root)
+		require.Nil(t, proof.Entry)
+		require.Nil(t, proof.Leaf)
+		require.Nil(t, proof.Predecessor)
+		require.Nil(t, proof.Successor)
+	})
+
+	for _, key := range []string{"key1", "key3", "key5", "key7"} {
+		_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte(key), Value: []byte("value1")}}})
+		require.NoError(t, err)
+	}
+
+	hdr, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key3"), Value: []byte("value2")}}})
+	require.NoError(t, err)
+
+	_, err = db.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key7")}})
+	require.NoError(t, err)
+
+	commitmentHdr, err := db.CommitKeyIndex(context.Background())
+	require.NoError(t, err)
+
+	t.Run("latest entry", func(t *testing.T) {
+		proof, err := db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key3")})
+		require.NoError(t, err)
+		require.Equal(t, commitmentHdr.Id-1, proof.IndexedTx)
+		require.Equal(t, uint64(3), proof.Width)
+		require.Equal(t, commitmentHdr.Id, proof.CommitmentTx.Tx.Header.Id)
+
+		require.NotNil(t, proof.Entry)
+		require.Equal(t, []byte("value2"), proof.Entry.Value)
+		require.Equal(t, hdr.Id, proof.Entry.Tx)
+
+		requireKeyIndexLeaf(t, proof, proof.Leaf)
+		require.Equal(t, []byte("key3"), proof.Leaf.Key)
+		require.Equal(t, hdr.Id, proof.Leaf.Tx)
+
+		require.NotNil(t, proof.EntryInclusionProof)
+		require.Equal(t, hdr.Id, proof.EntryDualProof.SourceTxHeader.Id)
+		require.Equal(t, commitmentHdr.Id, proof.EntryDualProof.TargetTxHeader.Id)
+	})
+
+	t.Run("absent keys", func(t *testing.T) {
+		proof, err := db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key4")})
+		require.NoError(t, err)
+		require.Nil(t, proof.Entry)
+		require.Nil(t, proof.Leaf)
+		requireKeyIndexLeaf(t, proof, proof.Predecessor)
+		requireKeyIndexLeaf(t, proof, proof.Successor)
+		require.Equal(t, []byte("key3"), proof.Predecessor.Key)
+		require.Equal(t, []byte("key5"), proof.Successor.Key)
+
+		proof, err = db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key0")})
+		require.NoError(t, err)
+		require.Nil(t, proof.Predecessor)
+		requireKeyIndexLeaf(t, proof, proof.Successor)
+		require.Equal(t, []byte("key1"), proof.Successor.Key)
+
+		// deleted keys are not part of the key index
+		proof, err = db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key7")})
+		require.NoError(t, err)
+		require.Nil(t, proof.Entry)
+		requireKeyIndexLeaf(t, proof, proof.Predecessor)
+		require.Nil(t, proof.Successor)
+		require.Equal(t, []byte("key5"), proof.Predecessor.Key)
+	})
+
+	t.Run("proofs are generated against the last commitment", func(t *testing.T) {
+		hdr, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key4"), Value: []byte("value1")}}})
+		require.NoError(t, err)
+
+		proof, err := db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key4"), ProveSinceTx: hdr.Id})
+		require.NoError(t, err)
+		require.Nil(t, proof.Entry)
+		require.Equal(t, commitmentHdr.Id, proof.CommitmentTx.Tx.Header.Id)
+		require.Equal(t, uint64(3), proof.Width)
+
+		// the commitment is proven against the newer transaction
+		require.Equal(t, commitmentHdr.Id, proof.CommitmentTx.DualProof.SourceTxHeader.Id)
+		require.Equal(t, hdr.Id, proof.CommitmentTx.DualProof.TargetTxHeader.Id)
+
+		// reading proofs does not commit the key index
+		require.Equal(t, hdr.Id, db.st.LastCommittedTxID())
+
+		commitmentHdr, err := db.CommitKeyIndex(context.Background())
+		require.NoError(t, err)
+
+		proof, err = db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key4"), ProveSinceTx: hdr.Id})
+		require.NoError(t, err)
+		require.NotNil(t, proof.Entry)
+		require.Equal(t, []byte("value1"), proof.Entry.Value)
+		require.Equal(t, hdr.Id, proof.IndexedTx)
+		require.Equal(t, commitmentHdr.Id, proof.CommitmentTx.Tx.Header.Id)
+		require.Equal(t, uint64(4), proof.Width)
+		requireKeyIndexLeaf(t, proof, proof.Leaf)
+		require.Equal(t, hdr.Id, proof.CommitmentTx.DualProof.SourceTxHeader.Id)
+	})
+
+	t.Run("proving since a future transaction", func(t *testing.T) {
+		_, err := db.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key1"), ProveSinceTx: 1000})
+		require.ErrorIs(t, err, ErrIllegalState)
+	})
+}
+
+func TestKeyIndexAdvancedIncrementally(t *testing.T) {
+	rootPath := t.TempDir()
+
+	options := DefaultOptions().WithDBRootPath(rootPath)
+
+	d, err := NewDB("db", &dummyMultidbHandler{}, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+	require.NoError(t, err)
+
+	kdb := d.(*db)
+
+	for i := 0; i < 10; i++ {
+		_, err := kdb.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte("value")},
+		}})
+		require.NoError(t, err)
+	}
+
+	firstHdr, err := kdb.CommitKeyIndex(context.Background())
+	require.NoError(t, err)
+
+	_, err = kdb.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("key3"), Value: []byte("value2")},
+		{Key: []byte("key31"), Value: []byte("value")},
+		{Key: []byte("a"), Value: []byte("value")},
+		{Key: []byte("z"), Value: []byte("value")},
+	}})
+	require.NoError(t, err)
+
+	_, err = kdb.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key5"), []byte("key9")}})
+	require.NoError(t, err)
+
+	_, err = kdb.SetReference(context.Background(), &schema.ReferenceRequest{Key: []byte("ref"), ReferencedKey: []byte("key1")})
+	require.NoError(t, err)
+
+	tx, err := kdb.st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	md := store.NewKVMetadata()
+	err = md.AsNonIndexable(true)
+	require.NoError(t, err)
+
+	err = tx.Set(EncodeKey([]byte("key6")), md, WrapWithPrefix([]byte("value2"), PlainValuePrefix))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	commitmentHdr, err := kdb.CommitKeyIndex(context.Background())
+	require.NoError(t, err)
+
+	proof, err := kdb.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key3")})
+	require.NoError(t, err)
+	require.Equal(t, commitmentHdr.Id, proof.CommitmentTx.Tx.Header.Id)
+	require.Equal(t, []byte("value2"), proof.Entry.Value)
+	require.Equal(t, uint64(12), proof.Width)
+
+	// non-indexable entries are not part of the key index
+	proof, err = kdb.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key6")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), proof.Entry.Value)
+
+	// every committed version of the key index is kept
+	snap, err := kdb.keyIndexAt(context.Background(), firstHdr.Id-1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), snap.Size())
+
+	err = kdb.Close()
+	require.NoError(t, err)
+
+	t.Run("versions of the key index are persisted", func(t *testing.T) {
+		d, err := OpenDB("db", &dummyMultidbHandler{}, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+		require.NoError(t, err)
+
+		tree, err := d.(*db).openKeyIndex()
+		require.NoError(t, err)
+
+		version, err := tree.Version()
+		require.NoError(t, err)
+		require.Equal(t, commitmentHdr.Id-1, version)
+
+		err = d.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("the key index matches its commitment when built from scratch", func(t *testing.T) {
+		err := os.RemoveAll(filepath.Join(rootPath, "db", keyIndexDirname))
+		require.NoError(t, err)
+
+		d, err := OpenDB("db", &dummyMultidbHandler{}, options, logger.NewSimpleLogger("immudb ", os.Stderr))
+		require.NoError(t, err)
+		defer d.Close()
+
+		rebuilt, err := d.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key3")})
+		require.NoError(t, err)
+		require.Equal(t, proof.Root, rebuilt.Root)
+	})
+}
+
+func TestKeyIndexCommittedInBackground(t *testing.T) {
+	options := DefaultOptions().
+		WithDBRootPath(t.TempDir()).
+		WithKeyIndexCommitThreshold(5)
+
+	db := makeDbWith(t, "db", options)
+
+	_, _, err := db.readKeyIndexCommitment(context.Background())
+	require.ErrorIs(t, err, ErrNoKeyIndexCommitment)
+
+	setKeys := func(n int) (hdr *schema.TxHeader) {
+		for i := 0; i < n; i++ {
+			var err error
+
+			hdr, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+				{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte("value")},
+			}})
+			require.NoError(t, err)
+		}
+		return hdr
+	}
+
+	hdr := setKeys(5)
+
+	var commitmentTx uint64
+
+	require.Eventually(t, func() bool {
+		valRef, c, err := db.readKeyIndexCommitment(context.Background())
+		if err != nil {
+			return false
+		}
+
+		require.GreaterOrEqual(t, c.IndexedTx, hdr.Id)
+		commitmentTx = valRef.Tx()
+
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+
+	hdr = setKeys(5)
+
+	require.Eventually(t, func() bool {
+		valRef, c, err := db.readKeyIndexCommitment(context.Background())
+		require.NoError(t, err)
+
+		if valRef.Tx() == commitmentTx {
+			return false
+		}
+
+		require.GreaterOrEqual(t, c.IndexedTx, hdr.Id)
+		require.Equal(t, uint64(5), c.Width)
+
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+}
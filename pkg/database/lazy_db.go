@@ -25,6 +25,7 @@ import (
 
 	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
 )
@@ -174,6 +175,26 @@ func (db *lazyDB) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRe
 	return d.VerifiableGet(ctx, req)
 }
 
+func (db *lazyDB) CommitKeyIndex(ctx context.Context) (*schema.TxHeader, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.CommitKeyIndex(ctx)
+}
+
+func (db *lazyDB) KeyIndexProof(ctx context.Context, req *schema.KeyIndexProofRequest) (*schema.VerifiableKeyIndexProof, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.KeyIndexProof(ctx, req)
+}
+
 func (db *lazyDB) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -194,6 +215,16 @@ func (db *lazyDB) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*s
 	return d.Delete(ctx, req)
 }
 
+func (db *lazyDB) RedactEntries(ctx context.Context, req *schema.RedactEntriesRequest) (*schema.TxHeader, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.RedactEntries(ctx, req)
+}
+
 func (db *lazyDB) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -224,6 +255,16 @@ func (db *lazyDB) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.En
 	return d.Scan(ctx, req)
 }
 
+func (db *lazyDB) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.VerifiableScan(ctx, req)
+}
+
 func (db *lazyDB) History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -505,6 +546,16 @@ func (db *lazyDB) TxScan(ctx context.Context, req *schema.TxScanRequest) (*schem
 	return d.TxScan(ctx, req)
 }
 
+func (db *lazyDB) SubscribeChanges(ctx context.Context, req *schema.SubscribeChangesRequest, send func(*schema.VerifiableTx) error) error {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.SubscribeChanges(ctx, req, send)
+}
+
 func (db *lazyDB) FlushIndex(req *schema.FlushIndexRequest) error {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -525,6 +576,16 @@ func (db *lazyDB) CompactIndex() error {
 	return d.CompactIndex()
 }
 
+func (db *lazyDB) CompactValueLog(ctx context.Context) (*store.VLogGCReport, error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.CompactValueLog(ctx)
+}
+
 func (db *lazyDB) IsClosed() bool {
 	return db.m.IsClosed(db.idx)
 }
@@ -18,6 +18,7 @@ package database
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
 
 	"github.com/codenotary/immudb/embedded/store"
@@ -28,6 +29,7 @@ const (
 	SortedSetKeyPrefix
 	SQLPrefix
 	DocumentPrefix
+	KeyIndexPrefix
 )
 
 const (
@@ -63,6 +65,65 @@ func EncodeEntrySpec(
 	}
 }
 
+// Merge operators registered by the database, they operate over plain values
+// i.e. the value prefix is removed before applying the operator and added back to the result
+const (
+	IncrementMergeOperator = "kv." + store.IncrementMergeOperator
+	AppendMergeOperator    = "kv." + store.AppendMergeOperator
+)
+
+func registerMergeOperators(st *store.ImmuStore) error {
+	for name, stName := range map[string]string{
+		IncrementMergeOperator: store.IncrementMergeOperator,
+		AppendMergeOperator:    store.AppendMergeOperator,
+	} {
+		op, err := st.MergeOperator(stName)
+		if err != nil {
+			return err
+		}
+
+		err = st.RegisterMergeOperator(name, plainValueMergeOperator(op))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func plainValueMergeOperator(op store.MergeOperator) store.MergeOperator {
+	return func(current []byte, exists bool, operand []byte) ([]byte, error) {
+		if exists && (len(current) == 0 || current[0] != PlainValuePrefix) {
+			return nil, fmt.Errorf("%w: only plain values can be merged", store.ErrInvalidMergeValue)
+		}
+
+		var plainValue []byte
+		if exists {
+			plainValue = TrimPrefix(current)
+		}
+
+		v, err := op(plainValue, exists, operand)
+		if err != nil {
+			return nil, err
+		}
+
+		return WrapWithPrefix(v, PlainValuePrefix), nil
+	}
+}
+
+func EncodeMerge(
+	key []byte,
+	md *store.KVMetadata,
+	operator string,
+	operand []byte,
+) *store.EntrySpec {
+	return &store.EntrySpec{
+		Key:      WrapWithPrefix(key, SetKeyPrefix),
+		Metadata: md,
+		Merges:   []*store.MergeOp{{Operator: operator, Operand: operand}},
+	}
+}
+
 func EncodeReference(
 	key []byte,
 	md *store.KVMetadata,
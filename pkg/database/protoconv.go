@@ -17,6 +17,9 @@ limitations under the License.
 package database
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 )
@@ -56,6 +59,53 @@ func PreconditionFromProto(c *schema.Precondition) (store.Precondition, error) {
 			Key:  EncodeKey(key),
 			TxID: c.KeyNotModifiedAfterTX.GetTxID(),
 		}, nil
+
+	case *schema.Precondition_ValueMustMatch:
+		key := c.ValueMustMatch.GetKey()
+		if len(key) == 0 {
+			return nil, store.ErrInvalidPreconditionNullKey
+		}
+
+		// values are stored with a prefix, thus the digest of the stored value is directly compared
+		return &store.PreconditionValueDigestMustMatch{
+			Key:    EncodeKey(key),
+			Digest: sha256.Sum256(WrapWithPrefix(c.ValueMustMatch.GetValue(), PlainValuePrefix)),
+		}, nil
+
+	case *schema.Precondition_ValueDigestMustMatch:
+		key := c.ValueDigestMustMatch.GetKey()
+		if len(key) == 0 {
+			return nil, store.ErrInvalidPreconditionNullKey
+		}
+
+		digest := c.ValueDigestMustMatch.GetDigest()
+		if len(digest) != sha256.Size {
+			return nil, fmt.Errorf("%w: invalid value digest", store.ErrInvalidPrecondition)
+		}
+
+		return &store.PreconditionValueDigestMustMatch{
+			Key:         EncodeKey(key),
+			ValuePrefix: []byte{PlainValuePrefix},
+			Digest:      schema.DigestFromProto(digest),
+		}, nil
+
+	case *schema.Precondition_KeyMetadataMustMatch:
+		key := c.KeyMetadataMustMatch.GetKey()
+		if len(key) == 0 {
+			return nil, store.ErrInvalidPreconditionNullKey
+		}
+
+		return &store.PreconditionKeyMetadataMustMatch{
+			Key:      EncodeKey(key),
+			Metadata: schema.KVMetadataFromProto(c.KeyMetadataMustMatch.GetMetadata()),
+		}, nil
+
+	case *schema.Precondition_KeyCountInRange:
+		return &store.PreconditionKeyCountInRange{
+			Prefix:   EncodeKey(c.KeyCountInRange.GetPrefix()),
+			MinCount: c.KeyCountInRange.GetMinCount(),
+			MaxCount: c.KeyCountInRange.GetMaxCount(),
+		}, nil
 	}
 
 	return nil, store.ErrInvalidPreconditionNull
@@ -17,6 +17,7 @@ limitations under the License.
 package database
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/store"
@@ -78,4 +79,47 @@ func TestPreconditionFromProto(t *testing.T) {
 		require.IsType(t, &store.PreconditionKeyNotModifiedAfterTx{}, c)
 	})
 
+	t.Run("ValueMustMatch", func(t *testing.T) {
+		_, err := PreconditionFromProto(schema.PreconditionValueMustMatch(nil, []byte{1}))
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionNullKey)
+
+		c, err := PreconditionFromProto(schema.PreconditionValueMustMatch([]byte{1}, []byte{2}))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionValueDigestMustMatch{}, c)
+		require.Empty(t, c.(*store.PreconditionValueDigestMustMatch).ValuePrefix)
+		require.Equal(t, sha256.Sum256([]byte{PlainValuePrefix, 2}), c.(*store.PreconditionValueDigestMustMatch).Digest)
+	})
+
+	t.Run("ValueDigestMustMatch", func(t *testing.T) {
+		digest := sha256.Sum256([]byte{2})
+
+		_, err := PreconditionFromProto(schema.PreconditionValueDigestMustMatch(nil, digest[:]))
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionNullKey)
+
+		_, err = PreconditionFromProto(schema.PreconditionValueDigestMustMatch([]byte{1}, digest[1:]))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+
+		c, err := PreconditionFromProto(schema.PreconditionValueDigestMustMatch([]byte{1}, digest[:]))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionValueDigestMustMatch{}, c)
+		require.Equal(t, []byte{PlainValuePrefix}, c.(*store.PreconditionValueDigestMustMatch).ValuePrefix)
+		require.Equal(t, digest, c.(*store.PreconditionValueDigestMustMatch).Digest)
+	})
+
+	t.Run("KeyMetadataMustMatch", func(t *testing.T) {
+		_, err := PreconditionFromProto(schema.PreconditionKeyMetadataMustMatch(nil, nil))
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionNullKey)
+
+		c, err := PreconditionFromProto(schema.PreconditionKeyMetadataMustMatch([]byte{1}, &schema.KVMetadata{Deleted: true}))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionKeyMetadataMustMatch{}, c)
+		require.True(t, c.(*store.PreconditionKeyMetadataMustMatch).Metadata.Deleted())
+	})
+
+	t.Run("KeyCountInRange", func(t *testing.T) {
+		c, err := PreconditionFromProto(schema.PreconditionKeyCountInRange(nil, 1, 2))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionKeyCountInRange{}, c)
+		require.Equal(t, []byte{SetKeyPrefix}, c.(*store.PreconditionKeyCountInRange).Prefix)
+	})
 }
@@ -72,6 +72,13 @@ func TestReadOnlyReplica(t *testing.T) {
 	})
 	require.ErrorIs(t, err, ErrIsReplica)
 
+	_, err = replica.CommitKeyIndex(context.Background())
+	require.ErrorIs(t, err, ErrIsReplica)
+
+	// replicas do not commit the key index by themselves
+	_, err = replica.KeyIndexProof(context.Background(), &schema.KeyIndexProofRequest{Key: []byte("key1")})
+	require.ErrorIs(t, err, ErrNoKeyIndexCommitment)
+
 	_, _, err = replica.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "CREATE TABLE mytable(id INTEGER, title VARCHAR, PRIMARY KEY id)"})
 	require.ErrorIs(t, err, ErrIsReplica)
 
@@ -103,3 +103,114 @@ func (d *db) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries
 
 	return entries, nil
 }
+
+// VerifiableScan returns the entries within the range of a scan as of the last key index commitment,
+// proving they are the latest entries of their keys and that no key within the range was left out
+func (d *db) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	if req == nil || req.ScanRequest == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if req.ScanRequest.Offset > 0 {
+		return nil, fmt.Errorf("%w: offset is not supported by verifiable scans", ErrIllegalArguments)
+	}
+
+	if req.ScanRequest.Limit > uint64(d.maxResultSize) {
+		return nil, fmt.Errorf("%w: the specified limit (%d) is larger than the maximum allowed one (%d)",
+			ErrResultSizeLimitExceeded, req.ScanRequest.Limit, d.maxResultSize)
+	}
+
+	limit := int(req.ScanRequest.Limit)
+	if req.ScanRequest.Limit == 0 {
+		limit = d.maxResultSize
+	}
+
+	cp, err := d.lastKeyIndexCommitment(ctx, req.ProveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := cp.snap
+	n := snap.Size()
+
+	// position of the leaves following the order of the scan
+	leafAt := func(pos uint64) uint64 {
+		if req.ScanRequest.Desc {
+			return n - 1 - pos
+		}
+		return pos
+	}
+
+	keyAt := func(pos uint64) ([]byte, error) {
+		key, _, err := snap.At(leafAt(pos))
+		return key, err
+	}
+
+	r := NewKeyIndexRange(req.ScanRequest)
+
+	// position of the first leaf not preceding the range
+	start, end := uint64(0), n
+
+	for start < end {
+		h := start + (end-start)/2
+
+		key, err := keyAt(h)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.BeforeStart(key) {
+			start = h + 1
+		} else {
+			end = h
+		}
+	}
+
+	proof := &schema.VerifiableEntries{
+		CommitmentTx:             cp.verifiableTx,
+		CommitmentInclusionProof: cp.inclusionProof,
+		IndexedTx:                cp.commitment.IndexedTx,
+		Width:                    cp.commitment.Width,
+		Root:                     cp.commitment.Root[:],
+	}
+
+	if start > 0 {
+		proof.StartBoundary, err = keyIndexLeaf(snap, leafAt(start-1))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for pos := start; pos < n; pos++ {
+		key, err := keyAt(pos)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.AfterEnd(key) {
+			proof.EndBoundary, err = keyIndexLeaf(snap, leafAt(pos))
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		if len(proof.Entries) == limit {
+			if req.ScanRequest.Limit == 0 {
+				// completeness can not be proven when the default limit is applied
+				return nil, fmt.Errorf("%w: found more than %d entries, specify a limit to read them partially",
+					ErrResultSizeLimitReached, d.maxResultSize)
+			}
+			return proof, nil
+		}
+
+		e, err := d.keyIndexEntry(ctx, snap, leafAt(pos), cp.txHdr)
+		if err != nil {
+			return nil, err
+		}
+
+		proof.Entries = append(proof.Entries, e)
+	}
+
+	return proof, nil
+}
@@ -363,3 +363,125 @@ func TestStoreScanWithTruncation(t *testing.T) {
 		require.Equal(t, list.Entries[4].Key, []byte(`prefix:suffix5`))
 	})
 }
+
+func TestVerifiableScan(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.VerifiableScan(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{ScanRequest: &schema.ScanRequest{Offset: 1}})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{ScanRequest: &schema.ScanRequest{Limit: uint64(db.MaxResultSize() + 1)}})
+	require.ErrorIs(t, err, ErrResultSizeLimitExceeded)
+
+	_, err = db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{ScanRequest: &schema.ScanRequest{}})
+	require.ErrorIs(t, err, ErrNoKeyIndexCommitment)
+
+	_, err = db.CommitKeyIndex(context.Background())
+	require.NoError(t, err)
+
+	proof, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{ScanRequest: &schema.ScanRequest{}})
+	require.NoError(t, err)
+	require.Empty(t, proof.Entries)
+	require.Zero(t, proof.Width)
+
+	for _, key := range []string{"a", "key_1", "key_2", "key_3", "key_4", "z"} {
+		_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte(key), Value: []byte("val_" + key)}}})
+		require.NoError(t, err)
+	}
+
+	_, err = db.CommitKeyIndex(context.Background())
+	require.NoError(t, err)
+
+	entryKeys := func(proof *schema.VerifiableEntries) []string {
+		keys := make([]string, len(proof.Entries))
+		for i, e := range proof.Entries {
+			require.Equal(t, e.Entry.Key, e.Leaf.Key)
+			require.Equal(t, e.Entry.Tx, e.Leaf.Tx)
+			keys[i] = string(e.Entry.Key)
+		}
+		return keys
+	}
+
+	t.Run("prefix scan", func(t *testing.T) {
+		proof, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+			ScanRequest: &schema.ScanRequest{Prefix: []byte("key_")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"key_1", "key_2", "key_3", "key_4"}, entryKeys(proof))
+		require.Equal(t, []byte("a"), proof.StartBoundary.Key)
+		require.Equal(t, []byte("z"), proof.EndBoundary.Key)
+	})
+
+	t.Run("desc scan with seek and end keys", func(t *testing.T) {
+		proof, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+			ScanRequest: &schema.ScanRequest{
+				Prefix:       []byte("key_"),
+				SeekKey:      []byte("key_4"),
+				EndKey:       []byte("key_2"),
+				InclusiveEnd: true,
+				Desc:         true,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"key_3", "key_2"}, entryKeys(proof))
+		require.Equal(t, []byte("key_4"), proof.StartBoundary.Key)
+		require.Equal(t, []byte("key_1"), proof.EndBoundary.Key)
+	})
+
+	t.Run("limited scan", func(t *testing.T) {
+		proof, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+			ScanRequest: &schema.ScanRequest{Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "key_1"}, entryKeys(proof))
+		require.Nil(t, proof.StartBoundary)
+		require.Nil(t, proof.EndBoundary)
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		proof, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+			ScanRequest: &schema.ScanRequest{Prefix: []byte("key_0")},
+		})
+		require.NoError(t, err)
+		require.Empty(t, proof.Entries)
+		require.Equal(t, []byte("a"), proof.StartBoundary.Key)
+		require.Equal(t, []byte("key_1"), proof.EndBoundary.Key)
+	})
+
+	t.Run("default limit exceeded", func(t *testing.T) {
+		db.maxResultSize = 3
+
+		for _, key := range []string{"k_1", "k_2", "k_3", "k_4"} {
+			_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte(key), Value: []byte("val")}}})
+			require.NoError(t, err)
+		}
+
+		_, err := db.CommitKeyIndex(context.Background())
+		require.NoError(t, err)
+
+		_, err = db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+			ScanRequest: &schema.ScanRequest{Prefix: []byte("k_")},
+		})
+		require.ErrorIs(t, err, ErrResultSizeLimitReached)
+	})
+}
+
+func TestKeyIndexRange(t *testing.T) {
+	r := NewKeyIndexRange(&schema.ScanRequest{Prefix: []byte("key_"), SeekKey: []byte("key_2"), EndKey: []byte("key_4")})
+	require.True(t, r.BeforeStart([]byte("a")))
+	require.True(t, r.BeforeStart([]byte("key_1")))
+	require.True(t, r.BeforeStart([]byte("key_2")))
+	require.True(t, r.Contains([]byte("key_3")))
+	require.True(t, r.AfterEnd([]byte("key_4")))
+	require.True(t, r.AfterEnd([]byte("z")))
+
+	r = NewKeyIndexRange(&schema.ScanRequest{Prefix: []byte("key_"), SeekKey: []byte("key_4"), InclusiveSeek: true, Desc: true})
+	require.True(t, r.BeforeStart([]byte("z")))
+	require.True(t, r.BeforeStart([]byte("key_5")))
+	require.True(t, r.Contains([]byte("key_4")))
+	require.True(t, r.Contains([]byte("key_")))
+	require.True(t, r.AfterEnd([]byte("a")))
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -287,8 +288,19 @@ func (d *db) DescribeTable(ctx context.Context, tx *sql.SQLTx, tableName string)
 	return res, nil
 }
 
+// valuesContext keeps the values of a context, such as the session or the logged user,
+// but not its cancellation, as transactions may outlive the requests creating them
+type valuesContext struct {
+	context.Context
+}
+
+func (valuesContext) Deadline() (deadline time.Time, ok bool) { return }
+func (valuesContext) Done() <-chan struct{}                   { return nil }
+func (valuesContext) Err() error                              { return nil }
+
 func (d *db) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (tx *sql.SQLTx, err error) {
-	txCtx, txCancel := context.WithCancel(context.Background())
+	// the context of the transaction is used to resolve the user on behalf of which statements are executed
+	txCtx, txCancel := context.WithCancel(valuesContext{ctx})
 
 	txChan := make(chan *sql.SQLTx)
 	errChan := make(chan error)
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactValueLog(t *testing.T) {
+	options := DefaultOptions().WithDBRootPath(t.TempDir())
+	options.storeOpts.
+		WithFileSize(1024).
+		WithVLogGCOptions(store.DefaultVLogGCOptions().WithMinDiscardableRatio(0.1))
+
+	db := makeDbWith(t, "db", options)
+
+	_, _, err := db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+		Sql: "CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id)",
+	})
+	require.NoError(t, err)
+
+	value := func(prefix string, i int) []byte {
+		return bytes.Repeat([]byte(fmt.Sprintf("%s-%04d;", prefix, i)), 10)
+	}
+
+	for i := 0; i < 20; i++ {
+		_, err = db.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{Key: []byte("key"), Value: value("key", i)}},
+		})
+		require.NoError(t, err)
+
+		_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+			Sql:    "UPSERT INTO table1(id, title) VALUES (1, @title)",
+			Params: []*schema.NamedParam{{Name: "title", Value: &schema.SQLValue{Value: &schema.SQLValue_S{S: string(value("title", i))}}}},
+		})
+		require.NoError(t, err)
+	}
+
+	err = db.WaitForIndexingUpto(context.Background(), db.st.LastCommittedTxID())
+	require.NoError(t, err)
+
+	report, err := db.CompactValueLog(context.Background())
+	require.NoError(t, err)
+	require.Positive(t, report.CompactedFiles)
+	require.Positive(t, report.ReclaimedBytes)
+
+	entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("key")})
+	require.NoError(t, err)
+	require.Equal(t, value("key", 19), entry.Value)
+
+	// values of SQL rows are never discarded
+	for i := 0; i < 20; i++ {
+		rows, err := db.SQLQueryAll(context.Background(), nil, &schema.SQLQueryRequest{
+			Sql: fmt.Sprintf("SELECT title FROM table1 BEFORE TX %d", db.st.LastCommittedTxID()+1-uint64(2*i)),
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, string(value("title", 19-i)), rows[0].ValuesByPosition[0].RawValue())
+	}
+}
+
+func TestCompactValueLogRetainsPinnedValues(t *testing.T) {
+	options := DefaultOptions().WithDBRootPath(t.TempDir())
+	options.storeOpts.
+		WithFileSize(1024).
+		WithVLogGCOptions(store.DefaultVLogGCOptions().WithMinDiscardableRatio(0.1))
+
+	db := makeDbWith(t, "db", options)
+
+	value := func(i int) []byte {
+		return bytes.Repeat([]byte(fmt.Sprintf("key-%04d;", i)), 10)
+	}
+
+	var hdrs []*schema.TxHeader
+
+	for i := 0; i < 20; i++ {
+		hdr, err := db.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{Key: []byte("key"), Value: value(i)}},
+		})
+		require.NoError(t, err)
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	_, err := db.SetReference(context.Background(), &schema.ReferenceRequest{
+		Key:           []byte("ref"),
+		ReferencedKey: []byte("key"),
+		AtTx:          hdrs[0].Id,
+		BoundRef:      true,
+	})
+	require.NoError(t, err)
+
+	_, err = db.ZAdd(context.Background(), &schema.ZAddRequest{
+		Set:      []byte("set"),
+		Score:    1,
+		Key:      []byte("key"),
+		AtTx:     hdrs[1].Id,
+		BoundRef: true,
+	})
+	require.NoError(t, err)
+
+	err = db.WaitForIndexingUpto(context.Background(), db.st.LastCommittedTxID())
+	require.NoError(t, err)
+
+	report, err := db.CompactValueLog(context.Background())
+	require.NoError(t, err)
+	require.Positive(t, report.ReclaimedBytes)
+
+	entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte("ref")})
+	require.NoError(t, err)
+	require.Equal(t, value(0), entry.Value)
+
+	zentries, err := db.ZScan(context.Background(), &schema.ZScanRequest{Set: []byte("set")})
+	require.NoError(t, err)
+	require.Len(t, zentries.Entries, 1)
+	require.Equal(t, value(1), zentries.Entries[0].Entry.Value)
+
+	// versions which are not pinned are discarded
+	_, err = db.Get(context.Background(), &schema.KeyRequest{Key: []byte("key"), AtTx: hdrs[2].Id})
+	require.Error(t, err)
+}
+
+func TestCompactValueLogOnReplica(t *testing.T) {
+	options := DefaultOptions().WithDBRootPath(t.TempDir()).AsReplica(true)
+
+	db := makeDbWith(t, "db", options)
+
+	_, err := db.CompactValueLog(context.Background())
+	require.ErrorIs(t, err, ErrIsReplica)
+}
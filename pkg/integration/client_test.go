@@ -18,7 +18,9 @@ package integration
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -1468,6 +1470,687 @@ func TestImmuClient_VerifiedGetAt(t *testing.T) {
 	require.ErrorIs(t, err, store.ErrCorruptedData)
 }
 
+func TestImmuClient_VerifiedGetLatest(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.VerifiedGetLatest(ctx, []byte(`key1`))
+	require.ErrorContains(t, err, database.ErrNoKeyIndexCommitment.Error())
+
+	_, err = client.CommitKeyIndex(ctx)
+	require.NoError(t, err)
+
+	latest, err := client.VerifiedGetLatest(ctx, []byte(`key1`))
+	require.NoError(t, err)
+	require.Nil(t, latest.Entry)
+
+	_, err = client.Set(ctx, []byte(`key1`), []byte(`val1`))
+	require.NoError(t, err)
+
+	txHdr, err := client.Set(ctx, []byte(`key1`), []byte(`val2`))
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte(`key3`), []byte(`val1`))
+	require.NoError(t, err)
+
+	refHdr, err := client.SetReference(ctx, []byte(`ref1`), []byte(`key1`))
+	require.NoError(t, err)
+
+	commitmentHdr, err := client.CommitKeyIndex(ctx)
+	require.NoError(t, err)
+
+	latest, err = client.VerifiedGetLatest(ctx, []byte(`key1`))
+	require.NoError(t, err)
+	require.Equal(t, commitmentHdr.Id-1, latest.IndexedTx)
+	require.Equal(t, []byte(`key1`), latest.Entry.Key)
+	require.Equal(t, []byte(`val2`), latest.Entry.Value)
+	require.Equal(t, txHdr.Id, latest.Entry.Tx)
+
+	latest, err = client.VerifiedGetLatest(ctx, []byte(`ref1`))
+	require.NoError(t, err)
+	require.Equal(t, []byte(`key1`), latest.Entry.Key)
+	require.Equal(t, []byte(`val2`), latest.Entry.Value)
+	require.Equal(t, refHdr.Id, latest.Entry.ReferencedBy.Tx)
+
+	for _, key := range []string{`key0`, `key2`, `key4`} {
+		latest, err = client.VerifiedGetLatest(ctx, []byte(key))
+		require.NoError(t, err)
+		require.Nil(t, latest.Entry)
+	}
+
+	var staleProof *schema.VerifiableKeyIndexProof
+
+	bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+		req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+		staleProof = res
+		return res, err
+	}
+
+	_, err = client.VerifiedGetLatest(ctx, []byte(`key2`))
+	require.NoError(t, err)
+
+	bs.Server.PostKeyIndexProofFn = nil
+
+	setHdr, err := client.VerifiedSet(ctx, []byte(`key2`), []byte(`val1`))
+	require.NoError(t, err)
+
+	// the last commitment is older than the local state
+	latest, err = client.VerifiedGetLatest(ctx, []byte(`key2`))
+	require.NoError(t, err)
+	require.Equal(t, commitmentHdr.Id-1, latest.IndexedTx)
+	require.Nil(t, latest.Entry)
+
+	state, err := client.CurrentState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, setHdr.Id, state.TxId)
+
+	_, err = client.CommitKeyIndex(ctx)
+	require.NoError(t, err)
+
+	latest, err = client.VerifiedGetLatest(ctx, []byte(`key2`))
+	require.NoError(t, err)
+	require.Equal(t, setHdr.Id, latest.IndexedTx)
+	require.Equal(t, []byte(`val1`), latest.Entry.Value)
+
+	t.Run("an older commitment is proven against the local state", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			vtx, err := bs.Server.Srv.VerifiableTxById(ctx, &schema.VerifiableTxRequest{
+				Tx:           staleProof.CommitmentTx.Tx.Header.Id,
+				ProveSinceTx: req.ProveSinceTx,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			staleProof.CommitmentTx.DualProof = vtx.DualProof
+
+			return staleProof, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		latest, err := client.VerifiedGetLatest(ctx, []byte(`key2`))
+		require.NoError(t, err)
+		require.Equal(t, staleProof.IndexedTx, latest.IndexedTx)
+		require.Nil(t, latest.Entry)
+	})
+
+	t.Run("an older commitment not proven against the local state must be detected", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			vtx, err := bs.Server.Srv.VerifiableTxById(ctx, &schema.VerifiableTxRequest{
+				Tx:           staleProof.CommitmentTx.Tx.Header.Id,
+				ProveSinceTx: req.ProveSinceTx - 1,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			staleProof.CommitmentTx.DualProof = vtx.DualProof
+
+			return staleProof, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		_, err := client.VerifiedGetLatest(ctx, []byte(`key2`))
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("hiding an existing key must be detected", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Predecessor = res.Leaf
+			res.Leaf = nil
+			res.Entry = nil
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		_, err := client.VerifiedGetLatest(ctx, []byte(`key2`))
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("returning a stale value must be detected", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Entry.Value = []byte(`val1`)
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		_, err := client.VerifiedGetLatest(ctx, []byte(`key1`))
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("skipping keys in an absence proof must be detected", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			// key2 lays between key1 and key2a
+			key1Proof, err := bs.Server.Srv.KeyIndexProof(ctx, &schema.KeyIndexProofRequest{Key: []byte(`key1`)})
+			if err != nil {
+				return nil, err
+			}
+
+			res.Predecessor = key1Proof.Leaf
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		_, err := client.VerifiedGetLatest(ctx, []byte(`key2a`))
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("tampering the commitment must be detected", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.IndexedTx--
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		_, err := client.VerifiedGetLatest(ctx, []byte(`key1`))
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("tampering the commitment transaction header must be detected", func(t *testing.T) {
+		bs.Server.PostKeyIndexProofFn = func(ctx context.Context,
+			req *schema.KeyIndexProofRequest, res *schema.VerifiableKeyIndexProof, err error) (*schema.VerifiableKeyIndexProof, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.CommitmentTx.Tx.Header.Nentries++
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostKeyIndexProofFn = nil }()
+
+		_, err := client.VerifiedGetLatest(ctx, []byte(`key1`))
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
+func TestImmuClient_VerifiedScan(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	for _, key := range []string{`a`, `key1`, `key2`, `key3`, `z`} {
+		_, err := client.Set(ctx, []byte(key), []byte(`val_`+key))
+		require.NoError(t, err)
+	}
+
+	_, err := client.SetReference(ctx, []byte(`key4`), []byte(`key1`))
+	require.NoError(t, err)
+
+	commitmentHdr, err := client.CommitKeyIndex(ctx)
+	require.NoError(t, err)
+
+	_, err = client.VerifiedScan(ctx, &schema.ScanRequest{Offset: 1})
+	require.ErrorIs(t, err, ic.ErrIllegalArguments)
+
+	res, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+	require.NoError(t, err)
+	require.Equal(t, commitmentHdr.Id-1, res.IndexedTx)
+	require.Len(t, res.Entries, 4)
+	require.Equal(t, []byte(`key1`), res.Entries[0].Key)
+	require.Equal(t, []byte(`val_key1`), res.Entries[0].Value)
+	require.Equal(t, []byte(`key4`), res.Entries[3].ReferencedBy.Key)
+	require.Equal(t, []byte(`val_key1`), res.Entries[3].Value)
+
+	res, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`), Desc: true, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, []byte(`key3`), res.Entries[1].Key)
+
+	res, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`missing`)})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	var staleProof *schema.VerifiableEntries
+
+	bs.Server.PostVerifiableScanFn = func(ctx context.Context,
+		req *schema.VerifiableScanRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+		staleProof = res
+		return res, err
+	}
+
+	_, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+	require.NoError(t, err)
+
+	bs.Server.PostVerifiableScanFn = nil
+
+	setHdr, err := client.VerifiedSet(ctx, []byte(`key5`), []byte(`val_key5`))
+	require.NoError(t, err)
+
+	// the last commitment is older than the local state
+	res, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+	require.NoError(t, err)
+	require.Equal(t, commitmentHdr.Id-1, res.IndexedTx)
+	require.Len(t, res.Entries, 4)
+
+	_, err = client.CommitKeyIndex(ctx)
+	require.NoError(t, err)
+
+	res, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+	require.NoError(t, err)
+	require.Equal(t, setHdr.Id, res.IndexedTx)
+	require.Len(t, res.Entries, 5)
+
+	tamper := func(fn func(res *schema.VerifiableEntries)) {
+		bs.Server.PostVerifiableScanFn = func(ctx context.Context,
+			req *schema.VerifiableScanRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			fn(res)
+
+			return res, nil
+		}
+	}
+	defer func() { bs.Server.PostVerifiableScanFn = nil }()
+
+	t.Run("an older commitment is proven against the local state", func(t *testing.T) {
+		bs.Server.PostVerifiableScanFn = func(ctx context.Context,
+			req *schema.VerifiableScanRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			vtx, err := bs.Server.Srv.VerifiableTxById(ctx, &schema.VerifiableTxRequest{
+				Tx:           staleProof.CommitmentTx.Tx.Header.Id,
+				ProveSinceTx: req.ProveSinceTx,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			staleProof.CommitmentTx.DualProof = vtx.DualProof
+
+			return staleProof, nil
+		}
+
+		res, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.NoError(t, err)
+		require.Equal(t, staleProof.IndexedTx, res.IndexedTx)
+		require.Len(t, res.Entries, 4)
+	})
+
+	t.Run("an older commitment not proven against the local state must be detected", func(t *testing.T) {
+		bs.Server.PostVerifiableScanFn = func(ctx context.Context,
+			req *schema.VerifiableScanRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			vtx, err := bs.Server.Srv.VerifiableTxById(ctx, &schema.VerifiableTxRequest{
+				Tx:           staleProof.CommitmentTx.Tx.Header.Id,
+				ProveSinceTx: req.ProveSinceTx - 1,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			staleProof.CommitmentTx.DualProof = vtx.DualProof
+
+			return staleProof, nil
+		}
+
+		_, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("omitting an entry must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableEntries) {
+			res.Entries = append(res.Entries[:1], res.Entries[2:]...)
+		})
+
+		_, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("truncating the result must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableEntries) {
+			res.EndBoundary = res.Entries[len(res.Entries)-1].Leaf
+			res.Entries = res.Entries[:len(res.Entries)-1]
+		})
+
+		_, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+
+		tamper(func(res *schema.VerifiableEntries) {
+			res.EndBoundary = nil
+			res.Entries = res.Entries[:len(res.Entries)-1]
+		})
+
+		_, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("skipping the start of the range must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableEntries) {
+			res.StartBoundary = res.Entries[0].Leaf
+			res.Entries = res.Entries[1:]
+		})
+
+		_, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("returning a modified value must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableEntries) {
+			res.Entries[0].Entry.Value = []byte(`tampered`)
+		})
+
+		_, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte(`key`)})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
+func TestImmuClient_ValuePreconditions(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.Set(ctx, []byte("counter"), []byte("1"))
+	require.NoError(t, err)
+
+	// optimistic locking in a single round trip
+	_, err = client.SetAll(ctx, &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("counter"), Value: []byte("2")}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionValueMustMatch([]byte("counter"), []byte("1")),
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.SetAll(ctx, &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("counter"), Value: []byte("2")}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionValueMustMatch([]byte("counter"), []byte("1")),
+		},
+	})
+	require.ErrorContains(t, err, store.ErrPreconditionFailed.Error())
+
+	digest := sha256.Sum256([]byte("2"))
+
+	_, err = client.ExecAll(ctx, &schema.ExecAllRequest{
+		Operations: []*schema.Op{{
+			Operation: &schema.Op_Kv{Kv: &schema.KeyValue{Key: []byte("counter"), Value: []byte("3")}},
+		}},
+		Preconditions: []*schema.Precondition{
+			schema.PreconditionValueDigestMustMatch([]byte("counter"), digest[:]),
+			schema.PreconditionKeyMetadataMustMatch([]byte("counter"), nil),
+			schema.PreconditionKeyCountInRange([]byte("count"), 1, 1),
+		},
+	})
+	require.NoError(t, err)
+
+	entry, err := client.Get(ctx, []byte("counter"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), entry.Value)
+}
+
+func TestImmuClient_VerifiedTxEntries(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	var kvs []*schema.KeyValue
+	for i := 0; i < 10; i++ {
+		kvs = append(kvs, &schema.KeyValue{
+			Key:   []byte(fmt.Sprintf("key%d", i)),
+			Value: []byte(fmt.Sprintf("val%d", i)),
+		})
+	}
+
+	hdr, err := client.SetAll(ctx, &schema.SetRequest{KVs: kvs})
+	require.NoError(t, err)
+
+	_, err = client.VerifiedTxEntries(ctx, hdr.Id, nil)
+	require.ErrorIs(t, err, ic.ErrIllegalArguments)
+
+	tx, err := client.VerifiedTxEntries(ctx, hdr.Id, [][]byte{[]byte("key7"), []byte("key2"), []byte("key3")})
+	require.NoError(t, err)
+	require.Len(t, tx.Entries, 3)
+
+	for i, key := range []string{"key2", "key3", "key7"} {
+		require.Equal(t, []byte(key), tx.Entries[i].Key)
+	}
+
+	_, err = client.VerifiedTxEntries(ctx, hdr.Id, [][]byte{[]byte("missing")})
+	require.ErrorContains(t, err, store.ErrKeyNotFound.Error())
+
+	tamper := func(fn func(res *schema.VerifiableTx)) {
+		bs.Server.PostVerifiableTxByIdFn = func(ctx context.Context,
+			req *schema.VerifiableTxRequest, res *schema.VerifiableTx, err error) (*schema.VerifiableTx, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			fn(res)
+
+			return res, nil
+		}
+	}
+	defer func() { bs.Server.PostVerifiableTxByIdFn = nil }()
+
+	keys := [][]byte{[]byte("key1"), []byte("key5")}
+
+	t.Run("tampered value must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.Tx.Entries[0].Value = []byte("tampered")
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("entry of another key must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.Tx.Entries[0].Key = append([]byte{}, res.Tx.Entries[1].Key...)
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("omitting an entry must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.Tx.Entries = res.Tx.Entries[:1]
+			res.EntriesProof.Leaves = res.EntriesProof.Leaves[:1]
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("tampered proof must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.EntriesProof.Leaves[0]++
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("proof of a narrower tree must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.EntriesProof.Width--
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("missing proof must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.EntriesProof = nil
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("omitted value of an entry not marked as redacted must be detected", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.Tx.Entries[0].Value = nil
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("value of an entry marked as redacted must be omitted", func(t *testing.T) {
+		tamper(func(res *schema.VerifiableTx) {
+			res.Tx.Entries[0].Redacted = true
+		})
+
+		_, err := client.VerifiedTxEntries(ctx, hdr.Id, keys)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
+func TestImmuClient_SubscribeChanges(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.SubscribeChanges(ctx, nil)
+	require.ErrorIs(t, err, ic.ErrIllegalArguments)
+
+	hdr1, err := client.Set(ctx, []byte("user:1"), []byte("alice"))
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte("order:1"), []byte("order1"))
+	require.NoError(t, err)
+
+	hdr2, err := client.Set(ctx, []byte("user:2"), []byte("bob"))
+	require.NoError(t, err)
+
+	t.Run("streamed transactions should be verified", func(t *testing.T) {
+		reader, err := client.SubscribeChanges(ctx, &schema.SubscribeChangesRequest{
+			InitialTx:  1,
+			Prefix:     []byte("user:"),
+			WithProofs: true,
+		})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		for _, hdr := range []*schema.TxHeader{hdr1, hdr2} {
+			tx, err := reader.Next()
+			require.NoError(t, err)
+			require.Equal(t, hdr.Id, tx.Header.Id)
+			require.Len(t, tx.Entries, 1)
+		}
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, hdr2.Id, state.TxId)
+
+		hdr3, err := client.Set(ctx, []byte("user:3"), []byte("carol"))
+		require.NoError(t, err)
+
+		tx, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, hdr3.Id, tx.Header.Id)
+		require.Equal(t, database.EncodeKey([]byte("user:3")), tx.Entries[0].Key)
+
+		err = reader.Close()
+		require.NoError(t, err)
+
+		_, err = reader.Next()
+		require.Error(t, err)
+	})
+
+	t.Run("transactions older than the local state should be verified", func(t *testing.T) {
+		reader, err := client.SubscribeChanges(ctx, &schema.SubscribeChangesRequest{
+			InitialTx:  1,
+			Prefix:     []byte("order:"),
+			WithProofs: true,
+		})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		tx, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, hdr1.Id+1, tx.Header.Id)
+	})
+
+	t.Run("subscriptions bypassing row policies should be restricted to admins", func(t *testing.T) {
+		_, err := client.SQLExec(ctx, `
+			CREATE TABLE payments (id INTEGER AUTO_INCREMENT, owner VARCHAR[64], amount INTEGER, PRIMARY KEY id);
+			CREATE POLICY owner_only ON payments FOR SELECT USING (owner = CURRENT_USER);
+			INSERT INTO payments (owner, amount) VALUES ('immudb', 100), ('reader', 10);
+		`, nil)
+		require.NoError(t, err)
+
+		err = client.CreateUser(ctx, []byte("reader"), []byte("reader1Password!"), auth.PermissionR, "defaultdb")
+		require.NoError(t, err)
+
+		readerClient := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+		err = readerClient.OpenSession(ctx, []byte("reader"), []byte("reader1Password!"), "defaultdb")
+		require.NoError(t, err)
+		defer readerClient.CloseSession(context.Background())
+
+		res, err := readerClient.SQLQuery(ctx, "SELECT amount FROM payments", nil, false)
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		for _, req := range []*schema.SubscribeChangesRequest{
+			{InitialTx: 1, Table: "payments"},
+			{InitialTx: 1},
+		} {
+			reader, err := readerClient.SubscribeChanges(ctx, req)
+			if err == nil {
+				_, err = reader.Next()
+				reader.Close()
+			}
+			require.ErrorContains(t, err, "permission denied")
+		}
+
+		reader, err := readerClient.SubscribeChanges(ctx, &schema.SubscribeChangesRequest{
+			InitialTx: 1,
+			Prefix:    []byte("user:"),
+		})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		tx, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, hdr1.Id, tx.Header.Id)
+
+		adminReader, err := client.SubscribeChanges(ctx, &schema.SubscribeChangesRequest{
+			InitialTx: 1,
+			Table:     "payments",
+		})
+		require.NoError(t, err)
+		defer adminReader.Close()
+
+		tx, err = adminReader.Next()
+		require.NoError(t, err)
+		require.Len(t, tx.Entries, 2)
+	})
+}
+
 func TestImmuClient_VerifiedGetSince(t *testing.T) {
 	_, client, ctx := setupTestServerAndClient(t)
 
@@ -1681,3 +2364,65 @@ func TestServerLogRequestMetadata(t *testing.T) {
 
 	requireMetadataPresent(tx.Header)
 }
+
+func TestImmuClient_MergeOperations(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.Increment(ctx, []byte("counter"), 5)
+	require.NoError(t, err)
+
+	_, err = client.Increment(ctx, []byte("counter"), -2)
+	require.NoError(t, err)
+
+	entry, err := client.Get(ctx, []byte("counter"))
+	require.NoError(t, err)
+
+	n, err := store.DecodeInt64(entry.Value)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+
+	_, err = client.Append(ctx, []byte("log"), []byte("a"))
+	require.NoError(t, err)
+
+	_, err = client.Append(ctx, []byte("log"), []byte("b"))
+	require.NoError(t, err)
+
+	entry, err = client.VerifiedGet(ctx, []byte("log"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("ab"), entry.Value)
+
+	_, err = client.Increment(ctx, []byte("log"), 1)
+	require.ErrorContains(t, err, store.ErrInvalidMergeValue.Error())
+}
+
+func TestImmuClient_RedactEntries(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	hdr, err := client.SetAll(ctx, &schema.SetRequest{
+		KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte("personal-data")},
+			{Key: []byte("key2"), Value: []byte("public-data")},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.RedactEntries(ctx, hdr.Id, [][]byte{[]byte("key1")})
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, []byte("key1"))
+	require.ErrorContains(t, err, store.ErrValueRedacted.Error())
+
+	entry, err := client.VerifiedGet(ctx, []byte("key2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("public-data"), entry.Value)
+
+	// verified entries are returned along with the error, values of redacted ones are omitted
+	tx, err := client.VerifiedTxEntries(ctx, hdr.Id, [][]byte{[]byte("key1"), []byte("key2")})
+	require.ErrorIs(t, err, ic.ErrValueRedacted)
+	require.Len(t, tx.Entries, 2)
+	require.Nil(t, tx.Entries[0].Value)
+	require.True(t, tx.Entries[0].Redacted)
+	require.False(t, tx.Entries[1].Redacted)
+	// entries are returned in a raw form i.e. values are prefixed
+	require.Equal(t, []byte("public-data"), tx.Entries[1].Value[1:])
+}
@@ -78,6 +78,8 @@ func TestCreateDatabaseV2(t *testing.T) {
 		CommitLogMaxOpenedFiles: &schema.NullableUint32{Value: 2},
 		SyncFrequency:           &schema.NullableMilliseconds{Value: 15},
 		WriteBufferSize:         &schema.NullableUint32{Value: 4000},
+		CompressionFormat:       &schema.NullableString{Value: "lz4"},
+		CompressionLevel:        &schema.NullableUint32{Value: 1},
 		IndexSettings: &schema.IndexNullableSettings{
 			FlushThreshold:           &schema.NullableUint32{Value: 256},
 			SyncThreshold:            &schema.NullableUint32{Value: 512},
@@ -93,11 +95,21 @@ func TestCreateDatabaseV2(t *testing.T) {
 			CommitLogMaxOpenedFiles:  &schema.NullableUint32{Value: 3},
 			MaxBulkSize:              &schema.NullableUint32{Value: 35},
 			BulkPreparationTimeout:   &schema.NullableMilliseconds{Value: 150},
+
+			IncrementalCompaction:         &schema.NullableBool{Value: true},
+			IncrementalCompactionStepSize: &schema.NullableUint32{Value: 1 << 20},
+			IncrementalCompactionDelay:    &schema.NullableMilliseconds{Value: 50},
 		},
 		AhtSettings: &schema.AHTNullableSettings{
 			SyncThreshold:   &schema.NullableUint32{Value: 10_000},
 			WriteBufferSize: &schema.NullableUint32{Value: 8000},
 		},
+		VLogGCSettings: &schema.VLogGCNullableSettings{
+			RetainedVersions:    &schema.NullableUint32{Value: 3},
+			RetentionPeriod:     &schema.NullableMilliseconds{Value: 2 * time.Hour.Milliseconds()},
+			MinDiscardableRatio: &schema.NullableFloat{Value: 0.25},
+			Frequency:           &schema.NullableMilliseconds{Value: 1 * time.Hour.Milliseconds()},
+		},
 		TruncationSettings: &schema.TruncationNullableSettings{
 			RetentionPeriod:     &schema.NullableMilliseconds{Value: 24 * time.Hour.Milliseconds()},
 			TruncationFrequency: &schema.NullableMilliseconds{Value: 1 * time.Hour.Milliseconds()},
@@ -130,6 +142,8 @@ func TestCreateDatabaseV2(t *testing.T) {
 	require.Equal(t, dbNullableSettings.CommitLogMaxOpenedFiles.Value, res.Settings.CommitLogMaxOpenedFiles.Value)
 	require.Equal(t, dbNullableSettings.SyncFrequency.Value, res.Settings.SyncFrequency.Value)
 	require.Equal(t, dbNullableSettings.WriteBufferSize.Value, res.Settings.WriteBufferSize.Value)
+	require.Equal(t, dbNullableSettings.CompressionFormat.Value, res.Settings.CompressionFormat.Value)
+	require.Equal(t, dbNullableSettings.CompressionLevel.Value, res.Settings.CompressionLevel.Value)
 
 	require.Equal(t, dbNullableSettings.IndexSettings.FlushThreshold.Value, res.Settings.IndexSettings.FlushThreshold.Value)
 	require.Equal(t, dbNullableSettings.IndexSettings.SyncThreshold.Value, res.Settings.IndexSettings.SyncThreshold.Value)
@@ -145,10 +159,18 @@ func TestCreateDatabaseV2(t *testing.T) {
 	require.Equal(t, dbNullableSettings.IndexSettings.CommitLogMaxOpenedFiles.Value, res.Settings.IndexSettings.CommitLogMaxOpenedFiles.Value)
 	require.Equal(t, dbNullableSettings.IndexSettings.MaxBulkSize.Value, res.Settings.IndexSettings.MaxBulkSize.Value)
 	require.Equal(t, dbNullableSettings.IndexSettings.BulkPreparationTimeout.Value, res.Settings.IndexSettings.BulkPreparationTimeout.Value)
+	require.Equal(t, dbNullableSettings.IndexSettings.IncrementalCompaction.Value, res.Settings.IndexSettings.IncrementalCompaction.Value)
+	require.Equal(t, dbNullableSettings.IndexSettings.IncrementalCompactionStepSize.Value, res.Settings.IndexSettings.IncrementalCompactionStepSize.Value)
+	require.Equal(t, dbNullableSettings.IndexSettings.IncrementalCompactionDelay.Value, res.Settings.IndexSettings.IncrementalCompactionDelay.Value)
 
 	require.Equal(t, dbNullableSettings.AhtSettings.SyncThreshold.Value, res.Settings.AhtSettings.SyncThreshold.Value)
 	require.Equal(t, dbNullableSettings.AhtSettings.WriteBufferSize.Value, res.Settings.AhtSettings.WriteBufferSize.Value)
 
+	require.Equal(t, dbNullableSettings.VLogGCSettings.RetainedVersions.Value, res.Settings.VLogGCSettings.RetainedVersions.Value)
+	require.Equal(t, dbNullableSettings.VLogGCSettings.RetentionPeriod.Value, res.Settings.VLogGCSettings.RetentionPeriod.Value)
+	require.Equal(t, dbNullableSettings.VLogGCSettings.MinDiscardableRatio.Value, res.Settings.VLogGCSettings.MinDiscardableRatio.Value)
+	require.Equal(t, dbNullableSettings.VLogGCSettings.Frequency.Value, res.Settings.VLogGCSettings.Frequency.Value)
+
 	require.Equal(t, dbNullableSettings.TruncationSettings.RetentionPeriod.Value, res.Settings.TruncationSettings.RetentionPeriod.Value)
 	require.Equal(t, dbNullableSettings.TruncationSettings.TruncationFrequency.Value, res.Settings.TruncationSettings.TruncationFrequency.Value)
 
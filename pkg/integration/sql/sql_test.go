@@ -459,6 +459,58 @@ func TestImmuClient_SQL_UserStmts(t *testing.T) {
 	require.ErrorContains(t, err, "not connected")
 }
 
+func TestImmuClient_SQL_RowPolicies(t *testing.T) {
+	options := server.DefaultOptions().WithDir(t.TempDir())
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	client, err := bs.NewAuthenticatedClient(ic.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	_, err = client.SQLExec(context.Background(), `
+		CREATE TABLE payments (id INTEGER AUTO_INCREMENT, owner VARCHAR[64], amount INTEGER, PRIMARY KEY id);
+		CREATE POLICY owner_only ON payments FOR SELECT USING (owner = CURRENT_USER);
+		INSERT INTO payments (owner, amount) VALUES ('immudb', 100), ('user1', 10);
+	`, nil)
+	require.NoError(t, err)
+
+	_, err = client.SQLExec(context.Background(), "CREATE USER user1 WITH PASSWORD 'user1Password!' READ", nil)
+	require.NoError(t, err)
+
+	user1Client := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+	err = user1Client.OpenSession(context.Background(), []byte("user1"), []byte("user1Password!"), "defaultdb")
+	require.NoError(t, err)
+	defer user1Client.CloseSession(context.Background())
+
+	res, err := user1Client.SQLQuery(context.Background(), "SELECT owner, amount, CURRENT_USER FROM payments", nil, true)
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+	require.Equal(t, "user1", res.Rows[0].Values[0].GetS())
+	require.Equal(t, int64(10), res.Rows[0].Values[1].GetN())
+	require.Equal(t, "user1", res.Rows[0].Values[2].GetS())
+
+	tx, err := user1Client.NewTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.SQLExec(context.Background(), "SELECT 1", nil)
+	require.NoError(t, err)
+
+	res, err = tx.SQLQuery(context.Background(), "SELECT amount FROM payments", nil)
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+
+	err = tx.Rollback(context.Background())
+	require.NoError(t, err)
+
+	res, err = client.SQLQuery(context.Background(), "SELECT amount FROM payments", nil, true)
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 2)
+}
+
 func TestImmuClient_SQL_Errors(t *testing.T) {
 	options := server.DefaultOptions().WithDir(t.TempDir())
 	bs := servertest.NewBufconnServer(options)
@@ -806,6 +806,9 @@ type ServiceFactoryMock struct {
 	NewVEntryStreamReceiverF func(str stream.MsgReceiver) stream.VEntryStreamReceiver
 	NewVEntryStreamSenderF   func(str stream.MsgSender) stream.VEntryStreamSender
 
+	NewVScanStreamReceiverF func(str stream.MsgReceiver) stream.VScanStreamReceiver
+	NewVScanStreamSenderF   func(str stream.MsgSender) stream.VScanStreamSender
+
 	NewZStreamReceiverF func(str stream.MsgReceiver) stream.ZStreamReceiver
 	NewZStreamSenderF   func(str stream.MsgSender) stream.ZStreamSender
 
@@ -837,6 +840,14 @@ func (sfm *ServiceFactoryMock) NewVEntryStreamSender(str stream.MsgSender) strea
 	return sfm.NewVEntryStreamSenderF(str)
 }
 
+func (sfm *ServiceFactoryMock) NewVScanStreamReceiver(str stream.MsgReceiver) stream.VScanStreamReceiver {
+	return sfm.NewVScanStreamReceiverF(str)
+}
+
+func (sfm *ServiceFactoryMock) NewVScanStreamSender(str stream.MsgSender) stream.VScanStreamSender {
+	return sfm.NewVScanStreamSenderF(str)
+}
+
 func (sfm *ServiceFactoryMock) NewZStreamReceiver(str stream.MsgReceiver) stream.ZStreamReceiver {
 	return sfm.NewZStreamReceiverF(str)
 }
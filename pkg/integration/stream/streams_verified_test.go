@@ -17,6 +17,8 @@ limitations under the License.
 package integration
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"fmt"
@@ -25,6 +27,7 @@ import (
 	"testing"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/stream"
 	"github.com/codenotary/immudb/pkg/stream/streamtest"
 	"github.com/codenotary/immudb/pkg/streamutils"
 	"github.com/stretchr/testify/require"
@@ -90,3 +93,40 @@ func TestImmuClient_StreamVerifiedSetAndGet(t *testing.T) {
 	newSha1 := sha256.Sum256(entry.Value)
 	require.Equal(t, hashes[len(hashes)-1], newSha1[:])
 }
+
+func TestImmuClient_StreamVerifiedScan(t *testing.T) {
+	_, client := setupTest(t)
+
+	kvs := []*stream.KeyValue{}
+
+	for i := 1; i <= 3; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := make([]byte, 1<<(10+i))
+		value[0] = byte(i)
+
+		kvs = append(kvs, &stream.KeyValue{
+			Key:   &stream.ValueSize{Content: bufio.NewReader(bytes.NewBuffer(key)), Size: len(key)},
+			Value: &stream.ValueSize{Content: bufio.NewReader(bytes.NewBuffer(value)), Size: len(value)},
+		})
+	}
+
+	_, err := client.StreamVerifiedSet(context.Background(), kvs)
+	require.NoError(t, err)
+
+	_, err = client.CommitKeyIndex(context.Background())
+	require.NoError(t, err)
+
+	res, err := client.StreamVerifiedScan(context.Background(), &schema.ScanRequest{Prefix: []byte("key")})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+
+	for i, e := range res.Entries {
+		require.Equal(t, []byte(fmt.Sprintf("key%d", i+1)), e.Key)
+		require.Len(t, e.Value, 1<<(11+i))
+		require.Equal(t, byte(i+1), e.Value[0])
+	}
+
+	res, err = client.StreamVerifiedScan(context.Background(), &schema.ScanRequest{Prefix: []byte("missing")})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+}
@@ -386,3 +386,47 @@ func TestTransaction_HandlingReadConflict(t *testing.T) {
 	err = client.CloseSession(ctx)
 	require.NoError(t, err)
 }
+
+func TestTransaction_Cursors(t *testing.T) {
+	_, client := setupTest(t, 2)
+
+	_, err := client.SQLExec(context.Background(), "CREATE TABLE table1(id INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		_, err := client.SQLExec(context.Background(), "INSERT INTO table1(id) VALUES (@id)", map[string]interface{}{"id": i})
+		require.NoError(t, err)
+	}
+
+	tx, err := client.NewTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.SQLExec(context.Background(), "DECLARE c CURSOR FOR SELECT id FROM table1 ORDER BY id", nil)
+	require.NoError(t, err)
+
+	// results larger than the max result size are paged through the cursor
+	var ids []int64
+
+	for {
+		res, err := tx.SQLQuery(context.Background(), "FETCH 2 FROM c", nil)
+		require.NoError(t, err)
+
+		if len(res.Rows) == 0 {
+			break
+		}
+
+		for _, row := range res.Rows {
+			ids = append(ids, row.Values[0].GetN())
+		}
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+
+	err = tx.SQLExec(context.Background(), "CLOSE c", nil)
+	require.NoError(t, err)
+
+	_, err = tx.SQLQuery(context.Background(), "FETCH c", nil)
+	require.ErrorContains(t, err, "cursor does not exist")
+
+	err = tx.Rollback(context.Background())
+	require.NoError(t, err)
+}
@@ -30,6 +30,8 @@ var ErrUsernameNotprovided = errors.New("user name not provided")
 var ErrPwNotprovided = errors.New("password not provided")
 var ErrDBNotExists = errors.New("selected db doesn't exists")
 var ErrInvalidUsernameOrPassword = errors.New("invalid user name or password")
+var ErrAuthMethodNotAvailable = errors.New("no allowed authentication method is available for the user")
+var ErrAuthFailed = errors.New("authentication failed")
 var ErrExpectedQueryMessage = errors.New("expected query message")
 var ErrUseDBStatementNotSupported = errors.New("SQL statement not supported")
 var ErrSSLNotSupported = errors.New("SSL not supported")
@@ -40,6 +42,16 @@ var ErrParametersValueSizeTooLarge = errors.New("provided parameters exceeded th
 var ErrNegativeParameterValueLen = errors.New("negative parameter length detected")
 var ErrMalformedMessage = errors.New("malformed message detected")
 var ErrMessageTooLarge = errors.New("payload message hit allowed memory boundaries")
+var ErrInvalidCopyStmt = errors.New("invalid COPY statement")
+var ErrInvalidCopyData = errors.New("invalid COPY data")
+var ErrCopyFailed = errors.New("COPY from stdin failed")
+var ErrUnrecognizedParameter = errors.New("unrecognized configuration parameter")
+var ErrInvalidParameterValue = errors.New("invalid value for parameter")
+var ErrParameterCannotBeChanged = errors.New("parameter cannot be changed")
+var ErrSetStmtNotSupported = errors.New("SET statement not supported")
+var ErrStatementTimeout = errors.New("canceling statement due to statement timeout")
+var ErrReadOnlyTransaction = errors.New("cannot execute statement in a read-only transaction")
+var ErrInvalidNotification = errors.New("invalid notification")
 
 func MapPgError(err error) (er bm.ErrorResp) {
 	switch {
@@ -55,6 +67,22 @@ func MapPgError(err error) (er bm.ErrorResp) {
 			bm.Message(ErrDBNotExists.Error()),
 			bm.Hint("please provide a valid database name or use immuclient to create a new one"),
 		)
+	case errors.Is(err, ErrInvalidUsernameOrPassword):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityFaral),
+			bm.Code(pgmeta.PgServerErrInvalidPassword),
+			bm.Message(ErrInvalidUsernameOrPassword.Error()),
+		)
+	case errors.Is(err, ErrAuthMethodNotAvailable):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityFaral),
+			bm.Code(pgmeta.PgServerErrInvalidAuthorizationSpecification),
+			bm.Message(ErrAuthMethodNotAvailable.Error()),
+			bm.Hint("change the password of the user or enable the cleartext password authentication method"),
+		)
+	case errors.Is(err, ErrAuthFailed):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityFaral),
+			bm.Code(pgmeta.PgServerErrInvalidAuthorizationSpecification),
+			bm.Message(err.Error()),
+		)
 	case strings.Contains(err.Error(), "syntax error"):
 		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
 			bm.Code(pgmeta.PgServerErrSyntaxError),
@@ -93,6 +121,56 @@ func MapPgError(err error) (er bm.ErrorResp) {
 			bm.Code(pgmeta.PgServerErrProtocolViolation),
 			bm.Message(err.Error()),
 		)
+	case errors.Is(err, ErrInvalidCopyStmt):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrSyntaxError),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrInvalidCopyData):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrBadCopyFileFormat),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrCopyFailed):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrQueryCanceled),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrUnrecognizedParameter):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrUndefinedObject),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrInvalidParameterValue):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrInvalidParameterValue),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrParameterCannotBeChanged):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrCantChangeRuntimeParam),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrSetStmtNotSupported):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrFeatureNotSupported),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrStatementTimeout):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrQueryCanceled),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrReadOnlyTransaction):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrReadOnlySqlTransaction),
+			bm.Message(err.Error()),
+		)
+	case errors.Is(err, ErrInvalidNotification):
+		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
+			bm.Code(pgmeta.PgServerErrInvalidParameterValue),
+			bm.Message(err.Error()),
+		)
 	default:
 		er = bm.ErrorResponse(bm.Severity(pgmeta.PgSeverityError),
 			bm.Message(err.Error()),
@@ -39,4 +39,43 @@ func TestMapPgError(t *testing.T) {
 	err = ErrMalformedMessage
 	be = MapPgError(err)
 	require.NotNil(t, be)
+	err = ErrInvalidUsernameOrPassword
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrAuthMethodNotAvailable
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrAuthFailed
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrInvalidCopyStmt
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrInvalidCopyData
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrCopyFailed
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrUnrecognizedParameter
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrInvalidParameterValue
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrParameterCannotBeChanged
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrSetStmtNotSupported
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrStatementTimeout
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrReadOnlyTransaction
+	be = MapPgError(err)
+	require.NotNil(t, be)
+	err = ErrInvalidNotification
+	be = MapPgError(err)
+	require.NotNil(t, be)
 }
@@ -0,0 +1,436 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pgschema
+
+import (
+	"context"
+
+	"github.com/codenotary/immudb/embedded/sql"
+)
+
+var informationSchemaTablesCols = []sql.ColDescriptor{
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_type",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "self_referencing_column_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "reference_generation",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "user_defined_type_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "user_defined_type_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "user_defined_type_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_insertable_into",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_typed",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "commit_action",
+		Type:   sql.VarcharType,
+	},
+}
+
+type informationSchemaTablesResolver struct{}
+
+func (r *informationSchemaTablesResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	tables := tx.Catalog().GetTables()
+
+	rows := make([][]sql.ValueExp, len(tables))
+	for i, t := range tables {
+		rows[i] = []sql.ValueExp{
+			sql.NewNull(sql.VarcharType),     // table_catalog
+			sql.NewVarchar(publicSchemaName), // table_schema
+			sql.NewVarchar(t.Name()),         // table_name
+			sql.NewVarchar("BASE TABLE"),     // table_type
+			sql.NewNull(sql.VarcharType),     // self_referencing_column_name
+			sql.NewNull(sql.VarcharType),     // reference_generation
+			sql.NewNull(sql.VarcharType),     // user_defined_type_catalog
+			sql.NewNull(sql.VarcharType),     // user_defined_type_schema
+			sql.NewNull(sql.VarcharType),     // user_defined_type_name
+			sql.NewVarchar(yesOrNo(true)),    // is_insertable_into
+			sql.NewVarchar(yesOrNo(false)),   // is_typed
+			sql.NewNull(sql.VarcharType),     // commit_action
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		informationSchemaTablesCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *informationSchemaTablesResolver) Table() string {
+	return informationSchemaSchemaName + ".tables"
+}
+
+var informationSchemaColumnsCols = []sql.ColDescriptor{
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "column_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "ordinal_position",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "column_default",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_nullable",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "data_type",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "character_maximum_length",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "character_octet_length",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "numeric_precision",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "numeric_precision_radix",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "numeric_scale",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "datetime_precision",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "udt_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "udt_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "udt_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_identity",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "identity_generation",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_generated",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_updatable",
+		Type:   sql.VarcharType,
+	},
+}
+
+type informationSchemaColumnsResolver struct{}
+
+func (r *informationSchemaColumnsResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for i, col := range t.Cols() {
+			typ := pgTypeOf(col)
+
+			maxLen := sql.ValueExp(sql.NewNull(sql.IntegerType))
+			if typ == pgTypeVarchar {
+				maxLen = sql.NewInteger(int64(col.MaxLen()))
+			}
+
+			numericPrecision := sql.ValueExp(sql.NewNull(sql.IntegerType))
+			numericRadix := sql.ValueExp(sql.NewNull(sql.IntegerType))
+			numericScale := sql.ValueExp(sql.NewNull(sql.IntegerType))
+
+			switch col.Type() {
+			case sql.IntegerType:
+				numericPrecision = sql.NewInteger(64)
+				numericRadix = sql.NewInteger(2)
+				numericScale = sql.NewInteger(0)
+			case sql.Float64Type:
+				numericPrecision = sql.NewInteger(53)
+				numericRadix = sql.NewInteger(2)
+			}
+
+			datetimePrecision := sql.ValueExp(sql.NewNull(sql.IntegerType))
+			if col.Type() == sql.TimestampType {
+				datetimePrecision = sql.NewInteger(6)
+			}
+
+			columnDefault := sql.ValueExp(sql.NewNull(sql.VarcharType))
+			if col.DefaultValue() != "" {
+				columnDefault = sql.NewVarchar(col.DefaultValue())
+			}
+
+			identityGeneration := sql.ValueExp(sql.NewNull(sql.VarcharType))
+			if col.IsAutoIncremental() {
+				identityGeneration = sql.NewVarchar("BY DEFAULT")
+			}
+
+			rows = append(rows, []sql.ValueExp{
+				sql.NewNull(sql.VarcharType),                     // table_catalog
+				sql.NewVarchar(publicSchemaName),                 // table_schema
+				sql.NewVarchar(t.Name()),                         // table_name
+				sql.NewVarchar(col.Name()),                       // column_name
+				sql.NewInteger(int64(i + 1)),                     // ordinal_position
+				columnDefault,                                    // column_default
+				sql.NewVarchar(yesOrNo(isNullable(t, col))),      // is_nullable
+				sql.NewVarchar(typ.sqlName),                      // data_type
+				maxLen,                                           // character_maximum_length
+				maxLen,                                           // character_octet_length
+				numericPrecision,                                 // numeric_precision
+				numericRadix,                                     // numeric_precision_radix
+				numericScale,                                     // numeric_scale
+				datetimePrecision,                                // datetime_precision
+				sql.NewNull(sql.VarcharType),                     // udt_catalog
+				sql.NewVarchar(pgCatalogSchemaName),              // udt_schema
+				sql.NewVarchar(typ.name),                         // udt_name
+				sql.NewVarchar(yesOrNo(col.IsAutoIncremental())), // is_identity
+				identityGeneration,                               // identity_generation
+				sql.NewVarchar("NEVER"),                          // is_generated
+				sql.NewVarchar(yesOrNo(true)),                    // is_updatable
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		informationSchemaColumnsCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *informationSchemaColumnsResolver) Table() string {
+	return informationSchemaSchemaName + ".columns"
+}
+
+var informationSchemaTableConstraintsCols = []sql.ColDescriptor{
+	{
+		Column: "constraint_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_type",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "is_deferrable",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "initially_deferred",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "enforced",
+		Type:   sql.VarcharType,
+	},
+}
+
+type informationSchemaTableConstraintsResolver struct{}
+
+func (r *informationSchemaTableConstraintsResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for _, idx := range constraintIndexes(t) {
+			rows = append(rows, []sql.ValueExp{
+				sql.NewNull(sql.VarcharType),        // constraint_catalog
+				sql.NewVarchar(publicSchemaName),    // constraint_schema
+				sql.NewVarchar(constraintName(idx)), // constraint_name
+				sql.NewNull(sql.VarcharType),        // table_catalog
+				sql.NewVarchar(publicSchemaName),    // table_schema
+				sql.NewVarchar(t.Name()),            // table_name
+				sql.NewVarchar(constraintType(idx)), // constraint_type
+				sql.NewVarchar(yesOrNo(false)),      // is_deferrable
+				sql.NewVarchar(yesOrNo(false)),      // initially_deferred
+				sql.NewVarchar(yesOrNo(true)),       // enforced
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		informationSchemaTableConstraintsCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *informationSchemaTableConstraintsResolver) Table() string {
+	return informationSchemaSchemaName + ".table_constraints"
+}
+
+var informationSchemaKeyColumnUsageCols = []sql.ColDescriptor{
+	{
+		Column: "constraint_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "constraint_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_catalog",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_schema",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "table_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "column_name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "ordinal_position",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "position_in_unique_constraint",
+		Type:   sql.IntegerType,
+	},
+}
+
+type informationSchemaKeyColumnUsageResolver struct{}
+
+func (r *informationSchemaKeyColumnUsageResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for _, idx := range constraintIndexes(t) {
+			for i, col := range idx.Cols() {
+				rows = append(rows, []sql.ValueExp{
+					sql.NewNull(sql.VarcharType),        // constraint_catalog
+					sql.NewVarchar(publicSchemaName),    // constraint_schema
+					sql.NewVarchar(constraintName(idx)), // constraint_name
+					sql.NewNull(sql.VarcharType),        // table_catalog
+					sql.NewVarchar(publicSchemaName),    // table_schema
+					sql.NewVarchar(t.Name()),            // table_name
+					sql.NewVarchar(col.Name()),          // column_name
+					sql.NewInteger(int64(i + 1)),        // ordinal_position
+					sql.NewNull(sql.IntegerType),        // position_in_unique_constraint
+				})
+			}
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		informationSchemaKeyColumnUsageCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *informationSchemaKeyColumnUsageResolver) Table() string {
+	return informationSchemaSchemaName + ".key_column_usage"
+}
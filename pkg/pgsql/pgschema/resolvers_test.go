@@ -81,12 +81,270 @@ func TestQueryPgCatalogTables(t *testing.T) {
 	name, _ := row.ValuesBySelector[sql.EncodeSelector("", "c", "name")].RawValue().(string)
 	owner, _ := row.ValuesBySelector[sql.EncodeSelector("", "c", "owner")].RawValue().(string)
 	relType, _ := row.ValuesBySelector[sql.EncodeSelector("", "c", "type")].RawValue().(string)
-	schema := row.ValuesBySelector[sql.EncodeSelector("", "n", "schema")].RawValue()
+	schema, _ := row.ValuesBySelector[sql.EncodeSelector("", "n", "schema")].RawValue().(string)
 
 	require.Equal(t, "table1", name)
 	require.Equal(t, "immudb", owner)
 	require.Equal(t, "table", relType)
-	require.Nil(t, schema)
+	require.Equal(t, "public", schema)
+
+	_, err = res.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
+}
+
+func TestQueryPgAttributeTable(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	_, _, err := engine.Exec(context.Background(),
+		nil,
+		`CREATE TABLE table1 (id INTEGER AUTO_INCREMENT, name VARCHAR[64] NOT NULL, PRIMARY KEY id)`,
+		nil)
+	require.NoError(t, err)
+
+	rows, err := engine.Query(
+		context.Background(),
+		nil,
+		`SELECT a.attname, t.typname, a.atttypmod, a.attnotnull
+		FROM pg_attribute a
+			INNER JOIN pg_class c ON c.oid = a.attrelid
+			INNER JOIN pg_type t ON t.oid = a.atttypid
+		WHERE c.relname = 'table1' AND a.attnum > 0
+		ORDER BY a.attnum`,
+		nil,
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	expected := []struct {
+		name    string
+		typName string
+		typMod  int64
+		notNull bool
+	}{
+		{name: "id", typName: "int8", typMod: -1, notNull: true},
+		{name: "name", typName: "varchar", typMod: 68, notNull: true},
+	}
+
+	for _, e := range expected {
+		row, err := rows.Read(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, e.name, row.ValuesByPosition[0].RawValue())
+		require.Equal(t, e.typName, row.ValuesByPosition[1].RawValue())
+		require.Equal(t, e.typMod, row.ValuesByPosition[2].RawValue())
+		require.Equal(t, e.notNull, row.ValuesByPosition[3].RawValue())
+	}
+
+	_, err = rows.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
+}
+
+func TestQueryPgIndexTable(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	_, _, err := engine.Exec(context.Background(),
+		nil,
+		`CREATE TABLE table1 (id INTEGER, email VARCHAR[64], PRIMARY KEY id);
+		CREATE UNIQUE INDEX ON table1(email);`,
+		nil)
+	require.NoError(t, err)
+
+	rows, err := engine.Query(
+		context.Background(),
+		nil,
+		`SELECT c2.relname, i.indisprimary, i.indisunique, i.indkey
+		FROM pg_class c
+			INNER JOIN pg_index i ON c.oid = i.indrelid
+			INNER JOIN pg_class c2 ON i.indexrelid = c2.oid
+		WHERE c.relname = 'table1'
+		ORDER BY i.indisprimary DESC`,
+		nil,
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	row, err := rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "table1(id)", row.ValuesByPosition[0].RawValue())
+	require.Equal(t, true, row.ValuesByPosition[1].RawValue())
+	require.Equal(t, true, row.ValuesByPosition[2].RawValue())
+	require.Equal(t, "1", row.ValuesByPosition[3].RawValue())
+
+	row, err = rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "table1(email)", row.ValuesByPosition[0].RawValue())
+	require.Equal(t, false, row.ValuesByPosition[1].RawValue())
+	require.Equal(t, true, row.ValuesByPosition[2].RawValue())
+	require.Equal(t, "2", row.ValuesByPosition[3].RawValue())
+
+	_, err = rows.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
+}
+
+func TestQueryPgDatabaseTable(t *testing.T) {
+	engine := setupEngine(t, &mockMultiDBHandler{
+		users: []sql.User{
+			&user{username: "immudb", perm: sql.PermissionSysAdmin},
+		},
+		dbs: []string{"defaultdb", "db1"},
+	})
+
+	rows, err := engine.Query(context.Background(), nil, "SELECT datname FROM pg_database", nil)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	for _, db := range []string{"defaultdb", "db1"} {
+		row, err := rows.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, db, row.ValuesByPosition[0].RawValue())
+	}
+
+	_, err = rows.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
+}
+
+func TestQueryPgSettingsTable(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	rows, err := engine.Query(context.Background(), nil, "SELECT setting FROM pg_settings WHERE name = 'server_encoding'", nil)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	row, err := rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "UTF8", row.ValuesByPosition[0].RawValue())
+}
+
+func TestQueryPgPoliciesTable(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	_, _, err := engine.Exec(context.Background(),
+		nil,
+		`CREATE TABLE table1 (id INTEGER, owner VARCHAR[64], PRIMARY KEY id);
+		CREATE POLICY owner_only ON table1 FOR SELECT USING (owner = CURRENT_USER);`,
+		nil)
+	require.NoError(t, err)
+
+	rows, err := engine.Query(context.Background(), nil, "SELECT tablename, policyname, cmd, qual FROM pg_policies", nil)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	row, err := rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "table1", row.ValuesByPosition[0].RawValue())
+	require.Equal(t, "owner_only", row.ValuesByPosition[1].RawValue())
+	require.Equal(t, "SELECT", row.ValuesByPosition[2].RawValue())
+	require.Equal(t, "(owner = CURRENT_USER())", row.ValuesByPosition[3].RawValue())
+
+	_, err = rows.Read(context.Background())
+	require.ErrorIs(t, err, sql.ErrNoMoreRows)
+
+	rows, err = engine.Query(context.Background(), nil, "SELECT relrowsecurity FROM pg_class WHERE relname = 'table1'", nil)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	row, err = rows.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, true, row.ValuesByPosition[0].RawValue())
+}
+
+func TestQueryInformationSchema(t *testing.T) {
+	engine := setupEngine(t, nil)
+
+	_, _, err := engine.Exec(context.Background(),
+		nil,
+		`CREATE TABLE table1 (id INTEGER, title VARCHAR[32], ts TIMESTAMP, PRIMARY KEY id);
+		CREATE UNIQUE INDEX ON table1(title, ts);`,
+		nil)
+	require.NoError(t, err)
+
+	t.Run("tables", func(t *testing.T) {
+		rows, err := engine.Query(
+			context.Background(),
+			nil,
+			"SELECT table_schema, table_name, table_type FROM information_schema.tables WHERE table_schema = 'public'",
+			nil,
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		row, err := rows.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "public", row.ValuesByPosition[0].RawValue())
+		require.Equal(t, "table1", row.ValuesByPosition[1].RawValue())
+		require.Equal(t, "BASE TABLE", row.ValuesByPosition[2].RawValue())
+
+		_, err = rows.Read(context.Background())
+		require.ErrorIs(t, err, sql.ErrNoMoreRows)
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		rows, err := engine.Query(
+			context.Background(),
+			nil,
+			`SELECT c.column_name, c.data_type, c.is_nullable, c.character_maximum_length
+			FROM information_schema.columns c
+			WHERE c.table_name = 'table1'
+			ORDER BY c.ordinal_position`,
+			nil,
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		row, err := rows.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "id", row.ValuesByPosition[0].RawValue())
+		require.Equal(t, "bigint", row.ValuesByPosition[1].RawValue())
+		require.Equal(t, "NO", row.ValuesByPosition[2].RawValue())
+		require.Nil(t, row.ValuesByPosition[3].RawValue())
+
+		row, err = rows.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "title", row.ValuesByPosition[0].RawValue())
+		require.Equal(t, "character varying", row.ValuesByPosition[1].RawValue())
+		require.Equal(t, "YES", row.ValuesByPosition[2].RawValue())
+		require.Equal(t, int64(32), row.ValuesByPosition[3].RawValue())
+
+		row, err = rows.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "ts", row.ValuesByPosition[0].RawValue())
+		require.Equal(t, "timestamp without time zone", row.ValuesByPosition[1].RawValue())
+
+		_, err = rows.Read(context.Background())
+		require.ErrorIs(t, err, sql.ErrNoMoreRows)
+	})
+
+	t.Run("constraints", func(t *testing.T) {
+		rows, err := engine.Query(
+			context.Background(),
+			nil,
+			`SELECT tc.constraint_name, tc.constraint_type, kcu.column_name, kcu.ordinal_position
+			FROM information_schema.table_constraints tc
+				INNER JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = tc.constraint_name
+			WHERE tc.table_name = 'table1'`,
+			nil,
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		expected := [][]interface{}{
+			{"table1_pkey", "PRIMARY KEY", "id", int64(1)},
+			{"table1_title_ts_key", "UNIQUE", "title", int64(1)},
+			{"table1_title_ts_key", "UNIQUE", "ts", int64(2)},
+		}
+
+		for _, e := range expected {
+			row, err := rows.Read(context.Background())
+			require.NoError(t, err)
+
+			for i, v := range e {
+				require.Equal(t, v, row.ValuesByPosition[i].RawValue())
+			}
+		}
+
+		_, err = rows.Read(context.Background())
+		require.ErrorIs(t, err, sql.ErrNoMoreRows)
+	})
 }
 
 func TestQueryPgRolesTable(t *testing.T) {
@@ -136,6 +394,7 @@ type mockMultiDBHandler struct {
 	sql.MultiDBHandler
 
 	users []sql.User
+	dbs   []string
 }
 
 type user struct {
@@ -155,10 +414,18 @@ func (u *user) SQLPrivileges() []sql.SQLPrivilege {
 	return []sql.SQLPrivilege{sql.SQLPrivilegeCreate, sql.SQLPrivilegeSelect}
 }
 
+func (u *user) TablePrivileges() []sql.TablePrivilege {
+	return nil
+}
+
 func (h *mockMultiDBHandler) ListUsers(ctx context.Context) ([]sql.User, error) {
 	return h.users, nil
 }
 
+func (h *mockMultiDBHandler) ListDatabases(ctx context.Context) ([]string, error) {
+	return h.dbs, nil
+}
+
 func (h *mockMultiDBHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
 	if len(h.users) == 0 {
 		return nil, fmt.Errorf("no logged user")
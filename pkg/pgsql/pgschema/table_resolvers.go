@@ -18,8 +18,11 @@ package pgschema
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 )
 
 var pgClassCols = []sql.ColDescriptor{
@@ -184,42 +187,12 @@ func (r *pgClassResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias stri
 	catalog := tx.Catalog()
 	tables := catalog.GetTables()
 
-	rows := make([][]sql.ValueExp, len(tables))
-	for i, t := range tables {
-		rows[i] = []sql.ValueExp{
-			sql.NewInteger(int64(t.ID())),        // oid
-			sql.NewVarchar(t.Name()),             // relname
-			sql.NewInteger(-1),                   // relnamespace
-			sql.NewVarchar(""),                   // reltype
-			sql.NewNull(sql.IntegerType),         // reloftype
-			sql.NewInteger(0),                    // relowner
-			sql.NewNull(sql.IntegerType),         // relam
-			sql.NewNull(sql.IntegerType),         // relfilenode
-			sql.NewNull(sql.IntegerType),         // reltablespace
-			sql.NewNull(sql.IntegerType),         // relpages
-			sql.NewNull(sql.Float64Type),         // reltuples
-			sql.NewNull(sql.IntegerType),         // relallvisible
-			sql.NewNull(sql.IntegerType),         // reltoastrelid
-			sql.NewBool(len(t.GetIndexes()) > 1), // relhasindex
-			sql.NewBool(false),                   // relisshared
-			sql.NewNull(sql.VarcharType),         // relpersistence
-			sql.NewVarchar("r"),                  // relkind
-			sql.NewNull(sql.IntegerType),         // relnats
-			sql.NewNull(sql.IntegerType),         // relchecks
-			sql.NewBool(false),                   // relhasrules
-			sql.NewBool(false),                   // relhastriggers
-			sql.NewBool(false),                   // relhassubclass
-			sql.NewBool(false),                   // relrowsecurity
-			sql.NewBool(false),                   // relforcerowsecurity
-			sql.NewBool(false),                   // relispopulated
-			sql.NewVarchar(""),                   // relreplident
-			sql.NewBool(false),                   // relispartition
-			sql.NewInteger(0),                    // relrewrite
-			sql.NewNull(sql.IntegerType),         // relfrozenxid
-			sql.NewNull(sql.IntegerType),         // relminmxid
-			sql.NewNull(sql.AnyType),             // relacl
-			sql.NewNull(sql.AnyType),             // reloptions
-			sql.NewNull(sql.AnyType),             // relpartbound
+	rows := make([][]sql.ValueExp, 0, len(tables))
+	for _, t := range tables {
+		rows = append(rows, pgClassRow(int64(t.ID()), t.Name(), "r", len(t.GetIndexes()) > 1, len(t.GetPolicies()) > 0))
+
+		for _, idx := range t.GetIndexes() {
+			rows = append(rows, pgClassRow(indexOID(idx), idx.Name(), "i", false, false))
 		}
 	}
 
@@ -233,6 +206,44 @@ func (r *pgClassResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias stri
 	)
 }
 
+func pgClassRow(oid int64, name, kind string, hasIndex, rowSecurity bool) []sql.ValueExp {
+	return []sql.ValueExp{
+		sql.NewInteger(oid),                // oid
+		sql.NewVarchar(name),               // relname
+		sql.NewInteger(publicNamespaceOID), // relnamespace
+		sql.NewVarchar(""),                 // reltype
+		sql.NewNull(sql.IntegerType),       // reloftype
+		sql.NewInteger(0),                  // relowner
+		sql.NewNull(sql.IntegerType),       // relam
+		sql.NewNull(sql.IntegerType),       // relfilenode
+		sql.NewNull(sql.IntegerType),       // reltablespace
+		sql.NewNull(sql.IntegerType),       // relpages
+		sql.NewNull(sql.Float64Type),       // reltuples
+		sql.NewNull(sql.IntegerType),       // relallvisible
+		sql.NewNull(sql.IntegerType),       // reltoastrelid
+		sql.NewBool(hasIndex),              // relhasindex
+		sql.NewBool(false),                 // relisshared
+		sql.NewNull(sql.VarcharType),       // relpersistence
+		sql.NewVarchar(kind),               // relkind
+		sql.NewNull(sql.IntegerType),       // relnats
+		sql.NewNull(sql.IntegerType),       // relchecks
+		sql.NewBool(false),                 // relhasrules
+		sql.NewBool(false),                 // relhastriggers
+		sql.NewBool(false),                 // relhassubclass
+		sql.NewBool(rowSecurity),           // relrowsecurity
+		sql.NewBool(false),                 // relforcerowsecurity
+		sql.NewBool(false),                 // relispopulated
+		sql.NewVarchar(""),                 // relreplident
+		sql.NewBool(false),                 // relispartition
+		sql.NewInteger(0),                  // relrewrite
+		sql.NewNull(sql.IntegerType),       // relfrozenxid
+		sql.NewNull(sql.IntegerType),       // relminmxid
+		sql.NewNull(sql.AnyType),           // relacl
+		sql.NewNull(sql.AnyType),           // reloptions
+		sql.NewNull(sql.AnyType),           // relpartbound
+	}
+}
+
 func (r *pgClassResolver) Table() string {
 	return "pg_class"
 }
@@ -259,13 +270,23 @@ var pgNamespaceCols = []sql.ColDescriptor{
 type pgNamespaceResolver struct{}
 
 func (r *pgNamespaceResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	rows := make([][]sql.ValueExp, len(namespaces))
+	for i, ns := range namespaces {
+		rows[i] = []sql.ValueExp{
+			sql.NewInteger(ns.oid),   // oid
+			sql.NewVarchar(ns.name),  // nspname
+			sql.NewInteger(0),        // nspowner
+			sql.NewNull(sql.AnyType), // nspacl
+		}
+	}
+
 	return sql.NewValuesRowReader(
 		tx,
 		nil,
 		pgNamespaceCols,
 		true,
 		alias,
-		nil,
+		rows,
 	)
 }
 
@@ -371,10 +392,846 @@ func (r *pgRolesResolver) Table() string {
 	return "pg_roles"
 }
 
+var pgAttributeCols = []sql.ColDescriptor{
+	{
+		Column: "attrelid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "atttypid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attstattarget",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attlen",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attnum",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attndims",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attcacheoff",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "atttypmod",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attbyval",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "attstorage",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "attalign",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "attnotnull",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "atthasdef",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "atthasmissing",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "attidentity",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "attgenerated",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "attisdropped",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "attislocal",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "attinhcount",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attcollation",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "attacl",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "attoptions",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "attfdwoptions",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "attmissingval",
+		Type:   sql.AnyType,
+	},
+}
+
+type pgAttributeResolver struct{}
+
+func (r *pgAttributeResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for _, col := range t.Cols() {
+			typ := pgTypeOf(col)
+
+			identity := ""
+			if col.IsAutoIncremental() {
+				identity = "d"
+			}
+
+			rows = append(rows, []sql.ValueExp{
+				sql.NewInteger(int64(t.ID())),     // attrelid
+				sql.NewVarchar(col.Name()),        // attname
+				sql.NewInteger(typ.oid),           // atttypid
+				sql.NewInteger(-1),                // attstattarget
+				sql.NewInteger(typ.len),           // attlen
+				sql.NewInteger(int64(col.ID())),   // attnum
+				sql.NewInteger(0),                 // attndims
+				sql.NewInteger(-1),                // attcacheoff
+				sql.NewInteger(typeModifier(col)), // atttypmod
+				sql.NewBool(typ.byVal),            // attbyval
+				sql.NewVarchar("p"),               // attstorage
+				sql.NewVarchar("d"),               // attalign
+				sql.NewBool(!isNullable(t, col)),  // attnotnull
+				sql.NewBool(col.IsAutoIncremental() || col.DefaultValue() != ""), // atthasdef
+				sql.NewBool(false),       // atthasmissing
+				sql.NewVarchar(identity), // attidentity
+				sql.NewVarchar(""),       // attgenerated
+				sql.NewBool(false),       // attisdropped
+				sql.NewBool(true),        // attislocal
+				sql.NewInteger(0),        // attinhcount
+				sql.NewInteger(0),        // attcollation
+				sql.NewNull(sql.AnyType), // attacl
+				sql.NewNull(sql.AnyType), // attoptions
+				sql.NewNull(sql.AnyType), // attfdwoptions
+				sql.NewNull(sql.AnyType), // attmissingval
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgAttributeCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgAttributeResolver) Table() string {
+	return "pg_attribute"
+}
+
+var pgIndexCols = []sql.ColDescriptor{
+	{
+		Column: "indexrelid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "indrelid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "indnatts",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "indnkeyatts",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "indisunique",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indisprimary",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indisexclusion",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indimmediate",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indisclustered",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indisvalid",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indcheckxmin",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indisready",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indislive",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indisreplident",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "indkey",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "indcollation",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "indclass",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "indoption",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "indexprs",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "indpred",
+		Type:   sql.AnyType,
+	},
+}
+
+type pgIndexResolver struct{}
+
+func (r *pgIndexResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for _, idx := range t.GetIndexes() {
+			rows = append(rows, []sql.ValueExp{
+				sql.NewInteger(indexOID(idx)),          // indexrelid
+				sql.NewInteger(int64(t.ID())),          // indrelid
+				sql.NewInteger(int64(len(idx.Cols()))), // indnatts
+				sql.NewInteger(int64(len(idx.Cols()))), // indnkeyatts
+				sql.NewBool(idx.IsUnique()),            // indisunique
+				sql.NewBool(idx.IsPrimary()),           // indisprimary
+				sql.NewBool(false),                     // indisexclusion
+				sql.NewBool(true),                      // indimmediate
+				sql.NewBool(false),                     // indisclustered
+				sql.NewBool(true),                      // indisvalid
+				sql.NewBool(false),                     // indcheckxmin
+				sql.NewBool(true),                      // indisready
+				sql.NewBool(true),                      // indislive
+				sql.NewBool(false),                     // indisreplident
+				sql.NewVarchar(indexKey(idx, " ")),     // indkey
+				sql.NewNull(sql.AnyType),               // indcollation
+				sql.NewNull(sql.AnyType),               // indclass
+				sql.NewNull(sql.AnyType),               // indoption
+				sql.NewNull(sql.AnyType),               // indexprs
+				sql.NewNull(sql.AnyType),               // indpred
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgIndexCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgIndexResolver) Table() string {
+	return "pg_index"
+}
+
+// indexKey returns the attribute numbers of the indexed columns joined by sep
+func indexKey(idx *sql.Index, sep string) string {
+	attNums := make([]string, len(idx.Cols()))
+	for i, col := range idx.Cols() {
+		attNums[i] = strconv.FormatUint(uint64(col.ID()), 10)
+	}
+	return strings.Join(attNums, sep)
+}
+
+var pgTypeCols = []sql.ColDescriptor{
+	{
+		Column: "oid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "typnamespace",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typowner",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typlen",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typbyval",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "typtype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "typcategory",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "typispreferred",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "typisdefined",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "typdelim",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "typrelid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typelem",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typarray",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typnotnull",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "typbasetype",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typtypmod",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typndims",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typcollation",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "typdefault",
+		Type:   sql.AnyType,
+	},
+}
+
+type pgTypeResolver struct{}
+
+func (r *pgTypeResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	rows := make([][]sql.ValueExp, len(pgTypes))
+	for i, t := range pgTypes {
+		rows[i] = []sql.ValueExp{
+			sql.NewInteger(t.oid),                 // oid
+			sql.NewVarchar(t.name),                // typname
+			sql.NewInteger(pgCatalogNamespaceOID), // typnamespace
+			sql.NewInteger(0),                     // typowner
+			sql.NewInteger(t.len),                 // typlen
+			sql.NewBool(t.byVal),                  // typbyval
+			sql.NewVarchar("b"),                   // typtype
+			sql.NewVarchar(t.category),            // typcategory
+			sql.NewBool(false),                    // typispreferred
+			sql.NewBool(true),                     // typisdefined
+			sql.NewVarchar(","),                   // typdelim
+			sql.NewInteger(0),                     // typrelid
+			sql.NewInteger(0),                     // typelem
+			sql.NewInteger(0),                     // typarray
+			sql.NewBool(false),                    // typnotnull
+			sql.NewInteger(0),                     // typbasetype
+			sql.NewInteger(-1),                    // typtypmod
+			sql.NewInteger(0),                     // typndims
+			sql.NewInteger(0),                     // typcollation
+			sql.NewNull(sql.AnyType),              // typdefault
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgTypeCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgTypeResolver) Table() string {
+	return "pg_type"
+}
+
+var pgDatabaseCols = []sql.ColDescriptor{
+	{
+		Column: "oid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "datname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "datdba",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "encoding",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "datcollate",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "datctype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "datistemplate",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "datallowconn",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "datconnlimit",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "dattablespace",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "datacl",
+		Type:   sql.AnyType,
+	},
+}
+
+type pgDatabaseResolver struct{}
+
+func (r *pgDatabaseResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	dbs, err := tx.ListDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]sql.ValueExp, len(dbs))
+	for i, db := range dbs {
+		rows[i] = []sql.ValueExp{
+			sql.NewInteger(int64(i + 1)), // oid
+			sql.NewVarchar(db),           // datname
+			sql.NewInteger(0),            // datdba
+			sql.NewInteger(6),            // encoding (UTF8)
+			sql.NewVarchar("C"),          // datcollate
+			sql.NewVarchar("C"),          // datctype
+			sql.NewBool(false),           // datistemplate
+			sql.NewBool(true),            // datallowconn
+			sql.NewInteger(-1),           // datconnlimit
+			sql.NewInteger(0),            // dattablespace
+			sql.NewNull(sql.AnyType),     // datacl
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgDatabaseCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgDatabaseResolver) Table() string {
+	return "pg_database"
+}
+
+var pgConstraintCols = []sql.ColDescriptor{
+	{
+		Column: "oid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "conname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "connamespace",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "contype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "condeferrable",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "condeferred",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "convalidated",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "conrelid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "contypid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "conindid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "conparentid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "confrelid",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "confupdtype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "confdeltype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "confmatchtype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "conislocal",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "coninhcount",
+		Type:   sql.IntegerType,
+	},
+	{
+		Column: "connoinherit",
+		Type:   sql.BooleanType,
+	},
+	{
+		Column: "conkey",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "confkey",
+		Type:   sql.AnyType,
+	},
+	{
+		Column: "conbin",
+		Type:   sql.AnyType,
+	},
+}
+
+type pgConstraintResolver struct{}
+
+func (r *pgConstraintResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for _, idx := range constraintIndexes(t) {
+			conType := "u"
+			if idx.IsPrimary() {
+				conType = "p"
+			}
+
+			rows = append(rows, []sql.ValueExp{
+				sql.NewInteger(indexOID(idx)),                  // oid
+				sql.NewVarchar(constraintName(idx)),            // conname
+				sql.NewInteger(publicNamespaceOID),             // connamespace
+				sql.NewVarchar(conType),                        // contype
+				sql.NewBool(false),                             // condeferrable
+				sql.NewBool(false),                             // condeferred
+				sql.NewBool(true),                              // convalidated
+				sql.NewInteger(int64(t.ID())),                  // conrelid
+				sql.NewInteger(0),                              // contypid
+				sql.NewInteger(indexOID(idx)),                  // conindid
+				sql.NewInteger(0),                              // conparentid
+				sql.NewInteger(0),                              // confrelid
+				sql.NewVarchar(" "),                            // confupdtype
+				sql.NewVarchar(" "),                            // confdeltype
+				sql.NewVarchar(" "),                            // confmatchtype
+				sql.NewBool(true),                              // conislocal
+				sql.NewInteger(0),                              // coninhcount
+				sql.NewBool(true),                              // connoinherit
+				sql.NewVarchar("{" + indexKey(idx, ",") + "}"), // conkey
+				sql.NewNull(sql.AnyType),                       // confkey
+				sql.NewNull(sql.AnyType),                       // conbin
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgConstraintCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgConstraintResolver) Table() string {
+	return "pg_constraint"
+}
+
+var pgSettingsCols = []sql.ColDescriptor{
+	{
+		Column: "name",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "setting",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "unit",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "category",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "short_desc",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "context",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "vartype",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "source",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "boot_val",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "reset_val",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "pending_restart",
+		Type:   sql.BooleanType,
+	},
+}
+
+type pgSetting struct {
+	name      string
+	setting   string
+	category  string
+	shortDesc string
+	vartype   string
+}
+
+var pgSettings = []pgSetting{
+	{name: "server_version", setting: pgmeta.PgsqlServerVersion, category: "Preset Options", shortDesc: "Shows the server version.", vartype: "string"},
+	{name: "server_encoding", setting: "UTF8", category: "Preset Options", shortDesc: "Shows the server (database) character set encoding.", vartype: "string"},
+	{name: "client_encoding", setting: "UTF8", category: "Client Connection Defaults / Locale and Formatting", shortDesc: "Sets the client's character set encoding.", vartype: "string"},
+	{name: "standard_conforming_strings", setting: "on", category: "Version and Platform Compatibility", shortDesc: "Causes '...' strings to treat backslashes literally.", vartype: "bool"},
+	{name: "integer_datetimes", setting: "on", category: "Preset Options", shortDesc: "Shows whether datetimes are integer based.", vartype: "bool"},
+	{name: "DateStyle", setting: "ISO, MDY", category: "Client Connection Defaults / Locale and Formatting", shortDesc: "Sets the display format for date and time values.", vartype: "string"},
+	{name: "TimeZone", setting: "UTC", category: "Client Connection Defaults / Locale and Formatting", shortDesc: "Sets the time zone for displaying and interpreting time stamps.", vartype: "string"},
+	{name: "search_path", setting: publicSchemaName, category: "Client Connection Defaults / Statement Behavior", shortDesc: "Sets the schema search order for names that are not schema-qualified.", vartype: "string"},
+	{name: "max_identifier_length", setting: "63", category: "Preset Options", shortDesc: "Shows the maximum identifier length.", vartype: "integer"},
+}
+
+type pgSettingsResolver struct{}
+
+func (r *pgSettingsResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	rows := make([][]sql.ValueExp, len(pgSettings))
+	for i, s := range pgSettings {
+		rows[i] = []sql.ValueExp{
+			sql.NewVarchar(s.name),       // name
+			sql.NewVarchar(s.setting),    // setting
+			sql.NewNull(sql.VarcharType), // unit
+			sql.NewVarchar(s.category),   // category
+			sql.NewVarchar(s.shortDesc),  // short_desc
+			sql.NewVarchar("internal"),   // context
+			sql.NewVarchar(s.vartype),    // vartype
+			sql.NewVarchar("default"),    // source
+			sql.NewVarchar(s.setting),    // boot_val
+			sql.NewVarchar(s.setting),    // reset_val
+			sql.NewBool(false),           // pending_restart
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgSettingsCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgSettingsResolver) Table() string {
+	return "pg_settings"
+}
+
+var pgPoliciesCols = []sql.ColDescriptor{
+	{
+		Column: "schemaname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "tablename",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "policyname",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "permissive",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "roles",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "cmd",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "qual",
+		Type:   sql.VarcharType,
+	},
+	{
+		Column: "with_check",
+		Type:   sql.VarcharType,
+	},
+}
+
+type pgPoliciesResolver struct{}
+
+func (r *pgPoliciesResolver) Resolve(ctx context.Context, tx *sql.SQLTx, alias string) (sql.RowReader, error) {
+	var rows [][]sql.ValueExp
+
+	for _, t := range tx.Catalog().GetTables() {
+		for _, p := range t.GetPolicies() {
+			cmd := string(p.Command())
+			if cmd == "" {
+				cmd = "ALL"
+			}
+
+			rows = append(rows, []sql.ValueExp{
+				sql.NewVarchar(publicSchemaName), // schemaname
+				sql.NewVarchar(t.Name()),         // tablename
+				sql.NewVarchar(p.Name()),         // policyname
+				sql.NewVarchar("PERMISSIVE"),     // permissive
+				sql.NewVarchar("{public}"),       // roles
+				sql.NewVarchar(cmd),              // cmd
+				sql.NewVarchar(p.Condition()),    // qual
+				sql.NewNull(sql.VarcharType),     // with_check
+			})
+		}
+	}
+
+	return sql.NewValuesRowReader(
+		tx,
+		nil,
+		pgPoliciesCols,
+		true,
+		alias,
+		rows,
+	)
+}
+
+func (r *pgPoliciesResolver) Table() string {
+	return "pg_policies"
+}
+
 var tableResolvers = []sql.TableResolver{
 	&pgClassResolver{},
 	&pgNamespaceResolver{},
 	&pgRolesResolver{},
+	&pgAttributeResolver{},
+	&pgIndexResolver{},
+	&pgTypeResolver{},
+	&pgDatabaseResolver{},
+	&pgConstraintResolver{},
+	&pgSettingsResolver{},
+	&pgPoliciesResolver{},
+	&informationSchemaTablesResolver{},
+	&informationSchemaColumnsResolver{},
+	&informationSchemaTableConstraintsResolver{},
+	&informationSchemaKeyColumnUsageResolver{},
 }
 
 func PgCatalogResolvers() []sql.TableResolver {
@@ -0,0 +1,177 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pgschema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/sql"
+)
+
+const (
+	pgCatalogNamespaceOID         = 11
+	publicNamespaceOID            = 2200
+	informationSchemaNamespaceOID = 13000
+
+	publicSchemaName            = "public"
+	pgCatalogSchemaName         = "pg_catalog"
+	informationSchemaSchemaName = "information_schema"
+)
+
+type namespace struct {
+	oid  int64
+	name string
+}
+
+// immudb has no notion of schemas, all tables are exposed under the public one
+var namespaces = []namespace{
+	{oid: pgCatalogNamespaceOID, name: pgCatalogSchemaName},
+	{oid: publicNamespaceOID, name: publicSchemaName},
+	{oid: informationSchemaNamespaceOID, name: informationSchemaSchemaName},
+}
+
+type pgType struct {
+	oid      int64
+	name     string
+	len      int64
+	byVal    bool
+	category string
+
+	// sqlName is the name reported in information_schema.columns.data_type
+	sqlName string
+}
+
+var (
+	pgTypeBool        = pgType{oid: 16, name: "bool", len: 1, byVal: true, category: "B", sqlName: "boolean"}
+	pgTypeBytea       = pgType{oid: 17, name: "bytea", len: -1, category: "U", sqlName: "bytea"}
+	pgTypeName        = pgType{oid: 19, name: "name", len: 64, category: "S", sqlName: "name"}
+	pgTypeInt8        = pgType{oid: 20, name: "int8", len: 8, byVal: true, category: "N", sqlName: "bigint"}
+	pgTypeInt2        = pgType{oid: 21, name: "int2", len: 2, byVal: true, category: "N", sqlName: "smallint"}
+	pgTypeInt4        = pgType{oid: 23, name: "int4", len: 4, byVal: true, category: "N", sqlName: "integer"}
+	pgTypeText        = pgType{oid: 25, name: "text", len: -1, category: "S", sqlName: "text"}
+	pgTypeOid         = pgType{oid: 26, name: "oid", len: 4, byVal: true, category: "N", sqlName: "oid"}
+	pgTypeJSON        = pgType{oid: 114, name: "json", len: -1, category: "U", sqlName: "json"}
+	pgTypeFloat4      = pgType{oid: 700, name: "float4", len: 4, byVal: true, category: "N", sqlName: "real"}
+	pgTypeFloat8      = pgType{oid: 701, name: "float8", len: 8, byVal: true, category: "N", sqlName: "double precision"}
+	pgTypeVarchar     = pgType{oid: 1043, name: "varchar", len: -1, category: "S", sqlName: "character varying"}
+	pgTypeTimestamp   = pgType{oid: 1114, name: "timestamp", len: 8, byVal: true, category: "D", sqlName: "timestamp without time zone"}
+	pgTypeTimestamptz = pgType{oid: 1184, name: "timestamptz", len: 8, byVal: true, category: "D", sqlName: "timestamp with time zone"}
+	pgTypeUUID        = pgType{oid: 2950, name: "uuid", len: 16, category: "U", sqlName: "uuid"}
+	pgTypeJSONB       = pgType{oid: 3802, name: "jsonb", len: -1, category: "U", sqlName: "jsonb"}
+)
+
+var pgTypes = []pgType{
+	pgTypeBool,
+	pgTypeBytea,
+	pgTypeName,
+	pgTypeInt8,
+	pgTypeInt2,
+	pgTypeInt4,
+	pgTypeText,
+	pgTypeOid,
+	pgTypeJSON,
+	pgTypeFloat4,
+	pgTypeFloat8,
+	pgTypeVarchar,
+	pgTypeTimestamp,
+	pgTypeTimestamptz,
+	pgTypeUUID,
+	pgTypeJSONB,
+}
+
+// pgTypeOf returns the PostgreSQL type used to describe a column of the given table
+func pgTypeOf(col *sql.Column) pgType {
+	switch col.Type() {
+	case sql.BooleanType:
+		return pgTypeBool
+	case sql.IntegerType:
+		return pgTypeInt8
+	case sql.Float64Type:
+		return pgTypeFloat8
+	case sql.TimestampType:
+		return pgTypeTimestamp
+	case sql.UUIDType:
+		return pgTypeUUID
+	case sql.JSONType:
+		return pgTypeJSON
+	case sql.VarcharType:
+		if col.MaxLen() > 0 {
+			return pgTypeVarchar
+		}
+		return pgTypeText
+	}
+	return pgTypeBytea
+}
+
+// isNullable reports whether the column accepts NULL values, primary key columns never do.
+func isNullable(t *sql.Table, col *sql.Column) bool {
+	return col.IsNullable() && !t.PrimaryIndex().IncludesCol(col.ID())
+}
+
+// typeModifier returns the atttypmod value of the column, following
+// PostgreSQL conventions for variable length character types.
+func typeModifier(col *sql.Column) int64 {
+	if col.Type() == sql.VarcharType && col.MaxLen() > 0 {
+		return int64(col.MaxLen()) + 4
+	}
+	return -1
+}
+
+// indexOID returns an object identifier for the index which does not clash
+// with the ones assigned to tables.
+func indexOID(idx *sql.Index) int64 {
+	return int64(idx.Table().ID())<<16 | int64(idx.ID()+1)
+}
+
+// constraintName returns the name PostgreSQL would assign to the constraint enforced by the index.
+func constraintName(idx *sql.Index) string {
+	if idx.IsPrimary() {
+		return idx.Table().Name() + "_pkey"
+	}
+
+	cols := make([]string, len(idx.Cols()))
+	for i, col := range idx.Cols() {
+		cols[i] = col.Name()
+	}
+	return fmt.Sprintf("%s_%s_key", idx.Table().Name(), strings.Join(cols, "_"))
+}
+
+func constraintType(idx *sql.Index) string {
+	if idx.IsPrimary() {
+		return "PRIMARY KEY"
+	}
+	return "UNIQUE"
+}
+
+// constraintIndexes returns the indexes of the table that enforce a constraint
+func constraintIndexes(t *sql.Table) []*sql.Index {
+	var indexes []*sql.Index
+	for _, idx := range t.GetIndexes() {
+		if idx.IsUnique() {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+func yesOrNo(b bool) string {
+	if b {
+		return "YES"
+	}
+	return "NO"
+}
@@ -0,0 +1,523 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/auth"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
+	fm "github.com/codenotary/immudb/pkg/pgsql/server/fmessages"
+)
+
+const (
+	// AuthMethodSCRAMSHA256 authenticates users through the SASL SCRAM-SHA-256 mechanism,
+	// using channel binding when the connection is secured with TLS
+	AuthMethodSCRAMSHA256 = "scram-sha-256"
+	// AuthMethodMD5 authenticates users through a salted MD5 challenge
+	AuthMethodMD5 = "md5"
+	// AuthMethodPassword authenticates users by receiving their password in cleartext
+	AuthMethodPassword = "password"
+	// AuthMethodCert authenticates users through the client certificate verified when establishing
+	// the TLS connection, whose identities must match the user name or be mapped to it, see CertUserMapping
+	AuthMethodCert = "cert"
+)
+
+// DefaultAuthMethods lists the password-based authentication methods supported by the server,
+// client certificate authentication must be explicitly enabled. Client certificates are preferred
+// when enabled, otherwise the strongest method enabled for which a user has a verifier is used.
+var DefaultAuthMethods = []string{AuthMethodSCRAMSHA256, AuthMethodMD5, AuthMethodPassword}
+
+const (
+	scramSHA256     = "SCRAM-SHA-256"
+	scramSHA256Plus = "SCRAM-SHA-256-PLUS"
+
+	scramNonceLen     = 18
+	md5SaltLen        = 4
+	mockAuthSecretLen = 32
+
+	sessionKeepAliveInterval = time.Minute
+)
+
+// ErrUnsupportedAuthMethod is returned when configuring an unknown authentication method
+var ErrUnsupportedAuthMethod = errors.New("unsupported authentication method")
+
+// ErrInvalidCertUserMapping is returned when parsing a malformed certificate user mapping
+var ErrInvalidCertUserMapping = errors.New("invalid certificate user mapping")
+
+// Authenticator provides the pgsql server access to immudb users and sessions,
+// it's required to authenticate users without receiving their password in cleartext
+type Authenticator interface {
+	// GetUser returns the user with the given name, including its password verifiers
+	GetUser(ctx context.Context, username string) (*auth.User, error)
+	// OpenSession opens a session for an already authenticated user on the given database
+	OpenSession(ctx context.Context, user *auth.User, database string) (sessionID string, err error)
+	// KeepAlive prevents an open session from being closed due to inactivity
+	KeepAlive(sessionID string)
+	// CloseSession closes a session opened with OpenSession
+	CloseSession(sessionID string) error
+}
+
+// ValidateAuthMethods returns an error if any of the given authentication methods is not supported
+func ValidateAuthMethods(methods []string) error {
+	if len(methods) == 0 {
+		return fmt.Errorf("%w: at least one authentication method must be enabled", ErrUnsupportedAuthMethod)
+	}
+
+	for _, m := range methods {
+		switch m {
+		case AuthMethodSCRAMSHA256, AuthMethodMD5, AuthMethodPassword, AuthMethodCert:
+		default:
+			return fmt.Errorf("%w: '%s'", ErrUnsupportedAuthMethod, m)
+		}
+	}
+
+	return nil
+}
+
+func (s *session) authMethodEnabled(method string) bool {
+	for _, m := range s.authMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// selectAuthMethod returns the strongest enabled authentication method available for the user.
+// Unknown users are challenged as any other user so their existence is not disclosed,
+// in such case the returned user is nil and authentication fails once the exchange is completed.
+func (s *session) selectAuthMethod(ctx context.Context, username string) (string, *auth.User, error) {
+	var u *auth.User
+
+	if s.authenticator != nil {
+		user, err := s.authenticator.GetUser(ctx, username)
+		if err == nil {
+			u = user
+		}
+
+		if s.authMethodEnabled(AuthMethodCert) && u != nil {
+			if cert := s.clientCertificate(); cert != nil && s.certAuthenticates(cert, username) {
+				return AuthMethodCert, u, nil
+			}
+		}
+
+		if s.authMethodEnabled(AuthMethodSCRAMSHA256) && (u == nil || u.SCRAMSHA256 != "") {
+			return AuthMethodSCRAMSHA256, u, nil
+		}
+
+		if s.authMethodEnabled(AuthMethodMD5) && (u == nil || u.MD5 != "") {
+			return AuthMethodMD5, u, nil
+		}
+	}
+
+	if s.authMethodEnabled(AuthMethodPassword) {
+		return AuthMethodPassword, nil, nil
+	}
+
+	if s.authMethodEnabled(AuthMethodCert) {
+		return "", nil, fmt.Errorf("%w: no valid client certificate identifying the user was presented", pserr.ErrAuthFailed)
+	}
+
+	return "", nil, pserr.ErrAuthMethodNotAvailable
+}
+
+// authenticate verifies the identity of the user through the given method
+// and opens an immudb session on the selected database
+func (s *session) authenticate(ctx context.Context, method string, u *auth.User, database string) error {
+	var err error
+
+	switch method {
+	case AuthMethodCert:
+		// the client certificate was already verified during the TLS handshake
+	case AuthMethodSCRAMSHA256:
+		err = s.authenticateSCRAM(u)
+	case AuthMethodMD5:
+		err = s.authenticateMD5(u)
+	default:
+		return s.authenticatePassword(ctx, database)
+	}
+	if err != nil {
+		return err
+	}
+
+	sessionID, err := s.authenticator.OpenSession(ctx, u, database)
+	if err != nil {
+		return err
+	}
+
+	s.sessionID = sessionID
+	s.ctx = newSessionContext(sessionID)
+
+	s.keepAliveDone = make(chan struct{})
+	go s.keepAlive(sessionID, s.keepAliveDone)
+
+	return nil
+}
+
+func (s *session) keepAlive(sessionID string, done <-chan struct{}) {
+	ticker := time.NewTicker(sessionKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.authenticator.KeepAlive(sessionID)
+		case <-done:
+			return
+		}
+	}
+}
+
+func (s *session) nextPasswordMessage() ([]byte, error) {
+	msg, err := s.mr.ReadRawMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.t != 'p' {
+		return nil, fmt.Errorf("%w: expected password message", pserr.ErrMalformedMessage)
+	}
+
+	return msg.payload, nil
+}
+
+func (s *session) authenticateMD5(u *auth.User) error {
+	salt := make([]byte, md5SaltLen)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.writeMessage(bm.AuthenticationMD5Password(salt)); err != nil {
+		return err
+	}
+
+	payload, err := s.nextPasswordMessage()
+	if err != nil {
+		return err
+	}
+
+	pw, err := fm.ParsePasswordMsg(payload)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return pserr.ErrInvalidUsernameOrPassword
+	}
+
+	expected, err := auth.SaltedMD5Response(u.MD5, salt)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(pw.GetSecret())) != 1 {
+		return pserr.ErrInvalidUsernameOrPassword
+	}
+
+	return nil
+}
+
+func (s *session) authenticateSCRAM(u *auth.User) error {
+	var verifier *auth.SCRAMVerifier
+
+	if u == nil {
+		// a mock verifier is used for unknown users, the exchange fails once the proof is received
+		v, err := auth.MockSCRAMVerifier(s.connParams["user"], s.mockAuthSecret)
+		if err != nil {
+			return err
+		}
+		verifier = v
+	} else {
+		v, err := auth.ParseSCRAMVerifier(u.SCRAMSHA256)
+		if err != nil {
+			return err
+		}
+		verifier = v
+	}
+
+	mechanisms := []string{scramSHA256}
+
+	cbindData, err := s.tlsServerEndPoint()
+	if err != nil {
+		return err
+	}
+	if cbindData != nil {
+		mechanisms = []string{scramSHA256Plus, scramSHA256}
+	}
+
+	if _, err := s.writeMessage(bm.AuthenticationSASL(mechanisms)); err != nil {
+		return err
+	}
+
+	payload, err := s.nextPasswordMessage()
+	if err != nil {
+		return err
+	}
+
+	initialResponse, err := fm.ParseSASLInitialResponseMsg(payload)
+	if err != nil {
+		return err
+	}
+
+	exchange := &scramExchange{
+		verifier:    verifier,
+		mechanism:   initialResponse.Mechanism,
+		cbindData:   cbindData,
+		plusOffered: cbindData != nil,
+	}
+
+	serverFirst, err := exchange.serverFirstMessage(initialResponse.Data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.writeMessage(bm.AuthenticationSASLContinue(serverFirst)); err != nil {
+		return err
+	}
+
+	payload, err = s.nextPasswordMessage()
+	if err != nil {
+		return err
+	}
+
+	response, err := fm.ParseSASLResponseMsg(payload)
+	if err != nil {
+		return err
+	}
+
+	serverFinal, err := exchange.serverFinalMessage(response.Data)
+	if err != nil {
+		return err
+	}
+
+	if u == nil {
+		return pserr.ErrInvalidUsernameOrPassword
+	}
+
+	_, err = s.writeMessage(bm.AuthenticationSASLFinal(serverFinal))
+	return err
+}
+
+// tlsServerEndPoint returns the channel binding data of the tls-server-end-point type (RFC 5929),
+// nil is returned if the connection is not secured with TLS
+func (s *session) tlsServerEndPoint() ([]byte, error) {
+	if _, ok := s.mr.Connection().(*tls.Conn); !ok {
+		return nil, nil
+	}
+
+	if s.tlsConfig == nil || len(s.tlsConfig.Certificates) == 0 || len(s.tlsConfig.Certificates[0].Certificate) == 0 {
+		return nil, nil
+	}
+
+	cert, err := x509.ParseCertificate(s.tlsConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var h hash.Hash
+
+	switch cert.SignatureAlgorithm {
+	case x509.SHA384WithRSA, x509.SHA384WithRSAPSS, x509.ECDSAWithSHA384:
+		h = sha512.New384()
+	case x509.SHA512WithRSA, x509.SHA512WithRSAPSS, x509.ECDSAWithSHA512:
+		h = sha512.New()
+	default:
+		// MD5 and SHA-1 are replaced by SHA-256 as stated by RFC 5929
+		h = sha256.New()
+	}
+
+	h.Write(cert.Raw)
+
+	return h.Sum(nil), nil
+}
+
+// scramExchange holds the server side state of a SCRAM-SHA-256 authentication (RFC 5802, RFC 7677)
+type scramExchange struct {
+	verifier *auth.SCRAMVerifier
+
+	mechanism   string
+	cbindData   []byte
+	plusOffered bool
+
+	// serverNonce is randomly generated when not set
+	serverNonce string
+
+	gs2Header       []byte
+	clientFirstBare []byte
+	serverFirst     []byte
+	nonce           []byte
+}
+
+func (e *scramExchange) serverFirstMessage(clientFirst []byte) ([]byte, error) {
+	if e.mechanism != scramSHA256 && (e.mechanism != scramSHA256Plus || !e.plusOffered) {
+		return nil, fmt.Errorf("%w: unsupported SASL mechanism '%s'", pserr.ErrAuthFailed, e.mechanism)
+	}
+
+	// gs2-header: channel binding flag, optional authzid and the client-first-message-bare
+	parts := bytes.SplitN(clientFirst, []byte(","), 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed SCRAM message", pserr.ErrAuthFailed)
+	}
+
+	cbindFlag := string(parts[0])
+
+	switch {
+	case cbindFlag == "n":
+		if e.mechanism == scramSHA256Plus {
+			return nil, fmt.Errorf("%w: channel binding is required by %s", pserr.ErrAuthFailed, scramSHA256Plus)
+		}
+	case cbindFlag == "y":
+		// the client supports channel binding but thinks the server does not
+		if e.plusOffered {
+			return nil, fmt.Errorf("%w: channel binding is supported by the server", pserr.ErrAuthFailed)
+		}
+	case cbindFlag == "p=tls-server-end-point":
+		if e.mechanism != scramSHA256Plus {
+			return nil, fmt.Errorf("%w: channel binding requires %s", pserr.ErrAuthFailed, scramSHA256Plus)
+		}
+	case strings.HasPrefix(cbindFlag, "p="):
+		return nil, fmt.Errorf("%w: unsupported channel binding type '%s'", pserr.ErrAuthFailed, cbindFlag[2:])
+	default:
+		return nil, fmt.Errorf("%w: malformed SCRAM message", pserr.ErrAuthFailed)
+	}
+
+	if len(parts[1]) > 0 {
+		return nil, fmt.Errorf("%w: authorization identity is not supported", pserr.ErrAuthFailed)
+	}
+
+	e.gs2Header = clientFirst[:len(parts[0])+len(parts[1])+2]
+	e.clientFirstBare = parts[2]
+
+	// the username is taken from the startup message, as done by PostgreSQL
+	attrs := scramAttributes(e.clientFirstBare)
+
+	clientNonce, ok := attrs['r']
+	if !ok || len(clientNonce) == 0 {
+		return nil, fmt.Errorf("%w: missing client nonce", pserr.ErrAuthFailed)
+	}
+
+	if e.serverNonce == "" {
+		e.serverNonce = base64.StdEncoding.EncodeToString(randomBytes(scramNonceLen))
+	}
+
+	e.nonce = []byte(clientNonce + e.serverNonce)
+
+	e.serverFirst = []byte(fmt.Sprintf("r=%s,s=%s,i=%d",
+		e.nonce,
+		base64.StdEncoding.EncodeToString(e.verifier.Salt),
+		e.verifier.Iterations,
+	))
+
+	return e.serverFirst, nil
+}
+
+func (e *scramExchange) serverFinalMessage(clientFinal []byte) ([]byte, error) {
+	proofIdx := bytes.LastIndex(clientFinal, []byte(",p="))
+	if proofIdx < 0 {
+		return nil, fmt.Errorf("%w: missing client proof", pserr.ErrAuthFailed)
+	}
+
+	clientFinalWithoutProof := clientFinal[:proofIdx]
+
+	proof, err := base64.StdEncoding.DecodeString(string(clientFinal[proofIdx+3:]))
+	if err != nil || len(proof) != sha256.Size {
+		return nil, fmt.Errorf("%w: malformed client proof", pserr.ErrAuthFailed)
+	}
+
+	attrs := scramAttributes(clientFinalWithoutProof)
+
+	expectedCbind := e.gs2Header
+	if e.mechanism == scramSHA256Plus {
+		expectedCbind = append(append([]byte{}, e.gs2Header...), e.cbindData...)
+	}
+
+	if attrs['c'] != base64.StdEncoding.EncodeToString(expectedCbind) {
+		return nil, fmt.Errorf("%w: channel binding mismatch", pserr.ErrAuthFailed)
+	}
+
+	if attrs['r'] != string(e.nonce) {
+		return nil, fmt.Errorf("%w: nonce mismatch", pserr.ErrAuthFailed)
+	}
+
+	authMessage := bytes.Join([][]byte{e.clientFirstBare, e.serverFirst, clientFinalWithoutProof}, []byte(","))
+
+	clientSignature := hmacSHA256(e.verifier.StoredKey, authMessage)
+
+	clientKey := make([]byte, len(proof))
+	for i := range proof {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+
+	storedKey := sha256.Sum256(clientKey)
+
+	if subtle.ConstantTimeCompare(storedKey[:], e.verifier.StoredKey) != 1 {
+		return nil, pserr.ErrInvalidUsernameOrPassword
+	}
+
+	serverSignature := hmacSHA256(e.verifier.ServerKey, authMessage)
+
+	return []byte("v=" + base64.StdEncoding.EncodeToString(serverSignature)), nil
+}
+
+// scramAttributes parses the comma separated attributes of a SCRAM message,
+// only the first occurrence of each attribute is considered
+func scramAttributes(msg []byte) map[byte]string {
+	attrs := make(map[byte]string)
+
+	for _, attr := range bytes.Split(msg, []byte(",")) {
+		if len(attr) < 2 || attr[1] != '=' {
+			continue
+		}
+
+		if _, ok := attrs[attr[0]]; !ok {
+			attrs[attr[0]] = string(attr[2:])
+		}
+	}
+
+	return attrs
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	// crypto/rand.Read never returns an error on supported platforms
+	rand.Read(b)
+	return b
+}
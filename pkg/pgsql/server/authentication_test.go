@@ -0,0 +1,534 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/auth"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	h "github.com/codenotary/immudb/pkg/pgsql/server/fmessages/fmessages_test"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestValidateAuthMethods(t *testing.T) {
+	require.NoError(t, ValidateAuthMethods(DefaultAuthMethods))
+	require.NoError(t, ValidateAuthMethods([]string{AuthMethodMD5}))
+	require.NoError(t, ValidateAuthMethods([]string{AuthMethodCert, AuthMethodSCRAMSHA256}))
+	require.ErrorIs(t, ValidateAuthMethods(nil), ErrUnsupportedAuthMethod)
+	require.ErrorIs(t, ValidateAuthMethods([]string{AuthMethodMD5, "trust"}), ErrUnsupportedAuthMethod)
+}
+
+func TestSelectAuthMethod(t *testing.T) {
+	u := &auth.User{Username: "user"}
+	_, err := u.SetPassword([]byte("pencil"))
+	require.NoError(t, err)
+
+	legacyUser := &auth.User{Username: "legacy"}
+
+	authenticator := &mockAuthenticator{users: map[string]*auth.User{
+		"user":   u,
+		"legacy": legacyUser,
+	}}
+
+	tests := []struct {
+		methods       []string
+		authenticator Authenticator
+		username      string
+		method        string
+		user          *auth.User
+		err           error
+	}{
+		{DefaultAuthMethods, authenticator, "user", AuthMethodSCRAMSHA256, u, nil},
+		{[]string{AuthMethodMD5, AuthMethodPassword}, authenticator, "user", AuthMethodMD5, u, nil},
+		{[]string{AuthMethodPassword}, authenticator, "user", AuthMethodPassword, nil, nil},
+		{DefaultAuthMethods, authenticator, "unknown", AuthMethodSCRAMSHA256, nil, nil},
+		{[]string{AuthMethodMD5}, authenticator, "unknown", AuthMethodMD5, nil, nil},
+		{DefaultAuthMethods, authenticator, "legacy", AuthMethodPassword, nil, nil},
+		{[]string{AuthMethodSCRAMSHA256, AuthMethodMD5}, authenticator, "legacy", "", nil, pserr.ErrAuthMethodNotAvailable},
+		{DefaultAuthMethods, nil, "user", AuthMethodPassword, nil, nil},
+		{[]string{AuthMethodSCRAMSHA256}, nil, "user", "", nil, pserr.ErrAuthMethodNotAvailable},
+	}
+
+	for _, tt := range tests {
+		s := &session{authMethods: tt.methods, authenticator: tt.authenticator}
+
+		method, user, err := s.selectAuthMethod(context.Background(), tt.username)
+		require.ErrorIs(t, err, tt.err)
+		require.Equal(t, tt.method, method)
+		require.Equal(t, tt.user, user)
+	}
+}
+
+func TestCertUserMappings(t *testing.T) {
+	_, err := ParseCertUserMapping("svc")
+	require.ErrorIs(t, err, ErrInvalidCertUserMapping)
+
+	_, err = ParseCertUserMappings([]string{"svc svc", "/^(svc $1"})
+	require.ErrorIs(t, err, ErrInvalidCertUserMapping)
+
+	mappings, err := ParseCertUserMappings([]string{
+		"admin@example.org immudb",
+		`/^spiffe://example\.org/sa/(.*)$ \1`,
+	})
+	require.NoError(t, err)
+	require.Len(t, mappings, 2)
+
+	require.True(t, mappings[0].maps("admin@example.org", "immudb"))
+	require.False(t, mappings[0].maps("admin@example.org", "svc"))
+	require.True(t, mappings[1].maps("spiffe://example.org/sa/svc", "svc"))
+	require.False(t, mappings[1].maps("spiffe://example.org/sa/svc", "immudb"))
+	require.False(t, mappings[1].maps("spiffe://other.org/sa/svc", "svc"))
+
+	spiffeID, err := url.Parse("spiffe://example.org/sa/svc")
+	require.NoError(t, err)
+
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "workload"},
+		DNSNames:       []string{"workload.example.org"},
+		EmailAddresses: []string{"admin@example.org"},
+		URIs:           []*url.URL{spiffeID},
+	}
+
+	require.Equal(t, []string{
+		"workload",
+		"workload.example.org",
+		"admin@example.org",
+		"spiffe://example.org/sa/svc",
+	}, certIdentities(cert))
+
+	s := &session{}
+	require.True(t, s.certAuthenticates(cert, "workload"))
+	require.False(t, s.certAuthenticates(cert, "svc"))
+
+	s.certUserMappings = mappings
+	require.False(t, s.certAuthenticates(cert, "workload"))
+	require.True(t, s.certAuthenticates(cert, "svc"))
+	require.True(t, s.certAuthenticates(cert, "immudb"))
+}
+
+func TestSCRAMExchange(t *testing.T) {
+	// test vector from RFC 7677
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+
+	verifier := auth.SCRAMVerifierFor([]byte("pencil"), salt, 4096)
+
+	t.Run("rfc test vector", func(t *testing.T) {
+		e := &scramExchange{
+			verifier:    verifier,
+			mechanism:   scramSHA256,
+			serverNonce: "%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0",
+		}
+
+		serverFirst, err := e.serverFirstMessage([]byte("n,,n=user,r=rOprNGfwEbeRWgbNEkqO"))
+		require.NoError(t, err)
+		require.Equal(t, "r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096", string(serverFirst))
+
+		serverFinal, err := e.serverFinalMessage([]byte("c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ="))
+		require.NoError(t, err)
+		require.Equal(t, "v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=", string(serverFinal))
+	})
+
+	t.Run("channel binding", func(t *testing.T) {
+		cbindData := []byte("tls-server-end-point-hash")
+
+		e := &scramExchange{
+			verifier:    verifier,
+			mechanism:   scramSHA256Plus,
+			cbindData:   cbindData,
+			plusOffered: true,
+		}
+
+		gs2Header := "p=tls-server-end-point,,"
+		clientFirstBare := "n=,r=clientnonce"
+
+		serverFirst, err := e.serverFirstMessage([]byte(gs2Header + clientFirstBare))
+		require.NoError(t, err)
+
+		clientFinal := scramClientFinal(t, "pencil", clientFirstBare, string(serverFirst), append([]byte(gs2Header), cbindData...))
+
+		_, err = e.serverFinalMessage([]byte(clientFinal))
+		require.NoError(t, err)
+	})
+
+	t.Run("channel binding mismatch", func(t *testing.T) {
+		e := &scramExchange{
+			verifier:    verifier,
+			mechanism:   scramSHA256Plus,
+			cbindData:   []byte("tls-server-end-point-hash"),
+			plusOffered: true,
+		}
+
+		gs2Header := "p=tls-server-end-point,,"
+		clientFirstBare := "n=,r=clientnonce"
+
+		serverFirst, err := e.serverFirstMessage([]byte(gs2Header + clientFirstBare))
+		require.NoError(t, err)
+
+		clientFinal := scramClientFinal(t, "pencil", clientFirstBare, string(serverFirst), []byte(gs2Header+"another-hash"))
+
+		_, err = e.serverFinalMessage([]byte(clientFinal))
+		require.ErrorIs(t, err, pserr.ErrAuthFailed)
+	})
+
+	t.Run("invalid password", func(t *testing.T) {
+		e := &scramExchange{
+			verifier:  verifier,
+			mechanism: scramSHA256,
+		}
+
+		clientFirstBare := "n=,r=clientnonce"
+
+		serverFirst, err := e.serverFirstMessage([]byte("n,," + clientFirstBare))
+		require.NoError(t, err)
+
+		clientFinal := scramClientFinal(t, "pen", clientFirstBare, string(serverFirst), []byte("n,,"))
+
+		_, err = e.serverFinalMessage([]byte(clientFinal))
+		require.ErrorIs(t, err, pserr.ErrInvalidUsernameOrPassword)
+	})
+
+	t.Run("invalid client first message", func(t *testing.T) {
+		tests := []struct {
+			mechanism   string
+			plusOffered bool
+			msg         string
+		}{
+			{"SCRAM-SHA-1", false, "n,,n=,r=nonce"},
+			{scramSHA256Plus, false, "p=tls-server-end-point,,n=,r=nonce"},
+			{scramSHA256Plus, true, "n,,n=,r=nonce"},
+			{scramSHA256, true, "y,,n=,r=nonce"},
+			{scramSHA256, true, "p=tls-server-end-point,,n=,r=nonce"},
+			{scramSHA256Plus, true, "p=tls-unique,,n=,r=nonce"},
+			{scramSHA256, false, "x,,n=,r=nonce"},
+			{scramSHA256, false, "n,a=admin,n=,r=nonce"},
+			{scramSHA256, false, "n,,n="},
+			{scramSHA256, false, "n=,r=nonce"},
+		}
+
+		for _, tt := range tests {
+			e := &scramExchange{
+				verifier:    verifier,
+				mechanism:   tt.mechanism,
+				plusOffered: tt.plusOffered,
+			}
+
+			_, err := e.serverFirstMessage([]byte(tt.msg))
+			require.ErrorIs(t, err, pserr.ErrAuthFailed, tt.msg)
+		}
+	})
+
+	t.Run("client supporting channel binding without server support", func(t *testing.T) {
+		e := &scramExchange{
+			verifier:  verifier,
+			mechanism: scramSHA256,
+		}
+
+		clientFirstBare := "n=,r=clientnonce"
+
+		serverFirst, err := e.serverFirstMessage([]byte("y,," + clientFirstBare))
+		require.NoError(t, err)
+
+		clientFinal := scramClientFinal(t, "pencil", clientFirstBare, string(serverFirst), []byte("y,,"))
+
+		_, err = e.serverFinalMessage([]byte(clientFinal))
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid client final message", func(t *testing.T) {
+		e := &scramExchange{
+			verifier:  verifier,
+			mechanism: scramSHA256,
+		}
+
+		serverFirst, err := e.serverFirstMessage([]byte("n,,n=,r=clientnonce"))
+		require.NoError(t, err)
+
+		nonce := strings.TrimPrefix(strings.Split(string(serverFirst), ",")[0], "r=")
+		proof := base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))
+
+		for _, msg := range []string{
+			"c=biws,r=" + nonce,
+			"c=biws,r=" + nonce + ",p=invalid",
+			"c=biws,r=" + nonce + ",p=" + base64.StdEncoding.EncodeToString([]byte("short")),
+			"c=eSws,r=" + nonce + ",p=" + proof,
+			"c=biws,r=clientnonce,p=" + proof,
+		} {
+			_, err := e.serverFinalMessage([]byte(msg))
+			require.ErrorIs(t, err, pserr.ErrAuthFailed, msg)
+		}
+	})
+}
+
+func TestSessionAuthentication(t *testing.T) {
+	u := &auth.User{Username: "user", Active: true}
+	_, err := u.SetPassword([]byte("pencil"))
+	require.NoError(t, err)
+
+	t.Run("scram", func(t *testing.T) {
+		authenticator := &mockAuthenticator{users: map[string]*auth.User{"user": u}}
+
+		s, c := newAuthTestSession(authenticator)
+
+		go func() {
+			// SASL mechanisms
+			readBackendMessage(c)
+
+			clientFirstBare := "n=,r=clientnonce"
+			c.Write(h.Msg('p', h.Join([][]byte{h.S(scramSHA256), h.I32(3 + len(clientFirstBare)), []byte("n,," + clientFirstBare)})))
+
+			serverFirst := readBackendMessage(c)[4:]
+			c.Write(h.Msg('p', []byte(scramClientFinal(t, "pencil", clientFirstBare, string(serverFirst), []byte("n,,")))))
+
+			// SASL final
+			readBackendMessage(c)
+		}()
+
+		err := s.authenticate(context.Background(), AuthMethodSCRAMSHA256, u, "defaultdb")
+		require.NoError(t, err)
+		require.Equal(t, "session-user", s.sessionID)
+
+		err = s.Close()
+		require.NoError(t, err)
+		require.Equal(t, []string{"session-user"}, authenticator.closedSessions)
+	})
+
+	t.Run("scram with unknown user", func(t *testing.T) {
+		s, c := newAuthTestSession(&mockAuthenticator{})
+
+		go func() {
+			readBackendMessage(c)
+
+			clientFirstBare := "n=,r=clientnonce"
+			c.Write(h.Msg('p', h.Join([][]byte{h.S(scramSHA256), h.I32(3 + len(clientFirstBare)), []byte("n,," + clientFirstBare)})))
+
+			serverFirst := readBackendMessage(c)[4:]
+			c.Write(h.Msg('p', []byte(scramClientFinal(t, "pencil", clientFirstBare, string(serverFirst), []byte("n,,")))))
+		}()
+
+		err := s.authenticate(context.Background(), AuthMethodSCRAMSHA256, nil, "defaultdb")
+		require.ErrorIs(t, err, pserr.ErrInvalidUsernameOrPassword)
+	})
+
+	t.Run("scram salt of unknown users should not change across attempts", func(t *testing.T) {
+		serverFirst := func() string {
+			s, c := newAuthTestSession(&mockAuthenticator{})
+			s.connParams = map[string]string{"user": "unknown"}
+			s.mockAuthSecret = []byte("secret")
+
+			done := make(chan string)
+
+			go func() {
+				readBackendMessage(c)
+
+				clientFirstBare := "n=,r=clientnonce"
+				c.Write(h.Msg('p', h.Join([][]byte{h.S(scramSHA256), h.I32(3 + len(clientFirstBare)), []byte("n,," + clientFirstBare)})))
+
+				serverFirst := readBackendMessage(c)[4:]
+				c.Write(h.Msg('p', []byte(scramClientFinal(t, "pencil", clientFirstBare, string(serverFirst), []byte("n,,")))))
+
+				done <- string(serverFirst)
+			}()
+
+			err := s.authenticate(context.Background(), AuthMethodSCRAMSHA256, nil, "defaultdb")
+			require.ErrorIs(t, err, pserr.ErrInvalidUsernameOrPassword)
+
+			return <-done
+		}
+
+		salt := func(serverFirst string) string {
+			return strings.Split(serverFirst, ",")[1]
+		}
+
+		first := serverFirst()
+		second := serverFirst()
+
+		require.NotEqual(t, first, second)
+		require.Equal(t, salt(first), salt(second))
+	})
+
+	t.Run("md5", func(t *testing.T) {
+		authenticator := &mockAuthenticator{users: map[string]*auth.User{"user": u}}
+
+		s, c := newAuthTestSession(authenticator)
+
+		go func() {
+			salt := readBackendMessage(c)[4:]
+
+			res, _ := auth.SaltedMD5Response(auth.MD5Verifier("user", []byte("pencil")), salt)
+			c.Write(h.Msg('p', h.S(res)))
+		}()
+
+		err := s.authenticate(context.Background(), AuthMethodMD5, u, "defaultdb")
+		require.NoError(t, err)
+		require.Equal(t, "session-user", s.sessionID)
+	})
+
+	t.Run("md5 with invalid password", func(t *testing.T) {
+		s, c := newAuthTestSession(&mockAuthenticator{users: map[string]*auth.User{"user": u}})
+
+		go func() {
+			salt := readBackendMessage(c)[4:]
+
+			res, _ := auth.SaltedMD5Response(auth.MD5Verifier("user", []byte("pen")), salt)
+			c.Write(h.Msg('p', h.S(res)))
+		}()
+
+		err := s.authenticate(context.Background(), AuthMethodMD5, u, "defaultdb")
+		require.ErrorIs(t, err, pserr.ErrInvalidUsernameOrPassword)
+	})
+
+	t.Run("unexpected message", func(t *testing.T) {
+		s, c := newAuthTestSession(&mockAuthenticator{users: map[string]*auth.User{"user": u}})
+
+		go func() {
+			readBackendMessage(c)
+			c.Write(h.Msg('Q', h.S("SELECT 1")))
+		}()
+
+		err := s.authenticate(context.Background(), AuthMethodMD5, u, "defaultdb")
+		require.ErrorIs(t, err, pserr.ErrMalformedMessage)
+	})
+
+	t.Run("session not opened", func(t *testing.T) {
+		authenticator := &mockAuthenticator{
+			users:          map[string]*auth.User{"user": u},
+			openSessionErr: errors.New("permission denied"),
+		}
+
+		s, c := newAuthTestSession(authenticator)
+
+		go func() {
+			salt := readBackendMessage(c)[4:]
+
+			res, _ := auth.SaltedMD5Response(u.MD5, salt)
+			c.Write(h.Msg('p', h.S(res)))
+		}()
+
+		err := s.authenticate(context.Background(), AuthMethodMD5, u, "defaultdb")
+		require.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func newAuthTestSession(authenticator Authenticator) (*session, net.Conn) {
+	c1, c2 := net.Pipe()
+
+	return &session{
+		log:           logger.NewSimpleLogger("test", os.Stdout),
+		mr:            &messageReader{conn: c1},
+		authMethods:   DefaultAuthMethods,
+		authenticator: authenticator,
+		statements:    make(map[string]*statement),
+		portals:       make(map[string]*portal),
+	}, c2
+}
+
+// readBackendMessage returns the payload of the next message sent by the server
+func readBackendMessage(c net.Conn) []byte {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(c, header); err != nil {
+		return nil
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+	if _, err := io.ReadFull(c, payload); err != nil {
+		return nil
+	}
+
+	return payload
+}
+
+// scramClientFinal computes the client-final-message of a SCRAM-SHA-256 exchange
+func scramClientFinal(t *testing.T, password, clientFirstBare, serverFirst string, cbindInput []byte) string {
+	var nonce, salt string
+	var iterations int
+
+	for _, attr := range strings.Split(serverFirst, ",") {
+		switch attr[0] {
+		case 'r':
+			nonce = attr[2:]
+		case 's':
+			salt = attr[2:]
+		case 'i':
+			for _, d := range attr[2:] {
+				iterations = iterations*10 + int(d-'0')
+			}
+		}
+	}
+
+	rawSalt, err := base64.StdEncoding.DecodeString(salt)
+	require.NoError(t, err)
+
+	clientFinalWithoutProof := "c=" + base64.StdEncoding.EncodeToString(cbindInput) + ",r=" + nonce
+
+	saltedPassword := pbkdf2.Key([]byte(password), rawSalt, iterations, sha256.Size, sha256.New)
+	clientKey := hmacSHA256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+
+	authMessage := clientFirstBare + "," + serverFirst + "," + clientFinalWithoutProof
+	clientSignature := hmacSHA256(storedKey[:], []byte(authMessage))
+
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+
+	return clientFinalWithoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)
+}
+
+type mockAuthenticator struct {
+	users          map[string]*auth.User
+	openSessionErr error
+	closedSessions []string
+}
+
+func (a *mockAuthenticator) GetUser(ctx context.Context, username string) (*auth.User, error) {
+	u, ok := a.users[username]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return u, nil
+}
+
+func (a *mockAuthenticator) OpenSession(ctx context.Context, user *auth.User, database string) (string, error) {
+	if a.openSessionErr != nil {
+		return "", a.openSessionErr
+	}
+	return "session-" + user.Username, nil
+}
+
+func (a *mockAuthenticator) KeepAlive(sessionID string) {}
+
+func (a *mockAuthenticator) CloseSession(sessionID string) error {
+	a.closedSessions = append(a.closedSessions, sessionID)
+	return nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// AuthenticationMD5Password specifies that an MD5-encrypted password is required, salted with the given 4 bytes
+func AuthenticationMD5Password(salt []byte) []byte {
+	messageType := []byte(`R`)
+	messageLength := make([]byte, 4)
+	message := make([]byte, 4)
+	binary.BigEndian.PutUint32(messageLength, uint32(8+len(salt)))
+	binary.BigEndian.PutUint32(message, uint32(5))
+	return bytes.Join([][]byte{messageType, messageLength, message, salt}, nil)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// AuthenticationSASL specifies that SASL authentication is required, listing the mechanisms
+// supported by the server in order of preference
+func AuthenticationSASL(mechanisms []string) []byte {
+	var body []byte
+	for _, m := range mechanisms {
+		body = append(body, m...)
+		body = append(body, 0)
+	}
+	body = append(body, 0)
+
+	return authenticationMessage(10, body)
+}
+
+// AuthenticationSASLContinue carries SASL data specific to the mechanism being used
+func AuthenticationSASLContinue(data []byte) []byte {
+	return authenticationMessage(11, data)
+}
+
+// AuthenticationSASLFinal carries the additional data sent by the server once SASL authentication is completed
+func AuthenticationSASLFinal(data []byte) []byte {
+	return authenticationMessage(12, data)
+}
+
+func authenticationMessage(code uint32, data []byte) []byte {
+	messageType := []byte(`R`)
+	messageLength := make([]byte, 4)
+	message := make([]byte, 4)
+	binary.BigEndian.PutUint32(messageLength, uint32(8+len(data)))
+	binary.BigEndian.PutUint32(message, code)
+	return bytes.Join([][]byte{messageType, messageLength, message, data}, nil)
+}
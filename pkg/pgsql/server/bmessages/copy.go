@@ -0,0 +1,61 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// CopyInResponse notifies the frontend that the server is ready to receive COPY data.
+// format is 0 for textual formats and 1 for binary, colFormats holds the format of each column
+func CopyInResponse(format int8, colFormats []int16) []byte {
+	return copyResponse('G', format, colFormats)
+}
+
+// CopyOutResponse notifies the frontend that COPY data is going to be sent
+func CopyOutResponse(format int8, colFormats []int16) []byte {
+	return copyResponse('H', format, colFormats)
+}
+
+func copyResponse(messageType byte, format int8, colFormats []int16) []byte {
+	messageLength := make([]byte, 4)
+	binary.BigEndian.PutUint32(messageLength, uint32(4+1+2+2*len(colFormats)))
+
+	colNumb := make([]byte, 2)
+	binary.BigEndian.PutUint16(colNumb, uint16(len(colFormats)))
+
+	formats := make([]byte, 2*len(colFormats))
+	for i, f := range colFormats {
+		binary.BigEndian.PutUint16(formats[i*2:], uint16(f))
+	}
+
+	return bytes.Join([][]byte{{messageType}, messageLength, {byte(format)}, colNumb, formats}, nil)
+}
+
+// CopyData carries a chunk of the COPY data stream
+func CopyData(data []byte) []byte {
+	messageLength := make([]byte, 4)
+	binary.BigEndian.PutUint32(messageLength, uint32(4+len(data)))
+	return bytes.Join([][]byte{[]byte(`d`), messageLength, data}, nil)
+}
+
+// CopyDone notifies the end of the COPY data stream
+func CopyDone() []byte {
+	messageLength := make([]byte, 4)
+	binary.BigEndian.PutUint32(messageLength, uint32(4))
+	return bytes.Join([][]byte{[]byte(`c`), messageLength}, nil)
+}
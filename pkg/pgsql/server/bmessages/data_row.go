@@ -19,9 +19,13 @@ package bmessages
 import (
 	"bytes"
 	"encoding/binary"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
+	"github.com/google/uuid"
 )
 
 // DataRow if ResultColumnFormatCodes is nil default text format is used
@@ -44,67 +48,24 @@ func DataRow(rows []*sql.Row, colNumb int, ResultColumnFormatCodes []int16) []by
 			}
 
 			valueLength := make([]byte, 4)
-			value := make([]byte, 0)
 
-			BINformat := false
-			if len(ResultColumnFormatCodes) == 1 {
-				BINformat = ResultColumnFormatCodes[0] == 1
-			}
-			if ResultColumnFormatCodes != nil && len(ResultColumnFormatCodes) > i && ResultColumnFormatCodes[i] == 1 {
-				BINformat = true
+			//  As a special case, -1 indicates a NULL column value. No value bytes follow in the NULL case.
+			if val.IsNull() {
+				n := int32(-1)
+				binary.BigEndian.PutUint32(valueLength, uint32(n))
+				rowB = append(rowB, valueLength...)
+				continue
 			}
-			if BINformat {
-				if val.IsNull() {
-					n := -1
-					binary.BigEndian.PutUint32(valueLength, uint32(n))
-				} else {
-					rv := val.RawValue()
-					switch val.Type() {
-					case sql.IntegerType:
-						{
-							binary.BigEndian.PutUint32(valueLength, uint32(8))
-							value = make([]byte, 8)
-							binary.BigEndian.PutUint64(value, uint64(rv.(int64)))
-						}
-					case sql.JSONType:
-						{
-							jsonStr := trimQuotes(val.String())
-							binary.BigEndian.PutUint32(valueLength, uint32(len(jsonStr)))
-							value = []byte(jsonStr)
-						}
-					case sql.VarcharType:
-						{
-							s := rv.(string)
-							binary.BigEndian.PutUint32(valueLength, uint32(len(s)))
-							value = []byte(s)
-						}
-					case sql.BooleanType:
-						{
-							binary.BigEndian.PutUint32(valueLength, uint32(1))
-							value = []byte{0}
-							if rv.(bool) {
-								value = []byte{1}
-							}
-						}
-					case sql.BLOBType:
-						{
-							blob := rv.([]byte)
-							binary.BigEndian.PutUint32(valueLength, uint32(len(blob)))
-							value = blob
-						}
-					}
-				}
+
+			var value []byte
+
+			if resultFormatCode(ResultColumnFormatCodes, i) == 1 {
+				value = EncodeBinaryValue(val)
 			} else {
-				// only text format is allowed in simple query
-				value = renderValueAsByte(val)
+				value = EncodeTextValue(val)
 			}
+
 			binary.BigEndian.PutUint32(valueLength, uint32(len(value)))
-			//  As a special case, -1 indicates a NULL column value. No value bytes follow in the NULL case.
-			if value == nil {
-				tm := int32(-1)
-				value = nil
-				binary.BigEndian.PutUint32(valueLength, uint32(tm))
-			}
 			rowB = append(rowB, bytes.Join([][]byte{valueLength, value}, nil)...)
 		}
 
@@ -117,11 +78,19 @@ func DataRow(rows []*sql.Row, colNumb int, ResultColumnFormatCodes []int16) []by
 	return rowsB
 }
 
-func renderValueAsByte(v sql.TypedValue) []byte {
-	if v.IsNull() {
-		return nil
+// resultFormatCode returns the format code of the i-th column, a single format code applies to all the columns
+func resultFormatCode(formatCodes []int16, i int) int16 {
+	if len(formatCodes) == 1 {
+		return formatCodes[0]
+	}
+	if i < len(formatCodes) {
+		return formatCodes[i]
 	}
+	return 0
+}
 
+// EncodeTextValue returns the text representation of a non-null value
+func EncodeTextValue(v sql.TypedValue) []byte {
 	var s string
 	switch v.Type() {
 	case sql.VarcharType:
@@ -134,6 +103,43 @@ func renderValueAsByte(v sql.TypedValue) []byte {
 	return []byte(s)
 }
 
+// EncodeBinaryValue returns the binary representation of a non-null value,
+// following the encoding of the PostgreSQL type the value is described with
+func EncodeBinaryValue(v sql.TypedValue) []byte {
+	switch v.Type() {
+	case sql.IntegerType:
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, uint64(v.RawValue().(int64)))
+		return value
+	case sql.Float64Type:
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, math.Float64bits(v.RawValue().(float64)))
+		return value
+	case sql.BooleanType:
+		if v.RawValue().(bool) {
+			return []byte{1}
+		}
+		return []byte{0}
+	case sql.BLOBType:
+		blob, _ := v.RawValue().([]byte)
+		if blob == nil {
+			return []byte{}
+		}
+		return blob
+	case sql.UUIDType:
+		u := v.RawValue().(uuid.UUID)
+		return u[:]
+	case sql.TimestampType:
+		value := make([]byte, 8)
+		micros := v.RawValue().(time.Time).Sub(pgmeta.PgEpoch).Microseconds()
+		binary.BigEndian.PutUint64(value, uint64(micros))
+		return value
+	}
+
+	// varchar and json values share the text and binary representations
+	return EncodeTextValue(v)
+}
+
 func trimQuotes(s string) string {
 	return strings.TrimSuffix(strings.TrimPrefix(s, "'"), "'")
 }
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmessages
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeBinaryValue(t *testing.T) {
+	ts := time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC)
+	u := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	require.Equal(t, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, EncodeBinaryValue(sql.NewInteger(-1)))
+	require.Equal(t, math.Float64bits(1.5), binary.BigEndian.Uint64(EncodeBinaryValue(sql.NewFloat64(1.5))))
+	require.Equal(t, uint64(1000000), binary.BigEndian.Uint64(EncodeBinaryValue(sql.NewTimestamp(ts))))
+	require.Equal(t, u[:], EncodeBinaryValue(sql.NewUUID(u)))
+	require.Equal(t, []byte{1}, EncodeBinaryValue(sql.NewBool(true)))
+	require.Equal(t, []byte{}, EncodeBinaryValue(sql.NewBlob(nil)))
+	require.Equal(t, []byte("text"), EncodeBinaryValue(sql.NewVarchar("text")))
+	require.Equal(t, []byte(`{"a":1}`), EncodeBinaryValue(sql.NewJson(map[string]interface{}{"a": 1})))
+}
+
+func TestDataRowFormatCodes(t *testing.T) {
+	rows := []*sql.Row{{ValuesByPosition: []sql.TypedValue{sql.NewInteger(1), sql.NewNull(sql.Float64Type)}}}
+
+	// a single format code applies to all the columns
+	msg := DataRow(rows, 2, []int16{1})
+	require.Equal(t, []byte{'D', 0, 0, 0, 4 + 2 + 4 + 8 + 4, 0, 2, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 1, 0xff, 0xff, 0xff, 0xff}, msg)
+
+	msg = DataRow(rows, 2, nil)
+	require.Equal(t, []byte{'D', 0, 0, 0, 4 + 2 + 4 + 1 + 4, 0, 2, 0, 0, 0, 1, '1', 0xff, 0xff, 0xff, 0xff}, msg)
+
+	require.Equal(t, pgmeta.PgTypeMap[sql.TimestampType][pgmeta.PgTypeMapOid], 1114)
+}
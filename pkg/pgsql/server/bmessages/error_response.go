@@ -44,7 +44,12 @@ func ErrorResponse(setters ...Option) *errorResp {
 
 //Encode encode in binary
 func (er *errorResp) Encode() []byte {
-	messageType := []byte(`E`)
+	return er.encode('E')
+}
+
+// encode serializes the fields with the specified message type, error and notice messages share the same format
+func (er *errorResp) encode(msgType byte) []byte {
+	messageType := []byte{msgType}
 	messageLength := make([]byte, 4)
 	body := make([]byte, 0)
 	for code, value := range er.fields {
@@ -44,3 +44,9 @@ func TestErrorResponse(t *testing.T) {
 	)
 	require.NotNil(t, er)
 }
+
+func TestNoticeResponse(t *testing.T) {
+	nr := NoticeResponse(Severity("NOTICE"), Message("test"))
+	require.Equal(t, byte('N'), nr[0])
+	require.Equal(t, ErrorResponse(Severity("NOTICE"), Message("test")).Encode()[1:5], nr[1:5])
+}
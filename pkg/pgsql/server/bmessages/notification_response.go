@@ -0,0 +1,41 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// NotificationResponse delivers an asynchronous notification raised on a channel the frontend is listening to.
+func NotificationResponse(pid int32, channel, payload string) []byte {
+	// Identifies the message as a notification response.
+	messageType := []byte(`A`)
+	selfMessageLength := make([]byte, 4)
+
+	// The process ID of the notifying backend process.
+	processID := make([]byte, 4)
+	binary.BigEndian.PutUint32(processID, uint32(pid))
+
+	// The name of the channel that the notify has been raised on.
+	channelName := append([]byte(channel), 0)
+	// The "payload" string passed from the notifying process.
+	payloadString := append([]byte(payload), 0)
+
+	binary.BigEndian.PutUint32(selfMessageLength, uint32(4+len(processID)+len(channelName)+len(payloadString)))
+	return bytes.Join([][]byte{messageType, selfMessageLength, processID, channelName, payloadString}, nil)
+}
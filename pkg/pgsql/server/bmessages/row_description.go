@@ -69,10 +69,7 @@ func RowDescription(cols []sql.ColDescriptor, formatCodes []int16) []byte {
 		// The format code being used for the field. Currently will be zero (text) or one (binary). In a RowDescription returned from the statement variant of Describe, the format code is not yet known and will always be zero.
 		// Int16
 		// In simple Query mode, the format of retrieved values is always text, except when the given command is a FETCH from a cursor declared with the BINARY option. In that case, the retrieved values are in binary format. The format codes given in the RowDescription message tell which format is being used.
-		fc := int16(0)
-		if len(formatCodes) >= n+1 {
-			fc = formatCodes[n]
-		}
+		fc := resultFormatCode(formatCodes, n)
 		formatCode := make([]byte, 2)
 		binary.BigEndian.PutUint16(formatCode, uint16(fc))
 
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CertUserMapping maps the identities of client certificates to immudb users, in the same way as
+// PostgreSQL user name maps. The identity is either matched literally or, when starting with a slash,
+// as a regular expression whose first parenthesized subexpression can be referenced with \1 in the user name.
+// The identities of a certificate are its subject common name and its DNS, email and URI alternative names.
+type CertUserMapping struct {
+	identity string
+	re       *regexp.Regexp
+	user     string
+}
+
+// ParseCertUserMapping parses a mapping in the form "<identity> <user>",
+// e.g. "/^spiffe://example.org/service/(.*)$ \1"
+func ParseCertUserMapping(s string) (*CertUserMapping, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("%w: certificate user mapping '%s' must be in the form '<identity> <user>'", ErrInvalidCertUserMapping, s)
+	}
+
+	m := &CertUserMapping{
+		identity: fields[0],
+		user:     fields[1],
+	}
+
+	if strings.HasPrefix(m.identity, "/") {
+		re, err := regexp.Compile(m.identity[1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCertUserMapping, err)
+		}
+		m.re = re
+	}
+
+	return m, nil
+}
+
+// ParseCertUserMappings parses a list of mappings, see ParseCertUserMapping
+func ParseCertUserMappings(ss []string) ([]*CertUserMapping, error) {
+	mappings := make([]*CertUserMapping, len(ss))
+
+	for i, s := range ss {
+		m, err := ParseCertUserMapping(s)
+		if err != nil {
+			return nil, err
+		}
+		mappings[i] = m
+	}
+
+	return mappings, nil
+}
+
+// maps returns true if the identity is mapped to the user
+func (m *CertUserMapping) maps(identity, user string) bool {
+	if m.re == nil {
+		return m.identity == identity && m.user == user
+	}
+
+	match := m.re.FindStringSubmatch(identity)
+	if match == nil {
+		return false
+	}
+
+	mappedUser := m.user
+	if len(match) > 1 {
+		mappedUser = strings.ReplaceAll(mappedUser, `\1`, match[1])
+	}
+
+	return mappedUser == user
+}
+
+func certIdentities(cert *x509.Certificate) []string {
+	var identities []string
+
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+
+	identities = append(identities, cert.DNSNames...)
+	identities = append(identities, cert.EmailAddresses...)
+
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	return identities
+}
+
+// clientCertificate returns the client certificate verified during the TLS handshake, nil is returned
+// if the connection is not secured with TLS, no certificate was presented or no client CAs are configured
+func (s *session) clientCertificate() *x509.Certificate {
+	conn, ok := s.mr.Connection().(*tls.Conn)
+	if !ok || s.tlsConfig == nil || s.tlsConfig.ClientCAs == nil {
+		return nil
+	}
+
+	state := conn.ConnectionState()
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return state.VerifiedChains[0][0]
+}
+
+// certAuthenticates returns true if the client certificate identifies the user. Without mappings,
+// any of the identities of the certificate must be the user name, otherwise a mapping is required.
+func (s *session) certAuthenticates(cert *x509.Certificate, user string) bool {
+	for _, identity := range certIdentities(cert) {
+		if len(s.certUserMappings) == 0 && identity == user {
+			return true
+		}
+
+		for _, m := range s.certUserMappings {
+			if m.maps(identity, user) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
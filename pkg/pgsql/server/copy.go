@@ -0,0 +1,972 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
+	fm "github.com/codenotary/immudb/pkg/pgsql/server/fmessages"
+	"github.com/google/uuid"
+)
+
+// DefaultCopyRowsPerTx is the default number of rows inserted by each transaction of a COPY FROM STDIN
+const DefaultCopyRowsPerTx = 1000
+
+var copyBinarySignature = []byte("PGCOPY\n\xff\r\n\x00")
+
+func (s *session) copy(st *copyStmt) error {
+	if st.from {
+		if s.readOnly() {
+			return pserr.ErrReadOnlyTransaction
+		}
+		return s.copyFrom(st)
+	}
+	return s.copyTo(st)
+}
+
+// copyFrom inserts the rows sent by the frontend in batches of at most copyRowsPerTx rows.
+// Unless COPY is executed inside an explicit transaction, each batch is committed on its own,
+// thus rows stored by previous batches are kept if the operation fails.
+func (s *session) copyFrom(st *copyStmt) error {
+	cols, err := s.copyColumns(st)
+	if err != nil {
+		return err
+	}
+
+	colNames := make([]string, len(cols))
+	for i, col := range cols {
+		colNames[i] = col.Column
+	}
+
+	var format int8
+	if st.format == copyFormatBinary {
+		format = 1
+	}
+
+	colFormats := make([]int16, len(cols))
+	for i := range colFormats {
+		colFormats[i] = int16(format)
+	}
+
+	if _, err := s.writeMessage(bm.CopyInResponse(format, colFormats)); err != nil {
+		return err
+	}
+
+	in := &copyInReader{mr: s.mr}
+
+	var rows copyRowReader
+
+	switch st.format {
+	case copyFormatBinary:
+		rows = newBinaryCopyRowReader(in, cols, s.timeZone())
+	case copyFormatCSV:
+		rows = newCSVCopyRowReader(in, st, cols, s.timeZone())
+	default:
+		rows = newTextCopyRowReader(in, st, cols, s.timeZone())
+	}
+
+	rowsPerTx := s.copyRowsPerTx
+	if rowsPerTx <= 0 {
+		rowsPerTx = DefaultCopyRowsPerTx
+	}
+
+	// batches can only be split when each one is committed on its own
+	splittable := s.tx == nil
+
+	batch := make([]*sql.RowSpec, 0, rowsPerTx)
+	copied := 0
+
+	flush := func() error {
+		fitting, err := s.copyInsert(st.table, colNames, batch, splittable)
+		if err != nil {
+			return err
+		}
+
+		copied += len(batch)
+		batch = batch[:0]
+
+		if fitting < rowsPerTx {
+			rowsPerTx = fitting
+		}
+
+		return nil
+	}
+
+	for {
+		values, err := rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		batch = append(batch, sql.NewRowSpec(values))
+
+		if len(batch) >= rowsPerTx {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// data following the end-of-data marker is ignored, but it's still required to wait for the end of the copy
+	if _, err := io.Copy(io.Discard, in); err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.writeMessage(bm.CommandComplete([]byte(fmt.Sprintf("COPY %d", copied))))
+	return err
+}
+
+// copyInsert stores the rows and returns the number of rows which fitted into a single transaction.
+// Rows exceeding the maximum number of entries per transaction are split in halves when allowed.
+func (s *session) copyInsert(table string, cols []string, rows []*sql.RowSpec, splittable bool) (int, error) {
+	stmt := sql.NewUpsertIntoStmt(table, cols, sql.NewValuesDataSource(rows), true, nil)
+
+	err := s.exec(stmt, nil, nil, false)
+	if err == nil {
+		return len(rows), nil
+	}
+
+	if !splittable || len(rows) < 2 || !errors.Is(err, store.ErrMaxTxEntriesLimitExceeded) {
+		return 0, err
+	}
+
+	half := len(rows) / 2
+
+	n1, err := s.copyInsert(table, cols, rows[:half], splittable)
+	if err != nil {
+		return 0, err
+	}
+
+	n2, err := s.copyInsert(table, cols, rows[half:], splittable)
+	if err != nil {
+		return 0, err
+	}
+
+	if n2 < n1 {
+		return n2, nil
+	}
+	return n1, nil
+}
+
+// copyColumns returns the descriptors of the columns involved in the COPY,
+// all the columns of the table are used if none was specified
+func (s *session) copyColumns(st *copyStmt) ([]sql.ColDescriptor, error) {
+	sel, err := parseCopySelect(copyTableQuery(st))
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := s.db.SQLQueryPrepared(s.ctx, s.tx, sel, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return reader.Columns(s.ctx)
+}
+
+func copyTableQuery(st *copyStmt) string {
+	targets := "*"
+
+	if len(st.columns) > 0 {
+		quoted := make([]string, len(st.columns))
+		for i, col := range st.columns {
+			quoted[i] = `"` + col + `"`
+		}
+		targets = strings.Join(quoted, ", ")
+	}
+
+	return fmt.Sprintf(`SELECT %s FROM "%s"`, targets, st.table)
+}
+
+func parseCopySelect(query string) (*sql.SelectStmt, error) {
+	stmts, err := sql.ParseSQL(strings.NewReader(removePGCatalogReferences(query)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("%w: a single SELECT statement is expected", pserr.ErrInvalidCopyStmt)
+	}
+
+	sel, ok := stmts[0].(*sql.SelectStmt)
+	if !ok {
+		return nil, fmt.Errorf("%w: a SELECT statement is expected", pserr.ErrInvalidCopyStmt)
+	}
+
+	return sel, nil
+}
+
+func (s *session) copyTo(st *copyStmt) error {
+	query := st.query
+	if query == "" {
+		query = copyTableQuery(st)
+	}
+
+	sel, err := parseCopySelect(query)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.sqlTx()
+	if err != nil {
+		return err
+	}
+
+	reader, err := s.db.SQLQueryPrepared(s.ctx, tx, sel, nil)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	cols, err := reader.Columns(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	var format int8
+	if st.format == copyFormatBinary {
+		format = 1
+	}
+
+	colFormats := make([]int16, len(cols))
+	for i := range colFormats {
+		colFormats[i] = int16(format)
+	}
+
+	if _, err := s.writeMessage(bm.CopyOutResponse(format, colFormats)); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	switch {
+	case st.format == copyFormatBinary:
+		buf.Write(copyBinarySignature)
+		buf.Write(make([]byte, 8)) // flags and header extension length
+	case st.header:
+		for i, col := range cols {
+			if i > 0 {
+				buf.WriteByte(st.delimiter)
+			}
+			writeCopyTextField(&buf, st, col.Column)
+		}
+		buf.WriteByte('\n')
+	}
+
+	if buf.Len() > 0 {
+		if _, err := s.writeMessage(bm.CopyData(buf.Bytes())); err != nil {
+			return err
+		}
+	}
+
+	copied := 0
+
+	err = sql.ReadRowsBatch(s.ctx, reader, maxRowsPerMessage, func(rowBatch []*sql.Row) error {
+		var msgs bytes.Buffer
+
+		for _, row := range rowBatch {
+			buf.Reset()
+
+			localizeRow(row, s.timeZone())
+
+			if err := encodeCopyRow(&buf, st, row); err != nil {
+				return err
+			}
+
+			msgs.Write(bm.CopyData(buf.Bytes()))
+		}
+
+		copied += len(rowBatch)
+
+		_, err := s.writeMessage(msgs.Bytes())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var end []byte
+
+	if st.format == copyFormatBinary {
+		end = bm.CopyData([]byte{0xff, 0xff})
+	}
+
+	end = append(end, bm.CopyDone()...)
+	end = append(end, bm.CommandComplete([]byte(fmt.Sprintf("COPY %d", copied)))...)
+
+	_, err = s.writeMessage(end)
+	return err
+}
+
+// copyInReader provides the content of the CopyData messages sent by the frontend,
+// io.EOF is returned once CopyDone is received
+type copyInReader struct {
+	mr   MessageReader
+	data []byte
+	done bool
+}
+
+func (r *copyInReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		msg, err := r.mr.ReadRawMessage()
+		if err != nil {
+			return 0, err
+		}
+
+		switch msg.t {
+		case 'd':
+			r.data = msg.payload
+		case 'c':
+			r.done = true
+		case 'f':
+			m, err := fm.ParseCopyFailMsg(msg.payload)
+			if err != nil {
+				return 0, err
+			}
+			return 0, fmt.Errorf("%w: %s", pserr.ErrCopyFailed, m.Message)
+		case 'H', 'S':
+			// Flush and Sync are ignored during copy-in mode
+		default:
+			return 0, fmt.Errorf("%w: unexpected message type '%c' during COPY from stdin", pserr.ErrMalformedMessage, msg.t)
+		}
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+// copyRowReader returns the values of the next row or io.EOF once there are no more rows
+type copyRowReader interface {
+	Read() ([]sql.ValueExp, error)
+}
+
+type textCopyRowReader struct {
+	r    *bufio.Reader
+	st   *copyStmt
+	cols []sql.ColDescriptor
+	loc  *time.Location
+	line int
+}
+
+func newTextCopyRowReader(r io.Reader, st *copyStmt, cols []sql.ColDescriptor, loc *time.Location) *textCopyRowReader {
+	return &textCopyRowReader{
+		r:    bufio.NewReader(r),
+		st:   st,
+		cols: cols,
+		loc:  loc,
+	}
+}
+
+func (r *textCopyRowReader) Read() ([]sql.ValueExp, error) {
+	for {
+		line, err := r.r.ReadString('\n')
+		if errors.Is(err, io.EOF) && len(line) == 0 {
+			return nil, io.EOF
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		r.line++
+
+		line = strings.TrimSuffix(line, "\n")
+		line = strings.TrimSuffix(line, "\r")
+
+		if line == `\.` {
+			return nil, io.EOF
+		}
+
+		if r.line == 1 && r.st.header {
+			continue
+		}
+
+		fields, err := splitCopyTextLine(line, r.st)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", pserr.ErrInvalidCopyData, r.line, err.Error())
+		}
+
+		return decodeCopyTextRow(fields, r.cols, r.line, r.loc)
+	}
+}
+
+// splitCopyTextLine returns the unescaped fields of a line in text format, nil fields are NULL
+func splitCopyTextLine(line string, st *copyStmt) ([]*string, error) {
+	var fields []*string
+
+	var field strings.Builder
+	start := 0
+
+	emit := func(end int) {
+		if line[start:end] == st.null {
+			fields = append(fields, nil)
+		} else {
+			v := field.String()
+			fields = append(fields, &v)
+		}
+
+		field.Reset()
+		start = end + 1
+	}
+
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+
+		if ch == st.delimiter {
+			emit(i)
+			continue
+		}
+
+		if ch != '\\' {
+			field.WriteByte(ch)
+			continue
+		}
+
+		if i+1 == len(line) {
+			return nil, errors.New("unterminated escape sequence")
+		}
+
+		i++
+		ch = line[i]
+
+		switch {
+		case ch == 'b':
+			field.WriteByte('\b')
+		case ch == 'f':
+			field.WriteByte('\f')
+		case ch == 'n':
+			field.WriteByte('\n')
+		case ch == 'r':
+			field.WriteByte('\r')
+		case ch == 't':
+			field.WriteByte('\t')
+		case ch == 'v':
+			field.WriteByte('\v')
+		case ch >= '0' && ch <= '7':
+			v := int(ch - '0')
+			for n := 1; n < 3 && i+1 < len(line) && line[i+1] >= '0' && line[i+1] <= '7'; n++ {
+				i++
+				v = v*8 + int(line[i]-'0')
+			}
+			field.WriteByte(byte(v))
+		case ch == 'x' && i+1 < len(line) && isHexDigit(line[i+1]):
+			v := hexDigit(line[i+1])
+			i++
+			if i+1 < len(line) && isHexDigit(line[i+1]) {
+				v = v*16 + hexDigit(line[i+1])
+				i++
+			}
+			field.WriteByte(v)
+		default:
+			field.WriteByte(ch)
+		}
+	}
+
+	emit(len(line))
+
+	return fields, nil
+}
+
+func isHexDigit(ch byte) bool {
+	return (ch >= '0' && ch <= '9') || (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+}
+
+func hexDigit(ch byte) byte {
+	switch {
+	case ch >= 'a':
+		return ch - 'a' + 10
+	case ch >= 'A':
+		return ch - 'A' + 10
+	default:
+		return ch - '0'
+	}
+}
+
+type csvCopyRowReader struct {
+	r    *bufio.Reader
+	st   *copyStmt
+	cols []sql.ColDescriptor
+	loc  *time.Location
+	line int
+}
+
+func newCSVCopyRowReader(r io.Reader, st *copyStmt, cols []sql.ColDescriptor, loc *time.Location) *csvCopyRowReader {
+	return &csvCopyRowReader{
+		r:    bufio.NewReader(r),
+		st:   st,
+		cols: cols,
+		loc:  loc,
+	}
+}
+
+func (r *csvCopyRowReader) Read() ([]sql.ValueExp, error) {
+	for {
+		fields, err := r.readRecord()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) == 1 && fields[0] != nil && *fields[0] == `\.` {
+			return nil, io.EOF
+		}
+
+		if r.line == 1 && r.st.header {
+			continue
+		}
+
+		return decodeCopyTextRow(fields, r.cols, r.line, r.loc)
+	}
+}
+
+// readRecord returns the fields of the next CSV record, unquoted fields matching the NULL string are returned as nil
+func (r *csvCopyRowReader) readRecord() ([]*string, error) {
+	var fields []*string
+
+	var field []byte
+	quoted := false
+	inQuotes := false
+	started := false
+
+	emit := func() {
+		v := string(field)
+
+		if !quoted && v == r.st.null {
+			fields = append(fields, nil)
+		} else {
+			fields = append(fields, &v)
+		}
+
+		field = field[:0]
+		quoted = false
+	}
+
+	for {
+		ch, err := r.r.ReadByte()
+		if errors.Is(err, io.EOF) {
+			if inQuotes {
+				return nil, fmt.Errorf("%w: line %d: unterminated CSV quoted field", pserr.ErrInvalidCopyData, r.line+1)
+			}
+			if !started {
+				return nil, io.EOF
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		started = true
+
+		if inQuotes {
+			if ch == r.st.escape {
+				next, err := r.r.Peek(1)
+				if err == nil && (next[0] == r.st.quote || next[0] == r.st.escape) {
+					r.r.ReadByte()
+					field = append(field, next[0])
+					continue
+				}
+			}
+
+			if ch == r.st.quote {
+				inQuotes = false
+				continue
+			}
+
+			field = append(field, ch)
+			continue
+		}
+
+		if ch == r.st.quote {
+			inQuotes = true
+			quoted = true
+			continue
+		}
+
+		if ch == r.st.delimiter {
+			emit()
+			continue
+		}
+
+		if ch == '\r' {
+			next, err := r.r.Peek(1)
+			if err == nil && next[0] == '\n' {
+				r.r.ReadByte()
+			}
+			break
+		}
+
+		if ch == '\n' {
+			break
+		}
+
+		field = append(field, ch)
+	}
+
+	emit()
+
+	r.line++
+
+	return fields, nil
+}
+
+func decodeCopyTextRow(fields []*string, cols []sql.ColDescriptor, line int, loc *time.Location) ([]sql.ValueExp, error) {
+	if len(fields) < len(cols) {
+		return nil, fmt.Errorf("%w: line %d: missing data for column '%s'", pserr.ErrInvalidCopyData, line, cols[len(fields)].Column)
+	}
+
+	if len(fields) > len(cols) {
+		return nil, fmt.Errorf("%w: line %d: extra data after last expected column", pserr.ErrInvalidCopyData, line)
+	}
+
+	values := make([]sql.ValueExp, len(cols))
+
+	for i, col := range cols {
+		v, err := decodeCopyTextValue(fields[i], col.Type, loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: column '%s': %s", pserr.ErrInvalidCopyData, line, col.Column, err.Error())
+		}
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+func decodeCopyTextValue(field *string, t sql.SQLValueType, loc *time.Location) (sql.ValueExp, error) {
+	if field == nil {
+		return sql.NewNull(t), nil
+	}
+
+	s := *field
+
+	switch t {
+	case sql.IntegerType:
+		v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer value '%s'", s)
+		}
+		return sql.NewInteger(v), nil
+	case sql.Float64Type:
+		v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float value '%s'", s)
+		}
+		return sql.NewFloat64(v), nil
+	case sql.BooleanType:
+		v, err := parseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NewBool(v), nil
+	case sql.VarcharType:
+		return sql.NewVarchar(s), nil
+	case sql.BLOBType:
+		v, err := parseBytea(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blob value '%s'", s)
+		}
+		return sql.NewBlob(v), nil
+	case sql.UUIDType:
+		v, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uuid value '%s'", s)
+		}
+		return sql.NewUUID(v), nil
+	case sql.TimestampType:
+		v, err := parseTimestamp(s, loc)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NewTimestamp(v), nil
+	case sql.JSONType:
+		v, err := sql.NewJsonFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid json value '%s'", s)
+		}
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+type binaryCopyRowReader struct {
+	r    *bufio.Reader
+	cols []sql.ColDescriptor
+	loc  *time.Location
+
+	headerRead bool
+	row        int
+}
+
+func newBinaryCopyRowReader(r io.Reader, cols []sql.ColDescriptor, loc *time.Location) *binaryCopyRowReader {
+	return &binaryCopyRowReader{
+		r:    bufio.NewReader(r),
+		cols: cols,
+		loc:  loc,
+	}
+}
+
+func (r *binaryCopyRowReader) readHeader() error {
+	header := make([]byte, len(copyBinarySignature)+8)
+
+	_, err := io.ReadFull(r.r, header)
+	if err != nil || !bytes.Equal(header[:len(copyBinarySignature)], copyBinarySignature) {
+		return fmt.Errorf("%w: COPY file signature not recognized", pserr.ErrInvalidCopyData)
+	}
+
+	flags := binary.BigEndian.Uint32(header[len(copyBinarySignature):])
+	if flags&(1<<16) != 0 {
+		return fmt.Errorf("%w: OIDs are not supported", pserr.ErrInvalidCopyData)
+	}
+
+	extLen := binary.BigEndian.Uint32(header[len(copyBinarySignature)+4:])
+
+	_, err = io.CopyN(io.Discard, r.r, int64(extLen))
+	if err != nil {
+		return fmt.Errorf("%w: invalid COPY file header", pserr.ErrInvalidCopyData)
+	}
+
+	r.headerRead = true
+
+	return nil
+}
+
+func (r *binaryCopyRowReader) Read() ([]sql.ValueExp, error) {
+	if !r.headerRead {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	var fieldCount int16
+
+	err := binary.Read(r.r, binary.BigEndian, &fieldCount)
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: unexpected end of data", pserr.ErrInvalidCopyData)
+	}
+
+	// file trailer
+	if fieldCount == -1 {
+		return nil, io.EOF
+	}
+
+	r.row++
+
+	if int(fieldCount) != len(r.cols) {
+		return nil, fmt.Errorf("%w: row %d: expected %d fields but got %d", pserr.ErrInvalidCopyData, r.row, len(r.cols), fieldCount)
+	}
+
+	values := make([]sql.ValueExp, len(r.cols))
+
+	for i, col := range r.cols {
+		var fieldLen int32
+
+		err := binary.Read(r.r, binary.BigEndian, &fieldLen)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unexpected end of data", pserr.ErrInvalidCopyData)
+		}
+
+		if fieldLen < 0 {
+			values[i] = sql.NewNull(col.Type)
+			continue
+		}
+
+		data := make([]byte, fieldLen)
+
+		_, err = io.ReadFull(r.r, data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unexpected end of data", pserr.ErrInvalidCopyData)
+		}
+
+		v, err := decodeCopyBinaryValue(data, col.Type, r.loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: column '%s': %s", pserr.ErrInvalidCopyData, r.row, col.Column, err.Error())
+		}
+
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+func decodeCopyBinaryValue(data []byte, t sql.SQLValueType, loc *time.Location) (sql.ValueExp, error) {
+	switch t {
+	case sql.IntegerType:
+		v, err := getInt64(data)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NewInteger(v), nil
+	case sql.Float64Type:
+		v, err := getFloat64(data)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NewFloat64(v), nil
+	case sql.BooleanType:
+		if len(data) != 1 {
+			return nil, fmt.Errorf("invalid %d bytes long BOOLEAN value", len(data))
+		}
+		return sql.NewBool(data[0] != 0), nil
+	case sql.VarcharType:
+		return sql.NewVarchar(string(data)), nil
+	case sql.BLOBType:
+		return sql.NewBlob(data), nil
+	case sql.UUIDType:
+		v, err := uuid.FromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %d bytes long UUID value", len(data))
+		}
+		return sql.NewUUID(v), nil
+	case sql.TimestampType:
+		v, err := getTimestamp(data, loc)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NewTimestamp(v), nil
+	case sql.JSONType:
+		v, err := sql.NewJsonFromString(string(trimJSONBVersion(data)))
+		if err != nil {
+			return nil, errors.New("invalid json value")
+		}
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func encodeCopyRow(buf *bytes.Buffer, st *copyStmt, row *sql.Row) error {
+	if st.format == copyFormatBinary {
+		binary.Write(buf, binary.BigEndian, int16(len(row.ValuesByPosition)))
+
+		for _, v := range row.ValuesByPosition {
+			if v.IsNull() {
+				binary.Write(buf, binary.BigEndian, int32(-1))
+				continue
+			}
+
+			data := bm.EncodeBinaryValue(v)
+
+			binary.Write(buf, binary.BigEndian, int32(len(data)))
+			buf.Write(data)
+		}
+
+		return nil
+	}
+
+	for i, v := range row.ValuesByPosition {
+		if i > 0 {
+			buf.WriteByte(st.delimiter)
+		}
+
+		if v.IsNull() {
+			buf.WriteString(st.null)
+			continue
+		}
+
+		writeCopyTextField(buf, st, string(bm.EncodeTextValue(v)))
+	}
+
+	buf.WriteByte('\n')
+
+	return nil
+}
+
+func writeCopyTextField(buf *bytes.Buffer, st *copyStmt, s string) {
+	if st.format == copyFormatCSV {
+		writeCopyCSVField(buf, st, s)
+		return
+	}
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		switch ch {
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\v':
+			buf.WriteString(`\v`)
+		default:
+			if ch == st.delimiter {
+				buf.WriteByte('\\')
+			}
+			buf.WriteByte(ch)
+		}
+	}
+}
+
+func writeCopyCSVField(buf *bytes.Buffer, st *copyStmt, s string) {
+	needsQuotes := s == st.null || s == `\.` ||
+		strings.IndexByte(s, st.delimiter) >= 0 ||
+		strings.IndexByte(s, st.quote) >= 0 ||
+		strings.ContainsAny(s, "\r\n")
+
+	if !needsQuotes {
+		buf.WriteString(s)
+		return
+	}
+
+	buf.WriteByte(st.quote)
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == st.quote || s[i] == st.escape {
+			buf.WriteByte(st.escape)
+		}
+		buf.WriteByte(s[i])
+	}
+
+	buf.WriteByte(st.quote)
+}
@@ -0,0 +1,536 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+)
+
+var copyPrefix = regexp.MustCompile(`(?is)^\s*copy\b`)
+
+const (
+	copyFormatText   = "text"
+	copyFormatCSV    = "csv"
+	copyFormatBinary = "binary"
+)
+
+// copyStmt is a COPY statement exchanging data with the frontend:
+//
+//	COPY table [ ( column [, ...] ) ] FROM STDIN [ [ WITH ] ( option [, ...] ) ]
+//	COPY { table [ ( column [, ...] ) ] | ( query ) } TO STDOUT [ [ WITH ] ( option [, ...] ) ]
+//
+// Options of the pre-9.0 syntax (BINARY, CSV, HEADER, DELIMITER [AS], NULL [AS], QUOTE [AS], ESCAPE [AS]) are accepted as well.
+type copyStmt struct {
+	table   string
+	columns []string
+	query   string
+
+	from bool
+
+	format    string
+	delimiter byte
+	null      string
+	header    bool
+	quote     byte
+	escape    byte
+}
+
+// parseCopyStmt returns nil if the statement is not a COPY statement
+func parseCopyStmt(statement string) (*copyStmt, error) {
+	if !copyPrefix.MatchString(statement) {
+		return nil, nil
+	}
+
+	t := &copyTokenizer{s: strings.TrimSpace(statement)}
+
+	t.next() // COPY
+
+	st := &copyStmt{}
+
+	tkn := t.peek()
+
+	if tkn.kind == '(' {
+		query, err := t.parenthesized()
+		if err != nil {
+			return nil, err
+		}
+		st.query = query
+	} else {
+		table, err := t.qualifiedIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		st.table = table
+
+		if t.peek().kind == '(' {
+			t.next()
+
+			for {
+				col := t.next()
+				if col.kind != tokenIdentifier {
+					return nil, fmt.Errorf("%w: column name expected", pserr.ErrInvalidCopyStmt)
+				}
+				st.columns = append(st.columns, col.val)
+
+				sep := t.next()
+				if sep.kind == ')' {
+					break
+				}
+				if sep.kind != ',' {
+					return nil, fmt.Errorf("%w: ',' or ')' expected", pserr.ErrInvalidCopyStmt)
+				}
+			}
+		}
+	}
+
+	direction := t.next()
+	endpoint := t.next()
+
+	switch {
+	case direction.isKeyword("from"):
+		if !endpoint.isKeyword("stdin") {
+			return nil, fmt.Errorf("%w: only COPY FROM STDIN is supported", pserr.ErrInvalidCopyStmt)
+		}
+		if st.query != "" {
+			return nil, fmt.Errorf("%w: COPY FROM requires a table", pserr.ErrInvalidCopyStmt)
+		}
+		st.from = true
+	case direction.isKeyword("to"):
+		if !endpoint.isKeyword("stdout") {
+			return nil, fmt.Errorf("%w: only COPY TO STDOUT is supported", pserr.ErrInvalidCopyStmt)
+		}
+	default:
+		return nil, fmt.Errorf("%w: FROM or TO expected", pserr.ErrInvalidCopyStmt)
+	}
+
+	opts, err := t.options()
+	if err != nil {
+		return nil, err
+	}
+
+	err = st.applyOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+type copyOption struct {
+	name  string
+	value string
+	set   bool
+}
+
+func (st *copyStmt) applyOptions(opts []copyOption) error {
+	var delimiter, null, quote, escape *string
+
+	st.format = copyFormatText
+
+	for i := range opts {
+		opt := opts[i]
+
+		switch opt.name {
+		case "format":
+			switch strings.ToLower(opt.value) {
+			case copyFormatText, copyFormatCSV, copyFormatBinary:
+				st.format = strings.ToLower(opt.value)
+			default:
+				return fmt.Errorf("%w: unsupported format '%s'", pserr.ErrInvalidCopyStmt, opt.value)
+			}
+		case "binary", "csv":
+			st.format = opt.name
+		case "header":
+			header, err := parseCopyBool(opt)
+			if err != nil {
+				return err
+			}
+			st.header = header
+		case "delimiter":
+			delimiter = &opts[i].value
+		case "null":
+			null = &opts[i].value
+		case "quote":
+			quote = &opts[i].value
+		case "escape":
+			escape = &opts[i].value
+		default:
+			return fmt.Errorf("%w: unsupported option '%s'", pserr.ErrInvalidCopyStmt, opt.name)
+		}
+	}
+
+	if st.format == copyFormatBinary {
+		if delimiter != nil || null != nil || quote != nil || escape != nil || st.header {
+			return fmt.Errorf("%w: options are not allowed in BINARY mode", pserr.ErrInvalidCopyStmt)
+		}
+		return nil
+	}
+
+	if st.format == copyFormatText && (quote != nil || escape != nil) {
+		return fmt.Errorf("%w: QUOTE and ESCAPE are only available using CSV format", pserr.ErrInvalidCopyStmt)
+	}
+
+	st.delimiter = '\t'
+	st.null = `\N`
+
+	if st.format == copyFormatCSV {
+		st.delimiter = ','
+		st.null = ""
+		st.quote = '"'
+	}
+
+	if delimiter != nil {
+		if len(*delimiter) != 1 || *delimiter == "\n" || *delimiter == "\r" {
+			return fmt.Errorf("%w: delimiter must be a single one-byte character", pserr.ErrInvalidCopyStmt)
+		}
+		st.delimiter = (*delimiter)[0]
+	}
+
+	if null != nil {
+		st.null = *null
+	}
+
+	if quote != nil {
+		if len(*quote) != 1 {
+			return fmt.Errorf("%w: quote must be a single one-byte character", pserr.ErrInvalidCopyStmt)
+		}
+		st.quote = (*quote)[0]
+	}
+
+	st.escape = st.quote
+
+	if escape != nil {
+		if len(*escape) != 1 {
+			return fmt.Errorf("%w: escape must be a single one-byte character", pserr.ErrInvalidCopyStmt)
+		}
+		st.escape = (*escape)[0]
+	}
+
+	if st.delimiter == st.quote && st.format == copyFormatCSV {
+		return fmt.Errorf("%w: delimiter and quote must be different", pserr.ErrInvalidCopyStmt)
+	}
+
+	if strings.IndexByte(st.null, st.delimiter) >= 0 {
+		return fmt.Errorf("%w: delimiter must not appear in the NULL specification", pserr.ErrInvalidCopyStmt)
+	}
+
+	return nil
+}
+
+func parseCopyBool(opt copyOption) (bool, error) {
+	if !opt.set {
+		return true, nil
+	}
+
+	switch strings.ToLower(opt.value) {
+	case "true", "on", "1":
+		return true, nil
+	case "false", "off", "0":
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%w: %s requires a boolean value", pserr.ErrInvalidCopyStmt, opt.name)
+}
+
+const (
+	tokenEOF        = 0
+	tokenIdentifier = 'i'
+	tokenString     = 's'
+)
+
+type copyToken struct {
+	kind   byte
+	val    string
+	quoted bool
+}
+
+func (t copyToken) isKeyword(kw string) bool {
+	return t.kind == tokenIdentifier && !t.quoted && t.val == kw
+}
+
+type copyTokenizer struct {
+	s   string
+	pos int
+
+	peeked  *copyToken
+	peekPos int
+}
+
+func (t *copyTokenizer) skipSpaces() {
+	for t.pos < len(t.s) && isCopySpace(t.s[t.pos]) {
+		t.pos++
+	}
+}
+
+func (t *copyTokenizer) peek() copyToken {
+	if t.peeked == nil {
+		t.peekPos = t.pos
+		tkn := t.read()
+		t.peeked = &tkn
+	}
+	return *t.peeked
+}
+
+func (t *copyTokenizer) next() copyToken {
+	tkn := t.peek()
+	t.peeked = nil
+	return tkn
+}
+
+func (t *copyTokenizer) read() copyToken {
+	t.skipSpaces()
+
+	if t.pos >= len(t.s) {
+		return copyToken{kind: tokenEOF}
+	}
+
+	ch := t.s[t.pos]
+
+	switch {
+	case ch == '(' || ch == ')' || ch == ',' || ch == ';' || ch == '.':
+		t.pos++
+		return copyToken{kind: ch}
+	case ch == '"':
+		val, ok := t.quoted('"')
+		if !ok {
+			return copyToken{kind: ch}
+		}
+		return copyToken{kind: tokenIdentifier, val: strings.ToLower(val), quoted: true}
+	case ch == '\'':
+		val, ok := t.quoted('\'')
+		if !ok {
+			return copyToken{kind: ch}
+		}
+		return copyToken{kind: tokenString, val: val}
+	case (ch == 'e' || ch == 'E') && t.pos+1 < len(t.s) && t.s[t.pos+1] == '\'':
+		t.pos++
+		val, ok := t.quoted('\'')
+		if !ok {
+			return copyToken{kind: ch}
+		}
+		return copyToken{kind: tokenString, val: unescapeCopyString(val)}
+	}
+
+	start := t.pos
+	for t.pos < len(t.s) && isCopyWordChar(t.s[t.pos]) {
+		t.pos++
+	}
+
+	if start == t.pos {
+		t.pos++
+		return copyToken{kind: t.s[start]}
+	}
+
+	return copyToken{kind: tokenIdentifier, val: strings.ToLower(t.s[start:t.pos])}
+}
+
+// quoted reads a quoted string, where the quote character is escaped by doubling it
+func (t *copyTokenizer) quoted(q byte) (string, bool) {
+	var sb strings.Builder
+
+	t.pos++ // opening quote
+
+	for t.pos < len(t.s) {
+		ch := t.s[t.pos]
+		t.pos++
+
+		if ch == q {
+			if t.pos < len(t.s) && t.s[t.pos] == q {
+				sb.WriteByte(q)
+				t.pos++
+				continue
+			}
+			return sb.String(), true
+		}
+
+		sb.WriteByte(ch)
+	}
+
+	return "", false
+}
+
+// parenthesized returns the raw content between the next pair of matching parenthesis
+func (t *copyTokenizer) parenthesized() (string, error) {
+	if t.peeked != nil {
+		t.pos = t.peekPos
+		t.peeked = nil
+	}
+
+	t.skipSpaces()
+
+	start := t.pos + 1
+	depth := 0
+
+	for t.pos < len(t.s) {
+		ch := t.s[t.pos]
+
+		switch ch {
+		case '\'', '"':
+			if _, ok := t.quoted(ch); !ok {
+				return "", fmt.Errorf("%w: unterminated quoted string", pserr.ErrInvalidCopyStmt)
+			}
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				t.pos++
+				return strings.TrimSpace(t.s[start : t.pos-1]), nil
+			}
+		}
+
+		t.pos++
+	}
+
+	return "", fmt.Errorf("%w: unbalanced parenthesis", pserr.ErrInvalidCopyStmt)
+}
+
+// qualifiedIdentifier returns the table name, an optional schema qualifier is discarded
+func (t *copyTokenizer) qualifiedIdentifier() (string, error) {
+	id := t.next()
+	if id.kind != tokenIdentifier {
+		return "", fmt.Errorf("%w: table name expected", pserr.ErrInvalidCopyStmt)
+	}
+
+	for t.peek().kind == '.' {
+		t.next()
+
+		id = t.next()
+		if id.kind != tokenIdentifier {
+			return "", fmt.Errorf("%w: table name expected", pserr.ErrInvalidCopyStmt)
+		}
+	}
+
+	return id.val, nil
+}
+
+func (t *copyTokenizer) options() ([]copyOption, error) {
+	var opts []copyOption
+
+	if t.peek().isKeyword("with") {
+		t.next()
+	}
+
+	if t.peek().kind == '(' {
+		t.next()
+
+		for {
+			name := t.next()
+			if name.kind != tokenIdentifier {
+				return nil, fmt.Errorf("%w: option name expected", pserr.ErrInvalidCopyStmt)
+			}
+
+			opt := copyOption{name: name.val}
+
+			if v := t.peek(); v.kind == tokenIdentifier || v.kind == tokenString {
+				t.next()
+				opt.value = v.val
+				opt.set = true
+			}
+
+			opts = append(opts, opt)
+
+			sep := t.next()
+			if sep.kind == ')' {
+				break
+			}
+			if sep.kind != ',' {
+				return nil, fmt.Errorf("%w: ',' or ')' expected", pserr.ErrInvalidCopyStmt)
+			}
+		}
+	} else {
+		for t.peek().kind == tokenIdentifier {
+			name := t.next()
+
+			opt := copyOption{name: name.val}
+
+			switch name.val {
+			case "delimiter", "null", "quote", "escape":
+				if t.peek().isKeyword("as") {
+					t.next()
+				}
+
+				v := t.next()
+				if v.kind != tokenString {
+					return nil, fmt.Errorf("%w: %s requires a string value", pserr.ErrInvalidCopyStmt, name.val)
+				}
+
+				opt.value = v.val
+				opt.set = true
+			}
+
+			opts = append(opts, opt)
+		}
+	}
+
+	if t.peek().kind == ';' {
+		t.next()
+	}
+
+	if t.peek().kind != tokenEOF {
+		return nil, fmt.Errorf("%w: unexpected input at position %d", pserr.ErrInvalidCopyStmt, t.pos)
+	}
+
+	return opts, nil
+}
+
+func isCopySpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+func isCopyWordChar(ch byte) bool {
+	return ch == '_' || ch == '$' ||
+		(ch >= 'a' && ch <= 'z') ||
+		(ch >= 'A' && ch <= 'Z') ||
+		(ch >= '0' && ch <= '9')
+}
+
+// unescapeCopyString interprets the backslash escapes of E'...' strings
+func unescapeCopyString(s string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		i++
+
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+
+	return sb.String()
+}
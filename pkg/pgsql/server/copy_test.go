@@ -0,0 +1,300 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCopyStmt(t *testing.T) {
+	t.Run("not a copy statement", func(t *testing.T) {
+		st, err := parseCopyStmt("SELECT * FROM copy")
+		require.NoError(t, err)
+		require.Nil(t, st)
+	})
+
+	t.Run("copy from stdin with defaults", func(t *testing.T) {
+		st, err := parseCopyStmt("copy Items from stdin;")
+		require.NoError(t, err)
+		require.Equal(t, &copyStmt{
+			table:     "items",
+			from:      true,
+			format:    copyFormatText,
+			delimiter: '\t',
+			null:      `\N`,
+		}, st)
+	})
+
+	t.Run("copy from stdin as issued by pgx", func(t *testing.T) {
+		st, err := parseCopyStmt(`copy "public"."Items" ( "id", "name" ) from stdin binary;`)
+		require.NoError(t, err)
+		require.Equal(t, &copyStmt{
+			table:   "items",
+			columns: []string{"id", "name"},
+			from:    true,
+			format:  copyFormatBinary,
+		}, st)
+	})
+
+	t.Run("copy from stdin with options", func(t *testing.T) {
+		st, err := parseCopyStmt(`COPY items (id) FROM STDIN WITH (FORMAT csv, HEADER false, DELIMITER ';', NULL 'null', QUOTE '''', ESCAPE E'\\')`)
+		require.NoError(t, err)
+		require.Equal(t, &copyStmt{
+			table:     "items",
+			columns:   []string{"id"},
+			from:      true,
+			format:    copyFormatCSV,
+			delimiter: ';',
+			null:      "null",
+			quote:     '\'',
+			escape:    '\\',
+		}, st)
+	})
+
+	t.Run("copy from stdin with legacy options", func(t *testing.T) {
+		st, err := parseCopyStmt(`COPY items FROM STDIN CSV HEADER DELIMITER AS '|' NULL AS ''`)
+		require.NoError(t, err)
+		require.Equal(t, &copyStmt{
+			table:     "items",
+			from:      true,
+			format:    copyFormatCSV,
+			delimiter: '|',
+			header:    true,
+			quote:     '"',
+			escape:    '"',
+		}, st)
+	})
+
+	t.Run("copy query to stdout", func(t *testing.T) {
+		st, err := parseCopyStmt(`COPY (SELECT id, ')' FROM items WHERE (id > 1)) TO STDOUT`)
+		require.NoError(t, err)
+		require.Equal(t, `SELECT id, ')' FROM items WHERE (id > 1)`, st.query)
+		require.False(t, st.from)
+	})
+
+	for _, stmt := range []string{
+		"COPY",
+		"COPY items",
+		"COPY items TO '/tmp/items'",
+		"COPY items FROM '/tmp/items'",
+		"COPY items FROM PROGRAM 'ls'",
+		"COPY (SELECT * FROM items) FROM STDIN",
+		"COPY (SELECT * FROM items TO STDOUT",
+		"COPY items (id name) FROM STDIN",
+		"COPY items FROM STDIN WITH (FORMAT xml)",
+		"COPY items FROM STDIN WITH (FREEZE)",
+		"COPY items FROM STDIN WITH (HEADER maybe)",
+		"COPY items FROM STDIN WITH (DELIMITER 'ab')",
+		"COPY items FROM STDIN WITH (FORMAT binary, HEADER)",
+		"COPY items FROM STDIN WITH (QUOTE '''')",
+		"COPY items FROM STDIN WITH (FORMAT csv, DELIMITER '\"')",
+		"COPY items FROM STDIN WITH (NULL 'a\tb')",
+		"COPY items FROM STDIN DELIMITER ','x",
+		"COPY items FROM STDIN DELIMITER 'unterminated",
+	} {
+		t.Run(stmt, func(t *testing.T) {
+			_, err := parseCopyStmt(stmt)
+			require.ErrorIs(t, err, pserr.ErrInvalidCopyStmt)
+		})
+	}
+}
+
+func TestCopyTextRowReader(t *testing.T) {
+	st, err := parseCopyStmt("COPY items FROM STDIN WITH (HEADER)")
+	require.NoError(t, err)
+
+	cols := []sql.ColDescriptor{
+		{Column: "id", Type: sql.IntegerType},
+		{Column: "name", Type: sql.VarcharType},
+		{Column: "active", Type: sql.BooleanType},
+		{Column: "ts", Type: sql.TimestampType},
+	}
+
+	data := "id\tname\tactive\tts\n" +
+		"1\ta\\tb\\\\c\\101\\x42\tyes\t2024-01-02T03:04:05Z\r\n" +
+		"2\t\\N\toff\t\\N\n" +
+		"\\.\n" +
+		"ignored\n"
+
+	r := newTextCopyRowReader(strings.NewReader(data), st, cols, time.UTC)
+
+	values, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, []sql.ValueExp{
+		sql.NewInteger(1),
+		sql.NewVarchar("a\tb\\cAB"),
+		sql.NewBool(true),
+		sql.NewTimestamp(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}, values)
+
+	values, err = r.Read()
+	require.NoError(t, err)
+	require.Equal(t, []sql.ValueExp{
+		sql.NewInteger(2),
+		sql.NewNull(sql.VarcharType),
+		sql.NewBool(false),
+		sql.NewNull(sql.TimestampType),
+	}, values)
+
+	_, err = r.Read()
+	require.ErrorIs(t, err, io.EOF)
+
+	for _, line := range []string{
+		"1\ta\ttrue\n",
+		"1\ta\ttrue\t2024-01-02\textra\n",
+		"x\ta\ttrue\t2024-01-02\n",
+		"1\ta\tmaybe\t2024-01-02\n",
+		"1\ta\ttrue\tyesterday\n",
+		"1\ta\\",
+	} {
+		r := newTextCopyRowReader(strings.NewReader(line), st, cols, time.UTC)
+		r.line = 1
+
+		_, err := r.Read()
+		require.ErrorIs(t, err, pserr.ErrInvalidCopyData)
+	}
+}
+
+func TestCopyCSVRowReader(t *testing.T) {
+	st, err := parseCopyStmt("COPY items FROM STDIN WITH (FORMAT csv, ESCAPE '\\')")
+	require.NoError(t, err)
+
+	cols := []sql.ColDescriptor{
+		{Column: "id", Type: sql.IntegerType},
+		{Column: "name", Type: sql.VarcharType},
+	}
+
+	data := "1,\"multi\nline, \\\"quoted\\\"\"\r\n" +
+		"2,\n" +
+		"3,\"\"\n" +
+		"4,\"unterminated\n"
+
+	r := newCSVCopyRowReader(strings.NewReader(data), st, cols, time.UTC)
+
+	values, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, []sql.ValueExp{sql.NewInteger(1), sql.NewVarchar("multi\nline, \"quoted\"")}, values)
+
+	values, err = r.Read()
+	require.NoError(t, err)
+	require.Equal(t, []sql.ValueExp{sql.NewInteger(2), sql.NewNull(sql.VarcharType)}, values)
+
+	values, err = r.Read()
+	require.NoError(t, err)
+	require.Equal(t, []sql.ValueExp{sql.NewInteger(3), sql.NewVarchar("")}, values)
+
+	_, err = r.Read()
+	require.ErrorIs(t, err, pserr.ErrInvalidCopyData)
+}
+
+func TestCopyBinaryRoundTrip(t *testing.T) {
+	st, err := parseCopyStmt("COPY items TO STDOUT BINARY")
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	row := &sql.Row{
+		ValuesByPosition: []sql.TypedValue{
+			sql.NewInteger(-1),
+			sql.NewVarchar("name"),
+			sql.NewBool(true),
+			sql.NewFloat64(1.5),
+			sql.NewBlob([]byte{1, 2}),
+			sql.NewTimestamp(ts),
+			sql.NewNull(sql.VarcharType),
+		},
+	}
+
+	cols := []sql.ColDescriptor{
+		{Column: "id", Type: sql.IntegerType},
+		{Column: "name", Type: sql.VarcharType},
+		{Column: "active", Type: sql.BooleanType},
+		{Column: "amount", Type: sql.Float64Type},
+		{Column: "data", Type: sql.BLOBType},
+		{Column: "ts", Type: sql.TimestampType},
+		{Column: "title", Type: sql.VarcharType},
+	}
+
+	var buf bytes.Buffer
+	buf.Write(copyBinarySignature)
+	buf.Write(make([]byte, 8))
+
+	err = encodeCopyRow(&buf, st, row)
+	require.NoError(t, err)
+
+	binary.Write(&buf, binary.BigEndian, int16(-1))
+
+	r := newBinaryCopyRowReader(&buf, cols, time.UTC)
+
+	values, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, []sql.ValueExp{
+		sql.NewInteger(-1),
+		sql.NewVarchar("name"),
+		sql.NewBool(true),
+		sql.NewFloat64(1.5),
+		sql.NewBlob([]byte{1, 2}),
+		sql.NewTimestamp(ts),
+		sql.NewNull(sql.VarcharType),
+	}, values)
+
+	_, err = r.Read()
+	require.ErrorIs(t, err, io.EOF)
+
+	r = newBinaryCopyRowReader(strings.NewReader("PGCOPY\n"), cols, time.UTC)
+
+	_, err = r.Read()
+	require.ErrorIs(t, err, pserr.ErrInvalidCopyData)
+}
+
+func TestEncodeCopyTextRow(t *testing.T) {
+	row := &sql.Row{
+		ValuesByPosition: []sql.TypedValue{
+			sql.NewInteger(1),
+			sql.NewVarchar("a\tb,\"c\"\n"),
+			sql.NewNull(sql.VarcharType),
+			sql.NewVarchar(""),
+		},
+	}
+
+	st, err := parseCopyStmt("COPY items TO STDOUT")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	err = encodeCopyRow(&buf, st, row)
+	require.NoError(t, err)
+	require.Equal(t, "1\ta\\tb,\"c\"\\n\t\\N\t\n", buf.String())
+
+	st, err = parseCopyStmt("COPY items TO STDOUT CSV")
+	require.NoError(t, err)
+
+	buf.Reset()
+
+	err = encodeCopyRow(&buf, st, row)
+	require.NoError(t, err)
+	require.Equal(t, "1,\"a\tb,\"\"c\"\"\n\",,\"\"\n", buf.String())
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fmessages
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+)
+
+var ErrInvalidCloseType = errors.New("invalid close message type")
+
+// The Close message closes an existing prepared statement or portal and releases resources. It is not an error to
+// issue Close against a nonexistent statement or portal name. The response is normally CloseComplete, but could be
+// ErrorResponse if some difficulty is encountered while releasing resources. Note that closing a prepared statement
+// implicitly closes any open portals that were constructed from that statement.
+type CloseMsg struct {
+	// 'S' to close a prepared statement; or 'P' to close a portal.
+	CloseType string
+	// The name of the prepared statement or portal to close (an empty string selects the unnamed prepared statement or portal).
+	Name string
+}
+
+func ParseCloseMsg(payload []byte) (CloseMsg, error) {
+	b := bytes.NewBuffer(payload)
+	r := bufio.NewReaderSize(b, len(payload)+1)
+
+	closeType, err := r.ReadByte()
+	if err != nil {
+		return CloseMsg{}, err
+	}
+	if closeType != 'S' && closeType != 'P' {
+		return CloseMsg{}, ErrInvalidCloseType
+	}
+
+	name, err := getNextString(r)
+	if err != nil {
+		return CloseMsg{}, err
+	}
+
+	return CloseMsg{
+		CloseType: string(closeType),
+		Name:      name,
+	}, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fmessages
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	h "github.com/codenotary/immudb/pkg/pgsql/server/fmessages/fmessages_test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseMsg(t *testing.T) {
+	var tests = []struct {
+		in  []byte
+		out CloseMsg
+		e   error
+	}{
+		{h.Join([][]byte{[]byte("P"), h.S("port")}),
+			CloseMsg{
+				CloseType: "P",
+				Name:      "port",
+			},
+			nil,
+		},
+		{h.Join([][]byte{[]byte("S"), h.S("")}),
+			CloseMsg{
+				CloseType: "S",
+			},
+			nil,
+		},
+		{h.Join([][]byte{[]byte("X"), h.S("port")}),
+			CloseMsg{},
+			ErrInvalidCloseType,
+		},
+		{h.Join([][]byte{}),
+			CloseMsg{},
+			io.EOF,
+		},
+		{h.Join([][]byte{[]byte("P")}),
+			CloseMsg{},
+			io.EOF,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d_close", i), func(t *testing.T) {
+			s, err := ParseCloseMsg(tt.in)
+			require.Equal(t, tt.out, s)
+			require.ErrorIs(t, err, tt.e)
+		})
+	}
+}
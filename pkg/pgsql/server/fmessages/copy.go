@@ -0,0 +1,55 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fmessages
+
+import (
+	"bufio"
+	"bytes"
+)
+
+// CopyDataMsg carries a chunk of the COPY data stream sent by the frontend
+type CopyDataMsg struct {
+	Data []byte
+}
+
+func ParseCopyDataMsg(payload []byte) (CopyDataMsg, error) {
+	return CopyDataMsg{Data: payload}, nil
+}
+
+// CopyDoneMsg notifies the end of the COPY data stream sent by the frontend
+type CopyDoneMsg struct{}
+
+func ParseCopyDoneMsg(payload []byte) (CopyDoneMsg, error) {
+	return CopyDoneMsg{}, nil
+}
+
+// CopyFailMsg notifies the COPY operation must be aborted
+type CopyFailMsg struct {
+	// An error message to report as the cause of failure.
+	Message string
+}
+
+func ParseCopyFailMsg(payload []byte) (CopyFailMsg, error) {
+	b := bytes.NewBuffer(payload)
+	r := bufio.NewReaderSize(b, len(payload))
+
+	msg, err := getNextString(r)
+	if err != nil {
+		return CopyFailMsg{}, err
+	}
+
+	return CopyFailMsg{Message: msg}, nil
+}
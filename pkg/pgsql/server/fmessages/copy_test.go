@@ -0,0 +1,60 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fmessages
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	h "github.com/codenotary/immudb/pkg/pgsql/server/fmessages/fmessages_test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyFailMsg(t *testing.T) {
+	var tests = []struct {
+		in  []byte
+		out CopyFailMsg
+		e   error
+	}{
+		{h.S("client failure"),
+			CopyFailMsg{Message: "client failure"},
+			nil,
+		},
+		{[]byte("unterminated"),
+			CopyFailMsg{},
+			io.EOF,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d_copy_fail", i), func(t *testing.T) {
+			s, err := ParseCopyFailMsg(tt.in)
+			require.Equal(t, tt.out, s)
+			require.ErrorIs(t, err, tt.e)
+		})
+	}
+}
+
+func TestCopyDataMsg(t *testing.T) {
+	s, err := ParseCopyDataMsg([]byte("1\tone\n"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1\tone\n"), s.Data)
+
+	_, err = ParseCopyDoneMsg(nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fmessages
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// SASLInitialResponseMsg is the first message sent by the client during a SASL authentication,
+// selecting the mechanism and optionally carrying its initial response
+type SASLInitialResponseMsg struct {
+	// Name of the SASL authentication mechanism that the client selected.
+	Mechanism string
+	// SASL mechanism specific "Initial Response", nil when it's not present.
+	Data []byte
+}
+
+func ParseSASLInitialResponseMsg(payload []byte) (SASLInitialResponseMsg, error) {
+	b := bytes.NewBuffer(payload)
+	r := bufio.NewReaderSize(b, len(payload))
+
+	mechanism, err := getNextString(r)
+	if err != nil {
+		return SASLInitialResponseMsg{}, err
+	}
+
+	dataLen, err := getNextInt32(r)
+	if err != nil {
+		return SASLInitialResponseMsg{}, err
+	}
+
+	if dataLen < 0 {
+		return SASLInitialResponseMsg{Mechanism: mechanism}, nil
+	}
+
+	data := make([]byte, dataLen)
+
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return SASLInitialResponseMsg{}, err
+	}
+
+	return SASLInitialResponseMsg{
+		Mechanism: mechanism,
+		Data:      data,
+	}, nil
+}
+
+// SASLResponseMsg carries SASL mechanism specific data sent by the client
+type SASLResponseMsg struct {
+	Data []byte
+}
+
+func ParseSASLResponseMsg(payload []byte) (SASLResponseMsg, error) {
+	return SASLResponseMsg{Data: payload}, nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fmessages
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	h "github.com/codenotary/immudb/pkg/pgsql/server/fmessages/fmessages_test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSASLInitialResponseMsg(t *testing.T) {
+	var tests = []struct {
+		in  []byte
+		out SASLInitialResponseMsg
+		e   error
+	}{
+		{h.Join([][]byte{h.S("SCRAM-SHA-256"), h.I32(4), []byte("n,,x")}),
+			SASLInitialResponseMsg{
+				Mechanism: "SCRAM-SHA-256",
+				Data:      []byte("n,,x"),
+			},
+			nil,
+		},
+		{h.Join([][]byte{h.S("SCRAM-SHA-256"), h.I32(-1)}),
+			SASLInitialResponseMsg{
+				Mechanism: "SCRAM-SHA-256",
+			},
+			nil,
+		},
+		{h.Join([][]byte{}),
+			SASLInitialResponseMsg{},
+			io.EOF,
+		},
+		{h.Join([][]byte{h.S("SCRAM-SHA-256")}),
+			SASLInitialResponseMsg{},
+			io.EOF,
+		},
+		{h.Join([][]byte{h.S("SCRAM-SHA-256"), h.I32(4), []byte("n,")}),
+			SASLInitialResponseMsg{},
+			io.ErrUnexpectedEOF,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d_sasl_initial_response", i), func(t *testing.T) {
+			s, err := ParseSASLInitialResponseMsg(tt.in)
+			require.Equal(t, tt.out, s)
+			require.ErrorIs(t, err, tt.e)
+		})
+	}
+}
+
+func TestSASLResponseMsg(t *testing.T) {
+	s, err := ParseSASLResponseMsg([]byte("c=biws,r=nonce,p=proof"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("c=biws,r=nonce,p=proof"), s.Data)
+}
@@ -169,7 +169,31 @@ func (s *session) HandleStartup(ctx context.Context) (err error) {
 		return err
 	}
 
-	if _, err = s.writeMessage(bm.AuthenticationCleartextPassword()); err != nil {
+	method, u, err := s.selectAuthMethod(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	err = s.authenticate(ctx, method, u, db)
+	if err != nil {
+		return err
+	}
+
+	s.log.Debugf("authentication successful for %s", user)
+	if _, err := s.writeMessage(bm.AuthenticationOk()); err != nil {
+		return err
+	}
+
+	s.applyStartupParams()
+
+	// server_version is needed by jdbc driver. Here is added the minor supported version at the moment
+	return s.writeParamsStatus()
+}
+
+// authenticatePassword receives the password in cleartext and uses it to open
+// a session through the immudb grpc server
+func (s *session) authenticatePassword(ctx context.Context, db string) error {
+	if _, err := s.writeMessage(bm.AuthenticationCleartextPassword()); err != nil {
 		return err
 	}
 
@@ -203,37 +227,22 @@ func (s *session) HandleStartup(ctx context.Context) (err error) {
 
 	s.client = client.NewClient().WithOptions(opts)
 
-	err = s.client.OpenSession(ctx, []byte(user), []byte(pw.GetSecret()), db)
+	err = s.client.OpenSession(ctx, []byte(s.user), []byte(pw.GetSecret()), db)
 	if err != nil {
 		return err
 	}
 
 	s.client.CurrentState(context.Background())
 
-	sessionID := s.client.GetSessionID()
-	s.ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", sessionID))
-
-	s.log.Debugf("authentication successful for %s", user)
-	if _, err := s.writeMessage(bm.AuthenticationOk()); err != nil {
-		return err
-	}
-
-	if _, err := s.writeMessage(bm.ParameterStatus([]byte("standard_conforming_strings"), []byte("on"))); err != nil {
-		return err
-	}
-
-	if _, err := s.writeMessage(bm.ParameterStatus([]byte("client_encoding"), []byte("UTF8"))); err != nil {
-		return err
-	}
-
-	// todo this is needed by jdbc driver. Here is added the minor supported version at the moment
-	if _, err := s.writeMessage(bm.ParameterStatus([]byte("server_version"), []byte(pgmeta.PgsqlServerVersion))); err != nil {
-		return err
-	}
+	s.ctx = newSessionContext(s.client.GetSessionID())
 
 	return nil
 }
 
+func newSessionContext(sessionID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", sessionID))
+}
+
 func parseProtocolVersion(payload []byte) string {
 	major := int(binary.BigEndian.Uint16(payload[0:2]))
 	minor := int(binary.BigEndian.Uint16(payload[2:4]))
@@ -247,9 +256,21 @@ func isValidProtocolVersion(version string) bool {
 func (s *session) Close() error {
 	s.mr.CloseConnection()
 
+	s.closePortals()
+
+	if s.listener != nil {
+		s.notifier.unlistenAll(s.db, s.listener)
+		s.listener.close()
+	}
+
 	if s.client != nil {
 		return s.client.CloseSession(s.ctx)
 	}
 
+	if s.keepAliveDone != nil {
+		close(s.keepAliveDone)
+		return s.authenticator.CloseSession(s.sessionID)
+	}
+
 	return nil
 }
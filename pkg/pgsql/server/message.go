@@ -19,6 +19,7 @@ package server
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 	"math"
 	"net"
 
@@ -70,7 +71,12 @@ func (r *messageReader) ReadRawMessage() (*rawMessage, error) {
 		return nil, errors.ErrMessageTooLarge
 	}
 	payload := make([]byte, pLen)
-	if _, err := r.conn.Read(payload); err != nil {
+	n, err := r.conn.Read(payload)
+	if err != nil {
+		return nil, err
+	}
+	// large payloads, such as the ones of CopyData messages, may not be received by a single read
+	if _, err := io.ReadFull(r.conn, payload[n:]); err != nil {
 		return nil, err
 	}
 
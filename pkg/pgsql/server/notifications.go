@@ -0,0 +1,562 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
+)
+
+const (
+	// tableChannelPrefix prefixes the built-in channels on which the changes committed to each table are notified
+	tableChannelPrefix = "immudb_table_"
+
+	// maxNotificationPayloadLen is the maximum length of a notification payload, as in PostgreSQL
+	maxNotificationPayloadLen = 7999
+
+	// maxPendingNotifications bounds the notifications queued while a session is busy, further ones are dropped
+	maxPendingNotifications = 10000
+
+	// notifierPID is the process ID reported in the notifications, backend process IDs are not assigned
+	notifierPID = 0
+)
+
+type notification struct {
+	channel string
+	payload string
+}
+
+// tableChangePayload is the payload of the notifications raised on the channel of a table,
+// primary keys made of several columns are notified as arrays of values
+type tableChangePayload struct {
+	TxID      uint64        `json:"tx"`
+	Keys      []interface{} `json:"keys,omitempty"`
+	Deleted   []interface{} `json:"deleted,omitempty"`
+	Truncated bool          `json:"truncated,omitempty"`
+}
+
+// notifier dispatches the notifications raised in each database to the sessions listening to their channels.
+// Changes committed to tables are notified by a watcher of the database transactions, which runs as long as
+// there are sessions listening to the channel of any of its tables.
+type notifier struct {
+	mutex sync.Mutex
+	log   logger.Logger
+	dbs   map[string]*dbChannels
+}
+
+type dbChannels struct {
+	listeners     map[string]map[*listener]struct{}
+	tableChannels int
+	stopWatching  context.CancelFunc
+}
+
+func newNotifier(log logger.Logger) *notifier {
+	return &notifier{
+		log: log,
+		dbs: make(map[string]*dbChannels),
+	}
+}
+
+func isTableChannel(channel string) bool {
+	return strings.HasPrefix(channel, tableChannelPrefix)
+}
+
+func (n *notifier) listen(db database.DB, channel string, l *listener) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if _, ok := l.channels[channel]; ok {
+		return
+	}
+
+	dbc, ok := n.dbs[db.GetName()]
+	if !ok {
+		dbc = &dbChannels{listeners: make(map[string]map[*listener]struct{})}
+		n.dbs[db.GetName()] = dbc
+	}
+
+	listeners, ok := dbc.listeners[channel]
+	if !ok {
+		listeners = make(map[*listener]struct{})
+		dbc.listeners[channel] = listeners
+	}
+
+	listeners[l] = struct{}{}
+	l.channels[channel] = struct{}{}
+
+	if !isTableChannel(channel) {
+		return
+	}
+
+	dbc.tableChannels++
+
+	if dbc.stopWatching == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		dbc.stopWatching = cancel
+
+		go n.watch(ctx, db)
+	}
+}
+
+func (n *notifier) unlisten(db database.DB, channel string, l *listener) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.removeListener(db.GetName(), channel, l)
+}
+
+func (n *notifier) unlistenAll(db database.DB, l *listener) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for channel := range l.channels {
+		n.removeListener(db.GetName(), channel, l)
+	}
+}
+
+func (n *notifier) removeListener(dbName, channel string, l *listener) {
+	if _, ok := l.channels[channel]; !ok {
+		return
+	}
+
+	delete(l.channels, channel)
+
+	dbc := n.dbs[dbName]
+
+	delete(dbc.listeners[channel], l)
+	if len(dbc.listeners[channel]) == 0 {
+		delete(dbc.listeners, channel)
+	}
+
+	if isTableChannel(channel) {
+		dbc.tableChannels--
+
+		if dbc.tableChannels == 0 {
+			dbc.stopWatching()
+			dbc.stopWatching = nil
+		}
+	}
+
+	if len(dbc.listeners) == 0 {
+		delete(n.dbs, dbName)
+	}
+}
+
+func (n *notifier) hasListeners(dbName, channel string) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	dbc, ok := n.dbs[dbName]
+	return ok && len(dbc.listeners[channel]) > 0
+}
+
+func (n *notifier) notify(dbName string, notifications ...*notification) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	dbc, ok := n.dbs[dbName]
+	if !ok {
+		return
+	}
+
+	for _, nt := range notifications {
+		for l := range dbc.listeners[nt.channel] {
+			l.push(nt)
+		}
+	}
+}
+
+// watch notifies the changes of the transactions committed to the database until the context is cancelled
+func (n *notifier) watch(ctx context.Context, db database.DB) {
+	state, err := db.CurrentState()
+	if err != nil {
+		n.log.Warningf("unable to watch transactions of database '%s': %v", db.GetName(), err)
+		return
+	}
+
+	lastTxID := state.TxId
+
+	for {
+		err := db.WaitForTx(ctx, lastTxID+1, false)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			n.log.Warningf("unable to watch transactions of database '%s': %v", db.GetName(), err)
+			return
+		}
+
+		state, err := db.CurrentState()
+		if err != nil {
+			n.log.Warningf("unable to watch transactions of database '%s': %v", db.GetName(), err)
+			return
+		}
+
+		err = n.notifyTxs(ctx, db, lastTxID+1, state.TxId)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			n.log.Warningf("unable to notify transactions up to %d of database '%s': %v", state.TxId, db.GetName(), err)
+		}
+
+		lastTxID = state.TxId
+	}
+}
+
+func (n *notifier) notifyTxs(ctx context.Context, db database.DB, fromTxID, toTxID uint64) error {
+	sqlTx, err := db.NewSQLTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return err
+	}
+	defer sqlTx.Cancel()
+
+	catalog := sqlTx.Catalog()
+
+	for txID := fromTxID; txID <= toTxID; txID++ {
+		tx, err := db.TxByID(ctx, &schema.TxRequest{
+			Tx: txID,
+			EntriesSpec: &schema.EntriesSpec{
+				SqlEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RAW_VALUE},
+			},
+			KeepReferencesUnresolved: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		notifications, err := n.tableNotifications(db.GetName(), catalog, tx)
+		if err != nil {
+			return err
+		}
+
+		n.notify(db.GetName(), notifications...)
+	}
+
+	return nil
+}
+
+// tableNotifications builds a notification for each table with listeners changed by the transaction
+func (n *notifier) tableNotifications(dbName string, catalog *sql.Catalog, tx *schema.Tx) ([]*notification, error) {
+	var tables []*sql.Table
+	payloads := make(map[string]*tableChangePayload)
+
+	for _, e := range tx.Entries {
+		table, values, err := catalog.DecodeRowEntry(e.Key, e.Value)
+		if errors.Is(err, sql.ErrIllegalMappedKey) || errors.Is(err, sql.ErrTableDoesNotExist) {
+			// not a row or a row of a table dropped afterwards
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		payload, ok := payloads[table.Name()]
+		if !ok {
+			if !n.hasListeners(dbName, tableChannelPrefix+table.Name()) {
+				continue
+			}
+
+			payload = &tableChangePayload{TxID: tx.Header.Id}
+			payloads[table.Name()] = payload
+			tables = append(tables, table)
+		}
+
+		pk := primaryKeyValue(table, values)
+
+		if e.Metadata != nil && e.Metadata.Deleted {
+			payload.Deleted = append(payload.Deleted, pk)
+		} else {
+			payload.Keys = append(payload.Keys, pk)
+		}
+	}
+
+	notifications := make([]*notification, len(tables))
+
+	for i, table := range tables {
+		payload := payloads[table.Name()]
+
+		bs, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bs) > maxNotificationPayloadLen {
+			// too many keys to be notified, listeners are expected to read the transaction
+			bs, err = json.Marshal(&tableChangePayload{TxID: payload.TxID, Truncated: true})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		notifications[i] = &notification{
+			channel: tableChannelPrefix + table.Name(),
+			payload: string(bs),
+		}
+	}
+
+	return notifications, nil
+}
+
+func primaryKeyValue(table *sql.Table, values map[uint32]sql.TypedValue) interface{} {
+	cols := table.PrimaryIndex().Cols()
+
+	pk := make([]interface{}, len(cols))
+
+	for i, col := range cols {
+		if v, ok := values[col.ID()]; ok {
+			pk[i] = v.RawValue()
+		}
+	}
+
+	if len(pk) == 1 {
+		return pk[0]
+	}
+	return pk
+}
+
+// listener queues the notifications raised on the channels a session is listening to.
+// Notifications are written as soon as they are raised while the session is idle, i.e. waiting
+// for a new query outside of a transaction, and are otherwise delivered once the session becomes idle.
+type listener struct {
+	// channels is guarded by the notifier mutex
+	channels map[string]struct{}
+
+	queueMutex sync.Mutex
+	pending    []*notification
+
+	// stateMutex prevents notifications from being written while the session is writing its responses
+	stateMutex sync.Mutex
+	idle       bool
+
+	write  func([]byte) (int, error)
+	signal chan struct{}
+	done   chan struct{}
+}
+
+func newListener(write func([]byte) (int, error)) *listener {
+	l := &listener{
+		channels: make(map[string]struct{}),
+		write:    write,
+		signal:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+
+	go l.deliver()
+
+	return l
+}
+
+func (l *listener) push(n *notification) {
+	l.queueMutex.Lock()
+	if len(l.pending) < maxPendingNotifications {
+		l.pending = append(l.pending, n)
+	}
+	l.queueMutex.Unlock()
+
+	select {
+	case l.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (l *listener) deliver() {
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-l.signal:
+			l.stateMutex.Lock()
+			if l.idle {
+				l.flush()
+			}
+			l.stateMutex.Unlock()
+		}
+	}
+}
+
+// setIdle is called by the session once it's waiting for a new query outside of a transaction
+// and before handling any message
+func (l *listener) setIdle(idle bool) {
+	l.stateMutex.Lock()
+	defer l.stateMutex.Unlock()
+
+	if idle {
+		l.flush()
+	}
+
+	l.idle = idle
+}
+
+func (l *listener) flush() {
+	l.queueMutex.Lock()
+	pending := l.pending
+	l.pending = nil
+	l.queueMutex.Unlock()
+
+	for _, n := range pending {
+		if _, err := l.write(bm.NotificationResponse(notifierPID, n.channel, n.payload)); err != nil {
+			// the connection is being closed
+			return
+		}
+	}
+}
+
+func (l *listener) close() {
+	close(l.done)
+}
+
+func (s *session) listen(channel string) error {
+	if isTableChannel(channel) {
+		if err := s.checkTableChannel(strings.TrimPrefix(channel, tableChannelPrefix)); err != nil {
+			return err
+		}
+	}
+
+	if s.listener == nil {
+		s.listener = newListener(s.writeMessage)
+	}
+
+	s.notifier.listen(s.db, channel, s.listener)
+
+	return nil
+}
+
+func (s *session) unlisten(channel string, all bool) {
+	if s.listener == nil {
+		return
+	}
+
+	if all {
+		s.notifier.unlistenAll(s.db, s.listener)
+		return
+	}
+
+	s.notifier.unlisten(s.db, channel, s.listener)
+}
+
+func (s *session) notify(channel, payload string) error {
+	if isTableChannel(channel) {
+		return fmt.Errorf("%w: channel '%s' is reserved", pserr.ErrInvalidNotification, channel)
+	}
+
+	if s.readOnly() {
+		return pserr.ErrReadOnlyTransaction
+	}
+
+	n := &notification{channel: channel, payload: payload}
+
+	if s.tx == nil {
+		s.notifier.notify(s.db.GetName(), n)
+		return nil
+	}
+
+	// identical notifications raised within a transaction are delivered once
+	for _, pn := range s.pendingNotifications {
+		if *pn == *n {
+			return nil
+		}
+	}
+
+	s.pendingNotifications = append(s.pendingNotifications, n)
+
+	return nil
+}
+
+// endTransaction delivers the notifications raised within the transaction if it has been committed
+func (s *session) endTransaction(committed bool) {
+	if committed && len(s.pendingNotifications) > 0 {
+		s.notifier.notify(s.db.GetName(), s.pendingNotifications...)
+	}
+
+	s.pendingNotifications = nil
+}
+
+// checkTableChannel verifies the changes of a table can be notified to the session user. The primary key
+// of the table must be readable by the user and no row-level security policy must apply, as notifications
+// would otherwise disclose the keys of the rows the user is not allowed to read.
+func (s *session) checkTableChannel(tableName string) error {
+	tx, err := s.db.NewSQLTx(s.ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return err
+	}
+	defer tx.Cancel()
+
+	table, err := tx.Catalog().GetTableByName(tableName)
+	if err != nil {
+		return err
+	}
+
+	user, err := tx.LoggedUser(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	if user == nil || (user.Permission() != sql.PermissionAdmin && user.Permission() != sql.PermissionSysAdmin) {
+		for _, p := range table.GetPolicies() {
+			if p.Command() == "" || p.Command() == sql.SQLPrivilegeSelect {
+				return fmt.Errorf("%w: rows of table '%s' are subject to row-level security policies", sql.ErrAccessDenied, tableName)
+			}
+		}
+	}
+
+	cols := make([]string, len(table.PrimaryIndex().Cols()))
+	for i, col := range table.PrimaryIndex().Cols() {
+		cols[i] = fmt.Sprintf("\"%s\"", col.Name())
+	}
+
+	stmts, err := sql.ParseSQLString(fmt.Sprintf("SELECT %s FROM \"%s\" LIMIT 1", strings.Join(cols, ", "), tableName))
+	if err != nil {
+		return err
+	}
+
+	reader, err := s.db.SQLQueryPrepared(s.ctx, tx, stmts[0].(*sql.SelectStmt), nil)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = reader.Read(s.ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoMoreRows) {
+		return err
+	}
+
+	return nil
+}
+
+// readyForQuery notifies the frontend the session is ready for a new query, notifications
+// are delivered right away from now on unless a transaction is in progress
+func (s *session) readyForQuery() error {
+	_, err := s.writeMessage(bm.ReadyForQuery())
+
+	if err == nil && s.listener != nil && s.tx == nil {
+		s.listener.setIdle(true)
+	}
+
+	return err
+}
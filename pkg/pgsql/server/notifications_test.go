@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNotificationStmts(t *testing.T) {
+	stmt, err := parseSessionStmt("LISTEN Alerts;")
+	require.NoError(t, err)
+	require.Equal(t, &listenStmt{channel: "alerts"}, stmt)
+
+	stmt, err = parseSessionStmt("unlisten alerts")
+	require.NoError(t, err)
+	require.Equal(t, &unlistenStmt{channel: "alerts"}, stmt)
+
+	stmt, err = parseSessionStmt("UNLISTEN *")
+	require.NoError(t, err)
+	require.Equal(t, &unlistenStmt{all: true}, stmt)
+
+	stmt, err = parseSessionStmt("NOTIFY alerts")
+	require.NoError(t, err)
+	require.Equal(t, &notifyStmt{channel: "alerts"}, stmt)
+
+	stmt, err = parseSessionStmt("NOTIFY alerts, 'it''s done'")
+	require.NoError(t, err)
+	require.Equal(t, &notifyStmt{channel: "alerts", payload: "it's done"}, stmt)
+
+	_, err = parseSessionStmt("NOTIFY alerts, done")
+	require.ErrorContains(t, err, "payload string expected")
+
+	_, err = parseSessionStmt("NOTIFY alerts, '" + strings.Repeat("x", maxNotificationPayloadLen+1) + "'")
+	require.ErrorIs(t, err, pserr.ErrInvalidNotification)
+
+	_, err = parseSessionStmt(`LISTEN ""`)
+	require.ErrorIs(t, err, pserr.ErrInvalidNotification)
+
+	_, err = parseSessionStmt("LISTEN 'alerts'")
+	require.ErrorContains(t, err, "channel name expected")
+
+	_, err = parseSessionStmt("LISTEN alerts, more")
+	require.ErrorContains(t, err, "unexpected input")
+}
+
+func TestListenerDelivery(t *testing.T) {
+	var written []string
+
+	l := newListener(func(b []byte) (int, error) {
+		written = append(written, string(b))
+		return len(b), nil
+	})
+	defer l.close()
+
+	n := newNotifier(logger.NewSimpleLogger("test", os.Stderr))
+	db := &mockDB{}
+
+	n.listen(db, "alerts", l)
+	n.listen(db, "alerts", l)
+	require.True(t, n.hasListeners("defaultdb", "alerts"))
+	require.False(t, n.hasListeners("otherdb", "alerts"))
+
+	// notifications are queued while the session is busy
+	n.notify("defaultdb", &notification{channel: "alerts", payload: "first"})
+	n.notify("defaultdb", &notification{channel: "other", payload: "ignored"})
+	n.notify("otherdb", &notification{channel: "alerts", payload: "ignored"})
+
+	l.stateMutex.Lock()
+	require.Empty(t, written)
+	l.stateMutex.Unlock()
+
+	l.setIdle(true)
+
+	l.stateMutex.Lock()
+	require.Len(t, written, 1)
+	require.Contains(t, written[0], "alerts\x00first\x00")
+	l.stateMutex.Unlock()
+
+	// while idle notifications are written as soon as they are raised
+	n.notify("defaultdb", &notification{channel: "alerts", payload: "second"})
+
+	require.Eventually(t, func() bool {
+		l.stateMutex.Lock()
+		defer l.stateMutex.Unlock()
+
+		return len(written) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	n.unlistenAll(db, l)
+	require.False(t, n.hasListeners("defaultdb", "alerts"))
+	require.Empty(t, n.dbs)
+}
@@ -18,6 +18,7 @@ package server
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/pkg/database"
@@ -66,3 +67,49 @@ func DatabaseList(dbList database.DatabaseList) Option {
 		args.dbList = dbList
 	}
 }
+
+// AuthMethods sets the authentication methods accepted by the server, see DefaultAuthMethods
+func AuthMethods(methods ...string) Option {
+	return func(args *pgsrv) {
+		args.authMethods = methods
+	}
+}
+
+// Auth provides access to immudb users and sessions, it's required
+// by authentication methods not receiving the password in cleartext
+func Auth(authenticator Authenticator) Option {
+	return func(args *pgsrv) {
+		args.authenticator = authenticator
+	}
+}
+
+// ClientCAs sets the certificate authorities used to verify the client certificates presented on the pgsql port,
+// it's required to authenticate users through their client certificates
+func ClientCAs(pool *x509.CertPool) Option {
+	return func(args *pgsrv) {
+		args.clientCAs = pool
+	}
+}
+
+// CertUserMappings sets the mappings of client certificate identities to users, see CertUserMapping
+func CertUserMappings(mappings ...*CertUserMapping) Option {
+	return func(args *pgsrv) {
+		args.certUserMappings = mappings
+	}
+}
+
+// MockAuthSecret sets the secret used to derive the salts presented to unknown users, it must be kept
+// across restarts so unknown users can not be told apart from existing ones. A random secret is used when not set
+func MockAuthSecret(secret []byte) Option {
+	return func(args *pgsrv) {
+		args.mockAuthSecret = secret
+	}
+}
+
+// CopyRowsPerTx sets the maximum number of rows inserted by each transaction of a COPY FROM STDIN,
+// see DefaultCopyRowsPerTx
+func CopyRowsPerTx(rows int) Option {
+	return func(args *pgsrv) {
+		args.copyRowsPerTx = rows
+	}
+}
@@ -19,6 +19,7 @@ package pgmeta
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 )
@@ -41,7 +42,7 @@ var ErrInvalidPgsqlProtocolVersion = errors.New("invalid pgsql protocol version"
 var PgTypeMap = map[string][]int{
 	sql.BooleanType:   {16, 1},    //bool
 	sql.BLOBType:      {17, -1},   //bytea
-	sql.TimestampType: {20, 8},    //int8
+	sql.TimestampType: {1114, 8},  //timestamp
 	sql.IntegerType:   {20, 8},    //int8
 	sql.VarcharType:   {25, -1},   //text
 	sql.UUIDType:      {2950, 16}, //uuid
@@ -50,6 +51,10 @@ var PgTypeMap = map[string][]int{
 	sql.AnyType:       {17, -1},   // bytea
 }
 
+// PgEpoch is the reference time of timestamps in binary format,
+// which are encoded as the number of microseconds elapsed since it
+var PgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
 const PgSeverityError = "ERROR"
 const PgSeverityFaral = "FATAL"
 const PgSeverityPanic = "PANIC"
@@ -59,10 +64,20 @@ const PgSeverityDebug = "DEBUG"
 const PgSeverityInfo = "INFO"
 const PgSeverityLog = "LOG"
 
+const PgSuccessfulCompletion = "00000"
 const PgServerErrRejectedEstablishmentOfSqlconnection = "08004"
 const PgServerErrSyntaxError = "42601"
 const PgServerErrProtocolViolation = "08P01"
 const PgServerErrConnectionFailure = "08006"
+const PgServerErrInvalidAuthorizationSpecification = "28000"
+const PgServerErrInvalidPassword = "28P01"
+const PgServerErrBadCopyFileFormat = "22P04"
+const PgServerErrQueryCanceled = "57014"
+const PgServerErrInvalidParameterValue = "22023"
+const PgServerErrUndefinedObject = "42704"
+const PgServerErrCantChangeRuntimeParam = "55P02"
+const PgServerErrReadOnlySqlTransaction = "25006"
+const PgServerErrFeatureNotSupported = "0A000"
 const ProgramLimitExceeded = "54000"
 const DataException = "22000"
 
@@ -82,6 +97,15 @@ var MTypes = map[byte]string{
 	't': "parameterDesctiption",
 	'B': "bind",
 	'H': "flush",
+	'G': "copyInResponse",
+	'd': "copyData",
+	'c': "copyDone",
+	'f': "copyFail",
+	'A': "notificationResponse",
+	'N': "noticeResponse",
+	's': "portalSuspended",
+	'2': "bindComplete",
+	'3': "closeComplete",
 }
 
 var MaxMsgSize = 32 << 20 // 32MB
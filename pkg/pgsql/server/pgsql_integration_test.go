@@ -17,22 +17,37 @@ limitations under the License.
 package server_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"database/sql"
+	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"math/rand"
+	"io"
+	"math/big"
+	mrand "math/rand"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	isql "github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/pgsql/errors"
+	pgsrv "github.com/codenotary/immudb/pkg/pgsql/server"
+	h "github.com/codenotary/immudb/pkg/pgsql/server/fmessages/fmessages_test"
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 	"github.com/codenotary/immudb/pkg/server"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	pq "github.com/lib/pq"
 
@@ -367,6 +382,8 @@ func TestPgsqlServer_SimpleQueryQueryMissingPassword(t *testing.T) {
 		WithPort(0).
 		WithPgsqlServer(true).
 		WithPgsqlServerPort(0).
+		// a missing password can only be detected when it's sent in cleartext
+		WithPgsqlServerAuthMethods([]string{pgsrv.AuthMethodPassword}).
 		WithMetricsServer(false).
 		WithWebServer(false)
 
@@ -818,8 +835,8 @@ func TestPgsqlServer_SimpleQueryNilValues(t *testing.T) {
 }
 
 func getRandomTableName() string {
-	rand.Seed(time.Now().UnixNano())
-	r := rand.Intn(100000)
+	mrand.Seed(time.Now().UnixNano())
+	r := mrand.Intn(100000)
 	return fmt.Sprintf("table%d", r)
 }
 
@@ -1211,3 +1228,1236 @@ func TestPgsqlServer_InvalidTraffic(t *testing.T) {
 	_, err = http.Get(fmt.Sprintf("http://localhost:%d", srv.PgsqlSrv.GetPort()))
 	require.Error(t, err)
 }
+
+func TestPgsqlServer_InformationSchema(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(td).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	table := getRandomTableName()
+	_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, title VARCHAR[64], PRIMARY KEY id)", table))
+	require.NoError(t, err)
+
+	var tableName string
+	err = db.QueryRow("SELECT table_name FROM information_schema.tables WHERE table_schema = 'public'").Scan(&tableName)
+	require.NoError(t, err)
+	require.Equal(t, table, tableName)
+
+	rows, err := db.Query(fmt.Sprintf("SELECT column_name, data_type FROM information_schema.columns WHERE table_name = '%s' ORDER BY ordinal_position", table))
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var cols [][2]string
+	for rows.Next() {
+		var name, dataType string
+		require.NoError(t, rows.Scan(&name, &dataType))
+
+		cols = append(cols, [2]string{name, dataType})
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, [][2]string{{"id", "bigint"}, {"title", "character varying"}}, cols)
+
+	var typName string
+	err = db.QueryRow("SELECT typname FROM pg_catalog.pg_type WHERE oid = 2950").Scan(&typName)
+	require.NoError(t, err)
+	require.Equal(t, "uuid", typName)
+}
+
+func TestPgsqlServer_AuthMethods(t *testing.T) {
+	pemServerCA, err := os.ReadFile("cert/ca-cert.pem")
+	require.NoError(t, err)
+
+	serverCert, err := tls.LoadX509KeyPair("cert/server-cert.pem", "cert/server-key.pem")
+	require.NoError(t, err)
+
+	certPool := x509.NewCertPool()
+	require.True(t, certPool.AppendCertsFromPEM(pemServerCA))
+
+	tlsConfig := &tls.Config{
+		RootCAs:      certPool,
+		Certificates: []tls.Certificate{serverCert},
+	}
+
+	for _, methods := range [][]string{
+		{pgsrv.AuthMethodSCRAMSHA256},
+		{pgsrv.AuthMethodMD5},
+		{pgsrv.AuthMethodPassword},
+		pgsrv.DefaultAuthMethods,
+	} {
+		t.Run(fmt.Sprintf("%v", methods), func(t *testing.T) {
+			options := server.DefaultOptions().
+				WithDir(t.TempDir()).
+				WithPort(0).
+				WithPgsqlServer(true).
+				WithPgsqlServerPort(0).
+				WithPgsqlServerAuthMethods(methods).
+				WithMetricsServer(false).
+				WithWebServer(false).
+				WithTLS(tlsConfig)
+
+			srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+			err := srv.Initialize()
+			require.NoError(t, err)
+
+			go func() {
+				srv.Start()
+			}()
+
+			defer func() {
+				srv.Stop()
+			}()
+
+			defer os.Remove(".state-")
+
+			for _, sslMode := range []string{"disable", "require"} {
+				db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=%s user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort(), sslMode))
+				require.NoError(t, err)
+
+				_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", getRandomTableName()))
+				require.NoError(t, err)
+
+				db.Close()
+
+				conn, err := pgx.Connect(context.Background(), fmt.Sprintf("host=localhost port=%d sslmode=%s user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort(), sslMode))
+				require.NoError(t, err)
+
+				_, err = conn.Exec(context.Background(), fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", getRandomTableName()))
+				require.NoError(t, err)
+
+				conn.Close(context.Background())
+
+				for _, user := range []string{"immudb", "unknown"} {
+					db, err = sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=%s user=%s dbname=defaultdb password=wrong", srv.PgsqlSrv.GetPort(), sslMode, user))
+					require.NoError(t, err)
+
+					_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", getRandomTableName()))
+					require.Error(t, err)
+
+					db.Close()
+
+					_, err = pgx.Connect(context.Background(), fmt.Sprintf("host=localhost port=%d sslmode=%s user=%s dbname=defaultdb password=wrong", srv.PgsqlSrv.GetPort(), sslMode, user))
+					require.Error(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPgsqlServer_InvalidAuthMethods(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithPgsqlServerAuthMethods([]string{"trust"}).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.ErrorIs(t, err, pgsrv.ErrUnsupportedAuthMethod)
+}
+
+func TestPgsqlServer_Copy(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE TABLE items (id INTEGER, name VARCHAR[64], active BOOLEAN, amount FLOAT, data BLOB, ts TIMESTAMP, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	// the secondary index doubles the number of entries per row,
+	// so default batches exceed the maximum number of entries per transaction and must be split
+	_, err = conn.Exec(ctx, "CREATE INDEX ON items(name)")
+	require.NoError(t, err)
+
+	t.Run("copy from stdin in binary format", func(t *testing.T) {
+		rows := make([][]interface{}, 2500)
+		for i := range rows {
+			rows[i] = []interface{}{int64(i), fmt.Sprintf("item%d", i), i%2 == 0, float64(i) / 2, []byte{byte(i)}}
+		}
+
+		n, err := conn.CopyFrom(ctx, pgx.Identifier{"items"}, []string{"id", "name", "active", "amount", "data"}, pgx.CopyFromRows(rows))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(rows)), n)
+
+		var count int64
+		err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM items").Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(rows)), count)
+
+		var name string
+		var active bool
+		err = conn.QueryRow(ctx, "SELECT name, active FROM items WHERE id = 1001").Scan(&name, &active)
+		require.NoError(t, err)
+		require.Equal(t, "item1001", name)
+		require.False(t, active)
+	})
+
+	t.Run("copy from stdin in text format", func(t *testing.T) {
+		data := "3000\tfirst\\tline\\\\\tt\t\\N\t\\\\x0aff\t2024-01-02 03:04:05.123456\n" +
+			"3001\t\\N\tno\t1.5\t\\N\t2024-01-02\r\n" +
+			"\\.\n"
+
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(data), "COPY items (id, name, active, amount, data, ts) FROM STDIN")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), tag.RowsAffected())
+
+		var name string
+		var data1 []byte
+		err = conn.QueryRow(ctx, "SELECT name, data FROM items WHERE id = 3000").Scan(&name, &data1)
+		require.NoError(t, err)
+		require.Equal(t, "first\tline\\", name)
+		require.Equal(t, []byte{0x0a, 0xff}, data1)
+
+		var nullName sql.NullString
+		err = conn.QueryRow(ctx, "SELECT name FROM items WHERE id = 3001").Scan(&nullName)
+		require.NoError(t, err)
+		require.False(t, nullName.Valid)
+	})
+
+	t.Run("copy from stdin in csv format", func(t *testing.T) {
+		data := "id;name;active\n" +
+			"4000;\"semi;colon \"\"quoted\"\"\";true\n" +
+			"4001;\"\";false\n" +
+			"4002;;false\n"
+
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(data), "COPY items (id, name, active) FROM STDIN WITH (FORMAT csv, HEADER, DELIMITER ';')")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), tag.RowsAffected())
+
+		var name sql.NullString
+		err = conn.QueryRow(ctx, "SELECT name FROM items WHERE id = 4000").Scan(&name)
+		require.NoError(t, err)
+		require.Equal(t, `semi;colon "quoted"`, name.String)
+
+		err = conn.QueryRow(ctx, "SELECT name FROM items WHERE id = 4001").Scan(&name)
+		require.NoError(t, err)
+		require.True(t, name.Valid)
+		require.Empty(t, name.String)
+
+		err = conn.QueryRow(ctx, "SELECT name FROM items WHERE id = 4002").Scan(&name)
+		require.NoError(t, err)
+		require.False(t, name.Valid)
+	})
+
+	t.Run("copy from stdin inside an explicit transaction", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "BEGIN")
+		require.NoError(t, err)
+
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader("5000\tin tx\n5001\tin tx\n"), "COPY items (id, name) FROM STDIN")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), tag.RowsAffected())
+
+		_, err = conn.Exec(ctx, "COMMIT")
+		require.NoError(t, err)
+
+		var count int64
+		err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM items WHERE name = 'in tx'").Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("copy from stdin with invalid data", func(t *testing.T) {
+		_, err := conn.PgConn().CopyFrom(ctx, strings.NewReader("6000\tname\tmaybe\n"), "COPY items (id, name, active) FROM STDIN")
+		require.ErrorContains(t, err, errors.ErrInvalidCopyData.Error())
+
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("6000\tname\n"), "COPY items (id, name, active) FROM STDIN")
+		require.ErrorContains(t, err, "missing data for column 'active'")
+
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("6000\tname\n"), "COPY items (id, name) FROM '/tmp/items'")
+		require.ErrorContains(t, err, errors.ErrInvalidCopyStmt.Error())
+
+		_, err = conn.PgConn().CopyFrom(ctx, iotest.ErrReader(fmt.Errorf("client failure")), "COPY items (id, name) FROM STDIN")
+		require.ErrorContains(t, err, "client failure")
+
+		var count int64
+		err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM items WHERE id >= 6000").Scan(&count)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("copy to stdout", func(t *testing.T) {
+		var out bytes.Buffer
+
+		tag, err := conn.PgConn().CopyTo(ctx, &out, "COPY items (id, name, amount, data, ts) TO STDOUT")
+		require.NoError(t, err)
+		require.Equal(t, int64(2500+2+3+2), tag.RowsAffected())
+		require.Contains(t, out.String(), "1001\titem1001\t500.5\te9\t\\N\n")
+		require.Contains(t, out.String(), "3000\tfirst\\tline\\\\\t\\N\t0aff\t2024-01-02 03:04:05.123456\n")
+		require.Contains(t, out.String(), "3001\t\\N\t1.5\t\\N\t2024-01-02 00:00:00\n")
+
+		out.Reset()
+
+		tag, err = conn.PgConn().CopyTo(ctx, &out, "COPY (SELECT id, name FROM items WHERE id >= 4000 AND id < 5000) TO STDOUT WITH (FORMAT csv, HEADER)")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), tag.RowsAffected())
+		require.Equal(t, "id,name\n4000,\"semi;colon \"\"quoted\"\"\"\n4001,\"\"\n4002,\n", out.String())
+
+		out.Reset()
+
+		tag, err = conn.PgConn().CopyTo(ctx, &out, "COPY (SELECT id, name FROM items WHERE id = 1) TO STDOUT BINARY")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), tag.RowsAffected())
+		require.Equal(t, "PGCOPY\n\xff\r\n\x00", out.String()[:11])
+		require.Equal(t, []byte{0xff, 0xff}, out.Bytes()[out.Len()-2:])
+
+		_, err = conn.PgConn().CopyTo(ctx, &out, "COPY (DELETE FROM items) TO STDOUT")
+		require.ErrorContains(t, err, errors.ErrInvalidCopyStmt.Error())
+	})
+}
+
+func TestPgsqlServer_BinaryFormat(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE TABLE values_table (id INTEGER, amount FLOAT, ts TIMESTAMP, uid UUID, doc JSON, active BOOLEAN, data BLOB, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+	uid := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	// pgx sends parameters and requests results in binary format for all these types
+	_, err = conn.Exec(ctx, "INSERT INTO values_table (id, amount, ts, uid, doc, active, data) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		int64(-1), 3.25, ts, uid, `{"name": "immudb"}`, true, []byte{0, 1})
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, "INSERT INTO values_table (id) VALUES ($1)", int32(2))
+	require.NoError(t, err)
+
+	var id int64
+	var amount float64
+	var rts time.Time
+	var ruid [16]byte
+	var doc map[string]interface{}
+	var active bool
+	var data []byte
+
+	err = conn.QueryRow(ctx, "SELECT id, amount, ts, uid, doc, active, data FROM values_table WHERE ts = $1", ts).
+		Scan(&id, &amount, &rts, &ruid, &doc, &active, &data)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), id)
+	require.Equal(t, 3.25, amount)
+	require.Equal(t, ts, rts.UTC())
+	require.Equal(t, uid, ruid)
+	require.Equal(t, map[string]interface{}{"name": "immudb"}, doc)
+	require.True(t, active)
+	require.Equal(t, []byte{0, 1}, data)
+
+	var nullAmount *float64
+	var nullTs *time.Time
+	err = conn.QueryRow(ctx, "SELECT amount, ts FROM values_table WHERE id = $1", 2).Scan(&nullAmount, &nullTs)
+	require.NoError(t, err)
+	require.Nil(t, nullAmount)
+	require.Nil(t, nullTs)
+
+	rows, err := conn.Query(ctx, "SELECT id, amount, ts FROM values_table", pgx.QueryResultFormats{pgx.BinaryFormatCode})
+	require.NoError(t, err)
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, 2, count)
+
+	// lib/pq sends parameters in text format
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	_, err = db.Exec("INSERT INTO values_table (id, amount, ts, uid, active) VALUES ($1, $2, $3, $4, $5)",
+		3, 1.5, ts, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "t")
+	require.NoError(t, err)
+
+	var ruidText string
+	err = db.QueryRow("SELECT amount, ts, uid FROM values_table WHERE id = $1", 3).Scan(&amount, &rts, &ruidText)
+	require.NoError(t, err)
+	require.Equal(t, 1.5, amount)
+	require.Equal(t, ts, rts.UTC())
+	require.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ruidText)
+}
+
+func TestPgsqlServer_SessionParameters(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	config, err := pgx.ParseConfig(fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb application_name=tests", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	var notices []*pgconn.Notice
+	config.OnNotice = func(_ *pgconn.PgConn, n *pgconn.Notice) {
+		notices = append(notices, n)
+	}
+
+	conn, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+
+	defer conn.Close(ctx)
+
+	require.Equal(t, "tests", conn.PgConn().ParameterStatus("application_name"))
+	require.Equal(t, "UTC", conn.PgConn().ParameterStatus("TimeZone"))
+	require.Equal(t, pgmeta.PgsqlServerVersion, conn.PgConn().ParameterStatus("server_version"))
+
+	t.Run("set and show parameters", func(t *testing.T) {
+		var value string
+
+		err = conn.QueryRow(ctx, "SHOW application_name").Scan(&value)
+		require.NoError(t, err)
+		require.Equal(t, "tests", value)
+
+		_, err = conn.Exec(ctx, "SET application_name TO 'reports'")
+		require.NoError(t, err)
+		require.Equal(t, "reports", conn.PgConn().ParameterStatus("application_name"))
+
+		_, err = conn.Exec(ctx, "SET statement_timeout = '90s'")
+		require.NoError(t, err)
+
+		err = conn.QueryRow(ctx, "SHOW statement_timeout").Scan(&value)
+		require.NoError(t, err)
+		require.Equal(t, "90s", value)
+
+		_, err = conn.Exec(ctx, "RESET statement_timeout")
+		require.NoError(t, err)
+
+		err = conn.QueryRow(ctx, "SHOW statement_timeout").Scan(&value)
+		require.NoError(t, err)
+		require.Equal(t, "0", value)
+
+		_, err = conn.Exec(ctx, "SET myapp.tenant = 'acme'")
+		require.NoError(t, err)
+
+		err = conn.QueryRow(ctx, "SHOW myapp.tenant").Scan(&value)
+		require.NoError(t, err)
+		require.Equal(t, "acme", value)
+
+		rows, err := conn.Query(ctx, "SHOW ALL")
+		require.NoError(t, err)
+
+		count := 0
+		for rows.Next() {
+			count++
+		}
+		require.NoError(t, rows.Err())
+		require.Greater(t, count, 10)
+
+		_, err = conn.Exec(ctx, "SHOW unknown_param")
+		require.ErrorContains(t, err, "SQLSTATE 42704")
+
+		_, err = conn.Exec(ctx, "SET server_version = '1'")
+		require.ErrorContains(t, err, "SQLSTATE 55P02")
+
+		_, err = conn.Exec(ctx, "SET DateStyle = 'German'")
+		require.ErrorContains(t, err, "SQLSTATE 22023")
+
+		_, err = conn.Exec(ctx, "RESET ALL")
+		require.NoError(t, err)
+		require.Equal(t, "UTC", conn.PgConn().ParameterStatus("TimeZone"))
+	})
+
+	t.Run("search path", func(t *testing.T) {
+		_, err = conn.Exec(ctx, `SET search_path TO "$user", public`)
+		require.NoError(t, err)
+		require.Empty(t, notices)
+
+		_, err = conn.Exec(ctx, "SET search_path TO myschema, public")
+		require.NoError(t, err)
+		require.Len(t, notices, 1)
+		require.Equal(t, "NOTICE", notices[0].Severity)
+		require.Contains(t, notices[0].Message, "myschema")
+
+		// names are still resolved in schema public
+		var value string
+		err = conn.QueryRow(ctx, "SHOW search_path").Scan(&value)
+		require.NoError(t, err)
+		require.Equal(t, "public", value)
+	})
+
+	_, err = conn.Exec(ctx, "CREATE TABLE events (id INTEGER AUTO_INCREMENT, ts TIMESTAMP, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	t.Run("snapshot", func(t *testing.T) {
+		_, err = conn.Exec(ctx, "SET immudb.snapshot_since_tx = 1000000")
+		require.ErrorContains(t, err, "SQLSTATE 22023")
+
+		_, err = conn.Exec(ctx, "SET immudb.snapshot_since_tx = 1")
+		require.NoError(t, err)
+
+		var count int64
+		err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM events").Scan(&count)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		_, err = conn.Exec(ctx, "RESET immudb.snapshot_since_tx")
+		require.NoError(t, err)
+	})
+
+	t.Run("time zone", func(t *testing.T) {
+		_, err = conn.Exec(ctx, "SET TIME ZONE 'Europe/Rome'")
+		require.NoError(t, err)
+		require.Equal(t, "Europe/Rome", conn.PgConn().ParameterStatus("TimeZone"))
+
+		// the timestamp is interpreted in the time zone of the session
+		_, err = conn.Exec(ctx, "INSERT INTO events (ts) VALUES ($1)", "2024-01-01 10:00:00")
+		require.NoError(t, err)
+
+		var ts time.Time
+		err = conn.QueryRow(ctx, "SELECT ts FROM events WHERE id = 1").Scan(&ts)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), ts)
+
+		_, err = conn.Exec(ctx, "SET TimeZone = UTC")
+		require.NoError(t, err)
+
+		err = conn.QueryRow(ctx, "SELECT ts FROM events WHERE id = 1").Scan(&ts)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), ts)
+
+		_, err = conn.Exec(ctx, "SET TimeZone = 'Nowhere/Unknown'")
+		require.ErrorContains(t, err, "SQLSTATE 22023")
+	})
+
+	t.Run("set local", func(t *testing.T) {
+		_, err = conn.Exec(ctx, "BEGIN")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "SET LOCAL application_name = 'in_tx'")
+		require.NoError(t, err)
+		require.Equal(t, "in_tx", conn.PgConn().ParameterStatus("application_name"))
+
+		_, err = conn.Exec(ctx, "COMMIT")
+		require.NoError(t, err)
+		require.Equal(t, "", conn.PgConn().ParameterStatus("application_name"))
+	})
+
+	t.Run("read-only mode", func(t *testing.T) {
+		_, err = conn.Exec(ctx, "SET SESSION CHARACTERISTICS AS TRANSACTION ISOLATION LEVEL SERIALIZABLE, READ ONLY")
+		require.NoError(t, err)
+		require.Equal(t, "on", conn.PgConn().ParameterStatus("default_transaction_read_only"))
+
+		var value string
+		err = conn.QueryRow(ctx, "SHOW transaction_read_only").Scan(&value)
+		require.NoError(t, err)
+		require.Equal(t, "on", value)
+
+		_, err = conn.Exec(ctx, "INSERT INTO events (ts) VALUES (NOW())")
+		require.ErrorContains(t, err, "SQLSTATE 25006")
+
+		_, err = conn.Exec(ctx, "UPDATE events SET ts = NOW() WHERE id = 1")
+		require.ErrorContains(t, err, "SQLSTATE 25006")
+
+		var count int64
+		err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM events").Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+
+		_, err = conn.Exec(ctx, "SET default_transaction_read_only = off")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "UPDATE events SET ts = NOW() WHERE id = 1")
+		require.NoError(t, err)
+	})
+
+	t.Run("statement timeout", func(t *testing.T) {
+		for i := 0; i < 500; i++ {
+			_, err = conn.Exec(ctx, "INSERT INTO events (ts) VALUES (NOW())")
+			require.NoError(t, err)
+		}
+
+		_, err = conn.Exec(ctx, "SET statement_timeout = '10s'")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "SELECT COUNT(*) FROM events AS e1 INNER JOIN events AS e2 ON e1.id >= e2.id")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "SET statement_timeout = 1")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "SELECT COUNT(*) FROM events AS e1 INNER JOIN events AS e2 ON e1.id >= e2.id")
+		require.ErrorContains(t, err, "SQLSTATE 57014")
+
+		_, err = conn.Exec(ctx, "SET statement_timeout = 0")
+		require.NoError(t, err)
+
+		var count int64
+		err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM events").Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, int64(501), count)
+	})
+}
+
+func TestPgsqlServer_Notifications(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	connString := fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort())
+
+	listenerConn, err := pgx.Connect(ctx, connString)
+	require.NoError(t, err)
+	defer listenerConn.Close(ctx)
+
+	conn, err := pgx.Connect(ctx, connString)
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE TABLE payments (id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	_, err = listenerConn.Exec(ctx, "LISTEN immudb_table_payments")
+	require.NoError(t, err)
+
+	_, err = listenerConn.Exec(ctx, "LISTEN alerts")
+	require.NoError(t, err)
+
+	type notification struct {
+		Channel string
+		Payload string
+	}
+
+	waitForNotification := func(t *testing.T) notification {
+		wctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		n, err := listenerConn.WaitForNotification(wctx)
+		require.NoError(t, err)
+		return notification{Channel: n.Channel, Payload: n.Payload}
+	}
+
+	t.Run("table changes", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "INSERT INTO payments (amount) VALUES (10), (20)")
+		require.NoError(t, err)
+
+		n := waitForNotification(t)
+		require.Equal(t, "immudb_table_payments", n.Channel)
+
+		var payload struct {
+			TxID    uint64  `json:"tx"`
+			Keys    []int64 `json:"keys"`
+			Deleted []int64 `json:"deleted"`
+		}
+
+		err = json.Unmarshal([]byte(n.Payload), &payload)
+		require.NoError(t, err)
+		require.NotZero(t, payload.TxID)
+		require.Equal(t, []int64{1, 2}, payload.Keys)
+		require.Empty(t, payload.Deleted)
+
+		_, err = conn.Exec(ctx, "DELETE FROM payments WHERE id = 1")
+		require.NoError(t, err)
+
+		n = waitForNotification(t)
+		require.Equal(t, "immudb_table_payments", n.Channel)
+
+		insertTxID := payload.TxID
+		payload.Keys = nil
+
+		err = json.Unmarshal([]byte(n.Payload), &payload)
+		require.NoError(t, err)
+		require.Greater(t, payload.TxID, insertTxID)
+		require.Empty(t, payload.Keys)
+		require.Equal(t, []int64{1}, payload.Deleted)
+	})
+
+	t.Run("explicit notifications", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "NOTIFY alerts, 'hello'")
+		require.NoError(t, err)
+
+		n := waitForNotification(t)
+		require.Equal(t, "alerts", n.Channel)
+		require.Equal(t, "hello", n.Payload)
+
+		for _, stmt := range []string{
+			"BEGIN",
+			"NOTIFY alerts, 'committed'",
+			"NOTIFY alerts, 'committed'",
+			"COMMIT",
+			"BEGIN",
+			"NOTIFY alerts, 'rolled back'",
+			"ROLLBACK",
+		} {
+			_, err = conn.Exec(ctx, stmt)
+			require.NoError(t, err)
+		}
+
+		_, err = conn.Exec(ctx, "NOTIFY alerts")
+		require.NoError(t, err)
+
+		n = waitForNotification(t)
+		require.Equal(t, "committed", n.Payload)
+
+		n = waitForNotification(t)
+		require.Equal(t, "", n.Payload)
+	})
+
+	t.Run("invalid statements", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "NOTIFY immudb_table_payments, 'fake'")
+		require.ErrorContains(t, err, "22023")
+
+		_, err = conn.Exec(ctx, fmt.Sprintf("NOTIFY alerts, '%s'", strings.Repeat("x", 8000)))
+		require.ErrorContains(t, err, "22023")
+
+		_, err = conn.Exec(ctx, "LISTEN immudb_table_missing")
+		require.ErrorContains(t, err, isql.ErrTableDoesNotExist.Error())
+	})
+
+	t.Run("unlisten", func(t *testing.T) {
+		_, err := listenerConn.Exec(ctx, "UNLISTEN *")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "INSERT INTO payments (amount) VALUES (30)")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "NOTIFY alerts, 'missed'")
+		require.NoError(t, err)
+
+		wctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+
+		_, err = listenerConn.WaitForNotification(wctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestPgsqlServer_ClientCertAuth(t *testing.T) {
+	serverCert, err := tls.LoadX509KeyPair("cert/server-cert.pem", "cert/server-key.pem")
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "workloads-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+
+	newClientCert := func(t *testing.T, commonName string, uri string) tls.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		spiffeID, err := url.Parse(uri)
+		require.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(time.Now().UnixNano()),
+			Subject:      pkix.Name{CommonName: commonName},
+			URIs:         []*url.URL{spiffeID},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+
+		return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	}
+
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithPgsqlServerAuthMethods([]string{pgsrv.AuthMethodCert, pgsrv.AuthMethodSCRAMSHA256}).
+		WithPgsqlServerClientCAs(clientCAs).
+		WithPgsqlServerCertUserMap([]string{`/^spiffe://example\.org/sa/(.*)$ \1`}).
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithTLS(&tls.Config{Certificates: []tls.Certificate{serverCert}})
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err = srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	connect := func(user, password string, cert *tls.Certificate) (*pgx.Conn, error) {
+		config, err := pgx.ParseConfig(fmt.Sprintf("host=localhost port=%d sslmode=require user=%s dbname=defaultdb", srv.PgsqlSrv.GetPort(), user))
+		require.NoError(t, err)
+
+		config.Password = password
+
+		if cert != nil {
+			config.TLSConfig.Certificates = []tls.Certificate{*cert}
+		}
+
+		return pgx.ConnectConfig(ctx, config)
+	}
+
+	conn, err := connect("immudb", "immudb", nil)
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, "CREATE USER svc WITH PASSWORD 'Svc@12345678' READWRITE")
+	require.NoError(t, err)
+
+	conn.Close(ctx)
+
+	t.Run("mapped certificate", func(t *testing.T) {
+		cert := newClientCert(t, "workload-1", "spiffe://example.org/sa/svc")
+
+		conn, err := connect("svc", "", &cert)
+		require.NoError(t, err)
+		defer conn.Close(ctx)
+
+		_, err = conn.Exec(ctx, "CREATE TABLE workloads (id INTEGER, PRIMARY KEY id)")
+		require.NoError(t, err)
+	})
+
+	t.Run("certificate of another user", func(t *testing.T) {
+		cert := newClientCert(t, "immudb", "spiffe://example.org/sa/svc")
+
+		// the common name is not considered as mappings are configured, the password is then required
+		_, err := connect("immudb", "", &cert)
+		require.Error(t, err)
+
+		conn, err := connect("immudb", "immudb", &cert)
+		require.NoError(t, err)
+		conn.Close(ctx)
+	})
+}
+
+func TestPgsqlServer_ClientCertAuthRequiresClientCAs(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithPgsqlServerAuthMethods([]string{pgsrv.AuthMethodCert}).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+	defer os.Remove(".state-")
+
+	err := srv.Initialize()
+	require.ErrorIs(t, err, pgsrv.ErrUnsupportedAuthMethod)
+}
+
+func TestPgsqlServer_SuspendedPortals(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, "CREATE TABLE items (id INTEGER, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		_, err = conn.Exec(ctx, "INSERT INTO items (id) VALUES ($1)", i)
+		require.NoError(t, err)
+	}
+
+	t.Run("pipelined batch", func(t *testing.T) {
+		batch := &pgx.Batch{}
+		batch.Queue("SELECT COUNT(*) FROM items")
+		batch.Queue("INSERT INTO items (id) VALUES (6)")
+		batch.Queue("SELECT id FROM items WHERE id > $1 ORDER BY id", 4)
+
+		br := conn.SendBatch(ctx, batch)
+
+		var count int64
+		require.NoError(t, br.QueryRow().Scan(&count))
+		require.Equal(t, int64(5), count)
+
+		_, err := br.Exec()
+		require.NoError(t, err)
+
+		rows, err := br.Query()
+		require.NoError(t, err)
+
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, []int64{5, 6}, ids)
+
+		require.NoError(t, br.Close())
+
+		_, err = conn.Exec(ctx, "DELETE FROM items WHERE id = 6")
+		require.NoError(t, err)
+	})
+
+	hc, err := conn.PgConn().Hijack()
+	require.NoError(t, err)
+	defer hc.Conn.Close()
+
+	send := func(msgs ...[]byte) {
+		_, err := hc.Conn.Write(h.Join(msgs))
+		require.NoError(t, err)
+	}
+
+	// receive returns the types of the messages sent by the server until ReadyForQuery,
+	// along with the values of the data rows
+	receive := func() (string, []string) {
+		var types []byte
+		var values []string
+
+		for {
+			header := make([]byte, 5)
+			_, err := io.ReadFull(hc.Conn, header)
+			require.NoError(t, err)
+
+			payload := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+			_, err = io.ReadFull(hc.Conn, payload)
+			require.NoError(t, err)
+
+			types = append(types, header[0])
+
+			switch header[0] {
+			case 'D':
+				values = append(values, string(payload[6:]))
+			case 'Z':
+				return string(types), values
+			}
+		}
+	}
+
+	parse := func(name, query string) []byte {
+		return h.Msg('P', h.Join([][]byte{h.S(name), h.S(query), h.I16(0)}))
+	}
+	bind := func(portal, stmt string) []byte {
+		return h.Msg('B', h.Join([][]byte{h.S(portal), h.S(stmt), h.I16(0), h.I16(0), h.I16(0)}))
+	}
+	execute := func(portal string, maxRows int) []byte {
+		return h.Msg('E', h.Join([][]byte{h.S(portal), h.I32(maxRows)}))
+	}
+	closePortal := func(portal string) []byte {
+		return h.Msg('C', h.Join([][]byte{[]byte("P"), h.S(portal)}))
+	}
+	sync := h.Msg('S', nil)
+
+	t.Run("row-limited execution", func(t *testing.T) {
+		send(
+			parse("", "SELECT id FROM items ORDER BY id"),
+			bind("p1", ""),
+			execute("p1", 2),
+			execute("p1", 2),
+			execute("p1", 2),
+			execute("p1", 2),
+			sync,
+		)
+
+		types, values := receive()
+		require.Equal(t, "12DDsDDsDCCZ", types)
+		require.Equal(t, []string{"1", "2", "3", "4", "5"}, values)
+	})
+
+	t.Run("portals are closed at sync", func(t *testing.T) {
+		send(execute("p1", 2), sync)
+
+		types, _ := receive()
+		require.Equal(t, "EZ", types)
+	})
+
+	t.Run("closed portal", func(t *testing.T) {
+		send(
+			parse("items", "SELECT id FROM items ORDER BY id DESC"),
+			bind("p2", "items"),
+			execute("p2", 1),
+			closePortal("p2"),
+			execute("p2", 1),
+			execute("p2", 1),
+			sync,
+		)
+
+		types, values := receive()
+		require.Equal(t, "12Ds3EZ", types)
+		require.Equal(t, []string{"5"}, values)
+	})
+
+	t.Run("portals within a transaction", func(t *testing.T) {
+		send(
+			parse("", "BEGIN"), bind("", ""), execute("", 0), sync,
+		)
+		types, _ := receive()
+		require.Equal(t, "12CZ", types)
+
+		send(bind("p3", "items"), execute("p3", 3), sync)
+		types, values := receive()
+		require.Equal(t, "2DDDsZ", types)
+		require.Equal(t, []string{"5", "4", "3"}, values)
+
+		// the portal survives the sync inside a transaction block
+		send(execute("p3", 0), sync)
+		types, values = receive()
+		require.Equal(t, "DDCZ", types)
+		require.Equal(t, []string{"2", "1"}, values)
+
+		send(parse("", "COMMIT"), bind("", ""), execute("", 0), execute("p3", 0), sync)
+		types, _ = receive()
+		require.Equal(t, "12CEZ", types)
+	})
+
+	t.Run("error recovery", func(t *testing.T) {
+		send(
+			parse("", "SELECT id FROM unknown_table"),
+			bind("", ""),
+			execute("", 0),
+			sync,
+			parse("", "SELECT id FROM items WHERE id = 1"),
+			bind("", ""),
+			execute("", 1),
+			sync,
+		)
+
+		types, _ := receive()
+		require.Equal(t, "EZ", types)
+
+		types, values := receive()
+		require.Equal(t, "12DsZ", types)
+		require.Equal(t, []string{"1"}, values)
+	})
+}
+
+func TestPgsqlServer_Cursors(t *testing.T) {
+	options := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithWebServer(false)
+
+	srv := server.DefaultServer().WithOptions(options).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer func() {
+		srv.Stop()
+	}()
+
+	defer os.Remove(".state-")
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", srv.PgsqlSrv.GetPort()))
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE TABLE items (id INTEGER, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		_, err = conn.Exec(ctx, "INSERT INTO items (id) VALUES ($1)", i)
+		require.NoError(t, err)
+	}
+
+	fetch := func(t *testing.T, query string) []int64 {
+		rows, err := conn.Query(ctx, query)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+
+		return ids
+	}
+
+	_, err = conn.Exec(ctx, "DECLARE c CURSOR FOR SELECT id FROM items ORDER BY id")
+	require.Error(t, err)
+
+	_, err = conn.Exec(ctx, "BEGIN")
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, "DECLARE c CURSOR FOR SELECT id FROM items ORDER BY id")
+	require.NoError(t, err)
+
+	require.Equal(t, []int64{1, 2, 3}, fetch(t, "FETCH 3 FROM c"))
+
+	_, err = conn.Exec(ctx, "MOVE 4 IN c")
+	require.NoError(t, err)
+
+	require.Equal(t, []int64{8, 9, 10}, fetch(t, "FETCH ALL FROM c"))
+	require.Empty(t, fetch(t, "FETCH c"))
+
+	_, err = conn.Exec(ctx, "CLOSE c")
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, "COMMIT")
+	require.NoError(t, err)
+}
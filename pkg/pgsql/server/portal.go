@@ -0,0 +1,186 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"errors"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
+)
+
+type portal struct {
+	Name                    string
+	Statement               *statement
+	Parameters              []*schema.NamedParam
+	ResultColumnFormatCodes []int16
+
+	// reader is kept open while the execution of the portal is suspended
+	reader sql.RowReader
+	// tx is the transaction created to read the rows of a suspended portal, if any
+	tx *sql.SQLTx
+	// completed is set once the portal has been executed to completion
+	completed bool
+}
+
+// close releases the resources held by a suspended portal
+func (p *portal) close() {
+	if p.reader != nil {
+		p.reader.Close()
+		p.reader = nil
+	}
+
+	if p.tx != nil {
+		p.tx.Cancel()
+		p.tx = nil
+	}
+}
+
+// executePortal executes the portal returning at most maxRows rows, zero meaning all of them.
+// When the limit is reached before all the rows are returned, the portal is suspended and its
+// execution can be resumed by a subsequent Execute message.
+func (s *session) executePortal(p *portal, maxRows int32) error {
+	if p.reader != nil {
+		return s.withStatementTimeout(func() error {
+			return s.fetchPortalRows(p, maxRows)
+		})
+	}
+
+	if p.completed {
+		_, err := s.writeMessage(bm.CommandComplete([]byte("ok")))
+		return err
+	}
+
+	st, ok := p.Statement.PreparedStmt.(*sql.SelectStmt)
+	if maxRows <= 0 || !ok || s.isEmulableInternally(p.Statement.SQLStatement) != nil {
+		p.completed = true
+
+		return s.fetchAndWriteResults(p.Statement.SQLStatement,
+			p.Parameters,
+			p.ResultColumnFormatCodes,
+			true,
+		)
+	}
+
+	return s.withStatementTimeout(func() error {
+		if err := s.openPortal(p, st); err != nil {
+			p.completed = true
+			return err
+		}
+		return s.fetchPortalRows(p, maxRows)
+	})
+}
+
+func (s *session) openPortal(p *portal, st *sql.SelectStmt) error {
+	tx, err := s.sqlTx()
+	if err != nil {
+		return err
+	}
+
+	reader, err := s.db.SQLQueryPrepared(s.ctx, tx, st, schema.NamedParamsFromProto(p.Parameters))
+	if err != nil {
+		if tx != nil && tx != s.tx {
+			tx.Cancel()
+		}
+		return err
+	}
+
+	p.reader = reader
+
+	if tx != s.tx {
+		p.tx = tx
+	}
+
+	return nil
+}
+
+func (s *session) fetchPortalRows(p *portal, maxRows int32) error {
+	cols, err := p.reader.Columns(s.ctx)
+	if err != nil {
+		p.close()
+		p.completed = true
+		return err
+	}
+
+	rows := make([]*sql.Row, 0, maxRowsPerMessage)
+
+	writeRows := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		_, err := s.writeMessage(bm.DataRow(rows, len(cols), p.ResultColumnFormatCodes))
+		rows = rows[:0]
+		return err
+	}
+
+	for n := int32(0); maxRows <= 0 || n < maxRows; n++ {
+		row, err := p.reader.Read(s.ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			p.close()
+			p.completed = true
+			return err
+		}
+
+		localizeRow(row, s.timeZone())
+		rows = append(rows, row)
+
+		if len(rows) == maxRowsPerMessage {
+			if err := writeRows(); err != nil {
+				return err
+			}
+		}
+
+		if n+1 == maxRows {
+			if err := writeRows(); err != nil {
+				return err
+			}
+
+			_, err := s.writeMessage(bm.PortalSuspended())
+			return err
+		}
+	}
+
+	p.close()
+	p.completed = true
+
+	if err := writeRows(); err != nil {
+		return err
+	}
+
+	_, err = s.writeMessage(bm.CommandComplete([]byte("ok")))
+	return err
+}
+
+// closePortal closes the portal with the given name, if any
+func (s *session) closePortal(name string) {
+	if p, ok := s.portals[name]; ok {
+		p.close()
+		delete(s.portals, name)
+	}
+}
+
+// closePortals closes all the portals of the session, as done at the end of each transaction
+func (s *session) closePortals() {
+	for name := range s.portals {
+		s.closePortal(name)
+	}
+}
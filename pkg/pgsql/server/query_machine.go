@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -41,7 +42,7 @@ const (
 func (s *session) QueryMachine() error {
 	var waitForSync = false
 
-	_, err := s.writeMessage(bm.ReadyForQuery())
+	err := s.readyForQuery()
 	if err != nil {
 		return err
 	}
@@ -53,10 +54,15 @@ func (s *session) QueryMachine() error {
 				s.log.Warningf("connection is closed")
 				return nil
 			}
+			waitForSync = extQueryMode
 			s.HandleError(err)
 			continue
 		}
 
+		if s.listener != nil {
+			s.listener.setIdle(false)
+		}
+
 		// When an error is detected while processing any extended-query message, the backend issues ErrorResponse,
 		// then reads and discards messages until a Sync is reached, then issues ReadyForQuery and returns to normal
 		// message processing. (But note that no skipping occurs if an error is detected while processing Sync — this
@@ -82,13 +88,16 @@ func (s *session) QueryMachine() error {
 				statements = fmt.Sprintf("select column_name as tq, column_name as tow, column_name as tn, column_name as COLUMN_NAME, type_name as DATA_TYPE, type_name as TYPE_NAME, type_name as p, type_name as l, type_name as s, type_name as r, is_nullable as NULLABLE, column_name as rk, column_name as cd, type_name as SQL_DATA_TYPE, type_name as sts, column_name as coll, type_name as orp, is_nullable as IS_NULLABLE, type_name as dz, type_name as ft, type_name as iau, type_name as pn, column_name as toi, column_name as btd, column_name as tmo, column_name as tin from table(%s)", tableName)
 			}
 
+			// a simple query destroys the unnamed portal
+			s.closePortal("")
+
 			err := s.fetchAndWriteResults(statements, nil, nil, extQueryMode)
 			if err != nil {
 				waitForSync = extQueryMode
 				s.HandleError(err)
 			}
 
-			if _, err = s.writeMessage(bm.ReadyForQuery()); err != nil {
+			if err = s.readyForQuery(); err != nil {
 				waitForSync = extQueryMode
 			}
 		case fm.ParseMsg:
@@ -104,7 +113,18 @@ func (s *session) QueryMachine() error {
 			var resCols []sql.ColDescriptor
 			var stmt sql.SQLStmt
 
-			if !s.isInBlackList(v.Statements) {
+			sessionStmt, err := parseSessionStmt(v.Statements)
+			if err != nil {
+				waitForSync = extQueryMode
+				s.HandleError(err)
+				continue
+			}
+
+			if show, ok := sessionStmt.(*showStmt); ok {
+				resCols = show.resultCols()
+			}
+
+			if sessionStmt == nil && !s.isInBlackList(v.Statements) {
 				stmts, err := sql.ParseSQL(strings.NewReader(v.Statements))
 				if err != nil {
 					waitForSync = extQueryMode
@@ -126,6 +146,8 @@ func (s *session) QueryMachine() error {
 					s.HandleError(err)
 					continue
 				}
+
+				stmt = stmts[0]
 			}
 
 			_, err = s.writeMessage(bm.ParseComplete())
@@ -191,7 +213,14 @@ func (s *session) QueryMachine() error {
 			}
 		case fm.SyncMsg:
 			waitForSync = false
-			s.writeMessage(bm.ReadyForQuery())
+
+			// portals only live until the end of the transaction, which is implicitly closed by Sync
+			// when not inside a BEGIN/COMMIT transaction block
+			if s.tx == nil {
+				s.closePortals()
+			}
+
+			s.readyForQuery()
 		case fm.BindMsg:
 			_, ok := s.portals[v.DestPortalName]
 			// unnamed portal overrides previous
@@ -208,7 +237,7 @@ func (s *session) QueryMachine() error {
 				continue
 			}
 
-			encodedParams, err := buildNamedParams(st.Params, v.ParamVals)
+			encodedParams, err := buildNamedParams(st.Params, v.ParamVals, s.timeZone())
 			if err != nil {
 				waitForSync = extQueryMode
 				s.HandleError(err)
@@ -220,6 +249,8 @@ func (s *session) QueryMachine() error {
 				continue
 			}
 
+			s.closePortal(v.DestPortalName)
+
 			newPortal := &portal{
 				Name:                    v.DestPortalName,
 				Statement:               st,
@@ -237,19 +268,24 @@ func (s *session) QueryMachine() error {
 				continue
 			}
 
-			delete(s.portals, v.PortalName)
-
-			err := s.fetchAndWriteResults(portal.Statement.SQLStatement,
-				portal.Parameters,
-				portal.ResultColumnFormatCodes,
-				extQueryMode,
-			)
-			if err != nil {
+			if err := s.executePortal(portal, v.MaxRows); err != nil {
 				waitForSync = extQueryMode
 				s.HandleError(err)
 			}
+		case fm.CloseMsg:
+			if v.CloseType == "S" {
+				delete(s.statements, v.Name)
+			} else {
+				s.closePortal(v.Name)
+			}
+
+			if _, err := s.writeMessage(bm.CloseComplete()); err != nil {
+				waitForSync = extQueryMode
+			}
 		case fm.FlushMsg:
 			// there is no buffer to be flushed
+		case fm.CopyDataMsg, fm.CopyDoneMsg, fm.CopyFailMsg:
+			// messages of an aborted COPY operation are dropped
 		default:
 			waitForSync = extQueryMode
 			s.HandleError(pserr.ErrUnknowMessageType)
@@ -272,6 +308,55 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 		return err
 	}
 
+	sessionStmt, err := parseSessionStmt(statements)
+	if err != nil {
+		return err
+	}
+	if sessionStmt != nil {
+		return s.execSessionStmt(sessionStmt, extQueryMode)
+	}
+
+	return s.withStatementTimeout(func() error {
+		return s.execStatements(statements, parameters, resultColumnFormatCodes, extQueryMode)
+	})
+}
+
+// withStatementTimeout runs fn aborting it once the statement timeout of the session, if any, expires
+func (s *session) withStatementTimeout(fn func() error) error {
+	timeout := s.statementTimeout()
+	if timeout <= 0 {
+		return fn()
+	}
+
+	sessionCtx := s.ctx
+
+	ctx, cancel := context.WithTimeout(sessionCtx, timeout)
+	s.ctx = ctx
+
+	defer func() {
+		cancel()
+		s.ctx = sessionCtx
+	}()
+
+	err := fn()
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return pserr.ErrStatementTimeout
+	}
+	return err
+}
+
+func (s *session) execStatements(statements string, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, extQueryMode bool) error {
+	copyStmt, err := parseCopyStmt(statements)
+	if err != nil {
+		return err
+	}
+	if copyStmt != nil {
+		if extQueryMode {
+			return fmt.Errorf("%w: COPY is only supported by the simple query protocol", pserr.ErrInvalidCopyStmt)
+		}
+		return s.copy(copyStmt)
+	}
+
 	stmts, err := sql.ParseSQL(
 		strings.NewReader(
 			removePGCatalogReferences(statements),
@@ -287,7 +372,7 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 			{
 				return pserr.ErrUseDBStatementNotSupported
 			}
-		case *sql.SelectStmt:
+		case sql.DataSource:
 			if err = s.query(st, parameters, resultColumnFormatCodes, extQueryMode); err != nil {
 				return err
 			}
@@ -310,11 +395,14 @@ func removePGCatalogReferences(sql string) string {
 	return strings.ReplaceAll(sql, "pg_catalog.", "")
 }
 
-func (s *session) query(st *sql.SelectStmt, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
+func (s *session) query(st sql.DataSource, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
 	tx, err := s.sqlTx()
 	if err != nil {
 		return err
 	}
+	if tx != nil && tx != s.tx {
+		defer tx.Cancel()
+	}
 
 	reader, err := s.db.SQLQueryPrepared(s.ctx, tx, st, schema.NamedParamsFromProto(parameters))
 	if err != nil {
@@ -334,12 +422,20 @@ func (s *session) query(st *sql.SelectStmt, parameters []*schema.NamedParam, res
 	}
 
 	return sql.ReadRowsBatch(s.ctx, reader, maxRowsPerMessage, func(rowBatch []*sql.Row) error {
+		for _, row := range rowBatch {
+			localizeRow(row, s.timeZone())
+		}
+
 		_, err := s.writeMessage(bm.DataRow(rowBatch, len(cols), resultColumnFormatCodes))
 		return err
 	})
 }
 
 func (s *session) exec(st sql.SQLStmt, namedParams []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
+	if err := s.checkReadOnly(st); err != nil {
+		return err
+	}
+
 	params := make(map[string]interface{}, len(namedParams))
 
 	for _, p := range namedParams {
@@ -351,17 +447,42 @@ func (s *session) exec(st sql.SQLStmt, namedParams []*schema.NamedParam, resultC
 		return err
 	}
 
+	switch st.(type) {
+	case *sql.CommitStmt, *sql.RollbackStmt:
+		// portals are closed at the end of the transaction in which they were created
+		s.closePortals()
+	}
+
 	ntx, _, err := s.db.SQLExecPrepared(s.ctx, tx, []sql.SQLStmt{st}, params)
 	s.tx = ntx
 
+	if s.tx == nil && len(s.pendingNotifications) > 0 {
+		_, commit := st.(*sql.CommitStmt)
+		s.endTransaction(commit && err == nil)
+	}
+
+	if s.tx == nil && len(s.localParams) > 0 {
+		if rerr := s.restoreLocalParams(); rerr != nil && err == nil {
+			err = rerr
+		}
+	}
+
 	return err
 }
 
-type portal struct {
-	Name                    string
-	Statement               *statement
-	Parameters              []*schema.NamedParam
-	ResultColumnFormatCodes []int16
+// checkReadOnly rejects the statements which may change the database when the session is in read-only mode
+func (s *session) checkReadOnly(st sql.SQLStmt) error {
+	if !s.readOnly() {
+		return nil
+	}
+
+	switch st.(type) {
+	case *sql.SelectStmt, *sql.BeginTransactionStmt, *sql.CommitStmt, *sql.RollbackStmt,
+		*sql.DeclareCursorStmt, *sql.MoveStmt, *sql.CloseCursorStmt:
+		return nil
+	}
+
+	return pserr.ErrReadOnlyTransaction
 }
 
 type statement struct {
@@ -375,9 +496,9 @@ type statement struct {
 func (s *session) inferParamAndResultCols(stmt sql.SQLStmt) ([]sql.ColDescriptor, []sql.ColDescriptor, error) {
 	var resCols []sql.ColDescriptor
 
-	sel, ok := stmt.(*sql.SelectStmt)
+	ds, ok := stmt.(sql.DataSource)
 	if ok {
-		rr, err := s.db.SQLQueryPrepared(s.ctx, s.tx, sel, nil)
+		rr, err := s.db.SQLQueryPrepared(s.ctx, s.tx, ds, nil)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -76,7 +76,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('B', h.Join([][]byte{h.S("port"), h.S("wrong_st"), h.I16(1), h.I16(0), h.I16(1), h.I32(2), h.I16(1), h.I16(1), h.I16(1)})))
@@ -111,11 +111,11 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				errst := make([]byte, 500)
 				c2.Read(errst)
 				// Terminate message
@@ -130,7 +130,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				c2.Close()
 			},
 			out: nil,
@@ -140,7 +140,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('D', h.Join([][]byte{{'S'}, h.S("wrong st")})))
@@ -153,7 +153,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('D', h.Join([][]byte{{'S'}, h.S("st")})))
@@ -166,7 +166,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('D', h.Join([][]byte{{'S'}, h.S("st")})))
@@ -181,7 +181,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('D', h.Join([][]byte{{'P'}, h.S("port")})))
@@ -234,7 +234,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('Q', h.S("set test")))
+				c2.Write(h.Msg('Q', h.S("set test = val")))
 				c2.Close()
 			},
 			out: nil,
@@ -244,7 +244,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 			in: func(c2 net.Conn) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
-				c2.Write(h.Msg('Q', h.S("set test")))
+				c2.Write(h.Msg('Q', h.S("set test = val")))
 				cc := make([]byte, len(bmessages.CommandComplete([]byte(`ok`))))
 				c2.Read(cc)
 				c2.Close()
@@ -257,7 +257,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set test = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('B', h.Join([][]byte{h.S("port"), h.S("st"), h.I16(1), h.I16(0), h.I16(1), h.I32(2), h.I16(1), h.I16(1), h.I16(1)})))
@@ -298,7 +298,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set set"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set set = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('B', h.Join([][]byte{h.S("port"), h.S("st"), h.I16(1), h.I16(0), h.I16(1), h.I32(2), h.I16(1), h.I16(1), h.I16(1)})))
@@ -335,7 +335,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set set"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set set = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('B', h.Join([][]byte{h.S("port"), h.S("st"), h.I16(1), h.I16(0), h.I16(1), h.I32(2), h.I16(1), h.I16(1), h.I16(1)})))
@@ -352,7 +352,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				ready4Query := make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				//parse message
-				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set set"), h.I16(1), h.I32(0)})))
+				c2.Write(h.Msg('P', h.Join([][]byte{h.S("st"), h.S("set set = val"), h.I16(1), h.I32(0)})))
 				ready4Query = make([]byte, len(bmessages.ReadyForQuery()))
 				c2.Read(ready4Query)
 				c2.Write(h.Msg('B', h.Join([][]byte{h.S("port"), h.S("st"), h.I16(1), h.I16(0), h.I16(1), h.I32(2), h.I16(1), h.I16(1), h.I16(1)})))
@@ -419,6 +419,7 @@ func TestSession_QueriesMachine(t *testing.T) {
 				mr:         mr,
 				statements: make(map[string]*statement),
 				portals:    make(map[string]*portal),
+				params:     make(map[string]string),
 				db:         &mockDB{},
 			}
 
@@ -441,6 +442,10 @@ type mockDB struct {
 	database.DB
 }
 
+func (db *mockDB) GetName() string {
+	return "defaultdb"
+}
+
 func (db *mockDB) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error) {
 	return nil, fmt.Errorf("dummy error")
 }
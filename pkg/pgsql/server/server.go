@@ -19,6 +19,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
@@ -41,6 +42,13 @@ type pgsrv struct {
 	port               int
 	immudbPort         int
 	dbList             database.DatabaseList
+	authMethods        []string
+	authenticator      Authenticator
+	clientCAs          *x509.CertPool
+	certUserMappings   []*CertUserMapping
+	mockAuthSecret     []byte
+	copyRowsPerTx      int
+	notifier           *notifier
 	listener           net.Listener
 }
 
@@ -61,17 +69,37 @@ func New(setters ...Option) *pgsrv {
 		host:           "0.0.0.0",
 		immudbPort:     3322,
 		port:           5432,
+		authMethods:    DefaultAuthMethods,
+		copyRowsPerTx:  DefaultCopyRowsPerTx,
 	}
 
 	for _, setter := range setters {
 		setter(srv)
 	}
 
+	if srv.mockAuthSecret == nil {
+		// unknown users are presented the same salt only until the server is restarted
+		srv.mockAuthSecret = randomBytes(mockAuthSecretLen)
+	}
+
+	if srv.clientCAs != nil && srv.tlsConfig != nil {
+		// client certificates are verified on the pgsql port without affecting the shared TLS configuration
+		srv.tlsConfig = srv.tlsConfig.Clone()
+		srv.tlsConfig.ClientCAs = srv.clientCAs
+		srv.tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	srv.notifier = newNotifier(srv.logger)
+
 	return srv
 }
 
 // Initialize initialize listener. If provided port is zero os auto assign a free one.
 func (s *pgsrv) Initialize() (err error) {
+	if s.certAuthEnabled() && (s.tlsConfig == nil || len(s.tlsConfig.Certificates) == 0 || s.tlsConfig.ClientCAs == nil) {
+		return fmt.Errorf("%w: '%s' requires TLS and the CAs of client certificates to be configured", ErrUnsupportedAuthMethod, AuthMethodCert)
+	}
+
 	s.listener, err = net.Listen("tcp", fmt.Sprintf("%s:%d", s.host, s.port))
 	if err != nil {
 		return err
@@ -104,8 +132,17 @@ func (s *pgsrv) Serve() (err error) {
 	}
 }
 
+func (s *pgsrv) certAuthEnabled() bool {
+	for _, m := range s.authMethods {
+		if m == AuthMethodCert {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *pgsrv) newSession(conn net.Conn) Session {
-	return newSession(conn, s.host, s.immudbPort, s.logger, s.tlsConfig, s.logRequestMetadata, s.dbList)
+	return newSession(conn, s.host, s.immudbPort, s.logger, s.tlsConfig, s.logRequestMetadata, s.dbList, s.authMethods, s.authenticator, s.certUserMappings, s.mockAuthSecret, s.copyRowsPerTx, s.notifier)
 }
 
 func (s *pgsrv) Stop() (err error) {
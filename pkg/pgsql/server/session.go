@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"net"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/embedded/sql"
@@ -42,8 +43,24 @@ type session struct {
 
 	dbList database.DatabaseList
 
+	authMethods      []string
+	authenticator    Authenticator
+	certUserMappings []*CertUserMapping
+	mockAuthSecret   []byte
+
+	copyRowsPerTx int
+
+	notifier *notifier
+	// listener is created when the session listens to a channel for the first time
+	listener *listener
+	// notifications raised within the current transaction, delivered on commit
+	pendingNotifications []*notification
+
 	client client.ImmuClient
 
+	sessionID     string
+	keepAliveDone chan struct{}
+
 	ctx    context.Context
 	user   string
 	ipAddr string
@@ -57,6 +74,12 @@ type session struct {
 
 	statements map[string]*statement
 	portals    map[string]*portal
+
+	// run-time parameters changed by the frontend, keyed by lower-cased name
+	params map[string]string
+	// previous values of the parameters changed with SET LOCAL in the current transaction
+	localParams map[string]*string
+	loc         *time.Location
 }
 
 type Session interface {
@@ -75,6 +98,12 @@ func newSession(
 	tlsConfig *tls.Config,
 	logRequestMetadata bool,
 	dbList database.DatabaseList,
+	authMethods []string,
+	authenticator Authenticator,
+	certUserMappings []*CertUserMapping,
+	mockAuthSecret []byte,
+	copyRowsPerTx int,
+	notifier *notifier,
 ) *session {
 	addr := c.RemoteAddr().String()
 	i := strings.Index(addr, ":")
@@ -89,10 +118,19 @@ func newSession(
 		log:                log,
 		logRequestMetadata: logRequestMetadata,
 		dbList:             dbList,
+		authMethods:        authMethods,
+		authenticator:      authenticator,
+		certUserMappings:   certUserMappings,
+		mockAuthSecret:     mockAuthSecret,
+		copyRowsPerTx:      copyRowsPerTx,
+		notifier:           notifier,
 		ipAddr:             addr,
 		mr:                 NewMessageReader(c),
 		statements:         make(map[string]*statement),
 		portals:            make(map[string]*portal),
+		params:             make(map[string]string),
+		localParams:        make(map[string]*string),
+		loc:                time.UTC,
 	}
 }
 
@@ -120,7 +158,8 @@ func (s *session) nextMessage() (interface{}, bool, error) {
 		msg.t == 'B' ||
 		msg.t == 'D' ||
 		msg.t == 'E' ||
-		msg.t == 'H' {
+		msg.t == 'H' ||
+		msg.t == 'C' {
 		extQueryMode = true
 	}
 
@@ -147,6 +186,14 @@ func (s *session) parseRawMessage(msg *rawMessage) (interface{}, error) {
 		return fm.ParseExecuteMsg(msg.payload)
 	case 'H':
 		return fm.ParseFlushMsg(msg.payload)
+	case 'C':
+		return fm.ParseCloseMsg(msg.payload)
+	case 'd':
+		return fm.ParseCopyDataMsg(msg.payload)
+	case 'c':
+		return fm.ParseCopyDoneMsg(msg.payload)
+	case 'f':
+		return fm.ParseCopyFailMsg(msg.payload)
 	default:
 		return nil, errors.ErrUnknowMessageType
 	}
@@ -160,17 +207,34 @@ func (s *session) writeMessage(msg []byte) (int, error) {
 	return s.mr.Write(msg)
 }
 
+// sqlTx returns the transaction in which statements are executed. Outside explicit transactions,
+// nil is returned unless a transaction has to be created to inject request metadata or
+// to apply the options derived from the session parameters.
 func (s *session) sqlTx() (*sql.SQLTx, error) {
-	if s.tx != nil || !s.logRequestMetadata {
+	if s.tx != nil {
 		return s.tx, nil
 	}
 
-	md := schema.Metadata{
-		schema.UserRequestMetadataKey: s.user,
-		schema.IpRequestMetadataKey:   s.ipAddr,
+	opts := s.txOptions()
+	if opts == nil && !s.logRequestMetadata {
+		return nil, nil
+	}
+
+	if opts == nil {
+		opts = sql.DefaultTxOptions()
+	}
+
+	ctx := s.ctx
+
+	if s.logRequestMetadata {
+		md := schema.Metadata{
+			schema.UserRequestMetadataKey: s.user,
+			schema.IpRequestMetadataKey:   s.ipAddr,
+		}
+
+		// create transaction explicitly to inject request metadata
+		ctx = schema.ContextWithMetadata(s.ctx, md)
 	}
 
-	// create transaction explicitly to inject request metadata
-	ctx := schema.ContextWithMetadata(s.ctx, md)
-	return s.db.NewSQLTx(ctx, sql.DefaultTxOptions())
+	return s.db.NewSQLTx(ctx, opts)
 }
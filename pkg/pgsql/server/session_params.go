@@ -0,0 +1,604 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
+)
+
+const (
+	paramClientEncoding      = "client_encoding"
+	paramDateStyle           = "DateStyle"
+	paramReadOnly            = "default_transaction_read_only"
+	paramSearchPath          = "search_path"
+	paramSnapshotSinceTx     = "immudb.snapshot_since_tx"
+	paramStatementTimeout    = "statement_timeout"
+	paramTimeZone            = "TimeZone"
+	paramTransactionReadOnly = "transaction_read_only"
+)
+
+// sessionParam describes a run-time parameter which can be inspected with SHOW
+// and, unless it's read-only, changed with SET and RESET
+type sessionParam struct {
+	name         string
+	defaultValue string
+	description  string
+
+	// reported parameters are notified to the frontend through ParameterStatus messages
+	reported bool
+	readOnly bool
+
+	// normalize validates the value and returns its canonical representation
+	normalize func(s *session, value string) (string, error)
+
+	// derivedFrom is the parameter the value is taken from
+	derivedFrom string
+}
+
+var sessionParams = []*sessionParam{
+	{
+		name:        "application_name",
+		description: "Sets the application name to be reported in statistics and logs.",
+		reported:    true,
+	},
+	{
+		name:         paramClientEncoding,
+		defaultValue: "UTF8",
+		description:  "Sets the client's character set encoding.",
+		reported:     true,
+		normalize:    normalizeClientEncoding,
+	},
+	{
+		name:         paramDateStyle,
+		defaultValue: "ISO, MDY",
+		description:  "Sets the display format for date and time values.",
+		reported:     true,
+		normalize:    normalizeDateStyle,
+	},
+	{
+		name:         paramReadOnly,
+		defaultValue: "off",
+		description:  "Sets the default read-only status of new transactions.",
+		reported:     true,
+		normalize:    normalizeBoolParam,
+	},
+	{
+		name:         paramSnapshotSinceTx,
+		defaultValue: "0",
+		description:  "Sets the transaction that must be included in the snapshot of new transactions, zero disables it.",
+		normalize:    normalizeSnapshotSinceTx,
+	},
+	{
+		name:         "integer_datetimes",
+		defaultValue: "on",
+		description:  "Shows whether datetimes are integer based.",
+		reported:     true,
+		readOnly:     true,
+	},
+	{
+		name:         "IntervalStyle",
+		defaultValue: "postgres",
+		description:  "Sets the display format for interval values.",
+		reported:     true,
+		normalize:    allowedValues("postgres"),
+	},
+	{
+		name:         "max_identifier_length",
+		defaultValue: "63",
+		description:  "Shows the maximum identifier length.",
+		readOnly:     true,
+	},
+	{
+		name:         paramSearchPath,
+		defaultValue: "public",
+		description:  "Sets the schema search order for names that are not schema-qualified.",
+		normalize:    normalizeSearchPath,
+	},
+	{
+		name:         "server_encoding",
+		defaultValue: "UTF8",
+		description:  "Shows the server (database) character set encoding.",
+		reported:     true,
+		readOnly:     true,
+	},
+	{
+		name:         "server_version",
+		defaultValue: pgmeta.PgsqlServerVersion,
+		description:  "Shows the server version.",
+		reported:     true,
+		readOnly:     true,
+	},
+	{
+		name:         "standard_conforming_strings",
+		defaultValue: "on",
+		description:  "Causes '...' strings to treat backslashes literally.",
+		reported:     true,
+		normalize:    allowedValues("on"),
+	},
+	{
+		name:         paramStatementTimeout,
+		defaultValue: "0",
+		description:  "Sets the maximum allowed duration of any statement, zero disables it.",
+		normalize:    normalizeStatementTimeout,
+	},
+	{
+		name:         paramTimeZone,
+		defaultValue: "UTC",
+		description:  "Sets the time zone for displaying and interpreting time stamps.",
+		reported:     true,
+		normalize:    normalizeTimeZone,
+	},
+	{
+		name:        paramTransactionReadOnly,
+		description: "Shows the read-only status of the current transaction.",
+		readOnly:    true,
+		derivedFrom: paramReadOnly,
+	},
+}
+
+func lookupSessionParam(name string) *sessionParam {
+	for _, p := range sessionParams {
+		if strings.EqualFold(p.name, name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// paramValue returns the current value of a parameter, the default value is returned unless it was set
+func (s *session) paramValue(name string) string {
+	p := lookupSessionParam(name)
+	if p != nil && p.derivedFrom != "" {
+		return s.paramValue(p.derivedFrom)
+	}
+
+	if v, ok := s.params[strings.ToLower(name)]; ok {
+		return v
+	}
+
+	if p != nil {
+		return p.defaultValue
+	}
+
+	return ""
+}
+
+// showParam returns the canonical name and the current value of a parameter
+func (s *session) showParam(name string) (string, string, error) {
+	if p := lookupSessionParam(name); p != nil {
+		return p.name, s.paramValue(p.name), nil
+	}
+
+	// placeholder parameters are only known once they are set
+	v, ok := s.params[strings.ToLower(name)]
+	if !ok {
+		return "", "", fmt.Errorf("%w \"%s\"", pserr.ErrUnrecognizedParameter, name)
+	}
+
+	return name, v, nil
+}
+
+// setParam changes the value of a parameter, the default value is restored when value is nil.
+// Changes of reported parameters are notified to the frontend.
+func (s *session) setParam(name string, value *string) error {
+	p, changed, err := s.updateParam(name, value)
+	if err != nil {
+		return err
+	}
+
+	if p != nil && p.name == paramSearchPath && value != nil {
+		if err := s.noticeIgnoredSchemas(*value); err != nil {
+			return err
+		}
+	}
+
+	if p == nil || !p.reported || !changed {
+		return nil
+	}
+
+	_, err = s.writeMessage(bm.ParameterStatus([]byte(p.name), []byte(s.paramValue(p.name))))
+	return err
+}
+
+// updateParam stores the new value of a parameter and returns whether it was changed.
+// Unknown parameters are kept as placeholders, so their values can be later inspected with SHOW.
+func (s *session) updateParam(name string, value *string) (*sessionParam, bool, error) {
+	p := lookupSessionParam(name)
+
+	if p == nil {
+		s.log.Debugf("setting unrecognized parameter '%s', it has no effect on the session", name)
+
+		if value == nil {
+			delete(s.params, strings.ToLower(name))
+		} else {
+			s.params[strings.ToLower(name)] = *value
+		}
+		return nil, true, nil
+	}
+
+	if p.readOnly {
+		return nil, false, fmt.Errorf("%w: \"%s\"", pserr.ErrParameterCannotBeChanged, p.name)
+	}
+
+	prev := s.paramValue(p.name)
+
+	newValue := p.defaultValue
+	if value != nil {
+		newValue = *value
+	}
+
+	if p.normalize != nil {
+		v, err := p.normalize(s, newValue)
+		if err != nil {
+			return nil, false, fmt.Errorf("%w \"%s\": %s", pserr.ErrInvalidParameterValue, p.name, err.Error())
+		}
+		newValue = v
+	}
+
+	if p.name == paramTimeZone {
+		loc, err := loadTimeZone(newValue)
+		if err != nil {
+			return nil, false, err
+		}
+		s.loc = loc
+	}
+
+	if value == nil {
+		delete(s.params, strings.ToLower(p.name))
+	} else {
+		s.params[strings.ToLower(p.name)] = newValue
+	}
+
+	return p, prev != newValue, nil
+}
+
+// setLocalParam changes the value of a parameter until the end of the current transaction
+func (s *session) setLocalParam(name string, value *string) error {
+	key := strings.ToLower(name)
+
+	if _, saved := s.localParams[key]; !saved {
+		var prev *string
+		if v, ok := s.params[key]; ok {
+			prev = &v
+		}
+		s.localParams[key] = prev
+	}
+
+	return s.setParam(name, value)
+}
+
+// restoreLocalParams reverts the parameters changed with SET LOCAL once the transaction is completed
+func (s *session) restoreLocalParams() error {
+	for name, prev := range s.localParams {
+		if err := s.setParam(name, prev); err != nil {
+			return err
+		}
+	}
+
+	s.localParams = make(map[string]*string)
+
+	return nil
+}
+
+// resetParams restores the default value of every parameter which can be changed
+func (s *session) resetParams() error {
+	for name := range s.params {
+		if p := lookupSessionParam(name); p != nil && p.readOnly {
+			continue
+		}
+
+		if err := s.setParam(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyStartupParams sets the parameters provided in the startup message.
+// Invalid values are ignored, the actual values are then reported to the frontend.
+func (s *session) applyStartupParams() {
+	for name, value := range s.connParams {
+		switch name {
+		case "user", "database", "options", "replication":
+			continue
+		}
+
+		p := lookupSessionParam(name)
+		if p == nil || p.readOnly {
+			continue
+		}
+
+		v := value
+		if _, _, err := s.updateParam(p.name, &v); err != nil {
+			s.log.Warningf("ignoring startup parameter '%s': %s", name, err.Error())
+		}
+	}
+}
+
+// writeParamsStatus reports the current value of the parameters notified through ParameterStatus messages
+func (s *session) writeParamsStatus() error {
+	for _, p := range sessionParams {
+		if !p.reported {
+			continue
+		}
+
+		if _, err := s.writeMessage(bm.ParameterStatus([]byte(p.name), []byte(s.paramValue(p.name)))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// timeZone returns the location used to interpret and display timestamps
+func (s *session) timeZone() *time.Location {
+	if s.loc == nil {
+		return time.UTC
+	}
+	return s.loc
+}
+
+func (s *session) statementTimeout() time.Duration {
+	d, _ := parseDurationParam(s.paramValue(paramStatementTimeout))
+	return d
+}
+
+func (s *session) readOnly() bool {
+	return s.paramValue(paramReadOnly) == "on"
+}
+
+// txOptions returns the options of the transactions implicitly created to run a statement,
+// nil is returned when the default options apply
+func (s *session) txOptions() *sql.TxOptions {
+	sinceTx, _ := strconv.ParseUint(s.paramValue(paramSnapshotSinceTx), 10, 64)
+	if sinceTx == 0 {
+		return nil
+	}
+
+	return sql.DefaultTxOptions().
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 {
+			return sinceTx
+		})
+}
+
+func allowedValues(values ...string) func(s *session, value string) (string, error) {
+	return func(s *session, value string) (string, error) {
+		for _, v := range values {
+			if strings.EqualFold(v, value) {
+				return v, nil
+			}
+		}
+		return "", fmt.Errorf("only %s is supported", strings.Join(values, ", "))
+	}
+}
+
+func normalizeBoolParam(s *session, value string) (string, error) {
+	b, err := parseBool(value)
+	if err != nil {
+		return "", err
+	}
+	if b {
+		return "on", nil
+	}
+	return "off", nil
+}
+
+func normalizeClientEncoding(s *session, value string) (string, error) {
+	switch strings.ToUpper(strings.ReplaceAll(value, "-", "")) {
+	case "UTF8", "UNICODE":
+		return "UTF8", nil
+	case "SQL_ASCII":
+		// no conversion is applied, as PostgreSQL does for this encoding
+		return "SQL_ASCII", nil
+	}
+	return "", fmt.Errorf("encoding %s is not supported, only UTF8 is supported", value)
+}
+
+// normalizeDateStyle accepts the ISO output format only, the field ordering is kept for compatibility
+func normalizeDateStyle(s *session, value string) (string, error) {
+	format := "ISO"
+	order := "MDY"
+
+	if current, ok := s.params[strings.ToLower(paramDateStyle)]; ok {
+		order = strings.TrimPrefix(current, format+", ")
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		switch strings.ToUpper(strings.TrimSpace(part)) {
+		case "ISO":
+		case "MDY", "US", "NONEURO", "NONEUROPEAN":
+			order = "MDY"
+		case "DMY", "EURO", "EUROPEAN":
+			order = "DMY"
+		case "YMD":
+			order = "YMD"
+		default:
+			return "", fmt.Errorf("date style %s is not supported, only the ISO format is supported", part)
+		}
+	}
+
+	return format + ", " + order, nil
+}
+
+var durationUnits = []struct {
+	name string
+	unit time.Duration
+}{
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"min", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+}
+
+func normalizeStatementTimeout(s *session, value string) (string, error) {
+	d, err := parseDurationParam(value)
+	if err != nil {
+		return "", err
+	}
+	return formatDurationParam(d), nil
+}
+
+// parseDurationParam parses an amount of time, milliseconds are assumed when no unit is specified
+func parseDurationParam(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	i := 0
+	for i < len(value) && value[i] >= '0' && value[i] <= '9' {
+		i++
+	}
+
+	n, err := strconv.ParseInt(value[:i], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("a non-negative duration is expected")
+	}
+
+	unit := strings.ToLower(strings.TrimSpace(value[i:]))
+	if unit == "" {
+		return time.Duration(n) * time.Millisecond, nil
+	}
+
+	for _, u := range durationUnits {
+		if u.name == unit {
+			return time.Duration(n) * u.unit, nil
+		}
+	}
+
+	return 0, fmt.Errorf("valid units are ms, s, min, h and d")
+}
+
+// formatDurationParam represents an amount of time using the largest unit which keeps it integral
+func formatDurationParam(d time.Duration) string {
+	if d == 0 {
+		return "0"
+	}
+
+	for _, u := range durationUnits {
+		if d%u.unit == 0 {
+			return strconv.FormatInt(int64(d/u.unit), 10) + u.name
+		}
+	}
+
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
+
+func normalizeTimeZone(s *session, value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch strings.ToUpper(value) {
+	case "UTC", "Z", "ZULU":
+		return "UTC", nil
+	case "GMT":
+		return "GMT", nil
+	}
+
+	// a number of hours east of UTC
+	if h, err := strconv.Atoi(value); err == nil {
+		if h < -15 || h > 15 {
+			return "", fmt.Errorf("time zone offset out of range")
+		}
+		return strconv.Itoa(h), nil
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		return "", fmt.Errorf("unknown time zone %s", value)
+	}
+
+	return value, nil
+}
+
+func loadTimeZone(name string) (*time.Location, error) {
+	if h, err := strconv.Atoi(name); err == nil {
+		return time.FixedZone(name, h*3600), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w \"%s\": %s", pserr.ErrInvalidParameterValue, paramTimeZone, err.Error())
+	}
+
+	return loc, nil
+}
+
+// normalizeSearchPath accepts any schema list but always resolves names in schema public,
+// the only schema supported, changes to the search path are then reported with a notice
+func normalizeSearchPath(s *session, value string) (string, error) {
+	return "public", nil
+}
+
+// ignoredSchemas returns the schemas of the search path that are not taken into account when resolving names
+func ignoredSchemas(searchPath string) []string {
+	var ignored []string
+
+	for _, schema := range strings.Split(searchPath, ",") {
+		schema = strings.Trim(strings.TrimSpace(schema), `"`)
+
+		switch strings.ToLower(schema) {
+		case "", "public", "$user", "pg_catalog":
+			// schema $user never exists and pg_catalog is implicitly searched
+			continue
+		}
+
+		ignored = append(ignored, schema)
+	}
+
+	return ignored
+}
+
+// noticeIgnoredSchemas warns the frontend that the schemas set in the search path have no effect
+func (s *session) noticeIgnoredSchemas(searchPath string) error {
+	ignored := ignoredSchemas(searchPath)
+	if len(ignored) == 0 {
+		return nil
+	}
+
+	_, err := s.writeMessage(bm.NoticeResponse(
+		bm.Severity(pgmeta.PgSeverityNotice),
+		bm.SeverityNotLoc(pgmeta.PgSeverityNotice),
+		bm.Code(pgmeta.PgSuccessfulCompletion),
+		bm.Message(fmt.Sprintf("search_path ignored, schemas %s are not supported", strings.Join(ignored, ", "))),
+		bm.Detail("names are always resolved in schema public"),
+	))
+	return err
+}
+
+func normalizeSnapshotSinceTx(s *session, value string) (string, error) {
+	txID, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("a transaction id is expected")
+	}
+
+	if txID > 0 && s.db != nil {
+		state, err := s.db.CurrentState()
+		if err != nil {
+			return "", err
+		}
+
+		if txID > state.TxId {
+			return "", fmt.Errorf("transaction %d is not yet committed", txID)
+		}
+	}
+
+	return strconv.FormatUint(txID, 10), nil
+}
@@ -17,26 +17,23 @@ limitations under the License.
 package server
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
+	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
 )
 
 var (
-	set           = regexp.MustCompile(`(?i)set\s+.+`)
-	selectVersion = regexp.MustCompile(`(?i)select\s+version\(\s*\)`)
-	dealloc       = regexp.MustCompile(`(?i)deallocate\s+\"([^\"]+)\"`)
+	sessionStmtPrefix = regexp.MustCompile(`(?is)^\s*(set|reset|show|listen|unlisten|notify)\b`)
+	selectVersion     = regexp.MustCompile(`(?i)select\s+version\(\s*\)`)
+	dealloc           = regexp.MustCompile(`(?i)deallocate\s+\"([^\"]+)\"`)
 )
 
 func (s *session) isInBlackList(statement string) bool {
-	if set.MatchString(statement) {
-		return true
-	}
-
-	if statement == ";" {
-		return true
-	}
-	return false
+	return statement == ";"
 }
 
 func (s *session) isEmulableInternally(statement string) interface{} {
@@ -73,3 +70,514 @@ type version struct{}
 type deallocate struct {
 	plan string
 }
+
+// setStmt changes or, when value is nil, restores the default value of a run-time parameter:
+//
+//	SET [ SESSION | LOCAL ] name { TO | = } { value [, ...] | DEFAULT }
+//	SET [ SESSION | LOCAL ] TIME ZONE { value | LOCAL | DEFAULT }
+//	SET [ SESSION | LOCAL ] { NAMES | SCHEMA } value
+//	SET SESSION CHARACTERISTICS AS TRANSACTION mode [, ...]
+//	RESET name
+type setStmt struct {
+	// name is empty when the statement doesn't affect any parameter
+	name  string
+	value *string
+	local bool
+	reset bool
+}
+
+// resetAllStmt restores the default value of all the run-time parameters:
+//
+//	RESET ALL
+type resetAllStmt struct{}
+
+// showStmt returns the current value of one or all the run-time parameters:
+//
+//	SHOW { name | TIME ZONE | ALL }
+type showStmt struct {
+	name string
+	all  bool
+}
+
+func (st *showStmt) resultCols() []sql.ColDescriptor {
+	if st.all {
+		return []sql.ColDescriptor{
+			{Column: "name", Type: sql.VarcharType},
+			{Column: "setting", Type: sql.VarcharType},
+			{Column: "description", Type: sql.VarcharType},
+		}
+	}
+	return []sql.ColDescriptor{{Column: st.name, Type: sql.VarcharType}}
+}
+
+// listenStmt registers the session as a listener on a notification channel:
+//
+//	LISTEN channel
+type listenStmt struct {
+	channel string
+}
+
+// unlistenStmt removes the registration of the session as a listener on one or all the channels:
+//
+//	UNLISTEN { channel | * }
+type unlistenStmt struct {
+	channel string
+	all     bool
+}
+
+// notifyStmt raises a notification on a channel, within a transaction it's delivered on commit:
+//
+//	NOTIFY channel [ , payload ]
+type notifyStmt struct {
+	channel string
+	payload string
+}
+
+// parseSessionStmt returns nil if the statement doesn't deal with run-time parameters or notifications,
+// SHOW statements supported by the SQL engine (e.g. SHOW TABLES) are not considered
+func parseSessionStmt(statement string) (interface{}, error) {
+	if !sessionStmtPrefix.MatchString(statement) {
+		return nil, nil
+	}
+
+	t := &copyTokenizer{s: statement}
+
+	var stmt interface{}
+	var err error
+
+	switch t.next().val {
+	case "show":
+		switch t.peek().val {
+		case "databases", "tables", "table", "users", "grants":
+			if !t.peek().quoted {
+				return nil, nil
+			}
+		}
+		stmt, err = parseShowStmt(t)
+	case "reset":
+		stmt, err = parseResetStmt(t)
+	case "listen":
+		stmt, err = parseListenStmt(t)
+	case "unlisten":
+		stmt, err = parseUnlistenStmt(t)
+	case "notify":
+		stmt, err = parseNotifyStmt(t)
+	default:
+		stmt, err = parseSetStmt(t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if t.peek().kind == ';' {
+		t.next()
+	}
+
+	if t.peek().kind != tokenEOF {
+		return nil, fmt.Errorf("syntax error: unexpected input at position %d", t.peekPos)
+	}
+
+	return stmt, nil
+}
+
+func parseShowStmt(t *copyTokenizer) (interface{}, error) {
+	switch {
+	case t.peek().isKeyword("all"):
+		t.next()
+		return &showStmt{all: true}, nil
+	case t.peek().isKeyword("time"):
+		t.next()
+		if !t.next().isKeyword("zone") {
+			return nil, fmt.Errorf("syntax error: ZONE expected")
+		}
+		return &showStmt{name: paramTimeZone}, nil
+	}
+
+	name, err := parseParamName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &showStmt{name: name}, nil
+}
+
+func parseResetStmt(t *copyTokenizer) (interface{}, error) {
+	if t.peek().isKeyword("all") {
+		t.next()
+		return &resetAllStmt{}, nil
+	}
+
+	if t.peek().isKeyword("time") {
+		t.next()
+		if !t.next().isKeyword("zone") {
+			return nil, fmt.Errorf("syntax error: ZONE expected")
+		}
+		return &setStmt{name: paramTimeZone, reset: true}, nil
+	}
+
+	name, err := parseParamName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setStmt{name: name, reset: true}, nil
+}
+
+func parseListenStmt(t *copyTokenizer) (interface{}, error) {
+	channel, err := parseChannelName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listenStmt{channel: channel}, nil
+}
+
+func parseUnlistenStmt(t *copyTokenizer) (interface{}, error) {
+	if t.peek().kind == '*' {
+		t.next()
+		return &unlistenStmt{all: true}, nil
+	}
+
+	channel, err := parseChannelName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unlistenStmt{channel: channel}, nil
+}
+
+func parseNotifyStmt(t *copyTokenizer) (interface{}, error) {
+	channel, err := parseChannelName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := &notifyStmt{channel: channel}
+
+	if t.peek().kind == ',' {
+		t.next()
+
+		payload := t.next()
+		if payload.kind != tokenString {
+			return nil, fmt.Errorf("syntax error: payload string expected")
+		}
+
+		if len(payload.val) > maxNotificationPayloadLen {
+			return nil, fmt.Errorf("%w: payload string too long", pserr.ErrInvalidNotification)
+		}
+
+		stmt.payload = payload.val
+	}
+
+	return stmt, nil
+}
+
+func parseChannelName(t *copyTokenizer) (string, error) {
+	id := t.next()
+	if id.kind != tokenIdentifier {
+		return "", fmt.Errorf("syntax error: channel name expected")
+	}
+
+	if id.val == "" {
+		return "", fmt.Errorf("%w: channel name cannot be empty", pserr.ErrInvalidNotification)
+	}
+
+	return id.val, nil
+}
+
+func parseSetStmt(t *copyTokenizer) (interface{}, error) {
+	local := false
+
+	switch {
+	case t.peek().isKeyword("local"):
+		t.next()
+		local = true
+	case t.peek().isKeyword("session"):
+		t.next()
+
+		switch {
+		case t.peek().isKeyword("characteristics"):
+			t.next()
+			if !t.next().isKeyword("as") || !t.next().isKeyword("transaction") {
+				return nil, fmt.Errorf("syntax error: AS TRANSACTION expected")
+			}
+			return parseTransactionModes(t)
+		case t.peek().isKeyword("authorization"):
+			return nil, fmt.Errorf("%w: SET SESSION AUTHORIZATION", pserr.ErrSetStmtNotSupported)
+		}
+	}
+
+	switch {
+	case t.peek().isKeyword("time"):
+		t.next()
+		if !t.next().isKeyword("zone") {
+			return nil, fmt.Errorf("syntax error: ZONE expected")
+		}
+
+		if v := t.peek(); v.isKeyword("local") || v.isKeyword("default") {
+			t.next()
+			return &setStmt{name: paramTimeZone, local: local}, nil
+		}
+
+		value, err := parseParamValues(t)
+		if err != nil {
+			return nil, err
+		}
+		return &setStmt{name: paramTimeZone, value: value, local: local}, nil
+	case t.peek().isKeyword("names"):
+		t.next()
+
+		value, err := parseParamValues(t)
+		if err != nil {
+			return nil, err
+		}
+		return &setStmt{name: paramClientEncoding, value: value, local: local}, nil
+	case t.peek().isKeyword("schema"):
+		t.next()
+
+		value, err := parseParamValues(t)
+		if err != nil {
+			return nil, err
+		}
+		return &setStmt{name: paramSearchPath, value: value, local: local}, nil
+	case t.peek().isKeyword("transaction"):
+		return nil, fmt.Errorf("%w: SET TRANSACTION, use SET SESSION CHARACTERISTICS AS TRANSACTION instead", pserr.ErrSetStmtNotSupported)
+	case t.peek().isKeyword("role"):
+		return nil, fmt.Errorf("%w: SET ROLE", pserr.ErrSetStmtNotSupported)
+	}
+
+	name, err := parseParamName(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if sep := t.next(); !sep.isKeyword("to") && sep.kind != '=' {
+		return nil, fmt.Errorf("syntax error: TO or = expected after %s", name)
+	}
+
+	value, err := parseParamValues(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setStmt{name: name, value: value, local: local}, nil
+}
+
+// parseTransactionModes maps the access mode to the default_transaction_read_only parameter,
+// isolation levels are accepted but ignored as transactions are always executed with snapshot isolation
+func parseTransactionModes(t *copyTokenizer) (interface{}, error) {
+	stmt := &setStmt{}
+
+	for {
+		switch {
+		case t.peek().isKeyword("read"):
+			t.next()
+
+			mode := t.next()
+			switch {
+			case mode.isKeyword("only"):
+				on := "on"
+				stmt = &setStmt{name: paramReadOnly, value: &on}
+			case mode.isKeyword("write"):
+				off := "off"
+				stmt = &setStmt{name: paramReadOnly, value: &off}
+			default:
+				return nil, fmt.Errorf("syntax error: ONLY or WRITE expected")
+			}
+		case t.peek().isKeyword("isolation"):
+			t.next()
+			if !t.next().isKeyword("level") {
+				return nil, fmt.Errorf("syntax error: LEVEL expected")
+			}
+
+			level := t.next()
+			switch {
+			case level.isKeyword("serializable"):
+			case level.isKeyword("repeatable"):
+				if !t.next().isKeyword("read") {
+					return nil, fmt.Errorf("syntax error: READ expected")
+				}
+			case level.isKeyword("read"):
+				if v := t.next(); !v.isKeyword("committed") && !v.isKeyword("uncommitted") {
+					return nil, fmt.Errorf("syntax error: COMMITTED or UNCOMMITTED expected")
+				}
+			default:
+				return nil, fmt.Errorf("syntax error: isolation level expected")
+			}
+		case t.peek().isKeyword("deferrable"):
+			t.next()
+		case t.peek().isKeyword("not"):
+			t.next()
+			if !t.next().isKeyword("deferrable") {
+				return nil, fmt.Errorf("syntax error: DEFERRABLE expected")
+			}
+		default:
+			return nil, fmt.Errorf("syntax error: transaction mode expected")
+		}
+
+		if t.peek().kind == ',' {
+			t.next()
+		}
+
+		if t.peek().kind == ';' || t.peek().kind == tokenEOF {
+			return stmt, nil
+		}
+	}
+}
+
+// parseParamName reads a parameter name, possibly qualified as in the case of custom parameters
+func parseParamName(t *copyTokenizer) (string, error) {
+	id := t.next()
+	if id.kind != tokenIdentifier {
+		return "", fmt.Errorf("syntax error: parameter name expected")
+	}
+
+	name := id.val
+
+	for t.peek().kind == '.' {
+		t.next()
+
+		id = t.next()
+		if id.kind != tokenIdentifier {
+			return "", fmt.Errorf("syntax error: parameter name expected")
+		}
+
+		name += "." + id.val
+	}
+
+	return name, nil
+}
+
+// parseParamValues returns nil when DEFAULT is specified, list of values are joined by commas
+func parseParamValues(t *copyTokenizer) (*string, error) {
+	if t.peek().isKeyword("default") {
+		t.next()
+		return nil, nil
+	}
+
+	var values []string
+
+	for {
+		sign := ""
+		if v := t.peek(); v.kind == '-' || v.kind == '+' {
+			t.next()
+			sign = string(v.kind)
+		}
+
+		v := t.next()
+		if v.kind != tokenIdentifier && v.kind != tokenString {
+			return nil, fmt.Errorf("syntax error: parameter value expected")
+		}
+
+		values = append(values, sign+v.val)
+
+		if t.peek().kind != ',' {
+			break
+		}
+		t.next()
+	}
+
+	value := strings.Join(values, ", ")
+	return &value, nil
+}
+
+func (s *session) execSessionStmt(stmt interface{}, skipRowDesc bool) error {
+	switch st := stmt.(type) {
+	case *setStmt:
+		if st.name != "" {
+			var err error
+
+			if st.local {
+				err = s.setLocalParam(st.name, st.value)
+			} else {
+				err = s.setParam(st.name, st.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		tag := "SET"
+		if st.reset {
+			tag = "RESET"
+		}
+
+		_, err := s.writeMessage(bm.CommandComplete([]byte(tag)))
+		return err
+	case *resetAllStmt:
+		if err := s.resetParams(); err != nil {
+			return err
+		}
+
+		_, err := s.writeMessage(bm.CommandComplete([]byte("RESET")))
+		return err
+	case *showStmt:
+		return s.writeParams(st, skipRowDesc)
+	case *listenStmt:
+		if err := s.listen(st.channel); err != nil {
+			return err
+		}
+
+		_, err := s.writeMessage(bm.CommandComplete([]byte("LISTEN")))
+		return err
+	case *unlistenStmt:
+		s.unlisten(st.channel, st.all)
+
+		_, err := s.writeMessage(bm.CommandComplete([]byte("UNLISTEN")))
+		return err
+	case *notifyStmt:
+		if err := s.notify(st.channel, st.payload); err != nil {
+			return err
+		}
+
+		_, err := s.writeMessage(bm.CommandComplete([]byte("NOTIFY")))
+		return err
+	}
+
+	return pserr.ErrMessageCannotBeHandledInternally
+}
+
+func (s *session) writeParams(st *showStmt, skipRowDesc bool) error {
+	var rows []*sql.Row
+
+	if st.all {
+		for _, p := range sessionParams {
+			rows = append(rows, newVarcharRow(p.name, s.paramValue(p.name), p.description))
+		}
+	} else {
+		name, value, err := s.showParam(st.name)
+		if err != nil {
+			return err
+		}
+
+		st.name = name
+		rows = append(rows, newVarcharRow(value))
+	}
+
+	cols := st.resultCols()
+
+	if !skipRowDesc {
+		if _, err := s.writeMessage(bm.RowDescription(cols, nil)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.writeMessage(bm.DataRow(rows, len(cols), nil)); err != nil {
+		return err
+	}
+
+	_, err := s.writeMessage(bm.CommandComplete([]byte("SHOW")))
+	return err
+}
+
+func newVarcharRow(values ...string) *sql.Row {
+	row := &sql.Row{
+		ValuesByPosition: make([]sql.TypedValue, len(values)),
+	}
+
+	for i, v := range values {
+		row.ValuesByPosition[i] = sql.NewVarchar(v)
+	}
+
+	return row
+}
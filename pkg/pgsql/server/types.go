@@ -20,74 +20,207 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
+	"github.com/google/uuid"
 )
 
-func buildNamedParams(paramsType []sql.ColDescriptor, paramsVal []interface{}) ([]*schema.NamedParam, error) {
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// buildNamedParams decodes the parameter values, timestamps are interpreted in the given location
+func buildNamedParams(paramsType []sql.ColDescriptor, paramsVal []interface{}, loc *time.Location) ([]*schema.NamedParam, error) {
 	pMap := make(map[string]interface{})
 	for index, param := range paramsType {
 		name := param.Column
 
-		val := paramsVal[index]
+		var v interface{}
+		var err error
+
+		switch p := paramsVal[index].(type) {
 		// text param
-		if p, ok := val.(string); ok {
-			switch param.Type {
-
-			case sql.IntegerType:
-				int, err := strconv.Atoi(p)
-				if err != nil {
-					return nil, err
-				}
-				pMap[name] = int64(int)
-			case sql.VarcharType:
-				pMap[name] = p
-			case sql.BooleanType:
-				pMap[name] = p == "true"
-			case sql.BLOBType:
-				d, err := hex.DecodeString(p)
-				if err != nil {
-					return nil, err
-				}
-				pMap[name] = d
-			}
-		}
+		case string:
+			v, err = decodeTextParam(param.Type, p, loc)
 		// binary param
-		if p, ok := val.([]byte); ok {
-			switch param.Type {
-			case sql.IntegerType:
-				i, err := getInt64(p)
-				if err != nil {
-					return nil, err
-				}
-				pMap[name] = i
-			case sql.VarcharType:
-				pMap[name] = string(p)
-			case sql.BooleanType:
-				v := false
-				if p[0] == byte(1) {
-					v = true
-				}
-				pMap[name] = v
-			case sql.BLOBType:
-				pMap[name] = p
-			}
+		case []byte:
+			v, err = decodeBinaryParam(param.Type, p, loc)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%s': %w", name, err)
+		}
+		if v != nil {
+			pMap[name] = v
 		}
 	}
 	return schema.EncodeParams(pMap)
 }
 
+func decodeTextParam(t sql.SQLValueType, p string, loc *time.Location) (interface{}, error) {
+	switch t {
+	case sql.IntegerType:
+		return strconv.ParseInt(p, 10, 64)
+	case sql.Float64Type:
+		return strconv.ParseFloat(p, 64)
+	case sql.VarcharType, sql.JSONType:
+		return p, nil
+	case sql.BooleanType:
+		return parseBool(p)
+	case sql.BLOBType:
+		return parseBytea(p)
+	case sql.UUIDType:
+		u, err := uuid.Parse(p)
+		if err != nil {
+			return nil, err
+		}
+		return u.String(), nil
+	case sql.TimestampType:
+		return parseTimestamp(p, loc)
+	}
+	return nil, nil
+}
+
+func decodeBinaryParam(t sql.SQLValueType, p []byte, loc *time.Location) (interface{}, error) {
+	switch t {
+	case sql.IntegerType:
+		return getInt64(p)
+	case sql.Float64Type:
+		return getFloat64(p)
+	case sql.VarcharType:
+		return string(p), nil
+	case sql.JSONType:
+		return string(trimJSONBVersion(p)), nil
+	case sql.BooleanType:
+		if len(p) != 1 {
+			return nil, fmt.Errorf("cannot convert a slice of %d byte in a BOOLEAN parameter", len(p))
+		}
+		return p[0] == byte(1), nil
+	case sql.BLOBType:
+		return p, nil
+	case sql.UUIDType:
+		u, err := uuid.FromBytes(p)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert a slice of %d byte in an UUID parameter", len(p))
+		}
+		return u.String(), nil
+	case sql.TimestampType:
+		return getTimestamp(p, loc)
+	}
+	return nil, nil
+}
+
 func getInt64(p []byte) (int64, error) {
 	switch len(p) {
 	case 8:
 		return int64(binary.BigEndian.Uint64(p)), nil
 	case 4:
-		return int64(binary.BigEndian.Uint32(p)), nil
+		return int64(int32(binary.BigEndian.Uint32(p))), nil
 	case 2:
-		return int64(binary.BigEndian.Uint16(p)), nil
+		return int64(int16(binary.BigEndian.Uint16(p))), nil
 	default:
 		return 0, fmt.Errorf("cannot convert a slice of %d byte in an INTEGER parameter", len(p))
 	}
 }
+
+func getFloat64(p []byte) (float64, error) {
+	switch len(p) {
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(p)), nil
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(p))), nil
+	default:
+		return 0, fmt.Errorf("cannot convert a slice of %d byte in a FLOAT parameter", len(p))
+	}
+}
+
+// getTimestamp decodes both timestamp and timestamptz values, as they share the binary representation.
+// The value is the wall clock time in the given location.
+func getTimestamp(p []byte, loc *time.Location) (time.Time, error) {
+	if len(p) != 8 {
+		return time.Time{}, fmt.Errorf("cannot convert a slice of %d byte in a TIMESTAMP parameter", len(p))
+	}
+
+	micros := int64(binary.BigEndian.Uint64(p))
+
+	return fromWallClock(pgmeta.PgEpoch.Add(time.Duration(micros)*time.Microsecond), loc), nil
+}
+
+// trimJSONBVersion removes the version number prefixing jsonb values,
+// it's a no-op for json values as they can not start with such byte
+func trimJSONBVersion(p []byte) []byte {
+	if len(p) > 0 && p[0] == 1 {
+		return p[1:]
+	}
+	return p
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "t", "true", "y", "yes", "on", "1":
+		return true, nil
+	case "f", "false", "n", "no", "off", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean value '%s'", s)
+}
+
+// parseBytea decodes the hex format of bytea values, the \x prefix is optional
+func parseBytea(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, `\x`))
+}
+
+// parseTimestamp interprets the timestamp in the given location unless a time zone is specified
+func parseTimestamp(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp value '%s'", s)
+}
+
+// fromWallClock returns the instant in which the wall clock time, expressed in UTC, is read in the given location
+func fromWallClock(t time.Time, loc *time.Location) time.Time {
+	if loc == time.UTC {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
+}
+
+// toWallClock returns the wall clock time of the instant in the given location, expressed in UTC
+func toWallClock(t time.Time, loc *time.Location) time.Time {
+	if loc == time.UTC {
+		return t
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// localizeRow converts the timestamps of the row to the wall clock time in the given location,
+// as the time zone is not part of the values sent to the frontend
+func localizeRow(row *sql.Row, loc *time.Location) {
+	if loc == time.UTC {
+		return
+	}
+
+	for i, v := range row.ValuesByPosition {
+		if v.Type() != sql.TimestampType || v.IsNull() {
+			continue
+		}
+		row.ValuesByPosition[i] = sql.NewTimestamp(toWallClock(v.RawValue().(time.Time), loc))
+	}
+}
@@ -20,9 +20,13 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,7 +61,7 @@ func Test_buildNamedParams(t *testing.T) {
 		},
 	}
 	pt := []interface{}{[]byte(`1`)}
-	_, err := buildNamedParams(cols, pt)
+	_, err := buildNamedParams(cols, pt, time.UTC)
 	require.ErrorContains(t, err, fmt.Sprintf("cannot convert a slice of %d byte in an INTEGER parameter", len(cols)))
 
 	// varchar error
@@ -68,7 +72,7 @@ func Test_buildNamedParams(t *testing.T) {
 		},
 	}
 	pt = []interface{}{[]byte(`1`)}
-	_, err = buildNamedParams(cols, pt)
+	_, err = buildNamedParams(cols, pt, time.UTC)
 	require.NoError(t, err)
 
 	// blob
@@ -79,7 +83,7 @@ func Test_buildNamedParams(t *testing.T) {
 		},
 	}
 	pt = []interface{}{[]byte(`1`)}
-	_, err = buildNamedParams(cols, pt)
+	_, err = buildNamedParams(cols, pt, time.UTC)
 	require.NoError(t, err)
 
 	// blob text error
@@ -90,6 +94,73 @@ func Test_buildNamedParams(t *testing.T) {
 		},
 	}
 	pt = []interface{}{"blob"}
-	_, err = buildNamedParams(cols, pt)
+	_, err = buildNamedParams(cols, pt, time.UTC)
 	require.ErrorIs(t, err, hex.InvalidByteError(108))
 }
+
+func Test_decodeParams(t *testing.T) {
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+	u := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	b32i := make([]byte, 4)
+	binary.BigEndian.PutUint32(b32i, uint32(0xffffffff))
+
+	bf := make([]byte, 8)
+	binary.BigEndian.PutUint64(bf, math.Float64bits(1.5))
+
+	bts := make([]byte, 8)
+	binary.BigEndian.PutUint64(bts, uint64(ts.Sub(pgmeta.PgEpoch).Microseconds()))
+
+	for _, tt := range []struct {
+		t   sql.SQLValueType
+		in  interface{}
+		out interface{}
+	}{
+		{sql.IntegerType, b32i, int64(-1)},
+		{sql.IntegerType, "-1", int64(-1)},
+		{sql.Float64Type, bf, 1.5},
+		{sql.Float64Type, "1.5", 1.5},
+		{sql.TimestampType, bts, ts},
+		{sql.TimestampType, "2024-05-06 07:08:09.123456", ts},
+		{sql.TimestampType, "2024-05-06 09:08:09.123456+02", ts},
+		{sql.UUIDType, u[:], u.String()},
+		{sql.UUIDType, u.String(), u.String()},
+		{sql.JSONType, []byte("\x01{}"), "{}"},
+		{sql.JSONType, []byte("{}"), "{}"},
+		{sql.BooleanType, "t", true},
+		{sql.BooleanType, "false", false},
+		{sql.BLOBType, `\x0aff`, []byte{0x0a, 0xff}},
+	} {
+		var v interface{}
+		var err error
+
+		switch in := tt.in.(type) {
+		case string:
+			v, err = decodeTextParam(tt.t, in, time.UTC)
+		case []byte:
+			v, err = decodeBinaryParam(tt.t, in, time.UTC)
+		}
+		require.NoError(t, err)
+
+		if vt, ok := v.(time.Time); ok {
+			require.True(t, ts.Equal(vt))
+			continue
+		}
+		require.Equal(t, tt.out, v)
+	}
+
+	_, err := decodeBinaryParam(sql.Float64Type, []byte{1}, time.UTC)
+	require.ErrorContains(t, err, "cannot convert a slice of 1 byte in a FLOAT parameter")
+
+	_, err = decodeBinaryParam(sql.TimestampType, []byte{1}, time.UTC)
+	require.ErrorContains(t, err, "cannot convert a slice of 1 byte in a TIMESTAMP parameter")
+
+	_, err = decodeBinaryParam(sql.UUIDType, []byte{1}, time.UTC)
+	require.ErrorContains(t, err, "cannot convert a slice of 1 byte in an UUID parameter")
+
+	_, err = decodeTextParam(sql.BooleanType, "maybe", time.UTC)
+	require.ErrorContains(t, err, "invalid boolean value")
+
+	_, err = decodeTextParam(sql.TimestampType, "yesterday", time.UTC)
+	require.ErrorContains(t, err, "invalid timestamp value")
+}
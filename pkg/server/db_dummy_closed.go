@@ -102,6 +102,14 @@ func (db *closedDB) VerifiableGet(ctx context.Context, req *schema.VerifiableGet
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) CommitKeyIndex(ctx context.Context) (*schema.TxHeader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) KeyIndexProof(ctx context.Context, req *schema.KeyIndexProofRequest) (*schema.VerifiableKeyIndexProof, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -110,6 +118,10 @@ func (db *closedDB) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) RedactEntries(ctx context.Context, req *schema.RedactEntriesRequest) (*schema.TxHeader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -122,6 +134,10 @@ func (db *closedDB) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -230,6 +246,10 @@ func (db *closedDB) TxScan(ctx context.Context, req *schema.TxScanRequest) (*sch
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) SubscribeChanges(ctx context.Context, req *schema.SubscribeChangesRequest, send func(*schema.VerifiableTx) error) error {
+	return store.ErrAlreadyClosed
+}
+
 func (db *closedDB) FlushIndex(req *schema.FlushIndexRequest) error {
 	return store.ErrAlreadyClosed
 }
@@ -238,6 +258,10 @@ func (db *closedDB) CompactIndex() error {
 	return store.ErrAlreadyClosed
 }
 
+func (db *closedDB) CompactValueLog(ctx context.Context) (*store.VLogGCReport, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) IsClosed() bool {
 	return true
 }
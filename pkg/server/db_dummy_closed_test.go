@@ -171,6 +171,9 @@ func TestDummyClosedDatabase(t *testing.T) {
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 	require.True(t, cdb.IsClosed())
 
+	_, err = cdb.CompactValueLog(context.Background())
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	err = cdb.Truncate(0)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -18,10 +18,13 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
@@ -161,6 +164,61 @@ func (s *ImmuServer) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 	return vEntry, nil
 }
 
+// CommitKeyIndex ...
+func (s *ImmuServer) CommitKeyIndex(ctx context.Context, _ *empty.Empty) (*schema.TxHeader, error) {
+	db, err := s.getDBFromCtx(ctx, "CommitKeyIndex")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.CommitKeyIndex(ctx)
+}
+
+// KeyIndexProof ...
+func (s *ImmuServer) KeyIndexProof(ctx context.Context, req *schema.KeyIndexProofRequest) (*schema.VerifiableKeyIndexProof, error) {
+	db, err := s.getDBFromCtx(ctx, "KeyIndexProof")
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := db.KeyIndexProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.signKeyIndexCommitment(db.GetName(), proof.CommitmentTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// signKeyIndexCommitment signs the state proven by the key index commitment transaction
+func (s *ImmuServer) signKeyIndexCommitment(dbName string, commitmentTx *schema.VerifiableTx) error {
+	if s.StateSigner == nil {
+		return nil
+	}
+
+	hdr := schema.TxHeaderFromProto(commitmentTx.DualProof.TargetTxHeader)
+	alh := hdr.Alh()
+
+	newState := &schema.ImmutableState{
+		Db:     dbName,
+		TxId:   hdr.ID,
+		TxHash: alh[:],
+	}
+
+	err := s.StateSigner.Sign(newState)
+	if err != nil {
+		return err
+	}
+
+	commitmentTx.Signature = newState.Signature
+
+	return nil
+}
+
 // Scan ...
 func (s *ImmuServer) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error) {
 	db, err := s.getDBFromCtx(ctx, "Scan")
@@ -171,6 +229,26 @@ func (s *ImmuServer) Scan(ctx context.Context, req *schema.ScanRequest) (*schema
 	return db.Scan(ctx, req)
 }
 
+// VerifiableScan ...
+func (s *ImmuServer) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	db, err := s.getDBFromCtx(ctx, "VerifiableScan")
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := db.VerifiableScan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.signKeyIndexCommitment(db.GetName(), proof.CommitmentTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
 // Count ...
 func (s *ImmuServer) Count(ctx context.Context, req *schema.KeyPrefix) (*schema.EntryCount, error) {
 	db, err := s.getDBFromCtx(ctx, "Scan")
@@ -244,6 +322,61 @@ func (s *ImmuServer) TxScan(ctx context.Context, req *schema.TxScanRequest) (*sc
 	return db.TxScan(ctx, req)
 }
 
+// SubscribeChanges streams committed transactions, including only the entries matching the filter of the request
+func (s *ImmuServer) SubscribeChanges(req *schema.SubscribeChangesRequest, srv schema.ImmuService_SubscribeChangesServer) error {
+	db, err := s.getDBFromCtx(srv.Context(), "SubscribeChanges")
+	if err != nil {
+		return err
+	}
+
+	err = s.checkSubscribeChangesPermission(srv.Context(), db, req)
+	if err != nil {
+		return err
+	}
+
+	return db.SubscribeChanges(srv.Context(), req, func(vtx *schema.VerifiableTx) error {
+		if vtx.DualProof != nil && s.StateSigner != nil {
+			hdr := schema.TxHeaderFromProto(vtx.DualProof.TargetTxHeader)
+			alh := hdr.Alh()
+
+			newState := &schema.ImmutableState{
+				Db:     db.GetName(),
+				TxId:   hdr.ID,
+				TxHash: alh[:],
+			}
+
+			err := s.StateSigner.Sign(newState)
+			if err != nil {
+				return err
+			}
+
+			vtx.Signature = newState.Signature
+		}
+
+		return srv.Send(vtx)
+	})
+}
+
+// checkSubscribeChangesPermission restricts to database administrators the subscriptions streaming raw SQL rows
+// or documents, i.e. those to tables, collections or to every entry, as row policies and column privileges
+// don't apply to them. Subscriptions to key-value prefixes can not include SQL rows or documents.
+func (s *ImmuServer) checkSubscribeChangesPermission(ctx context.Context, db database.DB, req *schema.SubscribeChangesRequest) error {
+	if req == nil || len(req.Prefix) > 0 || !s.Options.auth {
+		return nil
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if user.IsSysAdmin || user.HasPermission(db.GetName(), auth.PermissionAdmin) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: subscribing to changes of tables, collections or of the whole database requires admin permission", ErrPermissionDenied)
+}
+
 // History ...
 func (s *ImmuServer) History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
 	db, err := s.getDBFromCtx(ctx, "History")
@@ -389,6 +522,25 @@ func (s *ImmuServer) CompactIndex(ctx context.Context, _ *empty.Empty) (*empty.E
 	return &empty.Empty{}, err
 }
 
+func (s *ImmuServer) CompactValueLog(ctx context.Context, _ *empty.Empty) (*schema.CompactValueLogResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "CompactValueLog")
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := db.CompactValueLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.CompactValueLogResponse{
+		Database:        db.GetName(),
+		CompactedFiles:  uint32(report.CompactedFiles),
+		DiscardedValues: uint64(report.DiscardedValues),
+		ReclaimedBytes:  uint64(report.ReclaimedBytes),
+	}, nil
+}
+
 // GetAll ...
 func (s *ImmuServer) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	if req == nil {
@@ -416,6 +568,20 @@ func (s *ImmuServer) Delete(ctx context.Context, req *schema.DeleteKeysRequest)
 	return db.Delete(ctx, req)
 }
 
+// RedactEntries ...
+func (s *ImmuServer) RedactEntries(ctx context.Context, req *schema.RedactEntriesRequest) (*schema.TxHeader, error) {
+	if req == nil {
+		return nil, store.ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "RedactEntries")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.RedactEntries(ctx, req)
+}
+
 func (s *ImmuServer) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
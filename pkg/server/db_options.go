@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/codenotary/immudb/embedded/ahtree"
+	"github.com/codenotary/immudb/embedded/appendable"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/pkg/api/schema"
@@ -64,6 +65,10 @@ type dbOptions struct {
 
 	ExcludeCommitTime bool `json:"excludeCommitTime"`
 
+	CompressionFormat     string `json:"compressionFormat"` // applies to new value files
+	CompressionLevel      int    `json:"compressionLevel"`
+	CompressionDictionary string `json:"compressionDictionary"` // path of a trained zstd dictionary
+
 	MaxActiveTransactions int `json:"maxActiveTransactions"`
 	MVCCReadSetLimit      int `json:"mvccReadSetLimit"`
 
@@ -85,6 +90,8 @@ type dbOptions struct {
 
 	AHTOptions *ahtOptions `json:"ahtOptions"`
 
+	VLogGCOptions *vLogGCOptions `json:"vLogGCOptions"`
+
 	Autoload featureState `json:"autoload"` // unspecfied is considered as enabled for backward compatibility
 
 	CreatedBy string    `json:"createdBy"`
@@ -124,6 +131,10 @@ type indexOptions struct {
 	CommitLogMaxOpenedFiles  int          `json:"commitLogMaxOpenedFiles"`
 	MaxBulkSize              int          `json:"maxBulkSize"`
 	BulkPreparationTimeout   Milliseconds `json:"bulkPreparationTimeout"` // ms
+
+	IncrementalCompaction         bool         `json:"incrementalCompaction"`
+	IncrementalCompactionStepSize int          `json:"incrementalCompactionStepSize"`
+	IncrementalCompactionDelay    Milliseconds `json:"incrementalCompactionDelay"` // ms
 }
 
 type ahtOptions struct {
@@ -131,6 +142,44 @@ type ahtOptions struct {
 	WriteBufferSize int `json:"writeBufferSize"`
 }
 
+type vLogGCOptions struct {
+	RetainedVersions    int          `json:"retainedVersions"`
+	RetentionPeriod     Milliseconds `json:"retentionPeriod"` // ms
+	MinDiscardableRatio float32      `json:"minDiscardableRatio"`
+	Frequency           Milliseconds `json:"frequency"` // ms
+}
+
+const (
+	CompressionFormatNone  = "none"
+	CompressionFormatFlate = "flate"
+	CompressionFormatGZip  = "gzip"
+	CompressionFormatLZW   = "lzw"
+	CompressionFormatZLib  = "zlib"
+	CompressionFormatZStd  = "zstd"
+	CompressionFormatLZ4   = "lz4"
+)
+
+func compressionFormatFrom(format string) (int, error) {
+	switch format {
+	case "", CompressionFormatNone:
+		return appendable.NoCompression, nil
+	case CompressionFormatFlate:
+		return appendable.FlateCompression, nil
+	case CompressionFormatGZip:
+		return appendable.GZipCompression, nil
+	case CompressionFormatLZW:
+		return appendable.LZWCompression, nil
+	case CompressionFormatZLib:
+		return appendable.ZLibCompression, nil
+	case CompressionFormatZStd:
+		return appendable.ZStdCompression, nil
+	case CompressionFormatLZ4:
+		return appendable.LZ4Compression, nil
+	}
+
+	return 0, fmt.Errorf("%w: unsupported compression format '%s'", ErrIllegalArguments, format)
+}
+
 const (
 	DefaultMaxValueLen   = 1 << 25 //32Mb
 	DefaultStoreFileSize = 1 << 29 //512Mb
@@ -152,6 +201,9 @@ func (s *ImmuServer) defaultDBOptions(dbName, userName string) *dbOptions {
 
 		ExcludeCommitTime: false,
 
+		CompressionFormat: CompressionFormatNone,
+		CompressionLevel:  store.DefaultCompressionLevel,
+
 		MaxActiveTransactions:   store.DefaultMaxActiveTransactions,
 		MVCCReadSetLimit:        store.DefaultMVCCReadSetLimit,
 		MaxConcurrency:          store.DefaultMaxConcurrency,
@@ -169,6 +221,8 @@ func (s *ImmuServer) defaultDBOptions(dbName, userName string) *dbOptions {
 
 		AHTOptions: s.defaultAHTOptions(),
 
+		VLogGCOptions: s.defaultVLogGCOptions(),
+
 		Autoload: unspecifiedState,
 
 		CreatedAt:           time.Now(),
@@ -218,6 +272,10 @@ func (s *ImmuServer) defaultIndexOptions() *indexOptions {
 		CommitLogMaxOpenedFiles:  tbtree.DefaultCommitLogMaxOpenedFiles,
 		MaxBulkSize:              store.DefaultIndexingMaxBulkSize,
 		BulkPreparationTimeout:   Milliseconds(store.DefaultBulkPreparationTimeout.Milliseconds()),
+
+		IncrementalCompaction:         false,
+		IncrementalCompactionStepSize: tbtree.DefaultIncrementalCompactionStepSize,
+		IncrementalCompactionDelay:    Milliseconds(tbtree.DefaultIncrementalCompactionDelay.Milliseconds()),
 	}
 }
 
@@ -228,6 +286,13 @@ func (s *ImmuServer) defaultAHTOptions() *ahtOptions {
 	}
 }
 
+func (s *ImmuServer) defaultVLogGCOptions() *vLogGCOptions {
+	return &vLogGCOptions{
+		RetainedVersions:    store.DefaultVLogGCRetainedVersions,
+		MinDiscardableRatio: store.DefaultVLogGCMinDiscardableRatio,
+	}
+}
+
 func (s *ImmuServer) databaseOptionsFrom(opts *dbOptions) *database.Options {
 	return database.DefaultOptions().
 		WithDBRootPath(s.Options.Dir).
@@ -236,9 +301,12 @@ func (s *ImmuServer) databaseOptionsFrom(opts *dbOptions) *database.Options {
 		WithSyncReplication(opts.SyncReplication).
 		WithSyncAcks(opts.SyncAcks).
 		WithReadTxPoolSize(opts.ReadTxPoolSize).
-		WithRetentionPeriod(time.Millisecond * time.Duration(opts.RetentionPeriod)).
-		WithTruncationFrequency(time.Millisecond * time.Duration(opts.TruncationFrequency)).
-		WithMaxResultSize(s.Options.MaxResultSize)
+		WithRetentionPeriod(time.Millisecond*time.Duration(opts.RetentionPeriod)).
+		WithTruncationFrequency(time.Millisecond*time.Duration(opts.TruncationFrequency)).
+		WithMaxResultSize(s.Options.MaxResultSize).
+		WithKeyIndexCommitThreshold(s.Options.KeyIndexCommitThreshold).
+		WithCompressionDictionaryFile(opts.CompressionDictionary).
+		WithEncryption(s.encryptionAlgorithm, s.keyProvider)
 }
 
 func (opts *dbOptions) storeOptions() *store.Options {
@@ -260,7 +328,10 @@ func (opts *dbOptions) storeOptions() *store.Options {
 			WithHistoryLogMaxOpenedFiles(opts.IndexOptions.HistoryLogMaxOpenedFiles).
 			WithCommitLogMaxOpenedFiles(opts.IndexOptions.CommitLogMaxOpenedFiles).
 			WithMaxBulkSize(opts.IndexOptions.MaxBulkSize).
-			WithBulkPreparationTimeout(time.Millisecond * time.Duration(opts.IndexOptions.BulkPreparationTimeout))
+			WithBulkPreparationTimeout(time.Millisecond * time.Duration(opts.IndexOptions.BulkPreparationTimeout)).
+			WithIncrementalCompaction(opts.IndexOptions.IncrementalCompaction).
+			WithIncrementalCompactionStepSize(opts.IndexOptions.IncrementalCompactionStepSize).
+			WithIncrementalCompactionDelay(time.Millisecond * time.Duration(opts.IndexOptions.IncrementalCompactionDelay))
 	}
 
 	ahtOpts := store.DefaultAHTOptions()
@@ -270,6 +341,16 @@ func (opts *dbOptions) storeOptions() *store.Options {
 		ahtOpts.WithWriteBufferSize(opts.AHTOptions.WriteBufferSize)
 	}
 
+	vLogGCOpts := store.DefaultVLogGCOptions()
+
+	if opts.VLogGCOptions != nil {
+		vLogGCOpts.
+			WithRetainedVersions(opts.VLogGCOptions.RetainedVersions).
+			WithRetentionPeriod(time.Millisecond * time.Duration(opts.VLogGCOptions.RetentionPeriod)).
+			WithMinDiscardableRatio(float64(opts.VLogGCOptions.MinDiscardableRatio)).
+			WithFrequency(time.Millisecond * time.Duration(opts.VLogGCOptions.Frequency))
+	}
+
 	stOpts := store.DefaultOptions().
 		WithEmbeddedValues(opts.EmbeddedValues).
 		WithPreallocFiles(opts.PreallocFiles).
@@ -291,7 +372,16 @@ func (opts *dbOptions) storeOptions() *store.Options {
 		WithTxLogMaxOpenedFiles(opts.TxLogMaxOpenedFiles).
 		WithCommitLogMaxOpenedFiles(opts.CommitLogMaxOpenedFiles).
 		WithIndexOptions(indexOpts).
-		WithAHTOptions(ahtOpts)
+		WithAHTOptions(ahtOpts).
+		WithVLogGCOptions(vLogGCOpts)
+
+	// the compression format is validated along with the rest of the database options
+	compressionFormat, _ := compressionFormatFrom(opts.CompressionFormat)
+	stOpts.WithCompressionFormat(compressionFormat)
+
+	if opts.CompressionLevel > 0 {
+		stOpts.WithCompresionLevel(opts.CompressionLevel)
+	}
 
 	if opts.ExcludeCommitTime {
 		stOpts.WithTimeFunc(func() time.Time { return time.Unix(0, 0) })
@@ -331,6 +421,10 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 
 		ExcludeCommitTime: &schema.NullableBool{Value: opts.ExcludeCommitTime},
 
+		CompressionFormat:     &schema.NullableString{Value: opts.CompressionFormat},
+		CompressionLevel:      &schema.NullableUint32{Value: uint32(opts.CompressionLevel)},
+		CompressionDictionary: &schema.NullableString{Value: opts.CompressionDictionary},
+
 		MaxActiveTransactions: &schema.NullableUint32{Value: uint32(opts.MaxActiveTransactions)},
 		MvccReadSetLimit:      &schema.NullableUint32{Value: uint32(opts.MVCCReadSetLimit)},
 
@@ -361,6 +455,10 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 			CommitLogMaxOpenedFiles:  &schema.NullableUint32{Value: uint32(opts.IndexOptions.CommitLogMaxOpenedFiles)},
 			MaxBulkSize:              &schema.NullableUint32{Value: uint32(opts.IndexOptions.MaxBulkSize)},
 			BulkPreparationTimeout:   &schema.NullableMilliseconds{Value: int64(opts.IndexOptions.BulkPreparationTimeout)},
+
+			IncrementalCompaction:         &schema.NullableBool{Value: opts.IndexOptions.IncrementalCompaction},
+			IncrementalCompactionStepSize: &schema.NullableUint32{Value: uint32(opts.IndexOptions.IncrementalCompactionStepSize)},
+			IncrementalCompactionDelay:    &schema.NullableMilliseconds{Value: int64(opts.IndexOptions.IncrementalCompactionDelay)},
 		},
 
 		AhtSettings: &schema.AHTNullableSettings{
@@ -368,6 +466,13 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 			WriteBufferSize: &schema.NullableUint32{Value: uint32(opts.AHTOptions.WriteBufferSize)},
 		},
 
+		VLogGCSettings: &schema.VLogGCNullableSettings{
+			RetainedVersions:    &schema.NullableUint32{Value: uint32(opts.VLogGCOptions.RetainedVersions)},
+			RetentionPeriod:     &schema.NullableMilliseconds{Value: int64(opts.VLogGCOptions.RetentionPeriod)},
+			MinDiscardableRatio: &schema.NullableFloat{Value: opts.VLogGCOptions.MinDiscardableRatio},
+			Frequency:           &schema.NullableMilliseconds{Value: int64(opts.VLogGCOptions.Frequency)},
+		},
+
 		WriteTxHeaderVersion: &schema.NullableUint32{Value: uint32(opts.WriteTxHeaderVersion)},
 
 		Autoload: &schema.NullableBool{Value: opts.Autoload.isEnabled()},
@@ -568,6 +673,18 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		opts.ExcludeCommitTime = settings.ExcludeCommitTime.Value
 	}
 
+	if settings.CompressionFormat != nil {
+		opts.CompressionFormat = settings.CompressionFormat.Value
+	}
+
+	if settings.CompressionLevel != nil {
+		opts.CompressionLevel = int(settings.CompressionLevel.Value)
+	}
+
+	if settings.CompressionDictionary != nil {
+		opts.CompressionDictionary = settings.CompressionDictionary.Value
+	}
+
 	if settings.MaxActiveTransactions != nil {
 		opts.MaxActiveTransactions = int(settings.MaxActiveTransactions.Value)
 	}
@@ -675,6 +792,15 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		if settings.IndexSettings.BulkPreparationTimeout != nil {
 			opts.IndexOptions.BulkPreparationTimeout = Milliseconds(settings.IndexSettings.BulkPreparationTimeout.Value)
 		}
+		if settings.IndexSettings.IncrementalCompaction != nil {
+			opts.IndexOptions.IncrementalCompaction = settings.IndexSettings.IncrementalCompaction.Value
+		}
+		if settings.IndexSettings.IncrementalCompactionStepSize != nil {
+			opts.IndexOptions.IncrementalCompactionStepSize = int(settings.IndexSettings.IncrementalCompactionStepSize.Value)
+		}
+		if settings.IndexSettings.IncrementalCompactionDelay != nil {
+			opts.IndexOptions.IncrementalCompactionDelay = Milliseconds(settings.IndexSettings.IncrementalCompactionDelay.Value)
+		}
 	}
 
 	// aht options
@@ -692,6 +818,29 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		}
 	}
 
+	// value-log garbage collection options
+	if settings.VLogGCSettings != nil {
+		if opts.VLogGCOptions == nil {
+			opts.VLogGCOptions = s.defaultVLogGCOptions()
+		}
+
+		if settings.VLogGCSettings.RetainedVersions != nil {
+			opts.VLogGCOptions.RetainedVersions = int(settings.VLogGCSettings.RetainedVersions.Value)
+		}
+
+		if settings.VLogGCSettings.RetentionPeriod != nil {
+			opts.VLogGCOptions.RetentionPeriod = Milliseconds(settings.VLogGCSettings.RetentionPeriod.Value)
+		}
+
+		if settings.VLogGCSettings.MinDiscardableRatio != nil {
+			opts.VLogGCOptions.MinDiscardableRatio = settings.VLogGCSettings.MinDiscardableRatio.Value
+		}
+
+		if settings.VLogGCSettings.Frequency != nil {
+			opts.VLogGCOptions.Frequency = Milliseconds(settings.VLogGCSettings.Frequency.Value)
+		}
+	}
+
 	err := opts.Validate()
 	if err != nil {
 		return fmt.Errorf("%w: %v", ErrIllegalArguments, err)
@@ -819,6 +968,23 @@ func (opts *dbOptions) Validate() error {
 			ErrIllegalArguments, opts.Database, store.MinimumTruncationFrequency.Hours())
 	}
 
+	compressionFormat, err := compressionFormatFrom(opts.CompressionFormat)
+	if err != nil {
+		return fmt.Errorf("%w for database '%s'", err, opts.Database)
+	}
+
+	if opts.CompressionLevel < 0 || opts.CompressionLevel > appendable.BestCompression {
+		return fmt.Errorf(
+			"%w: invalid compression level (%d) for database '%s'",
+			ErrIllegalArguments, opts.CompressionLevel, opts.Database)
+	}
+
+	if opts.CompressionDictionary != "" && compressionFormat != appendable.ZStdCompression {
+		return fmt.Errorf(
+			"%w: invalid compression options for database '%s'. Dictionaries can only be used with zstd compression",
+			ErrIllegalArguments, opts.Database)
+	}
+
 	return opts.storeOptions().Validate()
 }
 
@@ -914,6 +1080,9 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.EmbeddedValues: %v", database, opts.EmbeddedValues)
 	s.Logger.Infof("%s.PreallocFiles: %v", database, opts.PreallocFiles)
 	s.Logger.Infof("%s.ExcludeCommitTime: %v", database, opts.ExcludeCommitTime)
+	s.Logger.Infof("%s.CompressionFormat: %v", database, opts.CompressionFormat)
+	s.Logger.Infof("%s.CompressionLevel: %v", database, opts.CompressionLevel)
+	s.Logger.Infof("%s.CompressionDictionary: %v", database, opts.CompressionDictionary)
 	s.Logger.Infof("%s.MaxActiveTransactions: %v", database, opts.MaxActiveTransactions)
 	s.Logger.Infof("%s.MVCCReadSetLimit: %v", database, opts.MVCCReadSetLimit)
 	s.Logger.Infof("%s.MaxConcurrency: %v", database, opts.MaxConcurrency)
@@ -943,6 +1112,13 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.IndexOptions.CommitLogMaxOpenedFiles: %v", database, opts.IndexOptions.CommitLogMaxOpenedFiles)
 	s.Logger.Infof("%s.IndexOptions.MaxBulkSize: %v", database, opts.IndexOptions.MaxBulkSize)
 	s.Logger.Infof("%s.IndexOptions.BulkPreparationTimeout: %v", database, opts.IndexOptions.BulkPreparationTimeout)
+	s.Logger.Infof("%s.IndexOptions.IncrementalCompaction: %v", database, opts.IndexOptions.IncrementalCompaction)
+	s.Logger.Infof("%s.IndexOptions.IncrementalCompactionStepSize: %v", database, opts.IndexOptions.IncrementalCompactionStepSize)
+	s.Logger.Infof("%s.IndexOptions.IncrementalCompactionDelay: %v", database, opts.IndexOptions.IncrementalCompactionDelay)
 	s.Logger.Infof("%s.AHTOptions.SyncThreshold: %v", database, opts.AHTOptions.SyncThreshold)
 	s.Logger.Infof("%s.AHTOptions.WriteBufferSize: %v", database, opts.AHTOptions.WriteBufferSize)
+	s.Logger.Infof("%s.VLogGCOptions.RetainedVersions: %v", database, opts.VLogGCOptions.RetainedVersions)
+	s.Logger.Infof("%s.VLogGCOptions.RetentionPeriod: %v", database, opts.VLogGCOptions.RetentionPeriod)
+	s.Logger.Infof("%s.VLogGCOptions.MinDiscardableRatio: %v", database, opts.VLogGCOptions.MinDiscardableRatio)
+	s.Logger.Infof("%s.VLogGCOptions.Frequency: %v", database, opts.VLogGCOptions.Frequency)
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
+)
+
+const (
+	EncryptionAlgorithmAESGCM            = "aes-gcm"
+	EncryptionAlgorithmXChaCha20Poly1305 = "xchacha20-poly1305"
+)
+
+func encryptionAlgorithmFrom(algorithm string) (int, error) {
+	switch algorithm {
+	case "":
+		return appendable.NoEncryption, nil
+	case EncryptionAlgorithmAESGCM:
+		return appendable.AESGCMEncryption, nil
+	case EncryptionAlgorithmXChaCha20Poly1305:
+		return appendable.XChaCha20Poly1305Encryption, nil
+	}
+
+	return 0, fmt.Errorf("%w: unsupported algorithm '%s'", ErrInvalidEncryptionOptions, algorithm)
+}
+
+// initializeEncryption resolves the encryption algorithm and the provider of the master keys
+// wrapping the data keys of each database. A key provider is needed to open databases
+// holding encrypted data even if encryption of newly created files is disabled.
+func (s *ImmuServer) initializeEncryption() error {
+	opts := s.Options.EncryptionOptions
+	if opts == nil {
+		opts = DefaultEncryptionOptions()
+	}
+
+	algorithm, err := encryptionAlgorithmFrom(opts.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	keyProvider := opts.KeyProvider
+
+	if keyProvider == nil && opts.MasterKeyFile != "" {
+		keyProvider, err = encryption.NewFileKeyProvider(opts.MasterKeyFile, opts.PreviousMasterKeyFiles...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if keyProvider == nil && len(opts.PreviousMasterKeyFiles) > 0 {
+		return fmt.Errorf("%w: previous master keys specified without a master key", ErrInvalidEncryptionOptions)
+	}
+
+	if keyProvider == nil && algorithm != appendable.NoEncryption {
+		return fmt.Errorf("%w: a master key is required to encrypt data", ErrInvalidEncryptionOptions)
+	}
+
+	s.encryptionAlgorithm = algorithm
+	s.keyProvider = keyProvider
+
+	return nil
+}
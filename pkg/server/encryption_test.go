@@ -0,0 +1,107 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	masterKey, err := encryption.NewKey()
+	require.NoError(t, err)
+
+	masterKeyFile := filepath.Join(t.TempDir(), "master.key")
+
+	err = os.WriteFile(masterKeyFile, []byte(hex.EncodeToString(masterKey)), 0600)
+	require.NoError(t, err)
+
+	opts := DefaultOptions().
+		WithDir(dir).
+		WithEncryptionOptions(DefaultEncryptionOptions().
+			WithAlgorithm(EncryptionAlgorithmXChaCha20Poly1305).
+			WithMasterKeyFile(masterKeyFile))
+
+	s, closer := testServer(opts)
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(dir, SystemDBName, "keyring"))
+	require.FileExists(t, filepath.Join(dir, DefaultDBName, "keyring"))
+
+	closer()
+
+	// encrypted databases can not be opened without a master key
+	s, closer = testServer(DefaultOptions().WithDir(dir))
+	defer closer()
+
+	err = s.Initialize()
+	require.Error(t, err)
+}
+
+func TestServerEncryptionInvalidOptions(t *testing.T) {
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		opts := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithEncryptionOptions(DefaultEncryptionOptions().WithAlgorithm("rot13"))
+
+		s, closer := testServer(opts)
+		defer closer()
+
+		err := s.Initialize()
+		require.ErrorIs(t, err, ErrInvalidEncryptionOptions)
+	})
+
+	t.Run("missing master key", func(t *testing.T) {
+		opts := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithEncryptionOptions(DefaultEncryptionOptions().WithAlgorithm(EncryptionAlgorithmAESGCM))
+
+		s, closer := testServer(opts)
+		defer closer()
+
+		err := s.Initialize()
+		require.ErrorIs(t, err, ErrInvalidEncryptionOptions)
+	})
+
+	t.Run("invalid master key file", func(t *testing.T) {
+		masterKeyFile := filepath.Join(t.TempDir(), "master.key")
+
+		err := os.WriteFile(masterKeyFile, []byte("invalid"), 0600)
+		require.NoError(t, err)
+
+		opts := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithEncryptionOptions(DefaultEncryptionOptions().
+				WithAlgorithm(EncryptionAlgorithmAESGCM).
+				WithMasterKeyFile(masterKeyFile))
+
+		s, closer := testServer(opts)
+		defer closer()
+
+		err = s.Initialize()
+		require.ErrorIs(t, err, encryption.ErrInvalidKey)
+	})
+}
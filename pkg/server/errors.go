@@ -57,6 +57,7 @@ var (
 	ErrTruncatorNotNeeded          = errors.New("truncator is not needed")
 	ErrTruncatorNotInProgress      = errors.New("truncation is not in progress")
 	ErrTruncatorDoesNotExist       = errors.New("truncator does not exist")
+	ErrInvalidEncryptionOptions    = errors.New("invalid encryption options")
 )
 
 func mapServerError(err error) error {
@@ -72,12 +72,28 @@ func (h *multidbHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
 
 	permCode := user.WhichPermission(db.GetName())
 	return &User{
-		username:      user.Username,
-		perm:          sql.PermissionFromCode(permCode),
-		sqlPrivileges: privileges,
+		username:        user.Username,
+		perm:            sql.PermissionFromCode(permCode),
+		sqlPrivileges:   privileges,
+		tablePrivileges: tablePrivilegesOn(user, db.GetName()),
 	}, nil
 }
 
+// tablePrivilegesOn returns the privileges the user holds on the tables of the database
+func tablePrivilegesOn(user *auth.User, database string) []sql.TablePrivilege {
+	privileges := make([]sql.TablePrivilege, 0, len(user.TablePrivileges))
+	for _, p := range user.TablePrivileges {
+		if p.Database == database {
+			privileges = append(privileges, sql.TablePrivilege{
+				Privilege: sql.SQLPrivilege(p.Privilege),
+				Table:     p.Table,
+				Columns:   p.Columns,
+			})
+		}
+	}
+	return privileges
+}
+
 func (h *multidbHandler) ListDatabases(ctx context.Context) ([]string, error) {
 	res, err := h.s.DatabaseList(ctx, nil)
 	if err != nil {
@@ -125,9 +141,22 @@ func (h *multidbHandler) ListUsers(ctx context.Context) ([]sql.User, error) {
 			}
 		}
 
-		if perm != nil {
-			users = append(users, &User{username: string(user.User), perm: sql.PermissionFromCode(perm.Permission), sqlPrivileges: privileges})
+		if perm == nil {
+			continue
+		}
+
+		// table privileges are not part of the user listing
+		authUser, err := h.s.getUser(ctx, user.User)
+		if err != nil {
+			return nil, err
 		}
+
+		users = append(users, &User{
+			username:        string(user.User),
+			perm:            sql.PermissionFromCode(perm.Permission),
+			sqlPrivileges:   privileges,
+			tablePrivileges: tablePrivilegesOn(authUser, db.GetName()),
+		})
 	}
 
 	return users, nil
@@ -143,9 +172,10 @@ func findPermission(permissions []*schema.Permission, database string) *schema.P
 }
 
 type User struct {
-	username      string
-	perm          sql.Permission
-	sqlPrivileges []sql.SQLPrivilege
+	username        string
+	perm            sql.Permission
+	sqlPrivileges   []sql.SQLPrivilege
+	tablePrivileges []sql.TablePrivilege
 }
 
 func (usr *User) Username() string {
@@ -160,6 +190,10 @@ func (usr *User) SQLPrivileges() []sql.SQLPrivilege {
 	return usr.sqlPrivileges
 }
 
+func (usr *User) TablePrivileges() []sql.TablePrivilege {
+	return usr.tablePrivileges
+}
+
 func permCode(permission sql.Permission) uint32 {
 	switch permission {
 	case sql.PermissionReadOnly:
@@ -254,6 +288,32 @@ func (h *multidbHandler) changeSQLPrivileges(ctx context.Context, database, user
 	return err
 }
 
+func (h *multidbHandler) GrantTablePrivileges(ctx context.Context, username string, privileges []sql.TablePrivilege) error {
+	return h.changeTablePrivileges(ctx, username, privileges, (*auth.User).GrantTablePrivilege)
+}
+
+func (h *multidbHandler) RevokeTablePrivileges(ctx context.Context, username string, privileges []sql.TablePrivilege) error {
+	return h.changeTablePrivileges(ctx, username, privileges, (*auth.User).RevokeTablePrivilege)
+}
+
+func (h *multidbHandler) changeTablePrivileges(
+	ctx context.Context,
+	username string,
+	privileges []sql.TablePrivilege,
+	change func(u *auth.User, database, table, privilege string, columns []string),
+) error {
+	db, err := h.s.getDBFromCtx(ctx, "ChangeSQLPrivileges")
+	if err != nil {
+		return err
+	}
+
+	return h.s.changeUserSQLPrivileges(ctx, username, db.GetName(), func(targetUser *auth.User) {
+		for _, p := range privileges {
+			change(targetUser, db.GetName(), p.Table, string(p.Privilege), p.Columns)
+		}
+	})
+}
+
 func (h *multidbHandler) DropUser(ctx context.Context, username string) error {
 	_, err := h.s.SetActiveUser(ctx, &schema.SetActiveUserRequest{
 		Username: username,
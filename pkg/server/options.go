@@ -18,14 +18,17 @@ package server
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/pkg/database"
+	pgsqlsrv "github.com/codenotary/immudb/pkg/pgsql/server"
 	"github.com/codenotary/immudb/pkg/replication"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 
@@ -72,10 +75,15 @@ type Options struct {
 	SigningKey                  string
 	synced                      bool
 	RemoteStorageOptions        *RemoteStorageOptions
+	EncryptionOptions           *EncryptionOptions
 	StreamChunkSize             int
 	TokenExpiryTimeMin          int
 	PgsqlServer                 bool
 	PgsqlServerPort             int
+	PgsqlServerAuthMethods      []string
+	PgsqlServerCopyRowsPerTx    int
+	PgsqlServerClientCAs        *x509.CertPool
+	PgsqlServerCertUserMap      []string
 	ReplicationOptions          *ReplicationOptions
 	SessionsOptions             *sessions.Options
 	PProf                       bool
@@ -84,6 +92,7 @@ type Options struct {
 	SwaggerUIEnabled            bool
 	LogRequestMetadata          bool
 	MaxActiveDatabases          int
+	KeyIndexCommitThreshold     int
 }
 
 type RemoteStorageOptions struct {
@@ -100,6 +109,13 @@ type RemoteStorageOptions struct {
 	S3InstanceMetadataURL string
 }
 
+type EncryptionOptions struct {
+	Algorithm              string // encryption algorithm of newly created files, empty when not encrypted
+	MasterKeyFile          string // file holding the current master key
+	PreviousMasterKeyFiles []string
+	KeyProvider            encryption.KeyProvider `json:"-"` // if set, it is used instead of master key files
+}
+
 type ReplicationOptions struct {
 	IsReplica                    bool
 	SyncReplication              bool
@@ -145,10 +161,13 @@ func DefaultOptions() *Options {
 		maintenance:                 false,
 		synced:                      true,
 		RemoteStorageOptions:        DefaultRemoteStorageOptions(),
+		EncryptionOptions:           DefaultEncryptionOptions(),
 		StreamChunkSize:             stream.DefaultChunkSize,
 		TokenExpiryTimeMin:          1440,
 		PgsqlServer:                 false,
 		PgsqlServerPort:             5432,
+		PgsqlServerAuthMethods:      pgsqlsrv.DefaultAuthMethods,
+		PgsqlServerCopyRowsPerTx:    pgsqlsrv.DefaultCopyRowsPerTx,
 		ReplicationOptions:          DefaultReplicationOptions(),
 		SessionsOptions:             sessions.DefaultOptions(),
 		PProf:                       false,
@@ -167,6 +186,10 @@ func DefaultRemoteStorageOptions() *RemoteStorageOptions {
 	}
 }
 
+func DefaultEncryptionOptions() *EncryptionOptions {
+	return &EncryptionOptions{}
+}
+
 func DefaultReplicationOptions() *ReplicationOptions {
 	return &ReplicationOptions{
 		IsReplica:                    false,
@@ -381,6 +404,9 @@ func (o *Options) String() string {
 		opts = append(opts, rightPad("   external id", o.RemoteStorageOptions.S3ExternalIdentifier))
 		opts = append(opts, rightPad("   metadata url", o.RemoteStorageOptions.S3InstanceMetadataURL))
 	}
+	if o.EncryptionOptions != nil && o.EncryptionOptions.Algorithm != "" {
+		opts = append(opts, rightPad("Encryption", o.EncryptionOptions.Algorithm))
+	}
 	if o.AdminPassword == auth.SysAdminPassword {
 		opts = append(opts, "----------------------------------------")
 		opts = append(opts, "Superadmin default credentials")
@@ -502,11 +528,40 @@ func (o *Options) WithPgsqlServerPort(port int) *Options {
 	return o
 }
 
+// WithPgsqlServerAuthMethods sets the authentication methods accepted by the pgsql server
+func (o *Options) WithPgsqlServerAuthMethods(methods []string) *Options {
+	o.PgsqlServerAuthMethods = methods
+	return o
+}
+
+// WithPgsqlServerCopyRowsPerTx sets the maximum number of rows inserted by each transaction of a COPY FROM STDIN
+func (o *Options) WithPgsqlServerCopyRowsPerTx(rows int) *Options {
+	o.PgsqlServerCopyRowsPerTx = rows
+	return o
+}
+
+// WithPgsqlServerClientCAs sets the certificate authorities used to verify the client certificates presented to the pgsql server
+func (o *Options) WithPgsqlServerClientCAs(pool *x509.CertPool) *Options {
+	o.PgsqlServerClientCAs = pool
+	return o
+}
+
+// WithPgsqlServerCertUserMap sets the mappings of client certificate identities to users in the form "<identity> <user>"
+func (o *Options) WithPgsqlServerCertUserMap(mappings []string) *Options {
+	o.PgsqlServerCertUserMap = mappings
+	return o
+}
+
 func (o *Options) WithRemoteStorageOptions(remoteStorageOptions *RemoteStorageOptions) *Options {
 	o.RemoteStorageOptions = remoteStorageOptions
 	return o
 }
 
+func (o *Options) WithEncryptionOptions(encryptionOptions *EncryptionOptions) *Options {
+	o.EncryptionOptions = encryptionOptions
+	return o
+}
+
 func (o *Options) WithReplicationOptions(replicationOptions *ReplicationOptions) *Options {
 	o.ReplicationOptions = replicationOptions
 	return o
@@ -542,6 +597,13 @@ func (o *Options) WithMaxActiveDatabases(n int) *Options {
 	return o
 }
 
+// WithKeyIndexCommitThreshold sets the number of transactions after which the key index of each database
+// gets committed in the background, zero disables background commitments
+func (o *Options) WithKeyIndexCommitThreshold(threshold int) *Options {
+	o.KeyIndexCommitThreshold = threshold
+	return o
+}
+
 // RemoteStorageOptions
 
 func (opts *RemoteStorageOptions) WithS3Storage(S3Storage bool) *RemoteStorageOptions {
@@ -599,6 +661,28 @@ func (opts *RemoteStorageOptions) WithS3InstanceMetadataURL(url string) *RemoteS
 	return opts
 }
 
+// EncryptionOptions
+
+func (opts *EncryptionOptions) WithAlgorithm(algorithm string) *EncryptionOptions {
+	opts.Algorithm = algorithm
+	return opts
+}
+
+func (opts *EncryptionOptions) WithMasterKeyFile(masterKeyFile string) *EncryptionOptions {
+	opts.MasterKeyFile = masterKeyFile
+	return opts
+}
+
+func (opts *EncryptionOptions) WithPreviousMasterKeyFiles(previousMasterKeyFiles []string) *EncryptionOptions {
+	opts.PreviousMasterKeyFiles = previousMasterKeyFiles
+	return opts
+}
+
+func (opts *EncryptionOptions) WithKeyProvider(keyProvider encryption.KeyProvider) *EncryptionOptions {
+	opts.KeyProvider = keyProvider
+	return opts
+}
+
 // ReplicationOptions
 
 func (opts *ReplicationOptions) WithIsReplica(isReplica bool) *ReplicationOptions {
@@ -18,6 +18,7 @@ package server
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/logger"
@@ -102,6 +103,7 @@ func TestReplicationOptions(t *testing.T) {
 
 func TestSetOptions(t *testing.T) {
 	tlsConfig := &tls.Config{Certificates: []tls.Certificate{}}
+	clientCAs := x509.NewCertPool()
 
 	op := DefaultOptions().WithDir("immudb_dir").WithNetwork("udp").
 		WithAddress("localhost").
@@ -122,6 +124,10 @@ func TestSetOptions(t *testing.T) {
 		WithTLS(tlsConfig).
 		WithPgsqlServer(true).
 		WithPgsqlServerPort(123456).
+		WithPgsqlServerAuthMethods([]string{"md5"}).
+		WithPgsqlServerCopyRowsPerTx(500).
+		WithPgsqlServerClientCAs(clientCAs).
+		WithPgsqlServerCertUserMap([]string{"svc-a alice"}).
 		WithPProf(true).
 		WithLogFormat(logger.LogFormatJSON)
 
@@ -150,6 +156,11 @@ func TestSetOptions(t *testing.T) {
 		op.TokenExpiryTimeMin != 52 ||
 		!op.PgsqlServer ||
 		op.PgsqlServerPort != 123456 ||
+		len(op.PgsqlServerAuthMethods) != 1 ||
+		op.PgsqlServerAuthMethods[0] != "md5" ||
+		op.PgsqlServerCopyRowsPerTx != 500 ||
+		op.PgsqlServerClientCAs != clientCAs ||
+		len(op.PgsqlServerCertUserMap) != 1 ||
 		op.PProf != true ||
 		op.IsJSONLogger() != true {
 		t.Errorf("database default options mismatch")
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/errors"
+)
+
+// PGSQL_MOCK_AUTH_SECRET_FNAME is the file holding the secret used by the pgsql server
+// to derive the salts presented to unknown users
+const PGSQL_MOCK_AUTH_SECRET_FNAME = "pgsql.mockauth"
+
+const pgsqlMockAuthSecretLen = 32
+
+// getOrSetPgsqlMockAuthSecret reads the secret used to authenticate unknown users
+// or creates it if it doesn't exist, so the salts presented to them don't change across restarts
+func getOrSetPgsqlMockAuthSecret(dataDir string) ([]byte, error) {
+	fname := filepath.Join(dataDir, PGSQL_MOCK_AUTH_SECRET_FNAME)
+
+	if fileExists(fname) {
+		return ioutil.ReadFile(fname)
+	}
+
+	secret := make([]byte, pgsqlMockAuthSecretLen)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, ioutil.WriteFile(fname, secret, 0600)
+}
+
+// pgsqlAuthenticator gives the pgsql server access to users and sessions,
+// so users can be authenticated without sending their password in cleartext
+type pgsqlAuthenticator struct {
+	server *ImmuServer
+}
+
+func (a *pgsqlAuthenticator) GetUser(ctx context.Context, username string) (*auth.User, error) {
+	if !a.server.Options.auth {
+		return nil, errors.New(ErrAuthDisabled).WithCode(errors.CodProtocolViolation)
+	}
+
+	return a.server.getUser(ctx, []byte(username))
+}
+
+func (a *pgsqlAuthenticator) OpenSession(ctx context.Context, user *auth.User, database string) (string, error) {
+	if !a.server.Options.auth {
+		return "", errors.New(ErrAuthDisabled).WithCode(errors.CodProtocolViolation)
+	}
+
+	session, err := a.server.openSession(user, strings.ToLower(database))
+	if err != nil {
+		return "", err
+	}
+
+	return session.GetID(), nil
+}
+
+func (a *pgsqlAuthenticator) KeepAlive(sessionID string) {
+	a.server.SessManager.UpdateSessionActivityTime(sessionID)
+}
+
+func (a *pgsqlAuthenticator) CloseSession(sessionID string) error {
+	return a.server.SessManager.DeleteSession(sessionID)
+}
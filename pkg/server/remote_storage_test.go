@@ -512,6 +512,7 @@ func requireDataExistsOnRemoteStorage(t *testing.T, storage remotestorage.Storag
 		"testdb/aht/data/00000000.dat",
 		"testdb/aht/tree/00000000.sha",
 		"testdb/commit/00000000.txi",
+		"testdb/index/bloom/00000000.bf",
 		"testdb/index/commit/00000000.ri",
 		"testdb/index/history/00000000.hx",
 		"testdb/index/nodes/00000000.n",
@@ -541,7 +542,7 @@ func TestIndexCompactionForRemoteStorage(t *testing.T) {
 	entries, subpath, err := storage.ListEntries(context.Background(), "testdb/index/")
 	require.NoError(t, err)
 	require.Len(t, entries, 0)
-	require.Equal(t, subpath, []string{"commit0000000000000001", "history", "nodes0000000000000001"})
+	require.Equal(t, subpath, []string{"bloom0000000000000001", "commit0000000000000001", "history", "nodes0000000000000001"})
 }
 
 func TestRemoteStorageUsedForNewDB(t *testing.T) {
@@ -134,6 +134,11 @@ func (s *ImmuServer) Initialize() error {
 		return logErr(s.Logger, "unable to initialize remote storage: %v", err)
 	}
 
+	err = s.initializeEncryption()
+	if err != nil {
+		return logErr(s.Logger, "unable to initialize encryption: %v", err)
+	}
+
 	// NOTE: MaxActiveDatabases might have changed since the server instance was created
 	s.dbList.Resize(s.Options.MaxActiveDatabases)
 
@@ -264,6 +269,21 @@ func (s *ImmuServer) Initialize() error {
 	grpc_prometheus.Register(s.GrpcServer)
 
 	if s.Options.PgsqlServer {
+		err = pgsqlsrv.ValidateAuthMethods(s.Options.PgsqlServerAuthMethods)
+		if err != nil {
+			return err
+		}
+
+		certUserMappings, err := pgsqlsrv.ParseCertUserMappings(s.Options.PgsqlServerCertUserMap)
+		if err != nil {
+			return err
+		}
+
+		mockAuthSecret, err := getOrSetPgsqlMockAuthSecret(s.Options.Dir)
+		if err != nil {
+			return err
+		}
+
 		s.PgsqlSrv = pgsqlsrv.New(
 			pgsqlsrv.Host(s.Options.Address),
 			pgsqlsrv.Port(s.Options.PgsqlServerPort),
@@ -272,6 +292,12 @@ func (s *ImmuServer) Initialize() error {
 			pgsqlsrv.Logger(s.Logger),
 			pgsqlsrv.DatabaseList(s.dbList),
 			pgsqlsrv.LogRequestMetadata(s.Options.LogRequestMetadata),
+			pgsqlsrv.AuthMethods(s.Options.PgsqlServerAuthMethods...),
+			pgsqlsrv.Auth(&pgsqlAuthenticator{server: s}),
+			pgsqlsrv.ClientCAs(s.Options.PgsqlServerClientCAs),
+			pgsqlsrv.CertUserMappings(certUserMappings...),
+			pgsqlsrv.MockAuthSecret(mockAuthSecret),
+			pgsqlsrv.CopyRowsPerTx(s.Options.PgsqlServerCopyRowsPerTx),
 		)
 
 		if err = s.PgsqlSrv.Initialize(); err != nil {
@@ -884,6 +884,10 @@ func testServerSetGetBatch(ctx context.Context, s *ImmuServer, t *testing.T) {
 	_, err = s.CompactIndex(ctx, &emptypb.Empty{})
 	require.ErrorIs(t, err, tbtree.ErrCompactionThresholdNotReached)
 
+	vLogGCRes, err := s.CompactValueLog(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Zero(t, vLogGCRes.ReclaimedBytes)
+
 	_, err = s.GetAll(ctx, nil)
 	require.ErrorIs(t, err, store.ErrIllegalArguments)
 
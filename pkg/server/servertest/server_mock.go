@@ -29,6 +29,10 @@ type ServerMock struct {
 
 	PreVerifiableGetFn func(context.Context, *schema.VerifiableGetRequest)
 
+	PostKeyIndexProofFn    func(context.Context, *schema.KeyIndexProofRequest, *schema.VerifiableKeyIndexProof, error) (*schema.VerifiableKeyIndexProof, error)
+	PostVerifiableScanFn   func(context.Context, *schema.VerifiableScanRequest, *schema.VerifiableEntries, error) (*schema.VerifiableEntries, error)
+	PostVerifiableTxByIdFn func(context.Context, *schema.VerifiableTxRequest, *schema.VerifiableTx, error) (*schema.VerifiableTx, error)
+
 	PreVerifiableSetFn  func(context.Context, *schema.VerifiableSetRequest)
 	PostSetFn           func(context.Context, *schema.SetRequest, *schema.TxHeader, error) (*schema.TxHeader, error)
 	PostVerifiableSetFn func(context.Context, *schema.VerifiableSetRequest, *schema.VerifiableTx, error) (*schema.VerifiableTx, error)
@@ -100,6 +104,10 @@ func (s *ServerMock) StreamScan(request *schema.ScanRequest, scanServer schema.I
 	return s.Srv.StreamScan(request, scanServer)
 }
 
+func (s *ServerMock) StreamVerifiableScan(request *schema.VerifiableScanRequest, scanServer schema.ImmuService_StreamVerifiableScanServer) error {
+	return s.Srv.StreamVerifiableScan(request, scanServer)
+}
+
 func (s *ServerMock) StreamZScan(request *schema.ZScanRequest, zscanServer schema.ImmuService_StreamZScanServer) error {
 	return s.Srv.StreamZScan(request, zscanServer)
 }
@@ -182,6 +190,19 @@ func (s *ServerMock) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 	return s.Srv.VerifiableGet(ctx, req)
 }
 
+func (s *ServerMock) CommitKeyIndex(ctx context.Context, e *empty.Empty) (*schema.TxHeader, error) {
+	return s.Srv.CommitKeyIndex(ctx, e)
+}
+
+func (s *ServerMock) KeyIndexProof(ctx context.Context, req *schema.KeyIndexProofRequest) (*schema.VerifiableKeyIndexProof, error) {
+	if s.PostKeyIndexProofFn == nil {
+		return s.Srv.KeyIndexProof(ctx, req)
+	}
+
+	rsp, err := s.Srv.KeyIndexProof(ctx, req)
+	return s.PostKeyIndexProofFn(ctx, req, rsp, err)
+}
+
 func (s *ServerMock) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	return s.Srv.GetAll(ctx, req)
 }
@@ -190,6 +211,10 @@ func (s *ServerMock) Delete(ctx context.Context, req *schema.DeleteKeysRequest)
 	return s.Srv.Delete(ctx, req)
 }
 
+func (s *ServerMock) RedactEntries(ctx context.Context, req *schema.RedactEntriesRequest) (*schema.TxHeader, error) {
+	return s.Srv.RedactEntries(ctx, req)
+}
+
 func (s *ServerMock) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	if s.PostExecAllFn == nil {
 		return s.Srv.ExecAll(ctx, req)
@@ -203,6 +228,15 @@ func (s *ServerMock) Scan(ctx context.Context, req *schema.ScanRequest) (*schema
 	return s.Srv.Scan(ctx, req)
 }
 
+func (s *ServerMock) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	if s.PostVerifiableScanFn == nil {
+		return s.Srv.VerifiableScan(ctx, req)
+	}
+
+	rsp, err := s.Srv.VerifiableScan(ctx, req)
+	return s.PostVerifiableScanFn(ctx, req, rsp, err)
+}
+
 func (s *ServerMock) Count(ctx context.Context, req *schema.KeyPrefix) (*schema.EntryCount, error) {
 	return s.Srv.Count(ctx, req)
 }
@@ -216,13 +250,22 @@ func (s *ServerMock) TxById(ctx context.Context, req *schema.TxRequest) (*schema
 }
 
 func (s *ServerMock) VerifiableTxById(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error) {
-	return s.Srv.VerifiableTxById(ctx, req)
+	if s.PostVerifiableTxByIdFn == nil {
+		return s.Srv.VerifiableTxById(ctx, req)
+	}
+
+	rsp, err := s.Srv.VerifiableTxById(ctx, req)
+	return s.PostVerifiableTxByIdFn(ctx, req, rsp, err)
 }
 
 func (s *ServerMock) TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error) {
 	return s.Srv.TxScan(ctx, req)
 }
 
+func (s *ServerMock) SubscribeChanges(req *schema.SubscribeChangesRequest, srv schema.ImmuService_SubscribeChangesServer) error {
+	return s.Srv.SubscribeChanges(req, srv)
+}
+
 func (s *ServerMock) History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
 	return s.Srv.History(ctx, req)
 }
@@ -343,6 +386,10 @@ func (s *ServerMock) CompactIndex(ctx context.Context, req *empty.Empty) (*empty
 	return s.Srv.CompactIndex(ctx, req)
 }
 
+func (s *ServerMock) CompactValueLog(ctx context.Context, req *empty.Empty) (*schema.CompactValueLogResponse, error) {
+	return s.Srv.CompactValueLog(ctx, req)
+}
+
 func (s *ServerMock) ChangePermission(ctx context.Context, req *schema.ChangePermissionRequest) (*empty.Empty, error) {
 	return s.Srv.ChangePermission(ctx, req)
 }
@@ -43,6 +43,20 @@ func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionReque
 	if err != nil {
 		return nil, errors.Wrap(err, ErrInvalidUsernameOrPassword)
 	}
+
+	session, err := s.openSession(u, databaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.OpenSessionResponse{
+		SessionID:  session.GetID(),
+		ServerUUID: s.UUID.String(),
+	}, nil
+}
+
+// openSession opens a session on the given database for an already authenticated user
+func (s *ImmuServer) openSession(u *auth.User, databaseName string) (*sessions.Session, error) {
 	if u.Username == auth.SysAdminUsername {
 		u.IsSysAdmin = true
 	}
@@ -53,6 +67,8 @@ func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionReque
 
 	db := s.sysDB
 	if databaseName != SystemDBName {
+		var err error
+
 		db, err = s.dbList.GetByName(databaseName)
 		if err != nil {
 			return nil, err
@@ -66,15 +82,7 @@ func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionReque
 		return nil, status.Errorf(codes.PermissionDenied, "Logged in user does not have permission on this database")
 	}
 
-	session, err := s.SessManager.NewSession(u, db)
-	if err != nil {
-		return nil, err
-	}
-
-	return &schema.OpenSessionResponse{
-		SessionID:  session.GetID(),
-		ServerUUID: s.UUID.String(),
-	}, nil
+	return s.SessManager.NewSession(u, db)
 }
 
 func (s *ImmuServer) CloseSession(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
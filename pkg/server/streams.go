@@ -323,6 +323,58 @@ func (s *ImmuServer) StreamScan(req *schema.ScanRequest, str schema.ImmuService_
 	return nil
 }
 
+// StreamVerifiableScan ...
+func (s *ImmuServer) StreamVerifiableScan(req *schema.VerifiableScanRequest, str schema.ImmuService_StreamVerifiableScanServer) error {
+	db, err := s.getDBFromCtx(str.Context(), "StreamVerifiableScan")
+	if err != nil {
+		return err
+	}
+
+	proof, err := db.VerifiableScan(str.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	err = s.signKeyIndexCommitment(db.GetName(), proof.CommitmentTx)
+	if err != nil {
+		return err
+	}
+
+	values := make([]*stream.ValueSize, len(proof.Entries))
+
+	// values are sent separately to overcome limits of large values
+	for i, e := range proof.Entries {
+		values[i] = &stream.ValueSize{
+			Content: bufio.NewReader(bytes.NewBuffer(e.Entry.Value)),
+			Size:    len(e.Entry.Value),
+		}
+
+		e.Entry = &schema.Entry{
+			Tx:           e.Entry.Tx,
+			Key:          e.Entry.Key,
+			Metadata:     e.Entry.Metadata,
+			ReferencedBy: e.Entry.ReferencedBy,
+			Expired:      e.Entry.Expired,
+			Revision:     e.Entry.Revision,
+		}
+	}
+
+	proofWithoutValuesProto, err := proto.Marshal(proof)
+	if err != nil {
+		return err
+	}
+
+	vsss := s.StreamServiceFactory.NewVScanStreamSender(s.StreamServiceFactory.NewMsgSender(str))
+
+	return vsss.Send(&stream.VerifiableEntries{
+		ProofWithoutValuesProto: &stream.ValueSize{
+			Content: bufio.NewReader(bytes.NewBuffer(proofWithoutValuesProto)),
+			Size:    len(proofWithoutValuesProto),
+		},
+		Values: values,
+	})
+}
+
 // StreamZScan ...
 func (s *ImmuServer) StreamZScan(request *schema.ZScanRequest, server schema.ImmuService_StreamZScanServer) error {
 	db, err := s.getDBFromCtx(server.Context(), "ZScan")
@@ -26,6 +26,7 @@ import (
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/codenotary/immudb/pkg/truncator"
 
+	"github.com/codenotary/immudb/embedded/appendable/encryption"
 	"github.com/codenotary/immudb/embedded/remotestorage"
 	pgsqlsrv "github.com/codenotary/immudb/pkg/pgsql/server"
 	"github.com/codenotary/immudb/pkg/replication"
@@ -87,6 +88,9 @@ type ImmuServer struct {
 
 	remoteStorage remotestorage.Storage
 	SessManager   sessions.Manager
+
+	encryptionAlgorithm int
+	keyProvider         encryption.KeyProvider
 }
 
 // DefaultServer returns a new ImmuServer instance with all configuration options set to their default values.
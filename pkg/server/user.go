@@ -513,7 +513,8 @@ func (s *ImmuServer) insertNewUser(ctx context.Context, username []byte, plainPa
 			"username can only contain letters, digits and underscores")
 	}
 
-	userdata := new(auth.User)
+	userdata := &auth.User{Username: string(username)}
+
 	plainpassword, err := userdata.SetPassword(plainPassword)
 	if err != nil {
 		return nil, nil, err
@@ -521,7 +522,6 @@ func (s *ImmuServer) insertNewUser(ctx context.Context, username []byte, plainPa
 
 	userdata.Active = true
 	userdata.HasPrivileges = true
-	userdata.Username = string(username)
 	userdata.Permissions = append(userdata.Permissions, auth.Permission{Permission: permission, Database: database})
 	userdata.SQLPrivileges = defaultSQLPrivilegesForPermission(database, permission)
 	userdata.CreatedBy = createdBy
@@ -551,9 +551,40 @@ func (s *ImmuServer) getValidatedUser(ctx context.Context, username []byte, pass
 		return nil, err
 	}
 
+	if !userdata.HasPasswordVerifiers() {
+		s.setPasswordVerifiers(ctx, userdata, password)
+	}
+
 	return userdata, nil
 }
 
+// setPasswordVerifiers stores the pgsql password verifiers of a user created before they were introduced,
+// so it can then authenticate through the pgsql server without sending its password in cleartext.
+// Authentication is not affected if verifiers can not be stored, they are then set on the next attempt
+func (s *ImmuServer) setPasswordVerifiers(ctx context.Context, u *auth.User, password []byte) {
+	if s.sysDB.IsReplica() {
+		return
+	}
+
+	upgraded := *u
+	if !upgraded.HasPrivileges {
+		// default privileges are derived when the user is loaded
+		upgraded.SQLPrivileges = nil
+	}
+
+	err := upgraded.SetPasswordVerifiers(password)
+	if err == nil {
+		err = s.saveUser(ctx, &upgraded)
+	}
+	if err != nil {
+		s.Logger.Warningf("unable to set pgsql password verifiers of user '%s': %v", u.Username, err)
+		return
+	}
+
+	u.SCRAMSHA256 = upgraded.SCRAMSHA256
+	u.MD5 = upgraded.MD5
+}
+
 // getUser returns userdata (username,hashed password, permission, active) from username
 func (s *ImmuServer) getUser(ctx context.Context, username []byte) (*auth.User, error) {
 	key := make([]byte, 1+len(username))
@@ -671,63 +702,76 @@ func (s *ImmuServer) ChangeSQLPrivileges(ctx context.Context, r *schema.ChangeSQ
 		privileges[i] = string(p)
 	}
 
-	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	err := s.changeUserSQLPrivileges(ctx, r.Username, r.Database, func(targetUser *auth.User) {
+		if r.Action == schema.PermissionAction_REVOKE {
+			targetUser.RevokeSQLPrivileges(r.Database, privileges)
+		} else {
+			targetUser.GrantSQLPrivileges(r.Database, privileges)
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return &schema.ChangeSQLPrivilegesResponse{}, nil
+}
+
+// changeUserSQLPrivileges applies the change to the sql privileges the user holds on the database,
+// provided the logged user is allowed to administer them.
+func (s *ImmuServer) changeUserSQLPrivileges(ctx context.Context, username, database string, change func(targetUser *auth.User)) error {
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
 	//do not allow to change own permissions, user can lock itsself out
-	if r.Username == user.Username {
-		return nil, status.Errorf(codes.InvalidArgument, "changing your own privileges is not allowed")
+	if username == user.Username {
+		return status.Errorf(codes.InvalidArgument, "changing your own privileges is not allowed")
 	}
 
-	if r.Username == auth.SysAdminUsername {
-		return nil, status.Errorf(codes.InvalidArgument, "changing sysadmin privileges is not allowed")
+	if username == auth.SysAdminUsername {
+		return status.Errorf(codes.InvalidArgument, "changing sysadmin privileges is not allowed")
 	}
 
 	// check if user exists
-	targetUser, err := s.getUser(ctx, []byte(r.Username))
+	targetUser, err := s.getUser(ctx, []byte(username))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "user %s not found", r.Username)
+		return status.Errorf(codes.NotFound, "user %s not found", username)
 	}
 
 	// target user should be active
 	if !targetUser.Active {
-		return nil, status.Errorf(codes.FailedPrecondition, "user %s is not active", r.Username)
+		return status.Errorf(codes.FailedPrecondition, "user %s is not active", username)
 	}
 
 	// target user should have permission on the requested database
-	if targetUser.WhichPermission(r.Database) == auth.PermissionNone {
-		return nil, status.Errorf(codes.FailedPrecondition, "user %s doesn't have permission on database %s", r.Username, r.Database)
+	if targetUser.WhichPermission(database) == auth.PermissionNone {
+		return status.Errorf(codes.FailedPrecondition, "user %s doesn't have permission on database %s", username, database)
 	}
 
 	// check if requesting user has permission on this database
 	if !user.IsSysAdmin {
-		if !user.HasPermission(r.Database, auth.PermissionAdmin) {
-			return nil, status.Errorf(codes.PermissionDenied, "you do not have permission on this database")
+		if !user.HasPermission(database, auth.PermissionAdmin) {
+			return status.Errorf(codes.PermissionDenied, "you do not have permission on this database")
 		}
 	}
 
-	if r.Action == schema.PermissionAction_REVOKE {
-		targetUser.RevokeSQLPrivileges(r.Database, privileges)
-	} else {
-		targetUser.GrantSQLPrivileges(r.Database, privileges)
-	}
+	change(targetUser)
 
 	targetUser.CreatedBy = user.Username
 	targetUser.CreatedAt = time.Now()
 	targetUser.HasPrivileges = true
 
 	if err := s.saveUser(ctx, targetUser); err != nil {
-		return nil, err
+		return err
 	}
 
-	s.Logger.Infof("permissions of user %s for database %s was changed by user %s", targetUser.Username, r.Database, user.Username)
+	s.Logger.Infof("permissions of user %s for database %s was changed by user %s", targetUser.Username, database, user.Username)
 
 	// remove user from loggedin users
 	s.removeUserFromLoginList(targetUser.Username)
 
-	return &schema.ChangeSQLPrivilegesResponse{}, nil
+	return nil
 }
 
 func isValidPrivilege(p string) bool {
@@ -57,6 +57,55 @@ func TestServerLogin(t *testing.T) {
 	}
 }
 
+func TestServerLoginSetsMissingPasswordVerifiers(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithPgsqlServerPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// users created by previous versions have no pgsql password verifiers
+	u, err := s.getUser(ctx, []byte(auth.SysAdminUsername))
+	require.NoError(t, err)
+
+	u.SCRAMSHA256 = ""
+	u.MD5 = ""
+
+	err = s.saveUser(ctx, u)
+	require.NoError(t, err)
+
+	_, err = s.Login(ctx, &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte("wrong"),
+	})
+	require.Error(t, err)
+
+	u, err = s.getUser(ctx, []byte(auth.SysAdminUsername))
+	require.NoError(t, err)
+	require.False(t, u.HasPasswordVerifiers())
+
+	_, err = s.Login(ctx, &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	u, err = s.getUser(ctx, []byte(auth.SysAdminUsername))
+	require.NoError(t, err)
+	require.True(t, u.HasPasswordVerifiers())
+	require.Equal(t, auth.MD5Verifier(auth.SysAdminUsername, []byte(auth.SysAdminPassword)), u.MD5)
+}
+
 func TestServerLogout(t *testing.T) {
 	dir := t.TempDir()
 
@@ -368,6 +417,43 @@ func TestServerCreateUser(t *testing.T) {
 
 	_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM mytable"})
 	require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+	reply, err = s.UseDatabase(ctx, &schema.Database{DatabaseName: testDatabase})
+	require.NoError(t, err)
+
+	adminCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", reply.Token))
+
+	_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: "CREATE TABLE mytable(id INTEGER, ssn VARCHAR, PRIMARY KEY id); INSERT INTO mytable(id, ssn) VALUES (1, '111-11-1111')"})
+	require.NoError(t, err)
+
+	_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: fmt.Sprintf("GRANT SELECT (id) ON mytable TO %s", string(testUsername))})
+	require.NoError(t, err)
+
+	users, err = s.ListUsers(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Empty(t, users.Users[1].SqlPrivileges)
+
+	grants, err := s.UnarySQLQuery(adminCtx, &schema.SQLQueryRequest{Sql: fmt.Sprintf("SHOW GRANTS FOR %s", string(testUsername))})
+	require.NoError(t, err)
+	require.Len(t, grants.Rows, 1)
+	require.Equal(t, "mytable", grants.Rows[0].Values[2].GetS())
+	require.Equal(t, "id", grants.Rows[0].Values[3].GetS())
+
+	userCtx, err = loginAsUser(s, string(testUsername), string(testPassword))
+	require.NoError(t, err)
+
+	reply, err = s.UseDatabase(userCtx, &schema.Database{DatabaseName: testDatabase})
+	require.NoError(t, err)
+
+	userCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", reply.Token))
+
+	res, err := s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT * FROM mytable"})
+	require.NoError(t, err)
+	require.Len(t, res.Columns, 1)
+	require.Len(t, res.Rows, 1)
+
+	_, err = s.UnarySQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT ssn FROM mytable"})
+	require.Error(t, err)
 }
 
 func TestUnmarshalUserWithNoPrivileges(t *testing.T) {
@@ -27,10 +27,12 @@ var ErrMaxTxValuesLenExceeded = "max transaction values length exceeded"
 var ErrChunkTooSmall = fmt.Sprintf("minimum chunk size is %d", MinChunkSize)
 var ErrRefOptNotImplemented = "reference operation is not implemented"
 var ErrUnableToReassembleExecAllMessage = "unable to reassemble ZAdd message on a streamExecAll"
+var ErrMissingVerifiableScanEntry = "verifiable scan entry not found in the received proof"
 
 func init() {
 	errors.CodeMap[ErrMaxValueLenExceeded] = errors.CodDataException
 	errors.CodeMap[ErrMaxTxValuesLenExceeded] = errors.CodDataException
 	errors.CodeMap[ErrRefOptNotImplemented] = errors.CodUndefinedFunction
 	errors.CodeMap[ErrUnableToReassembleExecAllMessage] = errors.CodInternalError
+	errors.CodeMap[ErrMissingVerifiableScanEntry] = errors.CodInternalError
 }
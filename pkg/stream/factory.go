@@ -32,6 +32,9 @@ type ServiceFactory interface {
 	NewVEntryStreamReceiver(str MsgReceiver) VEntryStreamReceiver
 	NewVEntryStreamSender(str MsgSender) VEntryStreamSender
 
+	NewVScanStreamReceiver(str MsgReceiver) VScanStreamReceiver
+	NewVScanStreamSender(str MsgSender) VScanStreamSender
+
 	NewZStreamReceiver(str MsgReceiver) ZStreamReceiver
 	NewZStreamSender(str MsgSender) ZStreamSender
 
@@ -72,6 +75,16 @@ func (s *serviceFactory) NewVEntryStreamSender(ms MsgSender) VEntryStreamSender
 	return NewVEntryStreamSender(ms)
 }
 
+// NewVScanStreamReceiver returns a VScanStreamReceiver
+func (s *serviceFactory) NewVScanStreamReceiver(mr MsgReceiver) VScanStreamReceiver {
+	return NewVScanStreamReceiver(mr, s.ChunkSize)
+}
+
+// NewVScanStreamSender returns a VScanStreamSender
+func (s *serviceFactory) NewVScanStreamSender(ms MsgSender) VScanStreamSender {
+	return NewVScanStreamSender(ms)
+}
+
 // NewZStreamReceiver returns a ZStreamReceiver
 func (s *serviceFactory) NewZStreamReceiver(mr MsgReceiver) ZStreamReceiver {
 	return NewZStreamReceiver(mr, s.ChunkSize)
@@ -43,6 +43,11 @@ type VerifiableEntry struct {
 	Value                  *ValueSize
 }
 
+type VerifiableEntries struct {
+	ProofWithoutValuesProto *ValueSize
+	Values                  []*ValueSize
+}
+
 type ZEntry struct {
 	Set   *ValueSize
 	Key   *ValueSize
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"io"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/errors"
+	"github.com/golang/protobuf/proto"
+)
+
+// ParseVerifiableEntries ...
+func ParseVerifiableEntries(
+	proofWithoutValuesProto []byte,
+	vr io.Reader,
+	chunkSize int,
+) (*schema.VerifiableEntries, error) {
+
+	var proof schema.VerifiableEntries
+	if err := proto.Unmarshal(proofWithoutValuesProto, &proof); err != nil {
+		return nil, err
+	}
+
+	for _, e := range proof.Entries {
+		if e.GetEntry() == nil {
+			return nil, errors.New(ErrMissingVerifiableScanEntry)
+		}
+
+		value, err := ReadValue(vr, chunkSize)
+		if err != nil {
+			return nil, err
+		}
+		// set the value on the entry, as it came without it
+		e.Entry.Value = value
+	}
+
+	return &proof, nil
+}
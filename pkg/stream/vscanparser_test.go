@@ -0,0 +1,53 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVerifiableEntries(t *testing.T) {
+	_, err := ParseVerifiableEntries([]byte("not a proto message"), nil, 0)
+	require.ErrorContains(t, err, "cannot parse invalid wire-format data")
+
+	proofBs, err := proto.Marshal(&schema.VerifiableEntries{Entries: []*schema.KeyIndexEntry{{}}})
+	require.NoError(t, err)
+	_, err = ParseVerifiableEntries(proofBs, nil, 0)
+	require.ErrorContains(t, err, ErrMissingVerifiableScanEntry)
+
+	proofBs, err = proto.Marshal(&schema.VerifiableEntries{
+		Entries: []*schema.KeyIndexEntry{{Entry: &schema.Entry{Key: []byte("key1")}}},
+	})
+	require.NoError(t, err)
+	valueReader := bufio.NewReader(bytes.NewBuffer([]byte{}))
+	_, err = ParseVerifiableEntries(proofBs, valueReader, 0)
+	require.ErrorIs(t, err, io.EOF)
+
+	valueReader = bufio.NewReader(bytes.NewBuffer([]byte("value1")))
+	proof, err := ParseVerifiableEntries(proofBs, valueReader, MinChunkSize)
+	require.NoError(t, err)
+	require.Len(t, proof.Entries, 1)
+	require.Equal(t, []byte("key1"), proof.Entries[0].Entry.Key)
+	require.Equal(t, []byte("value1"), proof.Entries[0].Entry.Value)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"io"
+)
+
+type vScanStreamReceiver struct {
+	s          io.Reader
+	BufferSize int
+}
+
+// NewVScanStreamReceiver ...
+func NewVScanStreamReceiver(s io.Reader, bs int) VScanStreamReceiver {
+	return &vScanStreamReceiver{
+		s:          s,
+		BufferSize: bs,
+	}
+}
+
+func (vssr *vScanStreamReceiver) Next() ([]byte, io.Reader, error) {
+	proofWithoutValuesProto, err := ReadValue(vssr.s, vssr.BufferSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	// values are sent after the proof, one per entry, return a Reader and let the caller read them
+	return proofWithoutValuesProto, vssr.s, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/stream/streamtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVScanStreamReceiver(t *testing.T) {
+	r := bytes.NewBuffer([]byte{})
+	vssr := NewVScanStreamReceiver(r, 4096)
+	require.NotNil(t, vssr)
+}
+
+func TestVScanStreamReceiver_Next(t *testing.T) {
+	me := []*streamtest.MsgError{
+		{M: []byte(`first`), E: io.EOF},
+		{M: []byte(`second`), E: io.EOF},
+	}
+	r := streamtest.DefaultMsgReceiverMock(me)
+	vssr := NewVScanStreamReceiver(r, 4096)
+	proofWithoutValuesProto, vr, err := vssr.Next()
+	require.NoError(t, err)
+	require.Equal(t, []byte(`first`), proofWithoutValuesProto)
+	require.NotNil(t, vr)
+
+	value, err := ReadValue(vr, 4096)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`second`), value)
+}
+
+func TestVScanStreamReceiver_NextErr(t *testing.T) {
+	me := []*streamtest.MsgError{
+		{M: []byte(`first`), E: errCustom},
+	}
+	r := streamtest.DefaultMsgReceiverMock(me)
+	vssr := NewVScanStreamReceiver(r, 4096)
+	proofWithoutValuesProto, vr, err := vssr.Next()
+	require.ErrorIs(t, err, errCustom)
+	require.Nil(t, proofWithoutValuesProto)
+	require.Nil(t, vr)
+}
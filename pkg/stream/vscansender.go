@@ -0,0 +1,47 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"errors"
+	"io"
+)
+
+type vScanStreamSender struct {
+	s MsgSender
+}
+
+// NewVScanStreamSender ...
+func NewVScanStreamSender(s MsgSender) *vScanStreamSender {
+	return &vScanStreamSender{
+		s: s,
+	}
+}
+
+func (vsss *vScanStreamSender) Send(ve *VerifiableEntries) error {
+	vss := append([]*ValueSize{ve.ProofWithoutValuesProto}, ve.Values...)
+	for _, vs := range vss {
+		err := vsss.s.Send(vs.Content, vs.Size, nil)
+		if errors.Is(err, io.EOF) {
+			return vsss.s.RecvMsg(nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"io"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/stream/streamtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVScanStreamSender(t *testing.T) {
+	sm := streamtest.DefaultImmuServiceSenderStreamMock()
+	s := streamtest.DefaultMsgSenderMock(sm, 4096)
+	vsss := NewVScanStreamSender(s)
+	require.IsType(t, &vScanStreamSender{}, vsss)
+}
+
+func TestVScanStreamSender_Send(t *testing.T) {
+	sm := streamtest.DefaultImmuServiceSenderStreamMock()
+	s := streamtest.DefaultMsgSenderMock(sm, 4096)
+	vsss := NewVScanStreamSender(s)
+	ve := &VerifiableEntries{
+		ProofWithoutValuesProto: &ValueSize{
+			Content: nil,
+			Size:    0,
+		},
+		Values: []*ValueSize{
+			{
+				Content: nil,
+				Size:    0,
+			},
+		},
+	}
+
+	err := vsss.Send(ve)
+
+	require.NoError(t, err)
+}
+
+func TestVScanStreamSender_SendErr(t *testing.T) {
+	sm := streamtest.DefaultImmuServiceSenderStreamMock()
+	s := streamtest.DefaultMsgSenderMock(sm, 4096)
+	s.SendF = func(reader io.Reader, payloadSize int, metadata map[string][]byte) (err error) {
+		return errCustom
+	}
+	vsss := NewVScanStreamSender(s)
+	ve := &VerifiableEntries{
+		ProofWithoutValuesProto: &ValueSize{
+			Content: nil,
+			Size:    0,
+		},
+	}
+
+	err := vsss.Send(ve)
+
+	require.ErrorIs(t, err, errCustom)
+}
@@ -108,11 +108,6 @@ func VerifyDocument(ctx context.Context,
 
 	entries := proof.VerifiableTx.Tx.Entries
 
-	htree, err := htree.New(len(entries))
-	if err != nil {
-		return nil, err
-	}
-
 	entrySpecDigest, err := store.EntrySpecDigestFor(int(proof.VerifiableTx.Tx.Header.Version))
 	if err != nil {
 		return nil, err
@@ -130,15 +125,33 @@ func VerifyDocument(ctx context.Context,
 		digests[i] = entrySpecDigest(eSpec)
 	}
 
-	err = htree.BuildWith(digests)
-	if err != nil {
-		return nil, err
-	}
-
 	txHdr := schema.TxHeaderFromProto(proof.VerifiableTx.Tx.Header)
 
-	if htree.Root() != txHdr.Eh {
-		return nil, store.ErrInvalidProof
+	if proof.EntriesProof != nil {
+		// compact proof: only the entry of the document is included
+		entriesProof := schema.MultiInclusionProofFromProto(proof.EntriesProof)
+
+		if entriesProof.Width != txHdr.NEntries {
+			return nil, store.ErrInvalidProof
+		}
+
+		if !store.VerifyMultiInclusion(entriesProof, digests, txHdr.Eh) {
+			return nil, store.ErrInvalidProof
+		}
+	} else {
+		htree, err := htree.New(len(entries))
+		if err != nil {
+			return nil, err
+		}
+
+		err = htree.BuildWith(digests)
+		if err != nil {
+			return nil, err
+		}
+
+		if htree.Root() != txHdr.Eh {
+			return nil, store.ErrInvalidProof
+		}
 	}
 
 	dualProof := schema.DualProofV2FromProto(proof.VerifiableTx.DualProof)
@@ -217,26 +230,5 @@ func encodedKeyForDocument(collectionID uint32, documentID string) ([]byte, erro
 		return nil, err
 	}
 
-	valbuf := bytes.Buffer{}
-
-	rval := sql.NewBlob(docID[:])
-	encVal, _, err := sql.EncodeRawValueAsKey(rval.RawValue(), sql.BLOBType, document.MaxDocumentIDLength)
-	if err != nil {
-		return nil, err
-	}
-	_, err = valbuf.Write(encVal)
-	if err != nil {
-		return nil, err
-	}
-
-	pkEncVals := valbuf.Bytes()
-
-	return sql.MapKey(
-		[]byte{documentPrefix},
-		sql.RowPrefix,
-		sql.EncodeID(1), // fixed database identifier
-		sql.EncodeID(collectionID),
-		sql.EncodeID(sql.PKIndexID),
-		pkEncVals,
-	), nil
+	return document.EncodedKeyForDocument([]byte{documentPrefix}, collectionID, docID)
 }